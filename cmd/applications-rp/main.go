@@ -97,17 +97,23 @@ func main() {
 		hostingSvc = append(hostingSvc, data.NewEmbeddedETCDService(data.EmbeddedETCDServiceOptions{ClientConfigSink: client}))
 	}
 
-	builders, err := builders(options)
+	config, err := controllerconfig.New(options)
 	if err != nil {
 		log.Fatal(err) //nolint:forbidigo // this is OK inside the main function.
 	}
 
+	builders := builders(config)
+
 	hostingSvc = append(
 		hostingSvc,
 		server.NewAPIService(options, builders),
 		server.NewAsyncWorker(options, builders),
 	)
 
+	if options.Config.DriftDetection.Enabled {
+		hostingSvc = append(hostingSvc, server.NewDriftDetector(options, config, driftCheckerFactories()))
+	}
+
 	tracerOpts := options.Config.TracerProvider
 	tracerOpts.ServiceName = serviceName
 	hostingSvc = append(hostingSvc, &trace.Service{Options: tracerOpts})
@@ -129,17 +135,21 @@ func main() {
 	}
 }
 
-func builders(options hostoptions.HostOptions) ([]builder.Builder, error) {
-	config, err := controllerconfig.New(options)
-	if err != nil {
-		return nil, err
-	}
-
+func builders(config *controllerconfig.RecipeControllerConfig) []builder.Builder {
 	return []builder.Builder{
 		corerp_setup.SetupNamespace(config).GenerateBuilder(),
 		daprrp_setup.SetupNamespace(config).GenerateBuilder(),
 		msgrp_setup.SetupNamespace(config).GenerateBuilder(),
 		dsrp_setup.SetupNamespace(config).GenerateBuilder(),
 		// Add resource provider builders...
-	}, nil
+	}
+}
+
+func driftCheckerFactories() []server.DriftCheckerFactory {
+	return []server.DriftCheckerFactory{
+		corerp_setup.SetupDriftCheckers,
+		daprrp_setup.SetupDriftCheckers,
+		msgrp_setup.SetupDriftCheckers,
+		dsrp_setup.SetupDriftCheckers,
+	}
 }
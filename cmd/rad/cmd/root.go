@@ -50,7 +50,9 @@ import (
 	install_kubernetes "github.com/radius-project/radius/pkg/cli/cmd/install/kubernetes"
 	"github.com/radius-project/radius/pkg/cli/cmd/radinit"
 	recipe_list "github.com/radius-project/radius/pkg/cli/cmd/recipe/list"
+	recipe_plan "github.com/radius-project/radius/pkg/cli/cmd/recipe/plan"
 	recipe_register "github.com/radius-project/radius/pkg/cli/cmd/recipe/register"
+	recipe_rollback "github.com/radius-project/radius/pkg/cli/cmd/recipe/rollback"
 	recipe_show "github.com/radius-project/radius/pkg/cli/cmd/recipe/show"
 	recipe_unregister "github.com/radius-project/radius/pkg/cli/cmd/recipe/unregister"
 	resource_delete "github.com/radius-project/radius/pkg/cli/cmd/resource/delete"
@@ -223,9 +225,15 @@ func initSubCommands() {
 	listRecipeCmd, _ := recipe_list.NewCommand(framework)
 	recipeCmd.AddCommand(listRecipeCmd)
 
+	planRecipeCmd, _ := recipe_plan.NewCommand(framework)
+	recipeCmd.AddCommand(planRecipeCmd)
+
 	registerRecipeCmd, _ := recipe_register.NewCommand(framework)
 	recipeCmd.AddCommand(registerRecipeCmd)
 
+	rollbackRecipeCmd, _ := recipe_rollback.NewCommand(framework)
+	recipeCmd.AddCommand(rollbackRecipeCmd)
+
 	showRecipeCmd, _ := recipe_show.NewCommand(framework)
 	recipeCmd.AddCommand(showRecipeCmd)
 
@@ -47,6 +47,9 @@ const (
 	// Used for CodeOperationCanceled.
 	CodeOperationCanceled = "OperationCanceled"
 
+	// Used when an async operation was delivered to the worker more than the maximum number of times.
+	CodeMaxDeliveryCountExceeded = "MaxDeliveryCountExceeded"
+
 	// Used for invalid api version parameter
 	CodeInvalidApiVersionParameter = "InvalidApiVersionParameter"
 
@@ -13,6 +13,7 @@ import (
 	uuid "github.com/google/uuid"
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	resources "github.com/radius-project/radius/pkg/ucp/resources"
+	store "github.com/radius-project/radius/pkg/ucp/store"
 )
 
 // MockStatusManager is a mock of StatusManager interface.
@@ -94,3 +95,17 @@ func (mr *MockStatusManagerMockRecorder) Update(arg0, arg1, arg2, arg3, arg4, ar
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockStatusManager)(nil).Update), arg0, arg1, arg2, arg3, arg4, arg5)
 }
+
+// UpdateWithResource mocks base method.
+func (m *MockStatusManager) UpdateWithResource(arg0 context.Context, arg1 store.StorageClient, arg2 *store.Object, arg3 resources.ID, arg4 uuid.UUID, arg5 v1.ProvisioningState, arg6 *time.Time, arg7 *v1.ErrorDetails) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWithResource", arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWithResource indicates an expected call of UpdateWithResource.
+func (mr *MockStatusManagerMockRecorder) UpdateWithResource(arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWithResource", reflect.TypeOf((*MockStatusManager)(nil).UpdateWithResource), arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7)
+}
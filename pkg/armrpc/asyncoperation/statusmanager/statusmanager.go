@@ -60,6 +60,10 @@ type StatusManager interface {
 	QueueAsyncOperation(ctx context.Context, sCtx *v1.ARMRequestContext, options QueueOperationOptions) error
 	// Update updates an async operation status.
 	Update(ctx context.Context, id resources.ID, operationID uuid.UUID, state v1.ProvisioningState, endTime *time.Time, opError *v1.ErrorDetails) error
+	// UpdateWithResource updates an async operation status and saves the resource in the same transaction. Returns
+	// store.ErrTransactionNotSupported if the resource and the operation status are not stored together, which is
+	// always the case with CosmosDB. Callers then have to save the resource and call Update separately.
+	UpdateWithResource(ctx context.Context, resourceClient store.StorageClient, resource *store.Object, id resources.ID, operationID uuid.UUID, state v1.ProvisioningState, endTime *time.Time, opError *v1.ErrorDetails) error
 	// Delete deletes an async operation status.
 	Delete(ctx context.Context, id resources.ID, operationID uuid.UUID) error
 }
@@ -161,20 +165,63 @@ func (aom *statusManager) Get(ctx context.Context, id resources.ID, operationID
 // Update retrieves an existing operation status resource from the store, updates its fields with the
 // given parameters, and saves it back to the store.
 func (aom *statusManager) Update(ctx context.Context, id resources.ID, operationID uuid.UUID, state v1.ProvisioningState, endTime *time.Time, opError *v1.ErrorDetails) error {
-	opID := aom.operationStatusResourceID(id, operationID)
 	storeClient, err := aom.getClient(ctx, id)
 	if err != nil {
 		return err
 	}
 
-	obj, err := storeClient.Get(ctx, opID)
+	obj, err := aom.updatedStatus(ctx, storeClient, id, operationID, state, endTime, opError)
 	if err != nil {
 		return err
 	}
 
+	return storeClient.Save(ctx, obj, store.WithETag(obj.ETag))
+}
+
+// UpdateWithResource updates the operation status like Update and saves the resource, which can be nil, using its ETag.
+// Both writes are committed in a single transaction, so the provisioning state of the resource and the operation status
+// can't diverge. If the resource and the operation status are not stored together, nothing is written and
+// store.ErrTransactionNotSupported is returned.
+//
+// The resources are only stored together with their operation statuses when a single storage client serves every
+// resource type, as with etcd, the Kubernetes API server, SQL and the in-memory store. CosmosDB stores every resource
+// type, including the operation statuses, in its own collection and its transactions are scoped to a collection, so
+// with CosmosDB the resource is never saved in the same transaction as its operation status.
+func (aom *statusManager) UpdateWithResource(ctx context.Context, resourceClient store.StorageClient, resource *store.Object, id resources.ID, operationID uuid.UUID, state v1.ProvisioningState, endTime *time.Time, opError *v1.ErrorDetails) error {
+	storeClient, err := aom.getClient(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if resource != nil && resourceClient != storeClient {
+		return &store.ErrTransactionNotSupported{}
+	}
+
+	obj, err := aom.updatedStatus(ctx, storeClient, id, operationID, state, endTime, opError)
+	if err != nil {
+		return err
+	}
+
+	if resource == nil {
+		return storeClient.Save(ctx, obj, store.WithETag(obj.ETag))
+	}
+
+	tx := (&store.Transaction{}).
+		Save(resource, store.WithETag(resource.ETag)).
+		Save(obj, store.WithETag(obj.ETag))
+	return store.ExecuteTransaction(ctx, storeClient, tx)
+}
+
+// updatedStatus retrieves the operation status resource from the store and applies the given changes without saving it.
+func (aom *statusManager) updatedStatus(ctx context.Context, storeClient store.StorageClient, id resources.ID, operationID uuid.UUID, state v1.ProvisioningState, endTime *time.Time, opError *v1.ErrorDetails) (*store.Object, error) {
+	obj, err := storeClient.Get(ctx, aom.operationStatusResourceID(id, operationID))
+	if err != nil {
+		return nil, err
+	}
+
 	s := &Status{}
 	if err := obj.As(s); err != nil {
-		return err
+		return nil, err
 	}
 
 	s.Status = state
@@ -190,7 +237,7 @@ func (aom *statusManager) Update(ctx context.Context, id resources.ID, operation
 
 	obj.Data = s
 
-	return storeClient.Save(ctx, obj, store.WithETag(obj.ETag))
+	return obj, nil
 }
 
 // Delete deletes the operation status resource associated with the given ID and
@@ -30,6 +30,7 @@ import (
 	queue "github.com/radius-project/radius/pkg/ucp/queue/client"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/store/inmemory"
 	"github.com/stretchr/testify/require"
 )
 
@@ -328,3 +329,84 @@ func TestUpdateAsyncOperationStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateWithResource(t *testing.T) {
+	rid, err := resources.ParseResource(ucpEnvResourceID)
+	require.NoError(t, err)
+
+	t.Run("shared_client_commits_transaction", func(t *testing.T) {
+		mctrl := gomock.NewController(t)
+		client := inmemory.NewClient()
+		dp := dataprovider.NewMockDataStorageProvider(mctrl)
+		dp.EXPECT().GetStorageClient(gomock.Any(), "Applications.Core/operationstatuses").Return(client, nil).AnyTimes()
+		sm := New(dp, nil, "test-location").(*statusManager)
+
+		ctx := context.Background()
+		status := &store.Object{Metadata: store.Metadata{ID: sm.operationStatusResourceID(rid, opID)}, Data: testAos}
+		require.NoError(t, client.Save(ctx, status))
+		resource := &store.Object{Metadata: store.Metadata{ID: rid.String()}, Data: map[string]any{"provisioningState": "Updating"}}
+		require.NoError(t, client.Save(ctx, resource))
+
+		resource.Data = map[string]any{"provisioningState": "Succeeded"}
+		err := sm.UpdateWithResource(ctx, client, resource, rid, opID, v1.ProvisioningStateSucceeded, nil, nil)
+		require.NoError(t, err)
+
+		obj, err := client.Get(ctx, rid.String())
+		require.NoError(t, err)
+		require.Equal(t, resource.ETag, obj.ETag)
+		require.Equal(t, map[string]any{"provisioningState": "Succeeded"}, obj.Data)
+
+		updated, err := sm.Get(ctx, rid, opID)
+		require.NoError(t, err)
+		require.Equal(t, v1.ProvisioningStateSucceeded, updated.Status)
+	})
+
+	t.Run("shared_client_conflict_writes_nothing", func(t *testing.T) {
+		mctrl := gomock.NewController(t)
+		client := inmemory.NewClient()
+		dp := dataprovider.NewMockDataStorageProvider(mctrl)
+		dp.EXPECT().GetStorageClient(gomock.Any(), "Applications.Core/operationstatuses").Return(client, nil).AnyTimes()
+		sm := New(dp, nil, "test-location").(*statusManager)
+
+		ctx := context.Background()
+		status := &store.Object{Metadata: store.Metadata{ID: sm.operationStatusResourceID(rid, opID)}, Data: testAos}
+		require.NoError(t, client.Save(ctx, status))
+		resource := &store.Object{Metadata: store.Metadata{ID: rid.String(), ETag: "stale"}, Data: map[string]any{"provisioningState": "Succeeded"}}
+
+		err := sm.UpdateWithResource(ctx, client, resource, rid, opID, v1.ProvisioningStateSucceeded, nil, nil)
+		require.ErrorIs(t, err, &store.ErrConcurrency{})
+
+		unchanged, err := sm.Get(ctx, rid, opID)
+		require.NoError(t, err)
+		require.Equal(t, testAos.Status, unchanged.Status)
+	})
+
+	t.Run("separate_clients_not_supported", func(t *testing.T) {
+		aomTest, mctrl := setup(t)
+		defer mctrl.Finish()
+
+		// Neither client is expected to be called.
+		resourceClient := store.NewMockStorageClient(mctrl)
+		resource := &store.Object{Metadata: store.Metadata{ID: rid.String(), ETag: "resource-etag"}, Data: map[string]any{}}
+
+		err := aomTest.manager.UpdateWithResource(context.Background(), resourceClient, resource, rid, opID, v1.ProvisioningStateSucceeded, nil, nil)
+		require.ErrorIs(t, err, &store.ErrTransactionNotSupported{})
+	})
+
+	t.Run("nil_resource_updates_status", func(t *testing.T) {
+		aomTest, mctrl := setup(t)
+		defer mctrl.Finish()
+
+		aomTest.storeClient.
+			EXPECT().
+			Get(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(&store.Object{Metadata: store.Metadata{ID: opID.String(), ETag: "etag"}, Data: testAos}, nil)
+		aomTest.storeClient.
+			EXPECT().
+			Save(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil)
+
+		err := aomTest.manager.UpdateWithResource(context.Background(), aomTest.storeClient, nil, rid, opID, v1.ProvisioningStateSucceeded, nil, nil)
+		require.NoError(t, err)
+	})
+}
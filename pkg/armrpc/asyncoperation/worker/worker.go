@@ -65,7 +65,9 @@ type Options struct {
 	// MaxOperationConcurrency is the maximum concurrency to process async request operation.
 	MaxOperationConcurrency int
 
-	// MaxOperationRetryCount is the maximum retry count to process async request operation.
+	// MaxOperationRetryCount is the maximum number of times a message is delivered to the worker. When a message is
+	// delivered more often than this, the operation is marked as failed and the message is moved to the dead-letter
+	// queue if the queue supports it.
 	MaxOperationRetryCount int
 
 	// MessageExtendMargin is the margin duration for clock skew before extending message lock.
@@ -147,6 +149,7 @@ func (w *AsyncRequestProcessWorker) Start(ctx context.Context) error {
 			op := &ctrl.Request{}
 			if err := json.Unmarshal(msgreq.Data, op); err != nil {
 				logger.Error(err, "failed to unmarshal queue message.")
+				w.deadLetterMessage(ctx, msgreq, fmt.Sprintf("failed to unmarshal queue message: %s", err.Error()))
 				return
 			}
 
@@ -164,6 +167,7 @@ func (w *AsyncRequestProcessWorker) Start(ctx context.Context) error {
 			armReqCtx, err := op.ARMRequestContext()
 			if err != nil {
 				opLogger.Error(err, "failed to get ARM request context.")
+				w.deadLetterMessage(reqCtx, msgreq, fmt.Sprintf("failed to get ARM request context: %s", err.Error()))
 				return
 			}
 			reqCtx = v1.WithARMRequestContext(reqCtx, armReqCtx)
@@ -171,26 +175,27 @@ func (w *AsyncRequestProcessWorker) Start(ctx context.Context) error {
 			asyncCtrl := w.registry.Get(armReqCtx.OperationType)
 			if asyncCtrl == nil {
 				opLogger.Error(nil, "cannot process unknown operation: "+armReqCtx.OperationType.String())
-				if err := w.requestQueue.FinishMessage(reqCtx, msgreq); err != nil {
-					opLogger.Error(err, "failed to finish the message")
-				}
+				w.deadLetterMessage(reqCtx, msgreq, "cannot process unknown operation: "+armReqCtx.OperationType.String())
 				return
 			}
 
 			if msgreq.DequeueCount > w.options.MaxOperationRetryCount {
-				errMsg := fmt.Sprintf("exceeded max retry count to process async operation message: %d", msgreq.DequeueCount)
+				errMsg := fmt.Sprintf("Operation (%s) has failed because it was attempted %d times without completing.", armReqCtx.OperationType.String(), msgreq.DequeueCount-1)
 				opLogger.Error(nil, errMsg)
 				failed := ctrl.NewFailedResult(v1.ErrorDetails{
-					Code:    v1.CodeInternal,
+					Code:    v1.CodeMaxDeliveryCountExceeded,
 					Message: errMsg,
+					Target:  op.ResourceID,
 				})
-				w.completeOperation(reqCtx, msgreq, failed, asyncCtrl.StorageClient())
+				if err := w.updateResourceAndOperationStatus(reqCtx, asyncCtrl.StorageClient(), op, failed.ProvisioningState(), failed.Error); err != nil {
+					return
+				}
+				w.deadLetterMessage(reqCtx, msgreq, errMsg)
+				metrics.DefaultAsyncOperationMetrics.RecordAsyncOperation(reqCtx, op, &failed)
 				return
 			}
 
-			// TODO: Handle the edge cases:
-			// 1. The same message is delivered twice in multiple instances.
-			// 2. provisioningState is not matched between resource and operationStatuses
+			// TODO: Handle the edge case where the same message is delivered twice in multiple instances.
 
 			dup, err := w.isDuplicated(reqCtx, asyncCtrl.StorageClient(), op.ResourceID, op.OperationID)
 			if err != nil {
@@ -346,6 +351,18 @@ func (w *AsyncRequestProcessWorker) completeOperation(ctx context.Context, messa
 	metrics.DefaultAsyncOperationMetrics.RecordAsyncOperation(ctx, req, &result)
 }
 
+// deadLetterMessage moves a message that can't be processed to the dead-letter queue so that it isn't delivered
+// again. The message is finished instead if the queue doesn't support dead-lettering.
+func (w *AsyncRequestProcessWorker) deadLetterMessage(ctx context.Context, message *queue.Message, reason string) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	if err := queue.DeadLetterMessage(ctx, w.requestQueue, message, reason); err != nil {
+		logger.Error(err, "failed to move the message to the dead-letter queue")
+		return
+	}
+
+	logger.Info("Moved the message to the dead-letter queue.", "messageID", message.ID, "reason", reason)
+}
+
 func (w *AsyncRequestProcessWorker) updateResourceAndOperationStatus(ctx context.Context, sc store.StorageClient, req *ctrl.Request, state v1.ProvisioningState, opErr *v1.ErrorDetails) error {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
@@ -355,19 +372,28 @@ func (w *AsyncRequestProcessWorker) updateResourceAndOperationStatus(ctx context
 		return err
 	}
 
-	err = updateResourceState(ctx, sc, rID.String(), state)
+	resource, err := resourceWithState(ctx, sc, rID.String(), state)
 	if errors.Is(err, &store.ErrNotFound{}) {
 		logger.Info("failed to update the provisioningState in resource because it no longer exists.")
 	} else if err != nil {
-		logger.Error(err, "failed to update the provisioningState in resource.")
+		logger.Error(err, "failed to get the resource to update the provisioningState.")
 		return err
 	}
 
-	// Otherwise we update the operationStatus to the result.
+	// Otherwise we update the operationStatus to the result. When the storage allows it the resource is saved in the
+	// same transaction so that a crash can't leave the resource and the operationStatus with different provisioning states.
 	now := time.Now().UTC()
-	err = w.sm.Update(ctx, rID, req.OperationID, state, &now, opErr)
+	err = w.sm.UpdateWithResource(ctx, sc, resource, rID, req.OperationID, state, &now, opErr)
+	if errors.Is(err, &store.ErrTransactionNotSupported{}) {
+		// The resource and the operationStatus are stored separately, so they have to be written one after the other.
+		if err := sc.Save(ctx, resource, store.WithETag(resource.ETag)); err != nil {
+			logger.Error(err, "failed to update the provisioningState in resource.")
+			return err
+		}
+		err = w.sm.Update(ctx, rID, req.OperationID, state, &now, opErr)
+	}
 	if err != nil {
-		logger.Error(err, "failed to update operationstatus", "operationID", req.OperationID.String())
+		logger.Error(err, "failed to update the provisioningState in resource and operationstatus", "operationID", req.OperationID.String())
 		return err
 	}
 
@@ -404,10 +430,12 @@ func (w *AsyncRequestProcessWorker) getMessageExtendDuration(visibleAt time.Time
 	return d
 }
 
-func updateResourceState(ctx context.Context, sc store.StorageClient, id string, state v1.ProvisioningState) error {
+// resourceWithState gets the resource and sets its provisioningState. It returns nil if the resource is already in
+// the target state and doesn't need to be saved.
+func resourceWithState(ctx context.Context, sc store.StorageClient, id string, state v1.ProvisioningState) (*store.Object, error) {
 	obj, err := sc.Get(ctx, id)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	objmap := obj.Data.(map[string]any)
@@ -416,15 +444,10 @@ func updateResourceState(ctx context.Context, sc store.StorageClient, id string,
 		// Do not update it if provisioning state is already the target state.
 		// This happens when redeploying worker can stop completing message.
 		// So, provisioningState in Resource is updated but not in operationStatus record.
-		return nil
+		return nil, nil
 	}
 
 	objmap["provisioningState"] = string(state)
 
-	err = sc.Save(ctx, obj, store.WithETag(obj.ETag))
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return obj, nil
 }
@@ -202,6 +202,7 @@ func TestStart_UnknownOperation(t *testing.T) {
 
 	require.Equal(t, 1, testMessage.DequeueCount)
 	require.False(t, called)
+	require.Len(t, tCtx.internalQ.DeadLetters(), 1)
 }
 
 func TestStart_MaxDequeueCount(t *testing.T) {
@@ -213,8 +214,7 @@ func TestStart_MaxDequeueCount(t *testing.T) {
 		DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
 			return newTestResourceObject(), nil
 		}).AnyTimes()
-	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
-	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Eq(v1.ProvisioningStateFailed), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	tCtx.mockSM.EXPECT().UpdateWithResource(gomock.Any(), gomock.Any(), gomock.Not(gomock.Nil()), gomock.Any(), gomock.Any(), gomock.Eq(v1.ProvisioningStateFailed), gomock.Any(), gomock.Any()).Return(nil).Times(1)
 	tCtx.mockSP.EXPECT().GetStorageClient(gomock.Any(), gomock.Any()).Return(store.StorageClient(tCtx.mockSC), nil).Times(1)
 
 	expectedDequeueCount := 2
@@ -265,6 +265,11 @@ func TestStart_MaxDequeueCount(t *testing.T) {
 	<-done
 
 	require.Equal(t, expectedDequeueCount+2, testMessage.DequeueCount)
+
+	deadLetters := tCtx.internalQ.DeadLetters()
+	require.Len(t, deadLetters, 1)
+	require.Equal(t, testMessage.ID, deadLetters[0].ID)
+	require.Contains(t, deadLetters[0].DeadLetterReason, "attempted 3 times without completing")
 }
 
 func TestStart_MaxConcurrency(t *testing.T) {
@@ -276,9 +281,8 @@ func TestStart_MaxConcurrency(t *testing.T) {
 		DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
 			return newTestResourceObject(), nil
 		}).AnyTimes()
-	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	tCtx.mockSM.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(testOperationStatus, nil).AnyTimes()
-	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	tCtx.mockSM.EXPECT().UpdateWithResource(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	tCtx.mockSP.EXPECT().GetStorageClient(gomock.Any(), gomock.Any()).Return(store.StorageClient(tCtx.mockSC), nil).AnyTimes()
 
 	registry := NewControllerRegistry(tCtx.mockSP)
@@ -355,9 +359,8 @@ func TestStart_RunOperation(t *testing.T) {
 		DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
 			return newTestResourceObject(), nil
 		}).AnyTimes()
-	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	tCtx.mockSM.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(testOperationStatus, nil).AnyTimes()
-	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	tCtx.mockSM.EXPECT().UpdateWithResource(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	tCtx.mockSP.EXPECT().GetStorageClient(gomock.Any(), gomock.Any()).Return(store.StorageClient(tCtx.mockSC), nil).AnyTimes()
 
 	registry := NewControllerRegistry(tCtx.mockSP)
@@ -427,8 +430,7 @@ func TestRunOperation_Successfully(t *testing.T) {
 		DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
 			return newTestResourceObject(), nil
 		}).AnyTimes()
-	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
-	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	tCtx.mockSM.EXPECT().UpdateWithResource(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 	testMessage := genTestMessage(uuid.New(), ctrl.DefaultAsyncOperationTimeout)
 	err := tCtx.testQueue.Enqueue(tCtx.ctx, testMessage)
@@ -466,8 +468,7 @@ func TestRunOperation_ExtendMessageLock(t *testing.T) {
 		DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
 			return newTestResourceObject(), nil
 		}).AnyTimes()
-	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
-	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	tCtx.mockSM.EXPECT().UpdateWithResource(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 	testMessage := genTestMessage(uuid.New(), ctrl.DefaultAsyncOperationTimeout)
 	err := tCtx.testQueue.Enqueue(tCtx.ctx, testMessage)
@@ -554,9 +555,8 @@ func TestRunOperation_Timeout(t *testing.T) {
 		DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
 			return newTestResourceObject(), nil
 		}).AnyTimes()
-	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
-	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
-		DoAndReturn(func(_ context.Context, _ resources.ID, _ uuid.UUID, state v1.ProvisioningState, _ *time.Time, opError *v1.ErrorDetails) error {
+	tCtx.mockSM.EXPECT().UpdateWithResource(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ store.StorageClient, _ *store.Object, _ resources.ID, _ uuid.UUID, state v1.ProvisioningState, _ *time.Time, opError *v1.ErrorDetails) error {
 			if state == v1.ProvisioningStateCanceled && strings.HasPrefix(opError.Message, "Operation (APPLICATIONS.CORE/ENVIRONMENTS|PUT) has timed out because it was processing longer than") &&
 				strings.HasPrefix(opError.Target, "/subscriptions/00000000-0000-0000-0000-000000000000") {
 				return nil
@@ -628,3 +628,25 @@ func TestRunOperation_PanicController(t *testing.T) {
 
 	require.Equal(t, 1, tCtx.internalQ.Len(), "ensure that message is not finished")
 }
+
+func TestUpdateResourceAndOperationStatus_TransactionNotSupported(t *testing.T) {
+	tCtx, mctrl := newTestContext(t, defaultTestLockTime)
+	defer mctrl.Finish()
+
+	req := &ctrl.Request{
+		OperationID: uuid.New(),
+		ResourceID:  "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/environments/env0",
+	}
+
+	tCtx.mockSC.EXPECT().Get(gomock.Any(), req.ResourceID, gomock.Any()).Return(newTestResourceObject(), nil).Times(1)
+	tCtx.mockSM.EXPECT().UpdateWithResource(gomock.Any(), gomock.Any(), gomock.Not(gomock.Nil()), gomock.Any(), req.OperationID, v1.ProvisioningStateSucceeded, gomock.Any(), gomock.Any()).
+		Return(&store.ErrTransactionNotSupported{}).Times(1)
+
+	// The resource is saved first, followed by the operation status.
+	saveResource := tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Not(gomock.Nil()), gomock.Any()).Return(nil).Times(1)
+	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), req.OperationID, v1.ProvisioningStateSucceeded, gomock.Any(), gomock.Any()).Return(nil).Times(1).After(saveResource)
+
+	worker := New(Options{}, tCtx.mockSM, tCtx.testQueue, nil)
+	err := worker.updateResourceAndOperationStatus(tCtx.ctx, tCtx.mockSC, req, v1.ProvisioningStateSucceeded, nil)
+	require.NoError(t, err)
+}
@@ -37,13 +37,13 @@ func TestDefaultOptions(t *testing.T) {
 	require.Equal(t, defaultMaxOperationConcurrency, worker.options.MaxOperationConcurrency)
 }
 
-func TestUpdateResourceState(t *testing.T) {
+func TestResourceWithState(t *testing.T) {
 	updateStates := []struct {
 		tc          string
 		in          map[string]any
 		updateState v1.ProvisioningState
 		outErr      error
-		callSave    bool
+		changed     bool
 	}{
 		{
 			tc: "not found provisioningState",
@@ -53,7 +53,7 @@ func TestUpdateResourceState(t *testing.T) {
 			},
 			updateState: v1.ProvisioningStateAccepted,
 			outErr:      nil,
-			callSave:    true,
+			changed:     true,
 		},
 		{
 			tc: "not update state",
@@ -64,7 +64,7 @@ func TestUpdateResourceState(t *testing.T) {
 			},
 			updateState: v1.ProvisioningStateAccepted,
 			outErr:      nil,
-			callSave:    false,
+			changed:     false,
 		},
 		{
 			tc: "update state",
@@ -75,7 +75,7 @@ func TestUpdateResourceState(t *testing.T) {
 			},
 			updateState: v1.ProvisioningStateAccepted,
 			outErr:      nil,
-			callSave:    true,
+			changed:     true,
 		},
 	}
 
@@ -96,19 +96,15 @@ func TestUpdateResourceState(t *testing.T) {
 					}, nil
 				})
 
-			if tt.callSave {
-				mStorageClient.
-					EXPECT().
-					Save(gomock.Any(), gomock.Any(), gomock.Any()).
-					DoAndReturn(func(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
-						k := obj.Data.(map[string]any)
-						require.Equal(t, k["provisioningState"].(string), string(tt.updateState))
-						return nil
-					})
-			}
-
-			err := updateResourceState(ctx, mStorageClient, "fakeid", tt.updateState)
+			obj, err := resourceWithState(ctx, mStorageClient, "fakeid", tt.updateState)
 			require.ErrorIs(t, err, tt.outErr)
+			if tt.changed {
+				require.NotNil(t, obj)
+				k := obj.Data.(map[string]any)
+				require.Equal(t, k["provisioningState"].(string), string(tt.updateState))
+			} else {
+				require.Nil(t, obj)
+			}
 		})
 	}
 
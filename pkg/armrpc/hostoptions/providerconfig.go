@@ -29,20 +29,22 @@ import (
 
 // ProviderConfig includes the resource provider configuration.
 type ProviderConfig struct {
-	Env              EnvironmentOptions                       `yaml:"environment"`
-	Identity         IdentityOptions                          `yaml:"identity"`
-	StorageProvider  dataprovider.StorageProviderOptions      `yaml:"storageProvider"`
-	SecretProvider   sprovider.SecretProviderOptions          `yaml:"secretProvider"`
-	QueueProvider    qprovider.QueueProviderOptions           `yaml:"queueProvider"`
-	Server           *ServerOptions                           `yaml:"server,omitempty"`
-	WorkerServer     *WorkerServerOptions                     `yaml:"workerServer,omitempty"`
-	MetricsProvider  metricsprovider.MetricsProviderOptions   `yaml:"metricsProvider"`
-	TracerProvider   trace.Options                            `yaml:"tracerProvider"`
-	ProfilerProvider profilerprovider.ProfilerProviderOptions `yaml:"profilerProvider"`
-	UCP              config.UCPOptions                        `yaml:"ucp"`
-	Logging          ucplog.LoggingOptions                    `yaml:"logging"`
-	Bicep            BicepOptions                             `yaml:"bicep,omitempty"`
-	Terraform        TerraformOptions                         `yaml:"terraform,omitempty"`
+	Env               EnvironmentOptions                       `yaml:"environment"`
+	Identity          IdentityOptions                          `yaml:"identity"`
+	StorageProvider   dataprovider.StorageProviderOptions      `yaml:"storageProvider"`
+	SecretProvider    sprovider.SecretProviderOptions          `yaml:"secretProvider"`
+	QueueProvider     qprovider.QueueProviderOptions           `yaml:"queueProvider"`
+	Server            *ServerOptions                           `yaml:"server,omitempty"`
+	WorkerServer      *WorkerServerOptions                     `yaml:"workerServer,omitempty"`
+	MetricsProvider   metricsprovider.MetricsProviderOptions   `yaml:"metricsProvider"`
+	TracerProvider    trace.Options                            `yaml:"tracerProvider"`
+	ProfilerProvider  profilerprovider.ProfilerProviderOptions `yaml:"profilerProvider"`
+	UCP               config.UCPOptions                        `yaml:"ucp"`
+	Logging           ucplog.LoggingOptions                    `yaml:"logging"`
+	Bicep             BicepOptions                             `yaml:"bicep,omitempty"`
+	Terraform         TerraformOptions                         `yaml:"terraform,omitempty"`
+	DriftDetection    DriftDetectionOptions                    `yaml:"driftDetection,omitempty"`
+	RecipeConcurrency RecipeConcurrencyOptions                 `yaml:"recipeConcurrency,omitempty"`
 
 	// FeatureFlags includes the list of feature flags.
 	FeatureFlags []string `yaml:"featureFlags"`
@@ -83,4 +85,47 @@ type BicepOptions struct {
 type TerraformOptions struct {
 	// Path is the path to the directory mounted to the container where terraform can be installed and executed.
 	Path string `yaml:"path,omitempty"`
+
+	// Version is the default version of Terraform, used when the environment doesn't pin a version. The latest version
+	// is used if it is empty.
+	Version string `yaml:"version,omitempty"`
+
+	// CachePath is the path to the directory where Terraform binaries are cached and reused across executions. Defaults
+	// to the "cache" directory under Path.
+	CachePath string `yaml:"cachePath,omitempty"`
+
+	// ExecPath is the path to a pre-provisioned Terraform binary. Terraform is never downloaded if it is set.
+	ExecPath string `yaml:"execPath,omitempty"`
+
+	// MirrorURL is the base URL of a mirror of https://releases.hashicorp.com used to download Terraform.
+	MirrorURL string `yaml:"mirrorURL,omitempty"`
+
+	// Offline disables downloading Terraform from https://releases.hashicorp.com. Terraform must be available from
+	// ExecPath, the cache or MirrorURL.
+	Offline bool `yaml:"offline,omitempty"`
+}
+
+// DriftDetectionOptions includes options for the detection of drift between the resources deployed by recipes and
+// their recorded state.
+type DriftDetectionOptions struct {
+	// Enabled enables the periodic drift check of resources deployed by recipes.
+	Enabled bool `yaml:"enabled,omitempty"`
+
+	// Interval is the interval between two drift checks, e.g. "30m". Defaults to 1h.
+	Interval string `yaml:"interval,omitempty"`
+
+	// AutoReconcile queues an update operation of a resource to re-execute its recipe when drift is detected.
+	AutoReconcile bool `yaml:"autoReconcile,omitempty"`
+}
+
+// RecipeConcurrencyOptions includes options limiting the number of recipe operations running concurrently. Operations
+// beyond the limits wait in a queue.
+type RecipeConcurrencyOptions struct {
+	// MaxPerEnvironment is the maximum number of recipe operations running concurrently for an environment.
+	// Operations are not limited per environment if it is zero.
+	MaxPerEnvironment int `yaml:"maxPerEnvironment,omitempty"`
+
+	// MaxPerDriver is the maximum number of recipe operations running concurrently for each driver, keyed by driver
+	// name, e.g. "terraform". Drivers without a limit are not limited.
+	MaxPerDriver map[string]int `yaml:"maxPerDriver,omitempty"`
 }
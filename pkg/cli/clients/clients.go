@@ -83,9 +83,26 @@ type DeploymentResult struct {
 	Outputs   map[string]DeploymentOutput
 }
 
+// DeploymentChange describes the change that a deployment would make to a resource.
+type DeploymentChange struct {
+	// Resource is the ID of the resource that would be changed.
+	Resource string
+
+	// ChangeType is the type of the change, for example Create, Modify, Delete or NoChange.
+	ChangeType string
+}
+
+// WhatIfResult is the result of a what-if operation on an ARM-JSON template.
+type WhatIfResult struct {
+	Changes []DeploymentChange
+}
+
 // DeploymentClient is used to deploy ARM-JSON templates (compiled Bicep output).
 type DeploymentClient interface {
 	Deploy(ctx context.Context, options DeploymentOptions) (DeploymentResult, error)
+
+	// WhatIf returns the changes that deploying the template would make, without deploying it.
+	WhatIf(ctx context.Context, options DeploymentOptions) (WhatIfResult, error)
 }
 
 //go:generate mockgen -destination=./mock_diagnosticsclient.go -package=clients -self_package github.com/radius-project/radius/pkg/cli/clients github.com/radius-project/radius/pkg/cli/clients DiagnosticsClient
@@ -144,6 +161,13 @@ type ApplicationsManagementClient interface {
 	ListAllResourcesByEnvironment(ctx context.Context, environmentName string) ([]generated.GenericResource, error)
 	ShowResource(ctx context.Context, resourceType string, resourceName string) (generated.GenericResource, error)
 	DeleteResource(ctx context.Context, resourceType string, resourceName string) (bool, error)
+
+	// CreateOrUpdateResource creates or updates the given resource and waits until the operation completes.
+	CreateOrUpdateResource(ctx context.Context, resourceType string, resourceName string, resource generated.GenericResource) (generated.GenericResource, error)
+
+	// PlanResource computes the changes that the execution of the recipe of the given resource would make, without deploying anything.
+	PlanResource(ctx context.Context, resourceType string, resourceName string, resource generated.GenericResource) (generated.RecipePlanResponse, error)
+
 	ListApplications(ctx context.Context) ([]corerp.ApplicationResource, error)
 	ShowApplication(ctx context.Context, applicationName string) (corerp.ApplicationResource, error)
 	GetGraph(ctx context.Context, applicationName string) (corerp.ApplicationGraphResponse, error)
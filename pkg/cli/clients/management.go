@@ -210,6 +210,43 @@ func (amc *UCPApplicationsManagementClient) DeleteResource(ctx context.Context,
 	return respFromCtx.StatusCode != 204, nil
 }
 
+// CreateOrUpdateResource creates a new client, sends a create or update request for the resource, polls until the
+// request is completed, and returns the resulting resource or an error if one occurred.
+func (amc *UCPApplicationsManagementClient) CreateOrUpdateResource(ctx context.Context, resourceType string, resourceName string, resource generated.GenericResource) (generated.GenericResource, error) {
+	client, err := generated.NewGenericResourcesClient(amc.RootScope, resourceType, &aztoken.AnonymousCredential{}, amc.ClientOptions)
+	if err != nil {
+		return generated.GenericResource{}, err
+	}
+
+	poller, err := client.BeginCreateOrUpdate(ctx, resourceName, resource, &generated.GenericResourcesClientBeginCreateOrUpdateOptions{})
+	if err != nil {
+		return generated.GenericResource{}, err
+	}
+
+	response, err := poller.PollUntilDone(ctx, nil)
+	if err != nil {
+		return generated.GenericResource{}, err
+	}
+
+	return response.GenericResource, nil
+}
+
+// PlanResource creates a new client and sends the resource to the plan action of its resource provider, returning
+// the changes that the execution of the recipe of the resource would make, or an error if one occurred.
+func (amc *UCPApplicationsManagementClient) PlanResource(ctx context.Context, resourceType string, resourceName string, resource generated.GenericResource) (generated.RecipePlanResponse, error) {
+	client, err := generated.NewGenericResourcesClient(amc.RootScope, resourceType, &aztoken.AnonymousCredential{}, amc.ClientOptions)
+	if err != nil {
+		return generated.RecipePlanResponse{}, err
+	}
+
+	response, err := client.Plan(ctx, resourceName, resource, &generated.GenericResourcesClientPlanOptions{})
+	if err != nil {
+		return generated.RecipePlanResponse{}, err
+	}
+
+	return response.RecipePlanResponse, nil
+}
+
 // ListApplications() retrieves a list of ApplicationResource objects from the Azure API
 // and returns them in a slice, or an error if one occurs.
 func (amc *UCPApplicationsManagementClient) ListApplications(ctx context.Context) ([]corerpv20231001.ApplicationResource, error) {
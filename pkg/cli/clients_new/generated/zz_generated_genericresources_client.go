@@ -330,3 +330,53 @@ func (client *GenericResourcesClient) listSecretsHandleResponse(resp *http.Respo
 	return result, nil
 }
 
+// Plan - Computes the changes that the execution of the recipe of a resource would make, without deploying anything
+// If the operation fails it returns an *azcore.ResponseError type.
+// Generated from API version 2023-10-01-preview
+// resourceName - The name of the generic resource
+// genericResourceParameters - generic resource to plan
+// options - GenericResourcesClientPlanOptions contains the optional parameters for the GenericResourcesClient.Plan method.
+func (client *GenericResourcesClient) Plan(ctx context.Context, resourceName string, genericResourceParameters GenericResource, options *GenericResourcesClientPlanOptions) (GenericResourcesClientPlanResponse, error) {
+	req, err := client.planCreateRequest(ctx, resourceName, genericResourceParameters, options)
+	if err != nil {
+		return GenericResourcesClientPlanResponse{}, err
+	}
+	resp, err := client.pl.Do(req)
+	if err != nil {
+		return GenericResourcesClientPlanResponse{}, err
+	}
+	if !runtime.HasStatusCode(resp, http.StatusOK) {
+		return GenericResourcesClientPlanResponse{}, runtime.NewResponseError(resp)
+	}
+	return client.planHandleResponse(resp)
+}
+
+// planCreateRequest creates the Plan request.
+func (client *GenericResourcesClient) planCreateRequest(ctx context.Context, resourceName string, genericResourceParameters GenericResource, options *GenericResourcesClientPlanOptions) (*policy.Request, error) {
+	urlPath := "/{rootScope}/providers/{resourceType}/{resourceName}/plan"
+	urlPath = strings.ReplaceAll(urlPath, "{rootScope}", client.rootScope)
+	urlPath = strings.ReplaceAll(urlPath, "{resourceType}", client.resourceType)
+	if resourceName == "" {
+		return nil, errors.New("parameter resourceName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{resourceName}", url.PathEscape(resourceName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.host, urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	return req, runtime.MarshalAsJSON(req, genericResourceParameters)
+}
+
+// planHandleResponse handles the Plan response.
+func (client *GenericResourcesClient) planHandleResponse(resp *http.Response) (GenericResourcesClientPlanResponse, error) {
+	result := GenericResourcesClientPlanResponse{}
+	if err := runtime.UnmarshalAsJSON(resp, &result.RecipePlanResponse); err != nil {
+		return GenericResourcesClientPlanResponse{}, err
+	}
+	return result, nil
+}
+
@@ -97,6 +97,11 @@ type GenericResourcesClientListSecretsOptions struct {
 	// placeholder for future optional parameters
 }
 
+// GenericResourcesClientPlanOptions contains the optional parameters for the GenericResourcesClient.Plan method.
+type GenericResourcesClientPlanOptions struct {
+	// placeholder for future optional parameters
+}
+
 // GenericResourcesList - Object that includes an array of GenericResources and a possible link for next set
 type GenericResourcesList struct {
 	// The link used to fetch the next page of resource list.
@@ -106,6 +111,33 @@ type GenericResourcesList struct {
 	Value []*GenericResource `json:"value,omitempty"`
 }
 
+// RecipePlanResponse - Response to a recipe plan request
+type RecipePlanResponse struct {
+	// The changes that the execution of the recipe would make
+	Changes []*RecipeResourceChange `json:"changes,omitempty"`
+
+	// TemplateKind is the kind of the recipe template used by the portable resource upon deployment.
+	TemplateKind *string `json:"templateKind,omitempty"`
+
+	// TemplatePath is the path of the recipe consumed by the portable resource upon deployment.
+	TemplatePath *string `json:"templatePath,omitempty"`
+
+	// TemplateVersion is the version number of the template.
+	TemplateVersion *string `json:"templateVersion,omitempty"`
+}
+
+// RecipeResourceChange - A change that the execution of a recipe would make to a resource
+type RecipeResourceChange struct {
+	// The action that would be taken on the resource
+	Action *string `json:"action,omitempty"`
+
+	// The identifier of the resource
+	ID *string `json:"id,omitempty"`
+
+	// The type of the resource
+	Type *string `json:"type,omitempty"`
+}
+
 // Resource - Common fields that are returned in the response for all Azure Resource Manager resources
 type Resource struct {
 	// READ-ONLY; Fully qualified resource ID for the resource. Ex - /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}
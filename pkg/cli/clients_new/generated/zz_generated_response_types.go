@@ -34,3 +34,8 @@ type GenericResourcesClientListSecretsResponse struct {
 	Value map[string]*string
 }
 
+// GenericResourcesClientPlanResponse contains the response from method GenericResourcesClient.Plan.
+type GenericResourcesClientPlanResponse struct {
+	RecipePlanResponse
+}
+
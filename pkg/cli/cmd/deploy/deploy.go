@@ -29,6 +29,7 @@ import (
 	"github.com/radius-project/radius/pkg/cli/connections"
 	"github.com/radius-project/radius/pkg/cli/deploy"
 	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/objectformats"
 	"github.com/radius-project/radius/pkg/cli/output"
 	"github.com/radius-project/radius/pkg/cli/workspaces"
 	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
@@ -98,6 +99,10 @@ rad deploy myapp.bicep --parameters @myfile.json
 
 # specify parameters from multiple sources
 rad deploy myapp.bicep --parameters @myfile.json --parameters version=latest
+
+
+# show the changes that the deployment would make, without deploying anything
+rad deploy myapp.bicep --what-if
 `,
 		Args: cobra.ExactArgs(1),
 		RunE: framework.RunCommand(runner),
@@ -108,6 +113,7 @@ rad deploy myapp.bicep --parameters @myfile.json --parameters version=latest
 	commonflags.AddEnvironmentNameFlag(cmd)
 	commonflags.AddApplicationNameFlag(cmd)
 	commonflags.AddParameterFlag(cmd)
+	cmd.Flags().BoolVar(&runner.WhatIf, "what-if", false, "Show the changes that the deployment would make, without deploying anything")
 
 	return cmd, runner
 }
@@ -126,6 +132,7 @@ type Runner struct {
 	Parameters      map[string]map[string]any
 	Workspace       *workspaces.Workspace
 	Providers       *clients.Providers
+	WhatIf          bool
 }
 
 // NewRunner creates a new instance of the `rad deploy` runner.
@@ -246,6 +253,10 @@ func (r *Runner) Run(ctx context.Context) error {
 		return err
 	}
 
+	if r.WhatIf {
+		return r.runWhatIf(ctx, template)
+	}
+
 	// Create application if specified. This supports the case where the application resource
 	// is not specified in Bicep. Creating the application automatically helps us "bootstrap" in a new environment.
 	if r.ApplicationName != "" {
@@ -303,3 +314,28 @@ func (r *Runner) Run(ctx context.Context) error {
 
 	return nil
 }
+
+// runWhatIf asks the deployment engine for the changes that deploying the template would make and prints them.
+// Nothing is deployed, and the application is not created.
+func (r *Runner) runWhatIf(ctx context.Context, template map[string]any) error {
+	r.Output.LogInfo("Computing the changes that deploying template '%v' into environment '%v' from workspace '%v' would make...", r.FilePath, r.EnvironmentName, r.Workspace.Name)
+
+	result, err := r.Deploy.WhatIf(ctx, deploy.Options{
+		ConnectionFactory: r.ConnectionFactory,
+		Workspace:         *r.Workspace,
+		Template:          template,
+		Parameters:        r.Parameters,
+		Providers:         r.Providers,
+	})
+	if err != nil {
+		return err
+	}
+
+	r.Output.LogInfo("")
+	if len(result.Changes) == 0 {
+		r.Output.LogInfo("No changes. The deployment would not modify any resources.")
+		return nil
+	}
+
+	return r.Output.WriteFormatted(output.FormatTable, result.Changes, objectformats.GetDeploymentChangeTableFormat())
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/radius-project/radius/pkg/cli/connections"
 	"github.com/radius-project/radius/pkg/cli/deploy"
 	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/objectformats"
 	"github.com/radius-project/radius/pkg/cli/output"
 	"github.com/radius-project/radius/pkg/cli/workspaces"
 	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
@@ -491,4 +492,59 @@ func Test_Run(t *testing.T) {
 		// is always empty.
 		require.Empty(t, outputSink.Writes)
 	})
+	t.Run("What-if deployment", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bicep := bicep.NewMockInterface(ctrl)
+		bicep.EXPECT().
+			PrepareTemplate("app.bicep").
+			Return(map[string]any{}, nil).
+			Times(1)
+
+		changes := []clients.DeploymentChange{
+			{
+				Resource:   "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/frontend",
+				ChangeType: "Create",
+			},
+		}
+
+		// The application is not created and nothing is deployed in what-if mode.
+		deployMock := deploy.NewMockInterface(ctrl)
+		deployMock.EXPECT().
+			WhatIf(gomock.Any(), gomock.Any()).
+			Return(clients.WhatIfResult{Changes: changes}, nil).
+			Times(1)
+
+		workspace := &workspaces.Workspace{
+			Connection: map[string]any{
+				"kind":    "kubernetes",
+				"context": "kind-kind",
+			},
+			Name: "kind-kind",
+		}
+		outputSink := &output.MockOutput{}
+
+		runner := &Runner{
+			Bicep:           bicep,
+			Deploy:          deployMock,
+			Output:          outputSink,
+			FilePath:        "app.bicep",
+			ApplicationName: "test-app",
+			EnvironmentName: radcli.TestEnvironmentName,
+			Parameters:      map[string]map[string]any{},
+			Workspace:       workspace,
+			Providers:       &clients.Providers{Radius: &clients.RadiusProvider{}},
+			WhatIf:          true,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		require.Equal(t, output.FormattedOutput{
+			Format:  output.FormatTable,
+			Obj:     changes,
+			Options: objectformats.GetDeploymentChangeTableFormat(),
+		}, outputSink.Writes[len(outputSink.Writes)-1])
+	})
 }
@@ -62,10 +62,18 @@ func RecipeParametersFormat() output.FormatterOptions {
 				Heading:  "TYPE",
 				JSONPath: "{ .Type }",
 			},
+			{
+				Heading:  "REQUIRED",
+				JSONPath: "{ .Required }",
+			},
 			{
 				Heading:  "DEFAULT VALUE",
 				JSONPath: "{ .DefaultValue }",
 			},
+			{
+				Heading:  "ALLOWED VALUES",
+				JSONPath: "{ .AllowedValues }",
+			},
 			{
 				Heading:  "MIN",
 				JSONPath: "{ .MinValue }",
@@ -74,6 +82,52 @@ func RecipeParametersFormat() output.FormatterOptions {
 				Heading:  "MAX",
 				JSONPath: "{ .MaxValue }",
 			},
+			{
+				Heading:  "DESCRIPTION",
+				JSONPath: "{ .Description }",
+			},
+		},
+	}
+}
+
+// RecipePlanChangesFormat returns a FormatterOptions struct containing the column headings and JSONPaths for the
+// table of changes that the execution of a recipe would make.
+func RecipePlanChangesFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "ACTION",
+				JSONPath: "{ .Action }",
+			},
+			{
+				Heading:  "TYPE",
+				JSONPath: "{ .Type }",
+			},
+			{
+				Heading:  "RESOURCE",
+				JSONPath: "{ .ID }",
+			},
+		},
+	}
+}
+
+// RecipeRollbackFormat returns a FormatterOptions struct containing the column headings and JSONPaths for the
+// table of resources redeployed by a recipe rollback.
+func RecipeRollbackFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "RESOURCE",
+				JSONPath: "{ .Name }",
+			},
+			{
+				Heading:  "PREVIOUS VERSION",
+				JSONPath: "{ .PreviousVersion }",
+			},
+			{
+				Heading:  "VERSION",
+				JSONPath: "{ .Version }",
+			},
 		},
 	}
 }
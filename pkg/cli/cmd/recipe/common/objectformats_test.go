@@ -47,14 +47,46 @@ func Test_RecipeParametersFormat(t *testing.T) {
 		Name:         "test",
 		DefaultValue: 1,
 		Type:         "test-type",
+		Required:     true,
 		MaxValue:     "3",
 		MinValue:     "4",
+		Description:  "test-description",
 	}
 
 	buffer := &bytes.Buffer{}
 	err := output.Write(output.FormatTable, obj, buffer, RecipeParametersFormat())
 	require.NoError(t, err)
 
-	expected := "PARAMETER  TYPE       DEFAULT VALUE  MIN       MAX\ntest       test-type  1              4         3\n"
+	expected := "PARAMETER  TYPE       REQUIRED  DEFAULT VALUE  ALLOWED VALUES  MIN       MAX       DESCRIPTION\ntest       test-type  true      1                              4         3         test-description\n"
+	require.Equal(t, expected, buffer.String())
+}
+
+func Test_RecipePlanChangesFormat(t *testing.T) {
+	obj := types.RecipePlanChange{
+		ID:     "test-id",
+		Type:   "test-type",
+		Action: "create",
+	}
+
+	buffer := &bytes.Buffer{}
+	err := output.Write(output.FormatTable, obj, buffer, RecipePlanChangesFormat())
+	require.NoError(t, err)
+
+	expected := "ACTION    TYPE       RESOURCE\ncreate    test-type  test-id\n"
+	require.Equal(t, expected, buffer.String())
+}
+
+func Test_RecipeRollbackFormat(t *testing.T) {
+	obj := types.RecipeRollback{
+		Name:            "test-resource",
+		PreviousVersion: "v2",
+		Version:         "v1",
+	}
+
+	buffer := &bytes.Buffer{}
+	err := output.Write(output.FormatTable, obj, buffer, RecipeRollbackFormat())
+	require.NoError(t, err)
+
+	expected := "RESOURCE       PREVIOUS VERSION  VERSION\ntest-resource  v2                v1\n"
 	require.Equal(t, expected, buffer.String())
 }
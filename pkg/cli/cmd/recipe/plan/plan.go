@@ -0,0 +1,251 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plan
+
+import (
+	"context"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/bicep"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	types "github.com/radius-project/radius/pkg/cli/cmd/recipe"
+	"github.com/radius-project/radius/pkg/cli/cmd/recipe/common"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad recipe plan` command.
+//
+
+// NewCommand creates a new cobra command that shows the changes that the execution of the recipe of a portable resource
+// would make, without deploying anything, with the option to override the recipe parameters and customize the output format.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "plan [resource-type] [resource-name]",
+		Short: "Show the changes that a recipe would make",
+		Long: `Show the changes that a recipe would make
+
+The recipe plan command outputs the infrastructure changes that running the recipe of a portable resource would make, without making them. Terraform recipes are planned with 'terraform plan', Bicep recipes with a what-if deployment, and Helm and Kubernetes recipes by rendering the objects.
+
+Each change is reported with the action that would be taken on the resource: create, update, replace, delete or noChange.
+
+You can override the recipe parameters of the resource using the '--parameters' flag ('-p' for short), in the same way as 'rad recipe register'.
+
+By default, the command is scoped to the resource group defined in your rad.yaml workspace file. You can optionally override this value through the group flag.
+
+By default, the command outputs a human-readable table. You can customize the output format with the output flag.`,
+		Example: `
+# show the changes that the recipe of a redis cache would make
+rad recipe plan Applications.Datastores/redisCaches redis
+
+# show the changes with different recipe parameters
+rad recipe plan Applications.Datastores/redisCaches redis --parameters sku=premium
+
+# show the changes in JSON format
+rad recipe plan Applications.Datastores/redisCaches redis --output json`,
+		RunE: framework.RunCommand(runner),
+		Args: cobra.ExactArgs(2),
+	}
+
+	commonflags.AddOutputFlag(cmd)
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	commonflags.AddParameterFlag(cmd)
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad recipe plan` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+	ResourceType      string
+	ResourceName      string
+	Parameters        map[string]map[string]any
+	Format            string
+}
+
+// NewRunner creates a new instance of the `rad recipe plan` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:      factory.GetConfigHolder(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad recipe plan` command.
+//
+
+// Validate takes in a command and a slice of strings and validates the command line arguments, setting the workspace,
+// resource type, resource name, parameters and output format in the Runner struct. It returns an error if any of the
+// arguments are invalid.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	// Allow --group to override the scope
+	scope, err := cli.RequireScope(cmd, *workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Scope = scope
+
+	r.ResourceType, r.ResourceName, err = cli.RequireResourceTypeAndName(args)
+	if err != nil {
+		return err
+	}
+
+	parameterArgs, err := cmd.Flags().GetStringArray("parameters")
+	if err != nil {
+		return err
+	}
+
+	parser := bicep.ParameterParser{FileSystem: bicep.OSFileSystem{}}
+	r.Parameters, err = parser.Parse(parameterArgs...)
+	if err != nil {
+		return err
+	}
+
+	format, err := cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+	if format == "" {
+		format = "table"
+	}
+	r.Format = format
+
+	return nil
+}
+
+// Run runs the `rad recipe plan` command.
+//
+
+// Run retrieves the resource, applies the parameter overrides to its recipe, asks the resource provider for the changes
+// that the recipe would make and prints them in the specified format. It returns an error if one occurs.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	resource, err := client.ShowResource(ctx, r.ResourceType, r.ResourceName)
+	if clients.Is404Error(err) {
+		return clierrors.Message("The resource %q of type %q was not found or has been deleted.", r.ResourceName, r.ResourceType)
+	} else if err != nil {
+		return err
+	}
+
+	recipe, ok := resource.Properties["recipe"].(map[string]any)
+	if !ok {
+		return clierrors.Message("The resource %q of type %q is not provisioned by a recipe.", r.ResourceName, r.ResourceType)
+	}
+
+	plan, err := client.PlanResource(ctx, r.ResourceType, r.ResourceName, r.planRequest(resource, recipe))
+	if err != nil {
+		return err
+	}
+
+	if r.Format != output.FormatTable {
+		return r.Output.WriteFormatted(r.Format, plan, common.RecipePlanChangesFormat())
+	}
+
+	recipeName, _ := recipe["name"].(string)
+	err = r.Output.WriteFormatted(r.Format, types.EnvironmentRecipe{
+		Name:            recipeName,
+		ResourceType:    r.ResourceType,
+		TemplateKind:    to.String(plan.TemplateKind),
+		TemplatePath:    to.String(plan.TemplatePath),
+		TemplateVersion: to.String(plan.TemplateVersion),
+	}, common.RecipeFormat())
+	if err != nil {
+		return err
+	}
+
+	r.Output.LogInfo("")
+
+	changes := []types.RecipePlanChange{}
+	for _, change := range plan.Changes {
+		if change == nil {
+			continue
+		}
+		changes = append(changes, types.RecipePlanChange{
+			ID:     to.String(change.ID),
+			Type:   to.String(change.Type),
+			Action: to.String(change.Action),
+		})
+	}
+
+	if len(changes) == 0 {
+		r.Output.LogInfo("No changes. The infrastructure matches the recipe.")
+		return nil
+	}
+
+	return r.Output.WriteFormatted(r.Format, changes, common.RecipePlanChangesFormat())
+}
+
+// planRequest builds the body of the plan request from the existing resource. Read-only properties are dropped and
+// the parameters passed on the command line override the recipe parameters of the resource.
+func (r *Runner) planRequest(resource generated.GenericResource, recipe map[string]any) generated.GenericResource {
+	properties := map[string]any{}
+	for k, v := range resource.Properties {
+		if k == "status" || k == "provisioningState" {
+			continue
+		}
+		properties[k] = v
+	}
+
+	if len(r.Parameters) > 0 {
+		parameters := map[string]any{}
+		if existing, ok := recipe["parameters"].(map[string]any); ok {
+			for k, v := range existing {
+				parameters[k] = v
+			}
+		}
+		for k, v := range bicep.ConvertToMapStringInterface(r.Parameters) {
+			parameters[k] = v
+		}
+
+		overridden := map[string]any{}
+		for k, v := range recipe {
+			overridden[k] = v
+		}
+		overridden["parameters"] = parameters
+		properties["recipe"] = overridden
+	}
+
+	return generated.GenericResource{
+		Location:   resource.Location,
+		Tags:       resource.Tags,
+		Properties: properties,
+	}
+}
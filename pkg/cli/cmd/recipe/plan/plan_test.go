@@ -0,0 +1,233 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plan
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	types "github.com/radius-project/radius/pkg/cli/cmd/recipe"
+	"github.com/radius-project/radius/pkg/cli/cmd/recipe/common"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	datastoresrp "github.com/radius-project/radius/pkg/datastoresrp/frontend/controller"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Valid Plan Command",
+			Input:         []string{datastoresrp.RedisCachesResourceType, "redis"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Valid Plan Command with parameters",
+			Input:         []string{"redisCaches", "redis", "--parameters", "sku=premium"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Plan Command with invalid resource type",
+			Input:         []string{"invalidType", "redis"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Plan Command without resource name",
+			Input:         []string{datastoresrp.RedisCachesResourceType},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Plan Command with too many positional args",
+			Input:         []string{datastoresrp.RedisCachesResourceType, "redis", "arg3"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	resource := generated.GenericResource{
+		Location: to.Ptr("global"),
+		Properties: map[string]any{
+			"environment":       "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/test-env",
+			"provisioningState": "Succeeded",
+			"status":            map[string]any{"outputResources": []any{}},
+			"recipe": map[string]any{
+				"name":       "default",
+				"parameters": map[string]any{"port": float64(6379)},
+			},
+		},
+	}
+
+	t.Run("Plan recipe - Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			ShowResource(gomock.Any(), datastoresrp.RedisCachesResourceType, "redis").
+			Return(resource, nil).Times(1)
+		appManagementClient.EXPECT().
+			PlanResource(gomock.Any(), datastoresrp.RedisCachesResourceType, "redis", gomock.Any()).
+			DoAndReturn(func(ctx context.Context, resourceType string, resourceName string, body generated.GenericResource) (generated.RecipePlanResponse, error) {
+				require.NotContains(t, body.Properties, "status")
+				require.NotContains(t, body.Properties, "provisioningState")
+				require.Equal(t, map[string]any{
+					"name":       "default",
+					"parameters": map[string]any{"port": float64(6379), "sku": "premium"},
+				}, body.Properties["recipe"])
+				return generated.RecipePlanResponse{
+					TemplateKind: to.Ptr(recipes.TemplateKindBicep),
+					TemplatePath: to.Ptr("ghcr.io/radius-project/recipes/redis:latest"),
+					Changes: []*generated.RecipeResourceChange{
+						{
+							ID:     to.Ptr("/planes/kubernetes/local/namespaces/default/providers/apps/Deployment/redis"),
+							Type:   to.Ptr("apps/Deployment"),
+							Action: to.Ptr(string(recipes.ResourceChangeActionCreate)),
+						},
+					},
+				}, nil
+			}).Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            outputSink,
+			Workspace:         &workspaces.Workspace{},
+			ResourceType:      datastoresrp.RedisCachesResourceType,
+			ResourceName:      "redis",
+			Parameters:        map[string]map[string]any{"sku": {"value": "premium"}},
+			Format:            "table",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format: "table",
+				Obj: types.EnvironmentRecipe{
+					Name:         "default",
+					ResourceType: datastoresrp.RedisCachesResourceType,
+					TemplateKind: recipes.TemplateKindBicep,
+					TemplatePath: "ghcr.io/radius-project/recipes/redis:latest",
+				},
+				Options: common.RecipeFormat(),
+			},
+			output.LogOutput{
+				Format: "",
+			},
+			output.FormattedOutput{
+				Format: "table",
+				Obj: []types.RecipePlanChange{
+					{
+						ID:     "/planes/kubernetes/local/namespaces/default/providers/apps/Deployment/redis",
+						Type:   "apps/Deployment",
+						Action: string(recipes.ResourceChangeActionCreate),
+					},
+				},
+				Options: common.RecipePlanChangesFormat(),
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Plan recipe - No changes", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			ShowResource(gomock.Any(), datastoresrp.RedisCachesResourceType, "redis").
+			Return(resource, nil).Times(1)
+		appManagementClient.EXPECT().
+			PlanResource(gomock.Any(), datastoresrp.RedisCachesResourceType, "redis", gomock.Any()).
+			Return(generated.RecipePlanResponse{
+				TemplateKind: to.Ptr(recipes.TemplateKindTerraform),
+				TemplatePath: to.Ptr("Azure/redis/azurerm"),
+				Changes:      []*generated.RecipeResourceChange{},
+			}, nil).Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            outputSink,
+			Workspace:         &workspaces.Workspace{},
+			ResourceType:      datastoresrp.RedisCachesResourceType,
+			ResourceName:      "redis",
+			Format:            "table",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, output.LogOutput{Format: "No changes. The infrastructure matches the recipe."}, outputSink.Writes[len(outputSink.Writes)-1])
+	})
+
+	t.Run("Plan recipe - Manual resource", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			ShowResource(gomock.Any(), datastoresrp.RedisCachesResourceType, "redis").
+			Return(generated.GenericResource{Properties: map[string]any{"resourceProvisioning": "manual"}}, nil).Times(1)
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            &output.MockOutput{},
+			Workspace:         &workspaces.Workspace{},
+			ResourceType:      datastoresrp.RedisCachesResourceType,
+			ResourceName:      "redis",
+			Format:            "table",
+		}
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("The resource %q of type %q is not provisioned by a recipe.", "redis", datastoresrp.RedisCachesResourceType), err)
+	})
+}
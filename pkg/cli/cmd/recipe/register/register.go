@@ -30,6 +30,7 @@ import (
 	"github.com/radius-project/radius/pkg/cli/workspaces"
 	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/to"
 	"github.com/spf13/cobra"
 )
 
@@ -49,6 +50,8 @@ You can specify parameters using the '--parameter' flag ('-p' for short). Parame
 		
 - A file containing a single value in JSON format
 - A key-value-pair passed in the command line
+
+You can register a named version of the recipe using the '--version' flag. The existing versions of the recipe are kept and the new version becomes the default version, used by the resources that don't pin a version. Use 'rad recipe rollback' to go back to the previous default version.
 		`,
 		Example: `
 # Add a recipe to an environment
@@ -59,6 +62,18 @@ rad recipe register cosmosdb -e env_name -w workspace --template-kind bicep --te
 		
 # specify multiple parameters using a JSON parameter file
 rad recipe register cosmosdb -e env_name -w workspace --template-kind bicep --template-path template_path --resource-type Applications.Datastores/mongoDatabases --parameters @myfile.json
+		
+# Add a Helm chart recipe to an environment
+rad recipe register redis -e env_name -w workspace --template-kind helm --template-path oci://registry/charts/redis --template-version 1.0.0 --resource-type Applications.Datastores/redisCaches
+		
+# Add a Kubernetes manifest or Kustomize recipe published to an OCI registry to an environment
+rad recipe register redis -e env_name -w workspace --template-kind kubernetes --template-path oci://registry/recipes/redis:1.0.0 --resource-type Applications.Datastores/redisCaches
+
+# Add a Kubernetes manifest recipe served over HTTPS to an environment
+rad recipe register redis -e env_name -w workspace --template-kind kubernetes --template-path https://example.com/recipes/redis.yaml --resource-type Applications.Datastores/redisCaches
+
+# Add a named version of a recipe and make it the default version of the recipe
+rad recipe register cosmosdb -e env_name -w workspace --template-kind terraform --template-path Azure/cosmosdb/azurerm --template-version 2.0.0 --resource-type Applications.Datastores/mongoDatabases --version v2
 		`,
 		Args: cobra.ExactArgs(1),
 		RunE: framework.RunCommand(runner),
@@ -70,12 +85,13 @@ rad recipe register cosmosdb -e env_name -w workspace --template-kind bicep --te
 	commonflags.AddEnvironmentNameFlag(cmd)
 	cmd.Flags().String("template-kind", "", "specify the kind for the template provided by the recipe.")
 	_ = cmd.MarkFlagRequired("template-kind")
-	cmd.Flags().String("template-version", "", "specify the version for the terraform module.")
+	cmd.Flags().String("template-version", "", "specify the version for the terraform module or helm chart.")
 	cmd.Flags().String("template-path", "", "specify the path to the template provided by the recipe.")
 	_ = cmd.MarkFlagRequired("template-path")
 	cmd.Flags().String("resource-type", "", "specify the type of the portable resource this recipe can be consumed by")
 	_ = cmd.MarkFlagRequired("resource-type")
-	cmd.Flags().Bool("plain-http", false, "Connect to the Bicep registry using HTTP (not-HTTPS). This should be used when the registry is known not to support HTTPS, for example in a locally-hosted registry. Defaults to false (use HTTPS/TLS).")
+	cmd.Flags().String("version", "", "specify the name of the recipe version to register. The version becomes the default version of the recipe.")
+	cmd.Flags().Bool("plain-http", false, "Connect to the Bicep, Helm or Kubernetes manifest registry using HTTP (not-HTTPS). This should be used when the registry is known not to support HTTPS, for example in a locally-hosted registry. Defaults to false (use HTTPS/TLS).")
 	commonflags.AddParameterFlag(cmd)
 
 	return cmd, runner
@@ -93,6 +109,7 @@ type Runner struct {
 	TemplateVersion   string
 	ResourceType      string
 	RecipeName        string
+	Version           string
 	Parameters        map[string]map[string]any
 }
 
@@ -161,6 +178,12 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 	}
 	r.PlainHTTP = plainHTTP
 
+	version, err := cmd.Flags().GetString("version")
+	if err != nil {
+		return err
+	}
+	r.Version = version
+
 	return nil
 }
 
@@ -200,6 +223,27 @@ func (r *Runner) Run(ctx context.Context) error {
 			PlainHTTP:    &r.PlainHTTP,
 			Parameters:   bicep.ConvertToMapStringInterface(r.Parameters),
 		}
+	case recipes.TemplateKindHelm:
+		properties = &corerp.HelmRecipeProperties{
+			TemplateKind:    &r.TemplateKind,
+			TemplatePath:    &r.TemplatePath,
+			TemplateVersion: &r.TemplateVersion,
+			PlainHTTP:       &r.PlainHTTP,
+			Parameters:      bicep.ConvertToMapStringInterface(r.Parameters),
+		}
+	case recipes.TemplateKindKubernetes:
+		properties = &corerp.KubernetesRecipeProperties{
+			TemplateKind: &r.TemplateKind,
+			TemplatePath: &r.TemplatePath,
+			PlainHTTP:    &r.PlainHTTP,
+			Parameters:   bicep.ConvertToMapStringInterface(r.Parameters),
+		}
+	}
+	if r.Version != "" {
+		properties, err = r.addVersion(envRecipes[r.ResourceType][r.RecipeName], properties)
+		if err != nil {
+			return err
+		}
 	}
 	if val, ok := envRecipes[r.ResourceType]; ok {
 		val[r.RecipeName] = properties
@@ -215,10 +259,52 @@ func (r *Runner) Run(ctx context.Context) error {
 		return clierrors.MessageWithCause(err, "Failed to register the recipe %q to the environment %q.", r.RecipeName, *envResource.ID)
 	}
 
+	if r.Version != "" {
+		r.Output.LogInfo("Successfully linked version %q of recipe %q to environment %q ", r.Version, r.RecipeName, r.Workspace.Environment)
+		return nil
+	}
+
 	r.Output.LogInfo("Successfully linked recipe %q to environment %q ", r.RecipeName, r.Workspace.Environment)
 	return nil
 }
 
+// addVersion adds the template of the command as a named version of the recipe and makes it the default version.
+// The template and versions of an existing recipe are kept, a new recipe also uses the template as its own.
+func (r *Runner) addVersion(existing corerp.RecipePropertiesClassification, properties corerp.RecipePropertiesClassification) (corerp.RecipePropertiesClassification, error) {
+	versions := map[string]*corerp.RecipeVersionProperties{}
+	if existing != nil {
+		if to.String(existing.GetRecipeProperties().TemplateKind) != r.TemplateKind {
+			return nil, clierrors.Message("The template kind %q doesn't match the template kind %q of the recipe %q.", r.TemplateKind, to.String(existing.GetRecipeProperties().TemplateKind), r.RecipeName)
+		}
+		for name, version := range existing.GetRecipeProperties().Versions {
+			versions[name] = version
+		}
+		properties = existing
+	}
+
+	version := &corerp.RecipeVersionProperties{
+		TemplatePath: to.Ptr(r.TemplatePath),
+		Parameters:   bicep.ConvertToMapStringInterface(r.Parameters),
+	}
+	if r.TemplateVersion != "" {
+		version.TemplateVersion = to.Ptr(r.TemplateVersion)
+	}
+	versions[r.Version] = version
+
+	switch c := properties.(type) {
+	case *corerp.TerraformRecipeProperties:
+		c.Versions, c.DefaultVersion = versions, to.Ptr(r.Version)
+	case *corerp.BicepRecipeProperties:
+		c.Versions, c.DefaultVersion = versions, to.Ptr(r.Version)
+	case *corerp.HelmRecipeProperties:
+		c.Versions, c.DefaultVersion = versions, to.Ptr(r.Version)
+	case *corerp.KubernetesRecipeProperties:
+		c.Versions, c.DefaultVersion = versions, to.Ptr(r.Version)
+	}
+
+	return properties, nil
+}
+
 func requireRecipeProperties(cmd *cobra.Command) (templateKind, templatePath, templateVersion string, err error) {
 	templateKind, err = cmd.Flags().GetString("template-kind")
 	if err != nil {
@@ -63,6 +63,24 @@ func Test_Validate(t *testing.T) {
 				Config:         configWithWorkspace,
 			},
 		},
+		{
+			Name:          "Valid Register Command for helm recipe",
+			Input:         []string{"test_recipe", "--template-kind", recipes.TemplateKindHelm, "--template-path", "oci://test_registry/charts/test_chart", "--resource-type", ds_ctrl.RedisCachesResourceType, "--template-version", "1.0.0", "--plain-http"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Valid Register Command for kubernetes recipe",
+			Input:         []string{"test_recipe", "--template-kind", recipes.TemplateKindKubernetes, "--template-path", "https://example.com/recipes/redis.yaml", "--resource-type", ds_ctrl.RedisCachesResourceType},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
 		{
 			Name:          "Valid Register Command with parameters passed as file",
 			Input:         []string{"test_recipe", "--template-kind", recipes.TemplateKindBicep, "--template-path", "test_template", "--resource-type", ds_ctrl.MongoDatabasesResourceType, "--parameters", "@testdata/recipeparam.json", "--plain-http"},
@@ -194,6 +212,56 @@ func Test_Run(t *testing.T) {
 		require.Equal(t, expectedOutput, outputSink.Writes)
 	})
 
+	t.Run("Register helm recipe Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		testEnvProperties := &v20231001preview.EnvironmentProperties{
+			Compute: &v20231001preview.KubernetesCompute{
+				Namespace: to.Ptr("default"),
+			},
+		}
+
+		envResource := v20231001preview.EnvironmentResource{
+			ID:         to.Ptr("/planes/radius/local/resourcegroups/kind-kind/providers/applications.core/environments/kind-kind"),
+			Name:       to.Ptr("kind-kind"),
+			Type:       to.Ptr("applications.core/environments"),
+			Location:   to.Ptr(v1.LocationGlobal),
+			Properties: testEnvProperties,
+		}
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetEnvDetails(gomock.Any(), gomock.Any()).
+			Return(envResource, nil).Times(1)
+		appManagementClient.EXPECT().
+			CreateEnvironment(context.Background(), "kind-kind", v1.LocationGlobal, testEnvProperties).
+			Return(nil).Times(1)
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            &output.MockOutput{},
+			Workspace:         &workspaces.Workspace{Environment: "kind-kind"},
+			TemplateKind:      recipes.TemplateKindHelm,
+			TemplatePath:      "oci://ghcr.io/testpublicrecipe/charts/redis",
+			TemplateVersion:   "1.0.0",
+			PlainHTTP:         true,
+			ResourceType:      ds_ctrl.RedisCachesResourceType,
+			RecipeName:        "redis",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := &v20231001preview.HelmRecipeProperties{
+			TemplateKind:    to.Ptr(recipes.TemplateKindHelm),
+			TemplatePath:    to.Ptr("oci://ghcr.io/testpublicrecipe/charts/redis"),
+			TemplateVersion: to.Ptr("1.0.0"),
+			PlainHTTP:       to.Ptr(true),
+			Parameters:      map[string]any{},
+		}
+		require.Equal(t, expected, testEnvProperties.Recipes[ds_ctrl.RedisCachesResourceType]["redis"])
+	})
+
 	t.Run("Register recipe Failure", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 
@@ -345,6 +413,125 @@ func Test_Run(t *testing.T) {
 		require.Equal(t, expectedOutput, outputSink.Writes)
 	})
 
+	t.Run("Register recipe version", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		envResource := v20231001preview.EnvironmentResource{
+			ID:       to.Ptr("/planes/radius/local/resourcegroups/kind-kind/providers/applications.core/environments/kind-kind"),
+			Name:     to.Ptr("kind-kind"),
+			Type:     to.Ptr("applications.core/environments"),
+			Location: to.Ptr(v1.LocationGlobal),
+			Properties: &v20231001preview.EnvironmentProperties{
+				Recipes: map[string]map[string]v20231001preview.RecipePropertiesClassification{
+					ds_ctrl.MongoDatabasesResourceType: {
+						"cosmosDB": &v20231001preview.TerraformRecipeProperties{
+							TemplateKind:    to.Ptr(recipes.TemplateKindTerraform),
+							TemplatePath:    to.Ptr("Azure/cosmosdb/azurerm"),
+							TemplateVersion: to.Ptr("1.0.0"),
+							DefaultVersion:  to.Ptr("v1"),
+							Versions: map[string]*v20231001preview.RecipeVersionProperties{
+								"v1": {TemplatePath: to.Ptr("Azure/cosmosdb/azurerm"), TemplateVersion: to.Ptr("1.1.0")},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetEnvDetails(gomock.Any(), gomock.Any()).
+			Return(envResource, nil).Times(1)
+		appManagementClient.EXPECT().
+			CreateEnvironment(context.Background(), "kind-kind", v1.LocationGlobal, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, envName string, location string, properties *v20231001preview.EnvironmentProperties) error {
+				expected := &v20231001preview.TerraformRecipeProperties{
+					TemplateKind:    to.Ptr(recipes.TemplateKindTerraform),
+					TemplatePath:    to.Ptr("Azure/cosmosdb/azurerm"),
+					TemplateVersion: to.Ptr("1.0.0"),
+					DefaultVersion:  to.Ptr("v2"),
+					Versions: map[string]*v20231001preview.RecipeVersionProperties{
+						"v1": {TemplatePath: to.Ptr("Azure/cosmosdb/azurerm"), TemplateVersion: to.Ptr("1.1.0")},
+						"v2": {TemplatePath: to.Ptr("Azure/cosmosdb/azurerm"), TemplateVersion: to.Ptr("2.0.0"), Parameters: map[string]any{}},
+					},
+				}
+				require.Equal(t, expected, properties.Recipes[ds_ctrl.MongoDatabasesResourceType]["cosmosDB"])
+				return nil
+			}).Times(1)
+
+		outputSink := &output.MockOutput{}
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            outputSink,
+			Workspace:         &workspaces.Workspace{Environment: "kind-kind"},
+			TemplateKind:      recipes.TemplateKindTerraform,
+			TemplatePath:      "Azure/cosmosdb/azurerm",
+			TemplateVersion:   "2.0.0",
+			ResourceType:      ds_ctrl.MongoDatabasesResourceType,
+			RecipeName:        "cosmosDB",
+			Version:           "v2",
+			Parameters:        map[string]map[string]any{},
+		}
+
+		expectedOutput := []any{
+			output.LogOutput{
+				Format: "Successfully linked version %q of recipe %q to environment %q ",
+				Params: []interface{}{
+					"v2",
+					"cosmosDB",
+					"kind-kind",
+				},
+			},
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, expectedOutput, outputSink.Writes)
+	})
+
+	t.Run("Register recipe version with a different template kind", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		envResource := v20231001preview.EnvironmentResource{
+			ID:       to.Ptr("/planes/radius/local/resourcegroups/kind-kind/providers/applications.core/environments/kind-kind"),
+			Name:     to.Ptr("kind-kind"),
+			Type:     to.Ptr("applications.core/environments"),
+			Location: to.Ptr(v1.LocationGlobal),
+			Properties: &v20231001preview.EnvironmentProperties{
+				Recipes: map[string]map[string]v20231001preview.RecipePropertiesClassification{
+					ds_ctrl.MongoDatabasesResourceType: {
+						"cosmosDB": &v20231001preview.BicepRecipeProperties{
+							TemplateKind: to.Ptr(recipes.TemplateKindBicep),
+							TemplatePath: to.Ptr("ghcr.io/testpublicrecipe/bicep/modules/mongodatabases:v1"),
+						},
+					},
+				},
+			},
+		}
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetEnvDetails(gomock.Any(), gomock.Any()).
+			Return(envResource, nil).Times(1)
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            &output.MockOutput{},
+			Workspace:         &workspaces.Workspace{Environment: "kind-kind"},
+			TemplateKind:      recipes.TemplateKindTerraform,
+			TemplatePath:      "Azure/cosmosdb/azurerm",
+			ResourceType:      ds_ctrl.MongoDatabasesResourceType,
+			RecipeName:        "cosmosDB",
+			Version:           "v2",
+			Parameters:        map[string]map[string]any{},
+		}
+
+		err := runner.Run(context.Background())
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "doesn't match the template kind")
+	})
+
 	t.Run("Register recipe with no namespace", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		testEnvProperties := &v20231001preview.EnvironmentProperties{
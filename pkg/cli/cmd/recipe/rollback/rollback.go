@@ -0,0 +1,282 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rollback
+
+import (
+	"context"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	types "github.com/radius-project/radius/pkg/cli/cmd/recipe"
+	"github.com/radius-project/radius/pkg/cli/cmd/recipe/common"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/portableresources"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad recipe rollback` command.
+//
+
+// NewCommand creates a new cobra command that makes the previous version of a recipe the default version of the
+// environment and redeploys the resources that use the recipe with it.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "rollback [recipe-name]",
+		Short: "Roll back a recipe to a previous version",
+		Long: `Roll back a recipe to a previous version
+
+The recipe rollback command makes the version of the recipe that was the default before the last change of its default version the default again, and redeploys the resources of the environment that use the recipe with it. You can roll back to any other version of the recipe using the '--version' flag.
+
+Resources that pin a version of the recipe are not redeployed. The version each resource was deployed with is reported in the recipe status of the resource.`,
+		Example: `
+# roll back the default recipe of redis caches to its previous version
+rad recipe rollback default --resource-type Applications.Datastores/redisCaches
+
+# roll back the recipe to a specific version
+rad recipe rollback default --resource-type Applications.Datastores/redisCaches --version v1`,
+		Args: cobra.ExactArgs(1),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddOutputFlag(cmd)
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	commonflags.AddEnvironmentNameFlag(cmd)
+	commonflags.AddResourceTypeFlag(cmd)
+	_ = cmd.MarkFlagRequired(cli.ResourceTypeFlag)
+	cmd.Flags().String("version", "", "specify the version of the recipe to roll back to. Defaults to the previous default version of the recipe.")
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad recipe rollback` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+	RecipeName        string
+	ResourceType      string
+	Version           string
+	Format            string
+}
+
+// NewRunner creates a new instance of the `rad recipe rollback` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:      factory.GetConfigHolder(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad recipe rollback` command.
+//
+
+// Validate checks the command line arguments for a workspace, environment, recipe name, resource type, version and
+// output format, and returns an error if any of these are invalid.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	environment, err := cli.RequireEnvironmentName(cmd, args, *workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Environment = environment
+
+	recipeName, err := cli.RequireRecipeNameArgs(cmd, args)
+	if err != nil {
+		return err
+	}
+	r.RecipeName = recipeName
+
+	resourceType, err := cli.GetResourceType(cmd)
+	if err != nil {
+		return err
+	}
+	r.ResourceType = resourceType
+
+	version, err := cmd.Flags().GetString("version")
+	if err != nil {
+		return err
+	}
+	r.Version = version
+
+	format, err := cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+	if format == "" {
+		format = output.FormatTable
+	}
+	r.Format = format
+
+	return nil
+}
+
+// Run runs the `rad recipe rollback` command.
+//
+
+// Run makes the version to roll back to the default version of the recipe, then redeploys the resources of the
+// environment that use the recipe and were not deployed with that version. It returns an error if any of the steps fail.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	envResource, recipeProperties, err := cmd.CheckIfRecipeExists(ctx, client, r.Workspace.Environment, r.RecipeName, r.ResourceType)
+	if err != nil {
+		return err
+	}
+
+	recipe := recipeProperties[r.ResourceType][r.RecipeName]
+	properties := recipe.GetRecipeProperties()
+	version := r.Version
+	if version == "" {
+		version = to.String(properties.PreviousDefaultVersion)
+	}
+	if version == "" {
+		return clierrors.Message("The recipe %q has no previous version to roll back to. Specify the version to roll back to using the '--version' flag.", r.RecipeName)
+	}
+	if _, ok := properties.Versions[version]; !ok {
+		return clierrors.Message("The version %q is not a version of the recipe %q.", version, r.RecipeName)
+	}
+
+	if to.String(properties.DefaultVersion) != version {
+		setDefaultVersion(recipe, version)
+		envResource.Properties.Recipes = recipeProperties
+		err = client.CreateEnvironment(ctx, r.Workspace.Environment, v1.LocationGlobal, envResource.Properties)
+		if err != nil {
+			return clierrors.MessageWithCause(err, "Failed to roll back the recipe %q of the environment %q.", r.RecipeName, *envResource.ID)
+		}
+		r.Output.LogInfo("Version %q is now the default version of recipe %q", version, r.RecipeName)
+	}
+
+	resources, err := client.ListAllResourcesOfTypeInEnvironment(ctx, r.Workspace.Environment, r.ResourceType)
+	if err != nil {
+		return err
+	}
+
+	redeployed := []types.RecipeRollback{}
+	for _, resource := range resources {
+		deployedVersion, ok := r.deployedVersion(resource)
+		if !ok || deployedVersion == version {
+			continue
+		}
+
+		name := to.String(resource.Name)
+		r.Output.LogInfo("Redeploying resource %q with version %q of recipe %q...", name, version, r.RecipeName)
+		_, err := client.CreateOrUpdateResource(ctx, r.ResourceType, name, redeployRequest(resource))
+		if err != nil {
+			return clierrors.MessageWithCause(err, "Failed to redeploy the resource %q with version %q of recipe %q.", name, version, r.RecipeName)
+		}
+
+		redeployed = append(redeployed, types.RecipeRollback{
+			Name:            name,
+			PreviousVersion: deployedVersion,
+			Version:         version,
+		})
+	}
+
+	if len(redeployed) == 0 {
+		r.Output.LogInfo("No resources were redeployed. All the resources using recipe %q are deployed with version %q or pin a version.", r.RecipeName, version)
+		return nil
+	}
+
+	return r.Output.WriteFormatted(r.Format, redeployed, common.RecipeRollbackFormat())
+}
+
+// deployedVersion returns the version of the recipe the resource was deployed with, and true if the resource uses
+// the default version of the recipe being rolled back.
+func (r *Runner) deployedVersion(resource generated.GenericResource) (string, bool) {
+	recipe, ok := resource.Properties["recipe"].(map[string]any)
+	if !ok {
+		return "", false
+	}
+
+	if provisioning, _ := resource.Properties["resourceProvisioning"].(string); strings.EqualFold(provisioning, string(portableresources.ResourceProvisioningManual)) {
+		return "", false
+	}
+
+	name, _ := recipe["name"].(string)
+	if name == "" {
+		name = portableresources.DefaultRecipeName
+	}
+	if name != r.RecipeName {
+		return "", false
+	}
+
+	// Resources pinned to a version are not affected by the default version of the recipe.
+	if pinned, _ := recipe["version"].(string); pinned != "" {
+		return "", false
+	}
+
+	status, _ := resource.Properties["status"].(map[string]any)
+	recipeStatus, _ := status["recipe"].(map[string]any)
+	version, _ := recipeStatus["version"].(string)
+	return version, true
+}
+
+// redeployRequest builds the body of the request that redeploys the resource. Read-only properties are dropped.
+func redeployRequest(resource generated.GenericResource) generated.GenericResource {
+	properties := map[string]any{}
+	for k, v := range resource.Properties {
+		if k == "status" || k == "provisioningState" {
+			continue
+		}
+		properties[k] = v
+	}
+
+	return generated.GenericResource{
+		Location:   resource.Location,
+		Tags:       resource.Tags,
+		Properties: properties,
+	}
+}
+
+// setDefaultVersion sets the default version of the recipe.
+func setDefaultVersion(recipe corerp.RecipePropertiesClassification, version string) {
+	switch c := recipe.(type) {
+	case *corerp.BicepRecipeProperties:
+		c.DefaultVersion = to.Ptr(version)
+	case *corerp.TerraformRecipeProperties:
+		c.DefaultVersion = to.Ptr(version)
+	case *corerp.HelmRecipeProperties:
+		c.DefaultVersion = to.Ptr(version)
+	case *corerp.KubernetesRecipeProperties:
+		c.DefaultVersion = to.Ptr(version)
+	case *corerp.RecipeProperties:
+		c.DefaultVersion = to.Ptr(version)
+	}
+}
@@ -0,0 +1,267 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rollback
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	types "github.com/radius-project/radius/pkg/cli/cmd/recipe"
+	"github.com/radius-project/radius/pkg/cli/cmd/recipe/common"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	datastoresrp "github.com/radius-project/radius/pkg/datastoresrp/frontend/controller"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Valid Rollback Command",
+			Input:         []string{"default", "--resource-type", datastoresrp.RedisCachesResourceType},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Valid Rollback Command with version",
+			Input:         []string{"default", "--resource-type", datastoresrp.RedisCachesResourceType, "--version", "v1"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Rollback Command without recipe name",
+			Input:         []string{"--resource-type", datastoresrp.RedisCachesResourceType},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Rollback Command with too many positional args",
+			Input:         []string{"default", "arg2", "--resource-type", datastoresrp.RedisCachesResourceType},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	envResource := func() corerp.EnvironmentResource {
+		return corerp.EnvironmentResource{
+			ID:       to.Ptr("/planes/radius/local/resourcegroups/kind-kind/providers/applications.core/environments/kind-kind"),
+			Name:     to.Ptr("kind-kind"),
+			Type:     to.Ptr("applications.core/environments"),
+			Location: to.Ptr(v1.LocationGlobal),
+			Properties: &corerp.EnvironmentProperties{
+				Recipes: map[string]map[string]corerp.RecipePropertiesClassification{
+					datastoresrp.RedisCachesResourceType: {
+						"default": &corerp.TerraformRecipeProperties{
+							TemplateKind:           to.Ptr(recipes.TemplateKindTerraform),
+							TemplatePath:           to.Ptr("Azure/redis/azurerm"),
+							TemplateVersion:        to.Ptr("1.0.0"),
+							DefaultVersion:         to.Ptr("v2"),
+							PreviousDefaultVersion: to.Ptr("v1"),
+							Versions: map[string]*corerp.RecipeVersionProperties{
+								"v1": {TemplatePath: to.Ptr("Azure/redis/azurerm"), TemplateVersion: to.Ptr("1.1.0")},
+								"v2": {TemplatePath: to.Ptr("Azure/redis/azurerm"), TemplateVersion: to.Ptr("2.0.0")},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	redisResource := func(name string, recipe map[string]any, version string) generated.GenericResource {
+		return generated.GenericResource{
+			Name:     to.Ptr(name),
+			Location: to.Ptr(v1.LocationGlobal),
+			Properties: map[string]any{
+				"environment":       "/planes/radius/local/resourcegroups/kind-kind/providers/applications.core/environments/kind-kind",
+				"provisioningState": "Succeeded",
+				"recipe":            recipe,
+				"status":            map[string]any{"recipe": map[string]any{"version": version}},
+			},
+		}
+	}
+
+	t.Run("Rollback to the previous version", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetEnvDetails(gomock.Any(), "kind-kind").
+			Return(envResource(), nil).Times(1)
+		appManagementClient.EXPECT().
+			CreateEnvironment(gomock.Any(), "kind-kind", v1.LocationGlobal, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, envName string, location string, properties *corerp.EnvironmentProperties) error {
+				recipe := properties.Recipes[datastoresrp.RedisCachesResourceType]["default"]
+				require.Equal(t, "v1", to.String(recipe.GetRecipeProperties().DefaultVersion))
+				return nil
+			}).Times(1)
+		appManagementClient.EXPECT().
+			ListAllResourcesOfTypeInEnvironment(gomock.Any(), "kind-kind", datastoresrp.RedisCachesResourceType).
+			Return([]generated.GenericResource{
+				redisResource("redis", map[string]any{"name": "default"}, "v2"),
+				redisResource("redis-rolled-back", map[string]any{"name": "default"}, "v1"),
+				redisResource("redis-pinned", map[string]any{"name": "default", "version": "v2"}, "v2"),
+				redisResource("redis-other", map[string]any{"name": "other"}, "v2"),
+			}, nil).Times(1)
+		appManagementClient.EXPECT().
+			CreateOrUpdateResource(gomock.Any(), datastoresrp.RedisCachesResourceType, "redis", gomock.Any()).
+			DoAndReturn(func(ctx context.Context, resourceType string, resourceName string, body generated.GenericResource) (generated.GenericResource, error) {
+				require.NotContains(t, body.Properties, "status")
+				require.NotContains(t, body.Properties, "provisioningState")
+				require.Equal(t, map[string]any{"name": "default"}, body.Properties["recipe"])
+				return body, nil
+			}).Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            outputSink,
+			Workspace:         &workspaces.Workspace{Environment: "kind-kind"},
+			RecipeName:        "default",
+			ResourceType:      datastoresrp.RedisCachesResourceType,
+			Format:            "table",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := output.FormattedOutput{
+			Format: "table",
+			Obj: []types.RecipeRollback{
+				{
+					Name:            "redis",
+					PreviousVersion: "v2",
+					Version:         "v1",
+				},
+			},
+			Options: common.RecipeRollbackFormat(),
+		}
+		require.Equal(t, expected, outputSink.Writes[len(outputSink.Writes)-1])
+	})
+
+	t.Run("Rollback to the default version", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetEnvDetails(gomock.Any(), "kind-kind").
+			Return(envResource(), nil).Times(1)
+		appManagementClient.EXPECT().
+			ListAllResourcesOfTypeInEnvironment(gomock.Any(), "kind-kind", datastoresrp.RedisCachesResourceType).
+			Return([]generated.GenericResource{
+				redisResource("redis", map[string]any{"name": "default"}, "v2"),
+			}, nil).Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            outputSink,
+			Workspace:         &workspaces.Workspace{Environment: "kind-kind"},
+			RecipeName:        "default",
+			ResourceType:      datastoresrp.RedisCachesResourceType,
+			Version:           "v2",
+			Format:            "table",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, []any{
+			output.LogOutput{
+				Format: "No resources were redeployed. All the resources using recipe %q are deployed with version %q or pin a version.",
+				Params: []any{"default", "v2"},
+			},
+		}, outputSink.Writes)
+	})
+
+	t.Run("Unknown version", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetEnvDetails(gomock.Any(), "kind-kind").
+			Return(envResource(), nil).Times(1)
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            &output.MockOutput{},
+			Workspace:         &workspaces.Workspace{Environment: "kind-kind"},
+			RecipeName:        "default",
+			ResourceType:      datastoresrp.RedisCachesResourceType,
+			Version:           "v3",
+			Format:            "table",
+		}
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("The version %q is not a version of the recipe %q.", "v3", "default"), err)
+	})
+
+	t.Run("No previous version", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		env := envResource()
+		env.Properties.Recipes[datastoresrp.RedisCachesResourceType]["default"].(*corerp.TerraformRecipeProperties).PreviousDefaultVersion = nil
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetEnvDetails(gomock.Any(), "kind-kind").
+			Return(env, nil).Times(1)
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            &output.MockOutput{},
+			Workspace:         &workspaces.Workspace{Environment: "kind-kind"},
+			RecipeName:        "default",
+			ResourceType:      datastoresrp.RedisCachesResourceType,
+			Format:            "table",
+		}
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("The recipe %q has no previous version to roll back to. Specify the version to roll back to using the '--version' flag.", "default"), err)
+	})
+}
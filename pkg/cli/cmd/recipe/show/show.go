@@ -30,6 +30,7 @@ import (
 	"github.com/radius-project/radius/pkg/cli/output"
 	"github.com/radius-project/radius/pkg/cli/workspaces"
 	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/spf13/cobra"
 )
 
@@ -178,29 +179,40 @@ func (r *Runner) Run(ctx context.Context) error {
 
 	r.Output.LogInfo("")
 
-	var recipeParams []types.RecipeParameter
-
-	for parameter := range recipeDetails.Parameters {
-		values := recipeDetails.Parameters[parameter].(map[string]any)
+	schema, err := recipes.NewParameterSchema(recipe.TemplateKind, recipeDetails.Parameters)
+	if err != nil {
+		return err
+	}
 
+	var recipeParams []types.RecipeParameter
+	for _, parameter := range schema.Parameters {
 		paramItem := types.RecipeParameter{
-			Name:         parameter,
-			DefaultValue: "-",
-			MaxValue:     "-",
-			MinValue:     "-",
+			Name:          parameter.Name,
+			Type:          parameter.DeclaredType,
+			Required:      parameter.Required,
+			DefaultValue:  "-",
+			AllowedValues: "-",
+			MaxValue:      "-",
+			MinValue:      "-",
+			Description:   "-",
 		}
-
-		for paramDetailName, paramDetailValue := range values {
-			switch paramDetailName {
-			case "type":
-				paramItem.Type = paramDetailValue.(string)
-			case "defaultValue":
-				paramItem.DefaultValue = paramDetailValue
-			case "maxValue":
-				paramItem.MaxValue = fmt.Sprintf("%v", paramDetailValue.(float64))
-			case "minValue":
-				paramItem.MinValue = fmt.Sprintf("%v", paramDetailValue.(float64))
-			}
+		if paramItem.Type == "" {
+			paramItem.Type = parameter.Type
+		}
+		if parameter.DefaultValue != nil {
+			paramItem.DefaultValue = parameter.DefaultValue
+		}
+		if len(parameter.AllowedValues) > 0 {
+			paramItem.AllowedValues = fmt.Sprintf("%v", parameter.AllowedValues)
+		}
+		if parameter.MaxValue != nil {
+			paramItem.MaxValue = fmt.Sprintf("%v", *parameter.MaxValue)
+		}
+		if parameter.MinValue != nil {
+			paramItem.MinValue = fmt.Sprintf("%v", *parameter.MinValue)
+		}
+		if parameter.Description != "" {
+			paramItem.Description = parameter.Description
 		}
 
 		recipeParams = append(recipeParams, paramItem)
@@ -117,18 +117,24 @@ func Test_Run(t *testing.T) {
 		}
 		recipeParams := []types.RecipeParameter{
 			{
-				Name:         "throughput",
-				Type:         "float64",
-				MaxValue:     "800",
-				MinValue:     "-",
-				DefaultValue: "-",
+				Name:          "throughput",
+				Type:          "float64",
+				MaxValue:      "800",
+				MinValue:      "-",
+				DefaultValue:  "-",
+				Required:      true,
+				AllowedValues: "-",
+				Description:   "-",
 			},
 			{
-				Name:         "sku",
-				Type:         "string",
-				MaxValue:     "-",
-				MinValue:     "-",
-				DefaultValue: "-",
+				Name:          "sku",
+				Type:          "string",
+				MaxValue:      "-",
+				MinValue:      "-",
+				DefaultValue:  "-",
+				Required:      true,
+				AllowedValues: "-",
+				Description:   "-",
 			},
 		}
 
@@ -194,18 +200,24 @@ func Test_Run(t *testing.T) {
 		}
 		recipeParams := []types.RecipeParameter{
 			{
-				Name:         "throughput",
-				Type:         "float64",
-				MaxValue:     "800",
-				MinValue:     "-",
-				DefaultValue: "-",
+				Name:          "throughput",
+				Type:          "float64",
+				MaxValue:      "800",
+				MinValue:      "-",
+				DefaultValue:  "-",
+				Required:      true,
+				AllowedValues: "-",
+				Description:   "-",
 			},
 			{
-				Name:         "sku",
-				Type:         "string",
-				MaxValue:     "-",
-				MinValue:     "-",
-				DefaultValue: "-",
+				Name:          "sku",
+				Type:          "string",
+				MaxValue:      "-",
+				MinValue:      "-",
+				DefaultValue:  "-",
+				Required:      true,
+				AllowedValues: "-",
+				Description:   "-",
 			},
 		}
 
@@ -271,18 +283,22 @@ func Test_Run(t *testing.T) {
 		}
 		recipeParams := []types.RecipeParameter{
 			{
-				Name:         "throughput",
-				Type:         "float64",
-				MaxValue:     "800",
-				MinValue:     "-",
-				DefaultValue: "-",
+				Name:          "throughput",
+				Type:          "float64",
+				MaxValue:      "800",
+				MinValue:      "-",
+				DefaultValue:  "-",
+				AllowedValues: "-",
+				Description:   "-",
 			},
 			{
-				Name:         "sku",
-				Type:         "string",
-				MaxValue:     "-",
-				MinValue:     "-",
-				DefaultValue: "-",
+				Name:          "sku",
+				Type:          "string",
+				MaxValue:      "-",
+				MinValue:      "-",
+				DefaultValue:  "-",
+				AllowedValues: "-",
+				Description:   "-",
 			},
 		}
 
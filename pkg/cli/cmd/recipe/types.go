@@ -26,9 +26,24 @@ type EnvironmentRecipe struct {
 }
 
 type RecipeParameter struct {
-	Name         string      `json:"name,omitempty"`
-	DefaultValue interface{} `json:"defaultValue,omitempty"`
-	Type         string      `json:"type,omitempty"`
-	MaxValue     string      `json:"maxValue,omitempty"`
-	MinValue     string      `json:"minValue,omitempty"`
+	Name          string      `json:"name,omitempty"`
+	DefaultValue  interface{} `json:"defaultValue,omitempty"`
+	Type          string      `json:"type,omitempty"`
+	Required      bool        `json:"required"`
+	AllowedValues string      `json:"allowedValues,omitempty"`
+	MaxValue      string      `json:"maxValue,omitempty"`
+	MinValue      string      `json:"minValue,omitempty"`
+	Description   string      `json:"description,omitempty"`
+}
+
+type RecipePlanChange struct {
+	ID     string `json:"id"`
+	Type   string `json:"type,omitempty"`
+	Action string `json:"action"`
+}
+
+type RecipeRollback struct {
+	Name            string `json:"name"`
+	PreviousVersion string `json:"previousVersion,omitempty"`
+	Version         string `json:"version"`
 }
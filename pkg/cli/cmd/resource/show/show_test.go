@@ -136,4 +136,53 @@ func Test_Run(t *testing.T) {
 		}
 		require.Equal(t, expected, outputSink.Writes)
 	})
+
+	t.Run("Validate rad resource show shows only the references of environment variables read from secrets", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		// The API returns the references to the secrets, never their values.
+		resource := radcli.CreateResource("containers", "foo")
+		resource.Properties = map[string]any{
+			"container": map[string]any{
+				"image": "magpie:latest",
+				"env": map[string]any{
+					"DB_USER": "admin",
+				},
+				"secretEnv": map[string]any{
+					"DB_PASSWORD": map[string]any{
+						"source": "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/secretStores/dbsecrets",
+						"key":    "password",
+					},
+				},
+			},
+		}
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			ShowResource(gomock.Any(), "containers", "foo").
+			Return(resource, nil).Times(1)
+
+		outputSink := &output.MockOutput{}
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            outputSink,
+			Workspace:         &workspaces.Workspace{},
+			ResourceType:      "containers",
+			ResourceName:      "foo",
+			Format:            "json",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format:  "json",
+				Obj:     resource,
+				Options: objectformats.GetGenericResourceTableFormat(),
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
 }
@@ -110,3 +110,28 @@ func DeployWithProgress(ctx context.Context, options Options) (clients.Deploymen
 
 	return result, nil
 }
+
+// WhatIf injects environment and application parameters into the template and asks the deployment engine for the
+// changes that deploying it would make. Nothing is deployed. If an error occurs, an error is returned.
+func WhatIf(ctx context.Context, options Options) (clients.WhatIfResult, error) {
+	deploymentClient, err := options.ConnectionFactory.CreateDeploymentClient(ctx, options.Workspace)
+	if err != nil {
+		return clients.WhatIfResult{}, err
+	}
+
+	err = bicep.InjectEnvironmentParam(options.Template, options.Parameters, options.Providers.Radius.EnvironmentID)
+	if err != nil {
+		return clients.WhatIfResult{}, err
+	}
+
+	err = bicep.InjectApplicationParam(options.Template, options.Parameters, options.Providers.Radius.ApplicationID)
+	if err != nil {
+		return clients.WhatIfResult{}, err
+	}
+
+	return deploymentClient.WhatIf(ctx, clients.DeploymentOptions{
+		Template:   options.Template,
+		Parameters: options.Parameters,
+		Providers:  options.Providers,
+	})
+}
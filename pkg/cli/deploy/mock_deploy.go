@@ -49,3 +49,18 @@ func (mr *MockInterfaceMockRecorder) DeployWithProgress(arg0, arg1 interface{})
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployWithProgress", reflect.TypeOf((*MockInterface)(nil).DeployWithProgress), arg0, arg1)
 }
+
+// WhatIf mocks base method.
+func (m *MockInterface) WhatIf(arg0 context.Context, arg1 Options) (clients.WhatIfResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WhatIf", arg0, arg1)
+	ret0, _ := ret[0].(clients.WhatIfResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WhatIf indicates an expected call of WhatIf.
+func (mr *MockInterfaceMockRecorder) WhatIf(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WhatIf", reflect.TypeOf((*MockInterface)(nil).WhatIf), arg0, arg1)
+}
@@ -29,6 +29,9 @@ type Interface interface {
 	// DeployWithProgress runs a deployment and displays progress to the user. This is intended to be used
 	// from the CLI and thus logs to the console.
 	DeployWithProgress(ctx context.Context, options Options) (clients.DeploymentResult, error)
+
+	// WhatIf returns the changes that the deployment would make, without deploying anything.
+	WhatIf(ctx context.Context, options Options) (clients.WhatIfResult, error)
 }
 
 // Options contains options to be used with DeployWithProgress.
@@ -67,3 +70,8 @@ type Impl struct {
 func (*Impl) DeployWithProgress(ctx context.Context, options Options) (clients.DeploymentResult, error) {
 	return DeployWithProgress(ctx, options)
 }
+
+// WhatIf returns the changes that the deployment would make, without deploying anything.
+func (*Impl) WhatIf(ctx context.Context, options Options) (clients.WhatIfResult, error) {
+	return WhatIf(ctx, options)
+}
@@ -94,8 +94,34 @@ func (dc *ResourceDeploymentClient) Deploy(ctx context.Context, options clients.
 	return summary, nil
 }
 
+// WhatIf asks the deployment engine for the changes that deploying the template would make and returns them
+// without deploying anything.
+func (dc *ResourceDeploymentClient) WhatIf(ctx context.Context, options clients.DeploymentOptions) (clients.WhatIfResult, error) {
+	name := fmt.Sprintf("rad-whatif-%v", uuid.New().String())
+	poller, err := dc.Client.WhatIf(ctx, dc.createDeployment(options), dc.deploymentID(name), sdkclients.DeploymentsClientAPIVersion)
+	if err != nil {
+		return clients.WhatIfResult{}, err
+	}
+
+	resp, err := poller.PollUntilDone(ctx, &runtime.PollUntilDoneOptions{Frequency: deploymentPollInterval})
+	if err != nil {
+		return clients.WhatIfResult{}, err
+	}
+
+	return dc.createWhatIfSummary(&resp.WhatIfOperationResult), nil
+}
+
 func (dc *ResourceDeploymentClient) startDeployment(ctx context.Context, name string, options clients.DeploymentOptions) (*runtime.Poller[sdkclients.ClientCreateOrUpdateResponse], error) {
-	var resourceId string
+	poller, err := dc.Client.CreateOrUpdate(ctx, dc.createDeployment(options), dc.deploymentID(name), sdkclients.DeploymentsClientAPIVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	return poller, nil
+}
+
+// deploymentID returns the UCP resource ID of the deployment with the given name.
+func (dc *ResourceDeploymentClient) deploymentID(name string) string {
 	scopes := []ucpresources.ScopeSegment{
 		{
 			Type: "radius",
@@ -113,25 +139,18 @@ func (dc *ResourceDeploymentClient) startDeployment(ctx context.Context, name st
 		},
 	}
 
-	resourceId = ucpresources.MakeUCPID(scopes, types, nil)
-	providerConfig := dc.GetProviderConfigs(options)
-
-	poller, err := dc.Client.CreateOrUpdate(ctx,
-		sdkclients.Deployment{
-			Properties: &sdkclients.DeploymentProperties{
-				Template:       options.Template,
-				Parameters:     options.Parameters,
-				ProviderConfig: providerConfig,
-				Mode:           armresources.DeploymentModeIncremental,
-			},
+	return ucpresources.MakeUCPID(scopes, types, nil)
+}
+
+func (dc *ResourceDeploymentClient) createDeployment(options clients.DeploymentOptions) sdkclients.Deployment {
+	return sdkclients.Deployment{
+		Properties: &sdkclients.DeploymentProperties{
+			Template:       options.Template,
+			Parameters:     options.Parameters,
+			ProviderConfig: dc.GetProviderConfigs(options),
+			Mode:           armresources.DeploymentModeIncremental,
 		},
-		resourceId,
-		sdkclients.DeploymentsClientAPIVersion)
-	if err != nil {
-		return nil, err
 	}
-
-	return poller, nil
 }
 
 // GetProviderConfigs() creates a default provider config and then updates it with any provider scopes passed in the DeploymentOptions.
@@ -197,6 +216,27 @@ func (dc *ResourceDeploymentClient) createSummary(deployment *armresources.Deplo
 	return clients.DeploymentResult{Resources: resources, Outputs: outputs}, nil
 }
 
+func (dc *ResourceDeploymentClient) createWhatIfSummary(result *armresources.WhatIfOperationResult) clients.WhatIfResult {
+	summary := clients.WhatIfResult{Changes: []clients.DeploymentChange{}}
+	if result.Properties == nil {
+		return summary
+	}
+
+	for _, change := range result.Properties.Changes {
+		if change == nil || change.ResourceID == nil {
+			continue
+		}
+
+		changeType := ""
+		if change.ChangeType != nil {
+			changeType = string(*change.ChangeType)
+		}
+		summary.Changes = append(summary.Changes, clients.DeploymentChange{Resource: *change.ResourceID, ChangeType: changeType})
+	}
+
+	return summary
+}
+
 func (dc *ResourceDeploymentClient) waitForCompletion(ctx context.Context, poller *runtime.Poller[sdkclients.ClientCreateOrUpdateResponse]) (clients.DeploymentResult, error) {
 	resp, err := poller.PollUntilDone(ctx, &runtime.PollUntilDoneOptions{Frequency: deploymentPollInterval})
 	if err != nil {
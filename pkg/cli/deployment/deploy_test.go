@@ -19,8 +19,10 @@ package deployment
 import (
 	"testing"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
 	"github.com/radius-project/radius/pkg/cli/clients"
 	sdkclients "github.com/radius-project/radius/pkg/sdk/clients"
+	"github.com/radius-project/radius/pkg/to"
 	"github.com/stretchr/testify/require"
 )
 
@@ -91,3 +93,40 @@ func Test_GetProviderConfigsWithAzProvider(t *testing.T) {
 	providerConfig := resourceDeploymentClient.GetProviderConfigs(options)
 	require.Equal(t, providerConfig, expectedConfig)
 }
+
+func Test_CreateWhatIfSummary(t *testing.T) {
+	resourceDeploymentClient := ResourceDeploymentClient{
+		RadiusResourceGroup: "testrg",
+	}
+
+	result := &armresources.WhatIfOperationResult{
+		Properties: &armresources.WhatIfOperationProperties{
+			Changes: []*armresources.WhatIfChange{
+				{
+					ResourceID: to.Ptr("/planes/radius/local/resourceGroups/testrg/providers/Applications.Core/containers/frontend"),
+					ChangeType: to.Ptr(armresources.ChangeTypeCreate),
+				},
+				{
+					ResourceID: to.Ptr("/planes/radius/local/resourceGroups/testrg/providers/Applications.Core/applications/app"),
+					ChangeType: to.Ptr(armresources.ChangeTypeNoChange),
+				},
+				nil,
+			},
+		},
+	}
+
+	expected := clients.WhatIfResult{
+		Changes: []clients.DeploymentChange{
+			{
+				Resource:   "/planes/radius/local/resourceGroups/testrg/providers/Applications.Core/containers/frontend",
+				ChangeType: "Create",
+			},
+			{
+				Resource:   "/planes/radius/local/resourceGroups/testrg/providers/Applications.Core/applications/app",
+				ChangeType: "NoChange",
+			},
+		},
+	}
+	require.Equal(t, expected, resourceDeploymentClient.createWhatIfSummary(result))
+	require.Equal(t, clients.WhatIfResult{Changes: []clients.DeploymentChange{}}, resourceDeploymentClient.createWhatIfSummary(&armresources.WhatIfOperationResult{}))
+}
@@ -73,3 +73,20 @@ func GetGenericResourceTableFormat() output.FormatterOptions {
 		},
 	}
 }
+
+// GetDeploymentChangeTableFormat returns the fields to output from the changes that a deployment would make.
+// This function should be used with the Go type clients.DeploymentChange.
+func GetDeploymentChangeTableFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "CHANGE",
+				JSONPath: "{ .ChangeType }",
+			},
+			{
+				Heading:  "RESOURCE",
+				JSONPath: "{ .Resource }",
+			},
+		},
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"testing"
 
+	"github.com/radius-project/radius/pkg/cli/clients"
 	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
 	"github.com/radius-project/radius/pkg/cli/output"
 	corerpv20231001preview "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
@@ -62,3 +63,17 @@ func Test_GetGenericResourceTableFormat(t *testing.T) {
 	expected := "RESOURCE  TYPE       GROUP       STATE\ntest      test-type  test-group  Updating\n"
 	require.Equal(t, expected, buffer.String())
 }
+
+func Test_GetDeploymentChangeTableFormat(t *testing.T) {
+	obj := clients.DeploymentChange{
+		Resource:   "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/test",
+		ChangeType: "Create",
+	}
+
+	buffer := &bytes.Buffer{}
+	err := output.Write(output.FormatTable, obj, buffer, GetDeploymentChangeTableFormat())
+	require.NoError(t, err)
+
+	expected := "CHANGE    RESOURCE\nCreate    /planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/test\n"
+	require.Equal(t, expected, buffer.String())
+}
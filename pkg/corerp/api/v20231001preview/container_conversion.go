@@ -18,11 +18,17 @@ package v20231001preview
 
 import (
 	"encoding/json"
+	"fmt"
+	"strings"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
 // ConvertTo converts from the versioned Container resource to version-agnostic datamodel.
@@ -58,50 +64,36 @@ func (src *ContainerResource) ConvertTo() (v1.DataModelInterface, error) {
 		}
 	}
 
-	var livenessProbe datamodel.HealthProbeProperties
-	if src.Properties.Container.LivenessProbe != nil {
-		livenessProbe = toHealthProbePropertiesDataModel(src.Properties.Container.LivenessProbe)
+	container, err := toContainerDataModel(src.Properties.Container, "$.properties.container")
+	if err != nil {
+		return nil, err
 	}
 
-	var readinessProbe datamodel.HealthProbeProperties
-	if src.Properties.Container.ReadinessProbe != nil {
-		readinessProbe = toHealthProbePropertiesDataModel(src.Properties.Container.ReadinessProbe)
+	initContainers, err := toSupportingContainersDataModel(src.Properties.InitContainers, "$.properties.initContainers")
+	if err != nil {
+		return nil, err
 	}
 
-	ports := make(map[string]datamodel.ContainerPort)
-	for key, val := range src.Properties.Container.Ports {
-		port := datamodel.ContainerPort{
-			ContainerPort: to.Int32(val.ContainerPort),
-			Protocol:      toPortProtocolDataModel(val.Protocol),
-			Provides:      to.String(val.Provides),
-		}
-
-		if val.Port != nil {
-			port.Port = to.Int32(val.Port)
-		}
-
-		if val.Scheme != nil {
-			port.Scheme = to.String(val.Scheme)
-		}
-
-		ports[key] = port
-	}
-
-	var volumes map[string]datamodel.VolumeProperties
-	if src.Properties.Container.Volumes != nil {
-		volumes = make(map[string]datamodel.VolumeProperties)
-		for key, val := range src.Properties.Container.Volumes {
-			volumes[key] = toVolumePropertiesDataModel(val)
-		}
+	sidecars, err := toSupportingContainersDataModel(src.Properties.Sidecars, "$.properties.sidecars")
+	if err != nil {
+		return nil, err
 	}
 
 	var extensions []datamodel.Extension
 	if src.Properties.Extensions != nil {
-		for _, e := range src.Properties.Extensions {
-			extensions = append(extensions, toExtensionDataModel(e))
+		for i, e := range src.Properties.Extensions {
+			ext, err := toExtensionDataModel(e, fmt.Sprintf("$.properties.extensions[%d]", i))
+			if err != nil {
+				return nil, err
+			}
+			extensions = append(extensions, ext)
 		}
 	}
 
+	if datamodel.FindExtension(extensions, datamodel.ManualScaling) != nil && datamodel.FindExtension(extensions, datamodel.AutoScaling) != nil {
+		return nil, &v1.ErrModelConversion{PropertyName: "$.properties.extensions", ValidValue: "either a manualScaling or an autoScaling extension"}
+	}
+
 	converted := &datamodel.ContainerResource{
 		BaseResource: v1.BaseResource{
 			TrackedResource: v1.TrackedResource{
@@ -120,19 +112,10 @@ func (src *ContainerResource) ConvertTo() (v1.DataModelInterface, error) {
 			BasicResourceProperties: rpv1.BasicResourceProperties{
 				Application: to.String(src.Properties.Application),
 			},
-			Connections: connections,
-			Container: datamodel.Container{
-				Image:           to.String(src.Properties.Container.Image),
-				ImagePullPolicy: toImagePullPolicyDataModel(src.Properties.Container.ImagePullPolicy),
-				Env:             to.StringMap(src.Properties.Container.Env),
-				LivenessProbe:   livenessProbe,
-				Ports:           ports,
-				ReadinessProbe:  readinessProbe,
-				Volumes:         volumes,
-				Command:         stringSlice(src.Properties.Container.Command),
-				Args:            stringSlice(src.Properties.Container.Args),
-				WorkingDir:      to.String(src.Properties.Container.WorkingDir),
-			},
+			Connections:          connections,
+			Container:            container,
+			InitContainers:       initContainers,
+			Sidecars:             sidecars,
 			Extensions:           extensions,
 			Runtimes:             toRuntimePropertiesDataModel(src.Properties.Runtimes),
 			ResourceProvisioning: toContainerResourceProvisioningDataModel(src.Properties.ResourceProvisioning),
@@ -185,41 +168,6 @@ func (dst *ContainerResource) ConvertFrom(src v1.DataModelInterface) error {
 		}
 	}
 
-	var livenessProbe HealthProbePropertiesClassification
-	if !c.Properties.Container.LivenessProbe.IsEmpty() {
-		livenessProbe = fromHealthProbePropertiesDataModel(c.Properties.Container.LivenessProbe)
-	}
-
-	var readinessProbe HealthProbePropertiesClassification
-	if !c.Properties.Container.ReadinessProbe.IsEmpty() {
-		readinessProbe = fromHealthProbePropertiesDataModel(c.Properties.Container.ReadinessProbe)
-	}
-
-	ports := make(map[string]*ContainerPortProperties)
-	for key, val := range c.Properties.Container.Ports {
-		ports[key] = &ContainerPortProperties{
-			ContainerPort: to.Ptr(val.ContainerPort),
-			Protocol:      fromPortProtocolDataModel(val.Protocol),
-			Provides:      to.Ptr(val.Provides),
-		}
-
-		if val.Port != 0 {
-			ports[key].Port = to.Ptr(val.Port)
-		}
-
-		if val.Scheme != "" {
-			ports[key].Scheme = to.Ptr(val.Scheme)
-		}
-	}
-
-	var volumes map[string]VolumeClassification
-	if c.Properties.Container.Volumes != nil {
-		volumes = make(map[string]VolumeClassification)
-		for key, val := range c.Properties.Container.Volumes {
-			volumes[key] = fromVolumePropertiesDataModel(val)
-		}
-	}
-
 	var extensions []ExtensionClassification
 	if c.Properties.Extensions != nil {
 		for _, e := range c.Properties.Extensions {
@@ -246,21 +194,12 @@ func (dst *ContainerResource) ConvertFrom(src v1.DataModelInterface) error {
 		Status: &ResourceStatus{
 			OutputResources: toOutputResourcesDataModel(c.Properties.Status.OutputResources),
 		},
-		ProvisioningState: fromProvisioningStateDataModel(c.InternalMetadata.AsyncProvisioningState),
-		Application:       to.Ptr(c.Properties.Application),
-		Connections:       connections,
-		Container: &Container{
-			Image:           to.Ptr(c.Properties.Container.Image),
-			ImagePullPolicy: fromImagePullPolicyDataModel(c.Properties.Container.ImagePullPolicy),
-			Env:             *to.StringMapPtr(c.Properties.Container.Env),
-			LivenessProbe:   livenessProbe,
-			Ports:           ports,
-			ReadinessProbe:  readinessProbe,
-			Volumes:         volumes,
-			Command:         to.SliceOfPtrs(c.Properties.Container.Command...),
-			Args:            to.SliceOfPtrs(c.Properties.Container.Args...),
-			WorkingDir:      to.Ptr(c.Properties.Container.WorkingDir),
-		},
+		ProvisioningState:    fromProvisioningStateDataModel(c.InternalMetadata.AsyncProvisioningState),
+		Application:          to.Ptr(c.Properties.Application),
+		Connections:          connections,
+		Container:            fromContainerDataModel(c.Properties.Container),
+		InitContainers:       fromSupportingContainersDataModel(c.Properties.InitContainers),
+		Sidecars:             fromSupportingContainersDataModel(c.Properties.Sidecars),
 		Extensions:           extensions,
 		Identity:             identity,
 		Runtimes:             fromRuntimePropertiesDataModel(c.Properties.Runtimes),
@@ -272,6 +211,190 @@ func (dst *ContainerResource) ConvertFrom(src v1.DataModelInterface) error {
 	return nil
 }
 
+// toContainerDataModel converts the versioned container definition found at the given path to datamodel.
+func toContainerDataModel(c *Container, path string) (datamodel.Container, error) {
+	if c == nil {
+		return datamodel.Container{}, nil
+	}
+
+	var livenessProbe datamodel.HealthProbeProperties
+	if c.LivenessProbe != nil {
+		livenessProbe = toHealthProbePropertiesDataModel(c.LivenessProbe)
+	}
+
+	var readinessProbe datamodel.HealthProbeProperties
+	if c.ReadinessProbe != nil {
+		readinessProbe = toHealthProbePropertiesDataModel(c.ReadinessProbe)
+	}
+
+	ports := make(map[string]datamodel.ContainerPort)
+	for key, val := range c.Ports {
+		port := datamodel.ContainerPort{
+			ContainerPort: to.Int32(val.ContainerPort),
+			Protocol:      toPortProtocolDataModel(val.Protocol),
+			Provides:      to.String(val.Provides),
+		}
+
+		if val.Port != nil {
+			port.Port = to.Int32(val.Port)
+		}
+
+		if val.Scheme != nil {
+			port.Scheme = to.String(val.Scheme)
+		}
+
+		ports[key] = port
+	}
+
+	var volumes map[string]datamodel.VolumeProperties
+	if c.Volumes != nil {
+		volumes = make(map[string]datamodel.VolumeProperties)
+		for key, val := range c.Volumes {
+			volume, err := toVolumePropertiesDataModel(val, path+".volumes."+key)
+			if err != nil {
+				return datamodel.Container{}, err
+			}
+			volumes[key] = volume
+		}
+	}
+
+	secretEnv, err := toSecretEnvDataModel(c.SecretEnv, c.Env, path+".secretEnv")
+	if err != nil {
+		return datamodel.Container{}, err
+	}
+
+	resources, err := toContainerResourcesDataModel(c.Resources, path+".resources")
+	if err != nil {
+		return datamodel.Container{}, err
+	}
+
+	return datamodel.Container{
+		Image:           to.String(c.Image),
+		ImagePullPolicy: toImagePullPolicyDataModel(c.ImagePullPolicy),
+		Env:             to.StringMap(c.Env),
+		SecretEnv:       secretEnv,
+		LivenessProbe:   livenessProbe,
+		Ports:           ports,
+		ReadinessProbe:  readinessProbe,
+		Volumes:         volumes,
+		Command:         stringSlice(c.Command),
+		Args:            stringSlice(c.Args),
+		WorkingDir:      to.String(c.WorkingDir),
+		Resources:       resources,
+	}, nil
+}
+
+// fromContainerDataModel converts the container definition from datamodel to the versioned model.
+func fromContainerDataModel(c datamodel.Container) *Container {
+	var livenessProbe HealthProbePropertiesClassification
+	if !c.LivenessProbe.IsEmpty() {
+		livenessProbe = fromHealthProbePropertiesDataModel(c.LivenessProbe)
+	}
+
+	var readinessProbe HealthProbePropertiesClassification
+	if !c.ReadinessProbe.IsEmpty() {
+		readinessProbe = fromHealthProbePropertiesDataModel(c.ReadinessProbe)
+	}
+
+	ports := make(map[string]*ContainerPortProperties)
+	for key, val := range c.Ports {
+		ports[key] = &ContainerPortProperties{
+			ContainerPort: to.Ptr(val.ContainerPort),
+			Protocol:      fromPortProtocolDataModel(val.Protocol),
+			Provides:      to.Ptr(val.Provides),
+		}
+
+		if val.Port != 0 {
+			ports[key].Port = to.Ptr(val.Port)
+		}
+
+		if val.Scheme != "" {
+			ports[key].Scheme = to.Ptr(val.Scheme)
+		}
+	}
+
+	var volumes map[string]VolumeClassification
+	if c.Volumes != nil {
+		volumes = make(map[string]VolumeClassification)
+		for key, val := range c.Volumes {
+			volumes[key] = fromVolumePropertiesDataModel(val)
+		}
+	}
+
+	return &Container{
+		Image:           to.Ptr(c.Image),
+		ImagePullPolicy: fromImagePullPolicyDataModel(c.ImagePullPolicy),
+		Env:             *to.StringMapPtr(c.Env),
+		SecretEnv:       fromSecretEnvDataModel(c.SecretEnv),
+		LivenessProbe:   livenessProbe,
+		Ports:           ports,
+		ReadinessProbe:  readinessProbe,
+		Volumes:         volumes,
+		Command:         to.SliceOfPtrs(c.Command...),
+		Args:            to.SliceOfPtrs(c.Args...),
+		WorkingDir:      to.Ptr(c.WorkingDir),
+		Resources:       fromContainerResourcesDataModel(c.Resources),
+	}
+}
+
+func toSupportingContainersDataModel(containers []*SupportingContainer, path string) ([]datamodel.SupportingContainer, error) {
+	var converted []datamodel.SupportingContainer
+	for i, c := range containers {
+		if c == nil {
+			continue
+		}
+
+		container, err := toContainerDataModel(&Container{
+			Image:           c.Image,
+			ImagePullPolicy: c.ImagePullPolicy,
+			Env:             c.Env,
+			SecretEnv:       c.SecretEnv,
+			LivenessProbe:   c.LivenessProbe,
+			Ports:           c.Ports,
+			ReadinessProbe:  c.ReadinessProbe,
+			Volumes:         c.Volumes,
+			Command:         c.Command,
+			Args:            c.Args,
+			WorkingDir:      c.WorkingDir,
+			Resources:       c.Resources,
+		}, fmt.Sprintf("%s[%d]", path, i))
+		if err != nil {
+			return nil, err
+		}
+
+		converted = append(converted, datamodel.SupportingContainer{
+			Name:      to.String(c.Name),
+			Container: container,
+		})
+	}
+
+	return converted, nil
+}
+
+func fromSupportingContainersDataModel(containers []datamodel.SupportingContainer) []*SupportingContainer {
+	var converted []*SupportingContainer
+	for _, c := range containers {
+		container := fromContainerDataModel(c.Container)
+		converted = append(converted, &SupportingContainer{
+			Name:            to.Ptr(c.Name),
+			Image:           container.Image,
+			ImagePullPolicy: container.ImagePullPolicy,
+			Env:             container.Env,
+			SecretEnv:       container.SecretEnv,
+			LivenessProbe:   container.LivenessProbe,
+			Ports:           container.Ports,
+			ReadinessProbe:  container.ReadinessProbe,
+			Volumes:         container.Volumes,
+			Command:         container.Command,
+			Args:            container.Args,
+			WorkingDir:      container.WorkingDir,
+			Resources:       container.Resources,
+		})
+	}
+
+	return converted
+}
+
 func toImagePullPolicyDataModel(pullPolicy *ImagePullPolicy) string {
 	if pullPolicy == nil {
 		return ""
@@ -442,7 +565,7 @@ func fromProtocolDataModel(protocol datamodel.Protocol) *DaprSidecarExtensionPro
 	return &p
 }
 
-func toVolumePropertiesDataModel(h VolumeClassification) datamodel.VolumeProperties {
+func toVolumePropertiesDataModel(h VolumeClassification, path string) (datamodel.VolumeProperties, error) {
 	switch c := h.(type) {
 	case *EphemeralVolume:
 		return datamodel.VolumeProperties{
@@ -451,7 +574,7 @@ func toVolumePropertiesDataModel(h VolumeClassification) datamodel.VolumePropert
 				VolumeBase:   toVolumeBaseDataModel(*c.GetVolume()),
 				ManagedStore: toManagedStoreDataModel(c.ManagedStore),
 			},
-		}
+		}, nil
 	case *PersistentVolume:
 		return datamodel.VolumeProperties{
 			Kind: datamodel.Persistent,
@@ -460,10 +583,21 @@ func toVolumePropertiesDataModel(h VolumeClassification) datamodel.VolumePropert
 				Source:     to.String(c.Source),
 				Permission: toPermissionDataModel(c.Permission),
 			},
+		}, nil
+	case *SecretVolume:
+		if err := validateSecretSource(to.String(c.Source), path+".source"); err != nil {
+			return datamodel.VolumeProperties{}, err
 		}
+		return datamodel.VolumeProperties{
+			Kind: datamodel.Secret,
+			Secret: &datamodel.SecretVolume{
+				VolumeBase: toVolumeBaseDataModel(*c.GetVolume()),
+				Source:     to.String(c.Source),
+			},
+		}, nil
 	}
 
-	return datamodel.VolumeProperties{}
+	return datamodel.VolumeProperties{}, nil
 }
 
 func fromVolumePropertiesDataModel(v datamodel.VolumeProperties) VolumeClassification {
@@ -481,6 +615,76 @@ func fromVolumePropertiesDataModel(v datamodel.VolumeProperties) VolumeClassific
 			Source:     &v.Persistent.Source,
 			Permission: fromPermissionDataModel(v.Persistent.Permission),
 		}
+	case datamodel.Secret:
+		return &SecretVolume{
+			Kind:      (*string)(&v.Kind),
+			MountPath: &v.Secret.MountPath,
+			Source:    &v.Secret.Source,
+		}
+	}
+
+	return nil
+}
+
+func toSecretEnvDataModel(secretEnv map[string]*SecretReference, env map[string]*string, path string) (map[string]datamodel.SecretReference, error) {
+	if secretEnv == nil {
+		return nil, nil
+	}
+
+	converted := make(map[string]datamodel.SecretReference, len(secretEnv))
+	for name, ref := range secretEnv {
+		refPath := path + "." + name
+		if _, ok := env[name]; ok {
+			return nil, &v1.ErrModelConversion{PropertyName: refPath, ValidValue: "the name of an environment variable not set in env"}
+		}
+		if ref == nil {
+			return nil, &v1.ErrModelConversion{PropertyName: refPath, ValidValue: "a reference to a key of a secret"}
+		}
+		if err := validateSecretSource(to.String(ref.Source), refPath+".source"); err != nil {
+			return nil, err
+		}
+		if errs := validation.IsConfigMapKey(to.String(ref.Key)); len(errs) > 0 {
+			return nil, &v1.ErrModelConversion{PropertyName: refPath + ".key", ValidValue: "a valid secret key"}
+		}
+
+		converted[name] = datamodel.SecretReference{
+			Source: to.String(ref.Source),
+			Key:    to.String(ref.Key),
+		}
+	}
+
+	return converted, nil
+}
+
+func fromSecretEnvDataModel(secretEnv map[string]datamodel.SecretReference) map[string]*SecretReference {
+	if secretEnv == nil {
+		return nil
+	}
+
+	converted := make(map[string]*SecretReference, len(secretEnv))
+	for name, ref := range secretEnv {
+		converted[name] = &SecretReference{
+			Source: to.Ptr(ref.Source),
+			Key:    to.Ptr(ref.Key),
+		}
+	}
+
+	return converted
+}
+
+// validateSecretSource validates that the source of a secret is either the resource ID of an
+// Applications.Core/secretStores resource or the name of a Kubernetes secret.
+func validateSecretSource(source string, path string) error {
+	if strings.Contains(source, "/") {
+		id, err := resources.ParseResource(source)
+		if err != nil || !strings.EqualFold(id.Type(), datamodel.SecretStoreResourceType) {
+			return &v1.ErrModelConversion{PropertyName: path, ValidValue: "the resource ID of an Applications.Core/secretStores resource or the name of a Kubernetes secret"}
+		}
+		return nil
+	}
+
+	if errs := validation.IsDNS1123Subdomain(source); len(errs) > 0 {
+		return &v1.ErrModelConversion{PropertyName: path, ValidValue: "the resource ID of an Applications.Core/secretStores resource or the name of a Kubernetes secret"}
 	}
 
 	return nil
@@ -657,7 +861,7 @@ func fromPermissionDataModel(rbac datamodel.VolumePermission) *VolumePermission
 }
 
 // toExtensionDataModel: Converts from versioned datamodel to base datamodel
-func toExtensionDataModel(e ExtensionClassification) datamodel.Extension {
+func toExtensionDataModel(e ExtensionClassification, path string) (datamodel.Extension, error) {
 	switch c := e.(type) {
 	case *ManualScalingExtension:
 		return datamodel.Extension{
@@ -665,7 +869,16 @@ func toExtensionDataModel(e ExtensionClassification) datamodel.Extension {
 			ManualScaling: &datamodel.ManualScalingExtension{
 				Replicas: c.Replicas,
 			},
+		}, nil
+	case *AutoScalingExtension:
+		autoScaling, err := toAutoScalingExtensionDataModel(c, path)
+		if err != nil {
+			return datamodel.Extension{}, err
 		}
+		return datamodel.Extension{
+			Kind:        datamodel.AutoScaling,
+			AutoScaling: autoScaling,
+		}, nil
 	case *DaprSidecarExtension:
 		return datamodel.Extension{
 			Kind: datamodel.DaprSidecar,
@@ -675,7 +888,7 @@ func toExtensionDataModel(e ExtensionClassification) datamodel.Extension {
 				Config:   to.String(c.Config),
 				Protocol: toDaprProtocolDataModel(c.Protocol),
 			},
-		}
+		}, nil
 	case *KubernetesMetadataExtension:
 		return datamodel.Extension{
 			Kind: datamodel.KubernetesMetadata,
@@ -683,10 +896,10 @@ func toExtensionDataModel(e ExtensionClassification) datamodel.Extension {
 				Annotations: to.StringMap(c.Annotations),
 				Labels:      to.StringMap(c.Labels),
 			},
-		}
+		}, nil
 	}
 
-	return datamodel.Extension{}
+	return datamodel.Extension{}, nil
 }
 
 // fromExtensionClassificationDataModel: Converts from base datamodel to versioned datamodel
@@ -697,6 +910,8 @@ func fromExtensionClassificationDataModel(e datamodel.Extension) ExtensionClassi
 			Kind:     to.Ptr(string(e.Kind)),
 			Replicas: e.ManualScaling.Replicas,
 		}
+	case datamodel.AutoScaling:
+		return fromAutoScalingExtensionDataModel(e.AutoScaling)
 	case datamodel.DaprSidecar:
 		return &DaprSidecarExtension{
 			Kind:     to.Ptr(string(e.Kind)),
@@ -717,6 +932,170 @@ func fromExtensionClassificationDataModel(e datamodel.Extension) ExtensionClassi
 	return nil
 }
 
+func toAutoScalingExtensionDataModel(e *AutoScalingExtension, path string) (*datamodel.AutoScalingExtension, error) {
+	maxReplicas := to.Int32(e.MaxReplicas)
+	if maxReplicas < 1 {
+		return nil, &v1.ErrModelConversion{PropertyName: path + ".maxReplicas", ValidValue: "a positive integer"}
+	}
+	if e.MinReplicas != nil && (*e.MinReplicas < 1 || *e.MinReplicas > maxReplicas) {
+		return nil, &v1.ErrModelConversion{PropertyName: path + ".minReplicas", ValidValue: "a positive integer lower than or equal to maxReplicas"}
+	}
+
+	autoScaling := &datamodel.AutoScalingExtension{
+		MinReplicas: e.MinReplicas,
+		MaxReplicas: maxReplicas,
+	}
+	for i, m := range e.Metrics {
+		if m == nil {
+			continue
+		}
+
+		metricPath := fmt.Sprintf("%s.metrics[%d]", path, i)
+		metric := datamodel.AutoScalingMetric{
+			Name:                     to.String(m.Name),
+			TargetAverageUtilization: m.TargetAverageUtilization,
+			TargetAverageValue:       to.String(m.TargetAverageValue),
+		}
+
+		var kind AutoScalingMetricKind
+		if m.Kind != nil {
+			kind = *m.Kind
+		}
+
+		switch kind {
+		case AutoScalingMetricKindCPU, AutoScalingMetricKindMemory:
+			metric.Kind = datamodel.AutoScalingMetricKind(kind)
+			if (metric.TargetAverageUtilization == nil) == (metric.TargetAverageValue == "") {
+				return nil, &v1.ErrModelConversion{PropertyName: metricPath, ValidValue: "either targetAverageUtilization or targetAverageValue"}
+			}
+		case AutoScalingMetricKindCustom:
+			metric.Kind = datamodel.AutoScalingMetricCustom
+			if metric.Name == "" {
+				return nil, &v1.ErrModelConversion{PropertyName: metricPath + ".name", ValidValue: "the name of the custom metric"}
+			}
+			if metric.TargetAverageUtilization != nil || metric.TargetAverageValue == "" {
+				return nil, &v1.ErrModelConversion{PropertyName: metricPath + ".targetAverageValue", ValidValue: "the target value of the custom metric"}
+			}
+		default:
+			return nil, &v1.ErrModelConversion{PropertyName: metricPath + ".kind", ValidValue: fmt.Sprintf("%v", PossibleAutoScalingMetricKindValues())}
+		}
+
+		if metric.TargetAverageUtilization != nil && *metric.TargetAverageUtilization < 1 {
+			return nil, &v1.ErrModelConversion{PropertyName: metricPath + ".targetAverageUtilization", ValidValue: "a positive percentage"}
+		}
+		if metric.TargetAverageValue != "" {
+			if _, err := resource.ParseQuantity(metric.TargetAverageValue); err != nil {
+				return nil, &v1.ErrModelConversion{PropertyName: metricPath + ".targetAverageValue", ValidValue: "a quantity such as 500m or 1Gi"}
+			}
+		}
+
+		autoScaling.Metrics = append(autoScaling.Metrics, metric)
+	}
+
+	return autoScaling, nil
+}
+
+func fromAutoScalingExtensionDataModel(e *datamodel.AutoScalingExtension) *AutoScalingExtension {
+	autoScaling := &AutoScalingExtension{
+		Kind:        to.Ptr(string(datamodel.AutoScaling)),
+		MinReplicas: e.MinReplicas,
+		MaxReplicas: to.Ptr(e.MaxReplicas),
+	}
+	for _, m := range e.Metrics {
+		metric := &AutoScalingMetric{
+			Kind:                     to.Ptr(AutoScalingMetricKind(m.Kind)),
+			TargetAverageUtilization: m.TargetAverageUtilization,
+		}
+		if m.Name != "" {
+			metric.Name = to.Ptr(m.Name)
+		}
+		if m.TargetAverageValue != "" {
+			metric.TargetAverageValue = to.Ptr(m.TargetAverageValue)
+		}
+		autoScaling.Metrics = append(autoScaling.Metrics, metric)
+	}
+
+	return autoScaling
+}
+
+func toContainerResourcesDataModel(r *ContainerResources, path string) (*datamodel.ContainerResources, error) {
+	if r == nil {
+		return nil, nil
+	}
+
+	resources := &datamodel.ContainerResources{
+		Requests: toContainerResourceQuantitiesDataModel(r.Requests),
+		Limits:   toContainerResourceQuantitiesDataModel(r.Limits),
+	}
+
+	quantities := []struct {
+		path    string
+		request string
+		limit   string
+		example string
+	}{
+		{"cpu", resources.Requests.CPU, resources.Limits.CPU, "500m or 2"},
+		{"memory", resources.Requests.Memory, resources.Limits.Memory, "128Mi or 1Gi"},
+	}
+	for _, q := range quantities {
+		var request, limit resource.Quantity
+		var err error
+		if q.request != "" {
+			if request, err = resource.ParseQuantity(q.request); err != nil {
+				return nil, &v1.ErrModelConversion{PropertyName: path + ".requests." + q.path, ValidValue: "a quantity such as " + q.example}
+			}
+		}
+		if q.limit != "" {
+			if limit, err = resource.ParseQuantity(q.limit); err != nil {
+				return nil, &v1.ErrModelConversion{PropertyName: path + ".limits." + q.path, ValidValue: "a quantity such as " + q.example}
+			}
+		}
+		if q.request != "" && q.limit != "" && request.Cmp(limit) > 0 {
+			return nil, &v1.ErrModelConversion{PropertyName: path + ".requests." + q.path, ValidValue: "lower than or equal to the limit"}
+		}
+	}
+
+	return resources, nil
+}
+
+func toContainerResourceQuantitiesDataModel(q *ContainerResourceQuantities) datamodel.ContainerResourceQuantities {
+	if q == nil {
+		return datamodel.ContainerResourceQuantities{}
+	}
+
+	return datamodel.ContainerResourceQuantities{
+		CPU:    to.String(q.CPU),
+		Memory: to.String(q.Memory),
+	}
+}
+
+func fromContainerResourcesDataModel(r *datamodel.ContainerResources) *ContainerResources {
+	if r == nil {
+		return nil
+	}
+
+	return &ContainerResources{
+		Requests: fromContainerResourceQuantitiesDataModel(r.Requests),
+		Limits:   fromContainerResourceQuantitiesDataModel(r.Limits),
+	}
+}
+
+func fromContainerResourceQuantitiesDataModel(q datamodel.ContainerResourceQuantities) *ContainerResourceQuantities {
+	if q.IsEmpty() {
+		return nil
+	}
+
+	quantities := &ContainerResourceQuantities{}
+	if q.CPU != "" {
+		quantities.CPU = to.Ptr(q.CPU)
+	}
+	if q.Memory != "" {
+		quantities.Memory = to.Ptr(q.Memory)
+	}
+
+	return quantities
+}
+
 func toHealthProbeBase(h HealthProbeProperties) datamodel.HealthProbeBase {
 	return datamodel.HealthProbeBase{
 		FailureThreshold:    h.FailureThreshold,
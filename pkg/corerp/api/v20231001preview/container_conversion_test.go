@@ -243,6 +243,371 @@ func TestContainerConvertVersionedToDataModelEmptyProtocol(t *testing.T) {
 
 }
 
+func TestContainerConvertVersionedToDataModel_ResourcesAndAutoScaling(t *testing.T) {
+	rawPayload := testutil.ReadFixture("containerresource-autoscaling.json")
+	r := &ContainerResource{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	dm, err := r.ConvertTo()
+	require.NoError(t, err)
+
+	ct := dm.(*datamodel.ContainerResource)
+	require.Equal(t, &datamodel.ContainerResources{
+		Requests: datamodel.ContainerResourceQuantities{CPU: "250m", Memory: "128Mi"},
+		Limits:   datamodel.ContainerResourceQuantities{CPU: "1", Memory: "1Gi"},
+	}, ct.Properties.Container.Resources)
+
+	expected := []datamodel.Extension{
+		{
+			Kind: datamodel.AutoScaling,
+			AutoScaling: &datamodel.AutoScalingExtension{
+				MinReplicas: to.Ptr[int32](2),
+				MaxReplicas: 10,
+				Metrics: []datamodel.AutoScalingMetric{
+					{Kind: datamodel.AutoScalingMetricCPU, TargetAverageUtilization: to.Ptr[int32](70)},
+					{Kind: datamodel.AutoScalingMetricMemory, TargetAverageValue: "512Mi"},
+					{Kind: datamodel.AutoScalingMetricCustom, Name: "requests_per_second", TargetAverageValue: "100"},
+				},
+			},
+		},
+	}
+	require.Equal(t, expected, ct.Properties.Extensions)
+}
+
+func TestContainerConvertDataModelToVersioned_ResourcesAndAutoScaling(t *testing.T) {
+	rawPayload := testutil.ReadFixture("containerresourcedatamodel-autoscaling.json")
+	r := &datamodel.ContainerResource{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	versioned := &ContainerResource{}
+	err = versioned.ConvertFrom(r)
+	require.NoError(t, err)
+
+	require.Equal(t, &ContainerResources{
+		Requests: &ContainerResourceQuantities{CPU: to.Ptr("250m"), Memory: to.Ptr("128Mi")},
+		Limits:   &ContainerResourceQuantities{Memory: to.Ptr("1Gi")},
+	}, versioned.Properties.Container.Resources)
+
+	expected := []ExtensionClassification{
+		&AutoScalingExtension{
+			Kind:        to.Ptr("autoScaling"),
+			MaxReplicas: to.Ptr[int32](10),
+			Metrics: []*AutoScalingMetric{
+				{Kind: to.Ptr(AutoScalingMetricKindCPU), TargetAverageUtilization: to.Ptr[int32](70)},
+				{Kind: to.Ptr(AutoScalingMetricKindCustom), Name: to.Ptr("requests_per_second"), TargetAverageValue: to.Ptr("100")},
+			},
+		},
+	}
+	require.Equal(t, expected, versioned.Properties.Extensions)
+}
+
+func TestContainerConvertVersionedToDataModel_SupportingContainers(t *testing.T) {
+	rawPayload := testutil.ReadFixture("containerresource-supportingcontainers.json")
+	r := &ContainerResource{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	dm, err := r.ConvertTo()
+	require.NoError(t, err)
+
+	ct := dm.(*datamodel.ContainerResource)
+	require.Len(t, ct.Properties.InitContainers, 1)
+	require.Equal(t, "migrate", ct.Properties.InitContainers[0].Name)
+	require.Equal(t, "ghcr.io/radius-project/migrations", ct.Properties.InitContainers[0].Image)
+	require.Equal(t, []string{"/migrate"}, ct.Properties.InitContainers[0].Command)
+
+	require.Len(t, ct.Properties.Sidecars, 1)
+	sidecar := ct.Properties.Sidecars[0]
+	require.Equal(t, "proxy", sidecar.Name)
+	require.Equal(t, "envoyproxy/envoy", sidecar.Image)
+	require.Equal(t, map[string]string{"UPSTREAM": "localhost:3000"}, sidecar.Env)
+	require.Equal(t, int32(8080), sidecar.Ports["proxy"].ContainerPort)
+	require.Equal(t, &datamodel.ContainerResources{
+		Limits: datamodel.ContainerResourceQuantities{Memory: "64Mi"},
+	}, sidecar.Resources)
+
+	require.Len(t, ct.Properties.AllContainers(), 3)
+}
+
+func TestContainerConvertDataModelToVersioned_SupportingContainers(t *testing.T) {
+	rawPayload := testutil.ReadFixture("containerresourcedatamodel-supportingcontainers.json")
+	r := &datamodel.ContainerResource{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	versioned := &ContainerResource{}
+	err = versioned.ConvertFrom(r)
+	require.NoError(t, err)
+
+	require.Len(t, versioned.Properties.InitContainers, 1)
+	require.Equal(t, "migrate", to.String(versioned.Properties.InitContainers[0].Name))
+	require.Equal(t, "ghcr.io/radius-project/migrations", to.String(versioned.Properties.InitContainers[0].Image))
+	require.Equal(t, []*string{to.Ptr("/migrate")}, versioned.Properties.InitContainers[0].Command)
+
+	require.Len(t, versioned.Properties.Sidecars, 1)
+	sidecar := versioned.Properties.Sidecars[0]
+	require.Equal(t, "proxy", to.String(sidecar.Name))
+	require.Equal(t, "envoyproxy/envoy", to.String(sidecar.Image))
+	require.Equal(t, map[string]*string{"UPSTREAM": to.Ptr("localhost:3000")}, sidecar.Env)
+	require.Equal(t, int32(8080), *sidecar.Ports["proxy"].ContainerPort)
+}
+
+func TestContainerConvertVersionedToDataModel_InvalidSupportingContainers(t *testing.T) {
+	r := &ContainerResource{
+		Properties: &ContainerProperties{
+			Application: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Applications.Core/applications/app0"),
+			Container: &Container{
+				Image: to.Ptr("ghcr.io/radius-project/webapptutorial-todoapp"),
+			},
+			Sidecars: []*SupportingContainer{
+				{
+					Name:  to.Ptr("proxy"),
+					Image: to.Ptr("envoyproxy/envoy"),
+					Resources: &ContainerResources{
+						Requests: &ContainerResourceQuantities{CPU: to.Ptr("lots")},
+					},
+				},
+			},
+		},
+	}
+
+	_, err := r.ConvertTo()
+	require.Error(t, err)
+	require.IsType(t, &v1.ErrModelConversion{}, err)
+	require.Contains(t, err.Error(), "$.properties.sidecars[0].resources.requests.cpu")
+}
+
+func TestContainerConvertVersionedToDataModel_Secrets(t *testing.T) {
+	rawPayload := testutil.ReadFixture("containerresource-secrets.json")
+	r := &ContainerResource{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	dm, err := r.ConvertTo()
+	require.NoError(t, err)
+
+	ct := dm.(*datamodel.ContainerResource)
+	require.Equal(t, map[string]string{"DB_USER": "admin"}, ct.Properties.Container.Env)
+
+	expectedSecretEnv := map[string]datamodel.SecretReference{
+		"DB_PASSWORD": {
+			Source: "/planes/radius/local/resourceGroups/testGroup/providers/Applications.Core/secretStores/dbsecrets",
+			Key:    "password",
+		},
+		"API_TOKEN": {
+			Source: "api-credentials",
+			Key:    "token",
+		},
+	}
+	require.Equal(t, expectedSecretEnv, ct.Properties.Container.SecretEnv)
+
+	expectedVolumes := map[string]datamodel.VolumeProperties{
+		"certs": {
+			Kind: datamodel.Secret,
+			Secret: &datamodel.SecretVolume{
+				VolumeBase: datamodel.VolumeBase{MountPath: "/etc/certs"},
+				Source:     "/planes/radius/local/resourceGroups/testGroup/providers/Applications.Core/secretStores/certs",
+			},
+		},
+	}
+	require.Equal(t, expectedVolumes, ct.Properties.Container.Volumes)
+}
+
+func TestContainerConvertDataModelToVersioned_Secrets(t *testing.T) {
+	rawPayload := testutil.ReadFixture("containerresourcedatamodel-secrets.json")
+	r := &datamodel.ContainerResource{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	versioned := &ContainerResource{}
+	err = versioned.ConvertFrom(r)
+	require.NoError(t, err)
+
+	require.Equal(t, map[string]*string{"DB_USER": to.Ptr("admin")}, versioned.Properties.Container.Env)
+
+	expectedSecretEnv := map[string]*SecretReference{
+		"DB_PASSWORD": {
+			Source: to.Ptr("/planes/radius/local/resourceGroups/testGroup/providers/Applications.Core/secretStores/dbsecrets"),
+			Key:    to.Ptr("password"),
+		},
+	}
+	require.Equal(t, expectedSecretEnv, versioned.Properties.Container.SecretEnv)
+
+	expectedVolumes := map[string]VolumeClassification{
+		"certs": &SecretVolume{
+			Kind:      to.Ptr("secret"),
+			MountPath: to.Ptr("/etc/certs"),
+			Source:    to.Ptr("api-certs"),
+		},
+	}
+	require.Equal(t, expectedVolumes, versioned.Properties.Container.Volumes)
+}
+
+func TestContainerConvertVersionedToDataModel_InvalidSecrets(t *testing.T) {
+	secretStoreID := "/planes/radius/local/resourceGroups/testGroup/providers/Applications.Core/secretStores/dbsecrets"
+
+	tests := []struct {
+		name      string
+		env       map[string]*string
+		secretEnv map[string]*SecretReference
+		volumes   map[string]VolumeClassification
+		err       error
+	}{
+		{
+			name: "name set in env",
+			env:  map[string]*string{"DB_PASSWORD": to.Ptr("password")},
+			secretEnv: map[string]*SecretReference{
+				"DB_PASSWORD": {Source: to.Ptr(secretStoreID), Key: to.Ptr("password")},
+			},
+			err: &v1.ErrModelConversion{PropertyName: "$.properties.container.secretEnv.DB_PASSWORD", ValidValue: "the name of an environment variable not set in env"},
+		},
+		{
+			name:      "missing reference",
+			secretEnv: map[string]*SecretReference{"DB_PASSWORD": nil},
+			err:       &v1.ErrModelConversion{PropertyName: "$.properties.container.secretEnv.DB_PASSWORD", ValidValue: "a reference to a key of a secret"},
+		},
+		{
+			name: "source is not a secretStore",
+			secretEnv: map[string]*SecretReference{
+				"DB_PASSWORD": {
+					Source: to.Ptr("/planes/radius/local/resourceGroups/testGroup/providers/Applications.Core/extenders/dbsecrets"),
+					Key:    to.Ptr("password"),
+				},
+			},
+			err: &v1.ErrModelConversion{PropertyName: "$.properties.container.secretEnv.DB_PASSWORD.source", ValidValue: "the resource ID of an Applications.Core/secretStores resource or the name of a Kubernetes secret"},
+		},
+		{
+			name: "invalid Kubernetes secret name",
+			secretEnv: map[string]*SecretReference{
+				"DB_PASSWORD": {Source: to.Ptr("DB_Secrets"), Key: to.Ptr("password")},
+			},
+			err: &v1.ErrModelConversion{PropertyName: "$.properties.container.secretEnv.DB_PASSWORD.source", ValidValue: "the resource ID of an Applications.Core/secretStores resource or the name of a Kubernetes secret"},
+		},
+		{
+			name: "invalid key",
+			secretEnv: map[string]*SecretReference{
+				"DB_PASSWORD": {Source: to.Ptr(secretStoreID), Key: to.Ptr("db/password")},
+			},
+			err: &v1.ErrModelConversion{PropertyName: "$.properties.container.secretEnv.DB_PASSWORD.key", ValidValue: "a valid secret key"},
+		},
+		{
+			name: "invalid secret volume source",
+			volumes: map[string]VolumeClassification{
+				"certs": &SecretVolume{
+					Kind:      to.Ptr("secret"),
+					MountPath: to.Ptr("/etc/certs"),
+					Source:    to.Ptr(""),
+				},
+			},
+			err: &v1.ErrModelConversion{PropertyName: "$.properties.container.volumes.certs.source", ValidValue: "the resource ID of an Applications.Core/secretStores resource or the name of a Kubernetes secret"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &ContainerResource{
+				Properties: &ContainerProperties{
+					Application: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Applications.Core/applications/app0"),
+					Container: &Container{
+						Image:     to.Ptr("ghcr.io/radius-project/webapptutorial-todoapp"),
+						Env:       tc.env,
+						SecretEnv: tc.secretEnv,
+						Volumes:   tc.volumes,
+					},
+				},
+			}
+
+			_, err := r.ConvertTo()
+			require.Equal(t, tc.err, err)
+		})
+	}
+}
+
+func TestContainerConvertVersionedToDataModel_InvalidResourcesAndAutoScaling(t *testing.T) {
+	validContainer := func() *Container {
+		return &Container{Image: to.Ptr("image")}
+	}
+
+	tests := []struct {
+		name       string
+		container  *Container
+		extensions []ExtensionClassification
+		err        *v1.ErrModelConversion
+	}{
+		{
+			name: "invalid quantity",
+			container: &Container{
+				Image:     to.Ptr("image"),
+				Resources: &ContainerResources{Requests: &ContainerResourceQuantities{Memory: to.Ptr("lots")}},
+			},
+			err: &v1.ErrModelConversion{PropertyName: "$.properties.container.resources.requests.memory", ValidValue: "a quantity such as 128Mi or 1Gi"},
+		},
+		{
+			name: "request greater than limit",
+			container: &Container{
+				Image: to.Ptr("image"),
+				Resources: &ContainerResources{
+					Requests: &ContainerResourceQuantities{CPU: to.Ptr("2")},
+					Limits:   &ContainerResourceQuantities{CPU: to.Ptr("500m")},
+				},
+			},
+			err: &v1.ErrModelConversion{PropertyName: "$.properties.container.resources.requests.cpu", ValidValue: "lower than or equal to the limit"},
+		},
+		{
+			name:       "min replicas greater than max replicas",
+			container:  validContainer(),
+			extensions: []ExtensionClassification{&AutoScalingExtension{Kind: to.Ptr("autoScaling"), MinReplicas: to.Ptr[int32](5), MaxReplicas: to.Ptr[int32](3)}},
+			err:        &v1.ErrModelConversion{PropertyName: "$.properties.extensions[0].minReplicas", ValidValue: "a positive integer lower than or equal to maxReplicas"},
+		},
+		{
+			name:      "cpu metric without target",
+			container: validContainer(),
+			extensions: []ExtensionClassification{&AutoScalingExtension{
+				Kind:        to.Ptr("autoScaling"),
+				MaxReplicas: to.Ptr[int32](3),
+				Metrics:     []*AutoScalingMetric{{Kind: to.Ptr(AutoScalingMetricKindCPU)}},
+			}},
+			err: &v1.ErrModelConversion{PropertyName: "$.properties.extensions[0].metrics[0]", ValidValue: "either targetAverageUtilization or targetAverageValue"},
+		},
+		{
+			name:      "custom metric without name",
+			container: validContainer(),
+			extensions: []ExtensionClassification{&AutoScalingExtension{
+				Kind:        to.Ptr("autoScaling"),
+				MaxReplicas: to.Ptr[int32](3),
+				Metrics:     []*AutoScalingMetric{{Kind: to.Ptr(AutoScalingMetricKindCustom), TargetAverageValue: to.Ptr("10")}},
+			}},
+			err: &v1.ErrModelConversion{PropertyName: "$.properties.extensions[0].metrics[0].name", ValidValue: "the name of the custom metric"},
+		},
+		{
+			name:      "manual and auto scaling",
+			container: validContainer(),
+			extensions: []ExtensionClassification{
+				&ManualScalingExtension{Kind: to.Ptr("manualScaling"), Replicas: to.Ptr[int32](2)},
+				&AutoScalingExtension{Kind: to.Ptr("autoScaling"), MaxReplicas: to.Ptr[int32](3)},
+			},
+			err: &v1.ErrModelConversion{PropertyName: "$.properties.extensions", ValidValue: "either a manualScaling or an autoScaling extension"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &ContainerResource{
+				Properties: &ContainerProperties{
+					Application: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Applications.Core/applications/app0"),
+					Container:   tt.container,
+					Extensions:  tt.extensions,
+				},
+			}
+
+			_, err := r.ConvertTo()
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
+
 func TestContainerConvertFromValidation(t *testing.T) {
 	validationTests := []struct {
 		src v1.DataModelInterface
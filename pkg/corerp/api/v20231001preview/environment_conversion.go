@@ -62,7 +62,10 @@ func (src *EnvironmentResource) ConvertTo() (v1.DataModelInterface, error) {
 		return nil, err
 	}
 	converted.Properties.Compute = *envCompute
-	converted.Properties.RecipeConfig = toRecipeConfigDatamodel(src.Properties.RecipeConfig)
+	converted.Properties.RecipeConfig, err = toRecipeConfigDatamodel(src.Properties.RecipeConfig)
+	if err != nil {
+		return nil, err
+	}
 
 	if src.Properties.Recipes != nil {
 		envRecipes := make(map[string]map[string]datamodel.EnvironmentRecipeProperties)
@@ -108,6 +111,11 @@ func (src *EnvironmentResource) ConvertTo() (v1.DataModelInterface, error) {
 		converted.Properties.Simulated = true
 	}
 
+	converted.Properties.Gateway, err = toEnvironmentGatewayDatamodel(src.Properties.Gateway)
+	if err != nil {
+		return nil, err
+	}
+
 	var extensions []datamodel.Extension
 	if src.Properties.Extensions != nil {
 		for _, e := range src.Properties.Extensions {
@@ -171,6 +179,8 @@ func (dst *EnvironmentResource) ConvertFrom(src v1.DataModelInterface) error {
 		dst.Properties.Simulated = to.Ptr(env.Properties.Simulated)
 	}
 
+	dst.Properties.Gateway = fromEnvironmentGatewayDatamodel(env.Properties.Gateway)
+
 	var extensions []ExtensionClassification
 	if env.Properties.Extensions != nil {
 		for _, e := range env.Properties.Extensions {
@@ -182,7 +192,7 @@ func (dst *EnvironmentResource) ConvertFrom(src v1.DataModelInterface) error {
 	return nil
 }
 
-func toRecipeConfigDatamodel(config *RecipeConfigProperties) datamodel.RecipeConfigProperties {
+func toRecipeConfigDatamodel(config *RecipeConfigProperties) (datamodel.RecipeConfigProperties, error) {
 	if config != nil {
 		recipeConfig := datamodel.RecipeConfigProperties{}
 		if config.Terraform != nil {
@@ -205,14 +215,150 @@ func toRecipeConfigDatamodel(config *RecipeConfigProperties) datamodel.RecipeCon
 			}
 
 			recipeConfig.Terraform.Providers = toRecipeConfigTerraformProvidersDatamodel(config)
+			recipeConfig.Terraform.Version = to.String(config.Terraform.Version)
+
+			backend, err := toTerraformBackendDatamodel(config.Terraform.Backend)
+			if err != nil {
+				return datamodel.RecipeConfigProperties{}, err
+			}
+			recipeConfig.Terraform.Backend = backend
 		}
 
 		recipeConfig.Env = toRecipeConfigEnvDatamodel(config)
 
-		return recipeConfig
+		return recipeConfig, nil
+	}
+
+	return datamodel.RecipeConfigProperties{}, nil
+}
+
+func toTerraformBackendDatamodel(config *TerraformBackendConfig) (datamodel.TerraformBackendConfig, error) {
+	if config == nil {
+		return datamodel.TerraformBackendConfig{}, nil
+	}
+
+	backend := datamodel.TerraformBackendConfig{}
+	if config.Kind != nil {
+		if !isValidTerraformBackendKind(*config.Kind) {
+			return datamodel.TerraformBackendConfig{}, &v1.ErrModelConversion{PropertyName: "$.properties.recipeConfig.terraform.backend.kind", ValidValue: "[kubernetes pg s3 local]"}
+		}
+		backend.Kind = string(*config.Kind)
+	}
+
+	if config.S3 != nil {
+		backend.S3 = &datamodel.S3BackendConfig{
+			Bucket:       to.String(config.S3.Bucket),
+			Region:       to.String(config.S3.Region),
+			Endpoint:     to.String(config.S3.Endpoint),
+			KeyPrefix:    to.String(config.S3.KeyPrefix),
+			UsePathStyle: to.Bool(config.S3.UsePathStyle),
+		}
+	}
+
+	if config.Local != nil {
+		backend.Local = &datamodel.LocalBackendConfig{
+			Path: to.String(config.Local.Path),
+		}
+	}
+
+	switch backend.Kind {
+	case string(TerraformBackendKindS3):
+		if backend.S3 == nil || backend.S3.Bucket == "" {
+			return datamodel.TerraformBackendConfig{}, &v1.ErrModelConversion{PropertyName: "$.properties.recipeConfig.terraform.backend.s3.bucket", ValidValue: "a bucket name"}
+		}
+	case string(TerraformBackendKindLocal):
+		if backend.Local == nil || backend.Local.Path == "" {
+			return datamodel.TerraformBackendConfig{}, &v1.ErrModelConversion{PropertyName: "$.properties.recipeConfig.terraform.backend.local.path", ValidValue: "a directory path"}
+		}
+	}
+
+	return backend, nil
+}
+
+func fromTerraformBackendDatamodel(config datamodel.TerraformBackendConfig) *TerraformBackendConfig {
+	if reflect.DeepEqual(config, datamodel.TerraformBackendConfig{}) {
+		return nil
+	}
+
+	backend := &TerraformBackendConfig{}
+	if config.Kind != "" {
+		backend.Kind = to.Ptr(TerraformBackendKind(config.Kind))
+	}
+
+	if config.S3 != nil {
+		backend.S3 = &TerraformS3BackendConfig{
+			Bucket:       to.Ptr(config.S3.Bucket),
+			Region:       toStringPtr(config.S3.Region),
+			Endpoint:     toStringPtr(config.S3.Endpoint),
+			KeyPrefix:    toStringPtr(config.S3.KeyPrefix),
+			UsePathStyle: to.Ptr(config.S3.UsePathStyle),
+		}
+	}
+
+	if config.Local != nil {
+		backend.Local = &TerraformLocalBackendConfig{
+			Path: to.Ptr(config.Local.Path),
+		}
+	}
+
+	return backend
+}
+
+func isValidTerraformBackendKind(kind TerraformBackendKind) bool {
+	for _, k := range PossibleTerraformBackendKindValues() {
+		if k == kind {
+			return true
+		}
+	}
+
+	return false
+}
+
+func toEnvironmentGatewayDatamodel(config *EnvironmentGatewayConfig) (datamodel.EnvironmentGatewayConfig, error) {
+	if config == nil {
+		return datamodel.EnvironmentGatewayConfig{}, nil
+	}
+
+	gateway := datamodel.EnvironmentGatewayConfig{
+		GatewayClassName: to.String(config.GatewayClassName),
+	}
+	if config.Kind != nil {
+		if !isValidGatewayKind(*config.Kind) {
+			return datamodel.EnvironmentGatewayConfig{}, &v1.ErrModelConversion{PropertyName: "$.properties.gateway.kind", ValidValue: "[contour gatewayAPI]"}
+		}
+		gateway.Kind = string(*config.Kind)
+	}
+
+	if gateway.Kind == datamodel.GatewayKindGatewayAPI && gateway.GatewayClassName == "" {
+		return datamodel.EnvironmentGatewayConfig{}, &v1.ErrModelConversion{PropertyName: "$.properties.gateway.gatewayClassName", ValidValue: "a GatewayClass name"}
+	}
+
+	return gateway, nil
+}
+
+func fromEnvironmentGatewayDatamodel(config datamodel.EnvironmentGatewayConfig) *EnvironmentGatewayConfig {
+	if config == (datamodel.EnvironmentGatewayConfig{}) {
+		return nil
+	}
+
+	gateway := &EnvironmentGatewayConfig{
+		GatewayClassName: toStringPtr(config.GatewayClassName),
+	}
+	if config.Kind != "" {
+		gateway.Kind = to.Ptr(GatewayKind(config.Kind))
 	}
 
-	return datamodel.RecipeConfigProperties{}
+	return gateway
+}
+
+func isValidGatewayKind(kind GatewayKind) bool {
+	for _, k := range PossibleGatewayKindValues() {
+		if k == kind {
+			return true
+		}
+	}
+
+	return false
 }
 
 func fromRecipeConfigDatamodel(config datamodel.RecipeConfigProperties) *RecipeConfigProperties {
@@ -236,6 +382,10 @@ func fromRecipeConfigDatamodel(config datamodel.RecipeConfigProperties) *RecipeC
 			}
 
 			recipeConfig.Terraform.Providers = fromRecipeConfigTerraformProvidersDatamodel(config)
+			if config.Terraform.Version != "" {
+				recipeConfig.Terraform.Version = to.Ptr(config.Terraform.Version)
+			}
+			recipeConfig.Terraform.Backend = fromTerraformBackendDatamodel(config.Terraform.Backend)
 		}
 
 		recipeConfig.Env = fromRecipeConfigEnvDatamodel(config)
@@ -362,46 +512,131 @@ func toEnvExtensionDataModel(e ExtensionClassification) datamodel.Extension {
 }
 
 func toEnvironmentRecipeProperties(e RecipePropertiesClassification) (datamodel.EnvironmentRecipeProperties, error) {
+	var recipe datamodel.EnvironmentRecipeProperties
 	switch c := e.(type) {
 	case *TerraformRecipeProperties:
-		if c.TemplatePath != nil {
-			// Check for local paths
-			if strings.HasPrefix(to.String(c.TemplatePath), "/") || strings.HasPrefix(to.String(c.TemplatePath), "./") || strings.HasPrefix(to.String(c.TemplatePath), "../") {
-				return datamodel.EnvironmentRecipeProperties{}, v1.NewClientErrInvalidRequest(fmt.Sprintf(invalidLocalModulePathFmt, to.String(c.TemplatePath)))
-			}
+		if c.TemplatePath != nil && isLocalModulePath(to.String(c.TemplatePath)) {
+			return datamodel.EnvironmentRecipeProperties{}, v1.NewClientErrInvalidRequest(fmt.Sprintf(invalidLocalModulePathFmt, to.String(c.TemplatePath)))
 		}
-		return datamodel.EnvironmentRecipeProperties{
+		recipe = datamodel.EnvironmentRecipeProperties{
 			TemplateKind:    types.TemplateKindTerraform,
 			TemplateVersion: to.String(c.TemplateVersion),
 			TemplatePath:    to.String(c.TemplatePath),
 			Parameters:      c.Parameters,
-		}, nil
+		}
 	case *BicepRecipeProperties:
-		return datamodel.EnvironmentRecipeProperties{
+		recipe = datamodel.EnvironmentRecipeProperties{
 			TemplateKind: types.TemplateKindBicep,
 			TemplatePath: to.String(c.TemplatePath),
 			PlainHTTP:    to.Bool(c.PlainHTTP),
 			Parameters:   c.Parameters,
-		}, nil
+		}
+	case *HelmRecipeProperties:
+		recipe = datamodel.EnvironmentRecipeProperties{
+			TemplateKind:    types.TemplateKindHelm,
+			TemplateVersion: to.String(c.TemplateVersion),
+			TemplatePath:    to.String(c.TemplatePath),
+			PlainHTTP:       to.Bool(c.PlainHTTP),
+			Parameters:      c.Parameters,
+		}
+	case *KubernetesRecipeProperties:
+		recipe = datamodel.EnvironmentRecipeProperties{
+			TemplateKind: types.TemplateKindKubernetes,
+			TemplatePath: to.String(c.TemplatePath),
+			PlainHTTP:    to.Bool(c.PlainHTTP),
+			Parameters:   c.Parameters,
+		}
+	default:
+		return datamodel.EnvironmentRecipeProperties{}, nil
+	}
+
+	properties := e.GetRecipeProperties()
+	recipe.DefaultVersion = to.String(properties.DefaultVersion)
+	if properties.Versions != nil {
+		recipe.Versions = map[string]datamodel.RecipeVersionProperties{}
+		for name, version := range properties.Versions {
+			if version == nil || to.String(version.TemplatePath) == "" {
+				return datamodel.EnvironmentRecipeProperties{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("recipe version %q must specify a templatePath", name))
+			}
+			if recipe.TemplateKind == types.TemplateKindTerraform && isLocalModulePath(to.String(version.TemplatePath)) {
+				return datamodel.EnvironmentRecipeProperties{}, v1.NewClientErrInvalidRequest(fmt.Sprintf(invalidLocalModulePathFmt, to.String(version.TemplatePath)))
+			}
+			recipe.Versions[name] = datamodel.RecipeVersionProperties{
+				TemplatePath:    to.String(version.TemplatePath),
+				TemplateVersion: to.String(version.TemplateVersion),
+				Parameters:      version.Parameters,
+			}
+		}
 	}
-	return datamodel.EnvironmentRecipeProperties{}, nil
+
+	if recipe.DefaultVersion != "" {
+		if _, ok := recipe.Versions[recipe.DefaultVersion]; !ok {
+			return datamodel.EnvironmentRecipeProperties{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("the default version %q of the recipe is not one of its versions", recipe.DefaultVersion))
+		}
+	}
+
+	return recipe, nil
+}
+
+// isLocalModulePath returns true if the Terraform module path refers to the local filesystem.
+func isLocalModulePath(path string) bool {
+	return strings.HasPrefix(path, "/") || strings.HasPrefix(path, "./") || strings.HasPrefix(path, "../")
 }
 
 func fromRecipePropertiesClassificationDatamodel(e datamodel.EnvironmentRecipeProperties) RecipePropertiesClassification {
+	var versions map[string]*RecipeVersionProperties
+	if e.Versions != nil {
+		versions = map[string]*RecipeVersionProperties{}
+		for name, version := range e.Versions {
+			versions[name] = &RecipeVersionProperties{
+				TemplatePath:    to.Ptr(version.TemplatePath),
+				TemplateVersion: to.Ptr(version.TemplateVersion),
+				Parameters:      version.Parameters,
+			}
+		}
+	}
+
 	switch e.TemplateKind {
 	case types.TemplateKindTerraform:
 		return &TerraformRecipeProperties{
-			TemplateKind:    to.Ptr(e.TemplateKind),
-			TemplateVersion: to.Ptr(e.TemplateVersion),
-			TemplatePath:    to.Ptr(e.TemplatePath),
-			Parameters:      e.Parameters,
+			TemplateKind:           to.Ptr(e.TemplateKind),
+			TemplateVersion:        to.Ptr(e.TemplateVersion),
+			TemplatePath:           to.Ptr(e.TemplatePath),
+			Parameters:             e.Parameters,
+			Versions:               versions,
+			DefaultVersion:         toStringPtr(e.DefaultVersion),
+			PreviousDefaultVersion: toStringPtr(e.PreviousDefaultVersion),
 		}
 	case types.TemplateKindBicep:
 		return &BicepRecipeProperties{
-			TemplateKind: to.Ptr(e.TemplateKind),
-			TemplatePath: to.Ptr(e.TemplatePath),
-			Parameters:   e.Parameters,
-			PlainHTTP:    to.Ptr(e.PlainHTTP),
+			TemplateKind:           to.Ptr(e.TemplateKind),
+			TemplatePath:           to.Ptr(e.TemplatePath),
+			Parameters:             e.Parameters,
+			PlainHTTP:              to.Ptr(e.PlainHTTP),
+			Versions:               versions,
+			DefaultVersion:         toStringPtr(e.DefaultVersion),
+			PreviousDefaultVersion: toStringPtr(e.PreviousDefaultVersion),
+		}
+	case types.TemplateKindHelm:
+		return &HelmRecipeProperties{
+			TemplateKind:           to.Ptr(e.TemplateKind),
+			TemplateVersion:        to.Ptr(e.TemplateVersion),
+			TemplatePath:           to.Ptr(e.TemplatePath),
+			Parameters:             e.Parameters,
+			PlainHTTP:              to.Ptr(e.PlainHTTP),
+			Versions:               versions,
+			DefaultVersion:         toStringPtr(e.DefaultVersion),
+			PreviousDefaultVersion: toStringPtr(e.PreviousDefaultVersion),
+		}
+	case types.TemplateKindKubernetes:
+		return &KubernetesRecipeProperties{
+			TemplateKind:           to.Ptr(e.TemplateKind),
+			TemplatePath:           to.Ptr(e.TemplatePath),
+			Parameters:             e.Parameters,
+			PlainHTTP:              to.Ptr(e.PlainHTTP),
+			Versions:               versions,
+			DefaultVersion:         toStringPtr(e.DefaultVersion),
+			PreviousDefaultVersion: toStringPtr(e.PreviousDefaultVersion),
 		}
 	}
 
@@ -148,6 +148,16 @@ func TestConvertVersionedToDataModel(t *testing.T) {
 									},
 								},
 							},
+							Version: "1.6.4",
+							Backend: datamodel.TerraformBackendConfig{
+								Kind: "s3",
+								S3: &datamodel.S3BackendConfig{
+									Bucket:       "tfstate",
+									Region:       "us-east-1",
+									Endpoint:     "http://minio.minio-system:9000",
+									UsePathStyle: true,
+								},
+							},
 						},
 						Env: datamodel.EnvironmentVariables{
 							AdditionalProperties: map[string]string{
@@ -180,6 +190,16 @@ func TestConvertVersionedToDataModel(t *testing.T) {
 								TemplatePath: "br:ghcr.io/sampleregistry/radius/recipes/rediscaches",
 								PlainHTTP:    true,
 							},
+							"helm-recipe": datamodel.EnvironmentRecipeProperties{
+								TemplateKind:    recipes.TemplateKindHelm,
+								TemplatePath:    "oci://ghcr.io/sampleregistry/charts/redis",
+								TemplateVersion: "1.0.0",
+							},
+							"kubernetes-recipe": datamodel.EnvironmentRecipeProperties{
+								TemplateKind: recipes.TemplateKindKubernetes,
+								TemplatePath: "oci://ghcr.io/sampleregistry/recipes/redis:1.0.0",
+								PlainHTTP:    true,
+							},
 						},
 						dapr_ctrl.DaprStateStoresResourceType: {
 							"statestore-recipe": datamodel.EnvironmentRecipeProperties{
@@ -307,6 +327,46 @@ func TestConvertVersionedToDataModel(t *testing.T) {
 			},
 			err: nil,
 		},
+		{
+			filename: "environmentresource-with-gatewayapi.json",
+			expected: &datamodel.Environment{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/environments/env0",
+						Name: "env0",
+						Type: "Applications.Core/environments",
+						Tags: map[string]string{},
+					},
+					InternalMetadata: v1.InternalMetadata{
+						CreatedAPIVersion:      "2023-10-01-preview",
+						UpdatedAPIVersion:      "2023-10-01-preview",
+						AsyncProvisioningState: v1.ProvisioningStateAccepted,
+					},
+				},
+				Properties: datamodel.EnvironmentProperties{
+					Compute: rpv1.EnvironmentCompute{
+						Kind: "kubernetes",
+						KubernetesCompute: rpv1.KubernetesComputeProperties{
+							ResourceID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Microsoft.ContainerService/managedClusters/radiusTestCluster",
+							Namespace:  "default",
+						},
+					},
+					Gateway: datamodel.EnvironmentGatewayConfig{
+						Kind:             datamodel.GatewayKindGatewayAPI,
+						GatewayClassName: "eg",
+					},
+				},
+			},
+			err: nil,
+		},
+		{
+			filename: "environmentresource-invalid-gatewaykind.json",
+			err:      &v1.ErrModelConversion{PropertyName: "$.properties.gateway.kind", ValidValue: "[contour gatewayAPI]"},
+		},
+		{
+			filename: "environmentresource-missing-gatewayclassname.json",
+			err:      &v1.ErrModelConversion{PropertyName: "$.properties.gateway.gatewayClassName", ValidValue: "a GatewayClass name"},
+		},
 		{
 			filename: "environmentresource-invalid-missing-namespace.json",
 			err:      &v1.ErrModelConversion{PropertyName: "$.properties.compute.namespace", ValidValue: "63 characters or less"},
@@ -321,16 +381,28 @@ func TestConvertVersionedToDataModel(t *testing.T) {
 		},
 		{
 			filename: "environmentresource-invalid-templatekind.json",
-			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: "invalid template kind. Allowed formats: \"bicep\", \"terraform\""},
+			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: "invalid template kind. Allowed formats: \"bicep\", \"terraform\", \"helm\", \"kubernetes\""},
+		},
+		{
+			filename: "environmentresource-invalid-terraformbackend.json",
+			err:      &v1.ErrModelConversion{PropertyName: "$.properties.recipeConfig.terraform.backend.kind", ValidValue: "[kubernetes pg s3 local]"},
+		},
+		{
+			filename: "environmentresource-missing-terraformbackend-bucket.json",
+			err:      &v1.ErrModelConversion{PropertyName: "$.properties.recipeConfig.terraform.backend.s3.bucket", ValidValue: "a bucket name"},
 		},
 		{
 			filename: "environmentresource-missing-templatekind.json",
-			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: "invalid template kind. Allowed formats: \"bicep\", \"terraform\""},
+			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: "invalid template kind. Allowed formats: \"bicep\", \"terraform\", \"helm\", \"kubernetes\""},
 		},
 		{
 			filename: "environmentresource-terraformrecipe-localpath.json",
 			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: fmt.Sprintf(invalidLocalModulePathFmt, "../not-allowed/")},
 		},
+		{
+			filename: "environmentresource-invalid-recipedefaultversion.json",
+			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: "the default version \"v3\" of the recipe is not one of its versions"},
+		},
 	}
 
 	for _, tt := range conversionTests {
@@ -418,6 +490,22 @@ func TestConvertDataModelToVersioned(t *testing.T) {
 					require.Equal(t, 1, len(versioned.Properties.RecipeConfig.Terraform.Providers["azurerm"]))
 					subscriptionId := versioned.Properties.RecipeConfig.Terraform.Providers["azurerm"][0]["subscriptionId"]
 					require.Equal(t, "00000000-0000-0000-0000-000000000000", subscriptionId)
+					require.Equal(t, "1.6.4", string(*versioned.Properties.RecipeConfig.Terraform.Version))
+					require.Equal(t, TerraformBackendKindS3, *versioned.Properties.RecipeConfig.Terraform.Backend.Kind)
+					require.Equal(t, "tfstate", *versioned.Properties.RecipeConfig.Terraform.Backend.S3.Bucket)
+					require.Equal(t, "http://minio.minio-system:9000", *versioned.Properties.RecipeConfig.Terraform.Backend.S3.Endpoint)
+					require.True(t, *versioned.Properties.RecipeConfig.Terraform.Backend.S3.UsePathStyle)
+					require.Equal(t, GatewayKindGatewayAPI, *versioned.Properties.Gateway.Kind)
+					require.Equal(t, "eg", *versioned.Properties.Gateway.GatewayClassName)
+					helmRecipe, ok := versioned.Properties.Recipes[ds_ctrl.MongoDatabasesResourceType]["helm-recipe"].(*HelmRecipeProperties)
+					require.True(t, ok)
+					require.Equal(t, "oci://ghcr.io/sampleregistry/charts/mongodb", string(*helmRecipe.TemplatePath))
+					require.Equal(t, "1.0.0", string(*helmRecipe.TemplateVersion))
+					require.Equal(t, true, bool(*helmRecipe.PlainHTTP))
+					kubernetesRecipe, ok := versioned.Properties.Recipes[ds_ctrl.MongoDatabasesResourceType]["kubernetes-recipe"].(*KubernetesRecipeProperties)
+					require.True(t, ok)
+					require.Equal(t, "oci://ghcr.io/sampleregistry/recipes/mongodb:1.0.0", string(*kubernetesRecipe.TemplatePath))
+					require.Equal(t, true, bool(*kubernetesRecipe.PlainHTTP))
 					require.Equal(t, 1, len(versioned.Properties.RecipeConfig.Env))
 					require.Equal(t, to.Ptr("myEnvValue"), versioned.Properties.RecipeConfig.Env["myEnvVar"])
 				}
@@ -894,3 +982,38 @@ func Test_fromRecipeConfigEnvDatamodel(t *testing.T) {
 		})
 	}
 }
+
+func TestConvertVersionedToDataModel_RecipeVersions(t *testing.T) {
+	rawPayload := testutil.ReadFixture("environmentresource-with-recipeversions.json")
+	r := &EnvironmentResource{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	dm, err := r.ConvertTo()
+	require.NoError(t, err)
+
+	expected := datamodel.EnvironmentRecipeProperties{
+		TemplateKind:    "terraform",
+		TemplatePath:    "Azure/cosmosdb/azurerm",
+		TemplateVersion: "1.1.0",
+		DefaultVersion:  "v2",
+		Versions: map[string]datamodel.RecipeVersionProperties{
+			"v2": {
+				TemplatePath:    "Azure/cosmosdb/azurerm",
+				TemplateVersion: "2.0.0",
+				Parameters: map[string]any{
+					"throughput": float64(400),
+				},
+			},
+		},
+	}
+	recipe := dm.(*datamodel.Environment).Properties.Recipes["Applications.Datastores/mongoDatabases"]["cosmos-recipe"]
+	require.Equal(t, expected, recipe)
+
+	// The previous default version is recorded by the server and is returned to the client.
+	recipe.PreviousDefaultVersion = "v1"
+	versioned := fromRecipePropertiesClassificationDatamodel(recipe).(*TerraformRecipeProperties)
+	require.Equal(t, "v2", to.String(versioned.DefaultVersion))
+	require.Equal(t, "v1", to.String(versioned.PreviousDefaultVersion))
+	require.Equal(t, "2.0.0", to.String(versioned.Versions["v2"].TemplateVersion))
+}
@@ -17,10 +17,15 @@ limitations under the License.
 package v20231001preview
 
 import (
+	"fmt"
+	"net/http"
+	"time"
+
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 	"github.com/radius-project/radius/pkg/to"
+	"golang.org/x/exp/slices"
 )
 
 // ConvertTo converts from the versioned Gateway resource to version-agnostic datamodel.
@@ -44,16 +49,20 @@ func (src *GatewayResource) ConvertTo() (v1.DataModelInterface, error) {
 	// Note: SystemData conversion isn't required since this property comes ARM and datastore.
 	routes := []datamodel.GatewayRoute{}
 	if src.Properties.Routes != nil {
-		for _, r := range src.Properties.Routes {
-			s := datamodel.GatewayRoute{
-				Destination:   to.String(r.Destination),
-				Path:          to.String(r.Path),
-				ReplacePrefix: to.String(r.ReplacePrefix),
+		for i, r := range src.Properties.Routes {
+			s, err := toGatewayRouteDataModel(r, fmt.Sprintf("$.properties.routes[%d]", i))
+			if err != nil {
+				return nil, err
 			}
 			routes = append(routes, s)
 		}
 	}
 
+	cors, err := toGatewayCORSDataModel(src.Properties.Cors)
+	if err != nil {
+		return nil, err
+	}
+
 	var hostname *datamodel.GatewayPropertiesHostname
 	if src.Properties.Hostname != nil {
 		hostname = &datamodel.GatewayPropertiesHostname{
@@ -82,6 +91,7 @@ func (src *GatewayResource) ConvertTo() (v1.DataModelInterface, error) {
 			},
 			Hostname: hostname,
 			TLS:      tls,
+			CORS:     cors,
 			Routes:   routes,
 			URL:      to.String(src.Properties.URL),
 		},
@@ -109,12 +119,7 @@ func (dst *GatewayResource) ConvertFrom(src v1.DataModelInterface) error {
 	routes := []*GatewayRoute{}
 	if g.Properties.Routes != nil {
 		for _, r := range g.Properties.Routes {
-			s := &GatewayRoute{
-				Destination:   to.Ptr(r.Destination),
-				Path:          to.Ptr(r.Path),
-				ReplacePrefix: to.Ptr(r.ReplacePrefix),
-			}
-			routes = append(routes, s)
+			routes = append(routes, fromGatewayRouteDataModel(r))
 		}
 	}
 
@@ -141,6 +146,7 @@ func (dst *GatewayResource) ConvertFrom(src v1.DataModelInterface) error {
 		Hostname:          hostname,
 		Routes:            routes,
 		TLS:               tls,
+		Cors:              fromGatewayCORSDataModel(g.Properties.CORS),
 		URL:               to.Ptr(g.Properties.URL),
 	}
 
@@ -175,3 +181,182 @@ func fromTLSMinVersionDataModel(tlsMinVersion datamodel.MinimumTLSProtocolVersio
 
 	return &t
 }
+
+// validGatewayRouteMethods is the list of the HTTP methods a gateway route can match on.
+var validGatewayRouteMethods = []string{
+	http.MethodGet,
+	http.MethodHead,
+	http.MethodPost,
+	http.MethodPut,
+	http.MethodPatch,
+	http.MethodDelete,
+	http.MethodConnect,
+	http.MethodOptions,
+	http.MethodTrace,
+}
+
+func toGatewayRouteDataModel(r *GatewayRoute, path string) (datamodel.GatewayRoute, error) {
+	route := datamodel.GatewayRoute{
+		Destination:   to.String(r.Destination),
+		Path:          to.String(r.Path),
+		ReplacePrefix: to.String(r.ReplacePrefix),
+		Method:        to.String(r.Method),
+		Weight:        r.Weight,
+	}
+
+	if route.Method != "" && !slices.Contains(validGatewayRouteMethods, route.Method) {
+		return datamodel.GatewayRoute{}, &v1.ErrModelConversion{PropertyName: path + ".method", ValidValue: fmt.Sprintf("%v", validGatewayRouteMethods)}
+	}
+
+	if route.Weight != nil && *route.Weight < 0 {
+		return datamodel.GatewayRoute{}, &v1.ErrModelConversion{PropertyName: path + ".weight", ValidValue: "a non-negative integer"}
+	}
+
+	for i, h := range r.Headers {
+		if h == nil || to.String(h.Name) == "" {
+			return datamodel.GatewayRoute{}, &v1.ErrModelConversion{PropertyName: fmt.Sprintf("%s.headers[%d].name", path, i), ValidValue: "a header name"}
+		}
+		route.Headers = append(route.Headers, datamodel.GatewayRouteHeaderMatch{
+			Name:  to.String(h.Name),
+			Value: to.String(h.Value),
+		})
+	}
+
+	for i, q := range r.QueryParameters {
+		if q == nil || to.String(q.Name) == "" {
+			return datamodel.GatewayRoute{}, &v1.ErrModelConversion{PropertyName: fmt.Sprintf("%s.queryParameters[%d].name", path, i), ValidValue: "a query parameter name"}
+		}
+		route.QueryParameters = append(route.QueryParameters, datamodel.GatewayRouteQueryParameterMatch{
+			Name:  to.String(q.Name),
+			Value: to.String(q.Value),
+		})
+	}
+
+	if r.TimeoutPolicy != nil {
+		route.TimeoutPolicy = &datamodel.GatewayRouteTimeoutPolicy{
+			Request: to.String(r.TimeoutPolicy.Request),
+			Idle:    to.String(r.TimeoutPolicy.Idle),
+		}
+		if !isValidDuration(route.TimeoutPolicy.Request) {
+			return datamodel.GatewayRoute{}, &v1.ErrModelConversion{PropertyName: path + ".timeoutPolicy.request", ValidValue: "a duration such as 30s"}
+		}
+		if !isValidDuration(route.TimeoutPolicy.Idle) {
+			return datamodel.GatewayRoute{}, &v1.ErrModelConversion{PropertyName: path + ".timeoutPolicy.idle", ValidValue: "a duration such as 5m"}
+		}
+	}
+
+	if r.RetryPolicy != nil {
+		route.RetryPolicy = &datamodel.GatewayRouteRetryPolicy{
+			Attempts:      to.Int32(r.RetryPolicy.Attempts),
+			PerTryTimeout: to.String(r.RetryPolicy.PerTryTimeout),
+			RetryOn:       stringSlice(r.RetryPolicy.RetryOn),
+		}
+		for _, code := range r.RetryPolicy.RetriableStatusCodes {
+			route.RetryPolicy.RetriableStatusCodes = append(route.RetryPolicy.RetriableStatusCodes, to.Int32(code))
+		}
+		if route.RetryPolicy.Attempts < 1 {
+			return datamodel.GatewayRoute{}, &v1.ErrModelConversion{PropertyName: path + ".retryPolicy.attempts", ValidValue: "a positive integer"}
+		}
+		if !isValidDuration(route.RetryPolicy.PerTryTimeout) {
+			return datamodel.GatewayRoute{}, &v1.ErrModelConversion{PropertyName: path + ".retryPolicy.perTryTimeout", ValidValue: "a duration such as 5s"}
+		}
+	}
+
+	return route, nil
+}
+
+func fromGatewayRouteDataModel(r datamodel.GatewayRoute) *GatewayRoute {
+	route := &GatewayRoute{
+		Destination:   to.Ptr(r.Destination),
+		Path:          to.Ptr(r.Path),
+		ReplacePrefix: to.Ptr(r.ReplacePrefix),
+		Weight:        r.Weight,
+	}
+
+	if r.Method != "" {
+		route.Method = to.Ptr(r.Method)
+	}
+
+	for _, h := range r.Headers {
+		route.Headers = append(route.Headers, &GatewayRouteHeaderMatch{
+			Name:  to.Ptr(h.Name),
+			Value: to.Ptr(h.Value),
+		})
+	}
+
+	for _, q := range r.QueryParameters {
+		route.QueryParameters = append(route.QueryParameters, &GatewayRouteQueryParameterMatch{
+			Name:  to.Ptr(q.Name),
+			Value: to.Ptr(q.Value),
+		})
+	}
+
+	if r.TimeoutPolicy != nil {
+		route.TimeoutPolicy = &GatewayRouteTimeoutPolicy{
+			Request: to.Ptr(r.TimeoutPolicy.Request),
+			Idle:    to.Ptr(r.TimeoutPolicy.Idle),
+		}
+	}
+
+	if r.RetryPolicy != nil {
+		route.RetryPolicy = &GatewayRouteRetryPolicy{
+			Attempts:             to.Ptr(r.RetryPolicy.Attempts),
+			PerTryTimeout:        to.Ptr(r.RetryPolicy.PerTryTimeout),
+			RetryOn:              to.SliceOfPtrs(r.RetryPolicy.RetryOn...),
+			RetriableStatusCodes: to.SliceOfPtrs(r.RetryPolicy.RetriableStatusCodes...),
+		}
+	}
+
+	return route
+}
+
+func toGatewayCORSDataModel(cors *GatewayCors) (*datamodel.GatewayCORS, error) {
+	if cors == nil {
+		return nil, nil
+	}
+
+	converted := &datamodel.GatewayCORS{
+		AllowOrigins:     stringSlice(cors.AllowOrigins),
+		AllowMethods:     stringSlice(cors.AllowMethods),
+		AllowHeaders:     stringSlice(cors.AllowHeaders),
+		ExposeHeaders:    stringSlice(cors.ExposeHeaders),
+		AllowCredentials: to.Bool(cors.AllowCredentials),
+		MaxAge:           to.String(cors.MaxAge),
+	}
+
+	if len(converted.AllowOrigins) == 0 {
+		return nil, &v1.ErrModelConversion{PropertyName: "$.properties.cors.allowOrigins", ValidValue: "a non-empty list of origins"}
+	}
+	if len(converted.AllowMethods) == 0 {
+		return nil, &v1.ErrModelConversion{PropertyName: "$.properties.cors.allowMethods", ValidValue: "a non-empty list of HTTP methods"}
+	}
+	if !isValidDuration(converted.MaxAge) {
+		return nil, &v1.ErrModelConversion{PropertyName: "$.properties.cors.maxAge", ValidValue: "a duration such as 10m"}
+	}
+
+	return converted, nil
+}
+
+func fromGatewayCORSDataModel(cors *datamodel.GatewayCORS) *GatewayCors {
+	if cors == nil {
+		return nil
+	}
+
+	return &GatewayCors{
+		AllowOrigins:     to.SliceOfPtrs(cors.AllowOrigins...),
+		AllowMethods:     to.SliceOfPtrs(cors.AllowMethods...),
+		AllowHeaders:     to.SliceOfPtrs(cors.AllowHeaders...),
+		ExposeHeaders:    to.SliceOfPtrs(cors.ExposeHeaders...),
+		AllowCredentials: to.Ptr(cors.AllowCredentials),
+		MaxAge:           to.Ptr(cors.MaxAge),
+	}
+}
+
+// isValidDuration returns true if the value is empty or a valid duration.
+func isValidDuration(value string) bool {
+	if value == "" {
+		return true
+	}
+	_, err := time.ParseDuration(value)
+	return err == nil
+}
@@ -23,6 +23,7 @@ import (
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/test/testutil"
 	"github.com/radius-project/radius/test/testutil/resourcetypeutil"
 
@@ -265,3 +266,132 @@ func TestGatewayConvertFromValidation(t *testing.T) {
 		require.ErrorAs(t, tc.err, &err)
 	}
 }
+
+func TestGatewayTrafficPoliciesConvertVersionedToDataModel(t *testing.T) {
+	// arrange
+	rawPayload := testutil.ReadFixture("gatewayresource-with-trafficpolicies.json")
+	r := &GatewayResource{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	// act
+	dm, err := r.ConvertTo()
+
+	// assert
+	require.NoError(t, err)
+	gw := dm.(*datamodel.Gateway)
+	require.Equal(t, &datamodel.GatewayCORS{
+		AllowOrigins:     []string{"https://example.com"},
+		AllowMethods:     []string{"GET", "POST"},
+		AllowHeaders:     []string{"authorization"},
+		AllowCredentials: true,
+		MaxAge:           "10m",
+	}, gw.Properties.CORS)
+	require.Len(t, gw.Properties.Routes, 3)
+	require.Equal(t, datamodel.GatewayRoute{
+		Destination: "frontend",
+		Path:        "/",
+		Weight:      to.Ptr(int32(90)),
+		TimeoutPolicy: &datamodel.GatewayRouteTimeoutPolicy{
+			Request: "30s",
+			Idle:    "5m",
+		},
+		RetryPolicy: &datamodel.GatewayRouteRetryPolicy{
+			Attempts:             3,
+			PerTryTimeout:        "5s",
+			RetryOn:              []string{"retriable-status-codes"},
+			RetriableStatusCodes: []int32{503},
+		},
+	}, gw.Properties.Routes[0])
+	require.Equal(t, to.Ptr(int32(10)), gw.Properties.Routes[1].Weight)
+	require.Equal(t, datamodel.GatewayRoute{
+		Destination:     "backend",
+		Path:            "/api",
+		Method:          "POST",
+		Headers:         []datamodel.GatewayRouteHeaderMatch{{Name: "x-version", Value: "v2"}},
+		QueryParameters: []datamodel.GatewayRouteQueryParameterMatch{{Name: "debug", Value: "true"}},
+	}, gw.Properties.Routes[2])
+	require.True(t, gw.Properties.Routes[2].HasMatch())
+	require.False(t, gw.Properties.Routes[0].HasMatch())
+}
+
+func TestGatewayTrafficPoliciesConvertDataModelToVersioned(t *testing.T) {
+	// arrange
+	rawPayload := testutil.ReadFixture("gatewayresourcedatamodel-with-trafficpolicies.json")
+	r := &datamodel.Gateway{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	// act
+	versioned := &GatewayResource{}
+	err = versioned.ConvertFrom(r)
+
+	// assert
+	require.NoError(t, err)
+	require.Equal(t, []*string{to.Ptr("https://example.com")}, versioned.Properties.Cors.AllowOrigins)
+	require.Equal(t, []*string{to.Ptr("GET")}, versioned.Properties.Cors.AllowMethods)
+	require.Equal(t, "10m", *versioned.Properties.Cors.MaxAge)
+	require.Equal(t, int32(90), *versioned.Properties.Routes[0].Weight)
+	require.Equal(t, "30s", *versioned.Properties.Routes[0].TimeoutPolicy.Request)
+	require.Equal(t, int32(3), *versioned.Properties.Routes[0].RetryPolicy.Attempts)
+	require.Equal(t, []*string{to.Ptr("5xx")}, versioned.Properties.Routes[0].RetryPolicy.RetryOn)
+	require.Nil(t, versioned.Properties.Routes[0].Method)
+	require.Equal(t, "POST", *versioned.Properties.Routes[1].Method)
+	require.Equal(t, "x-version", *versioned.Properties.Routes[1].Headers[0].Name)
+	require.Equal(t, "v2", *versioned.Properties.Routes[1].Headers[0].Value)
+	require.Equal(t, "debug", *versioned.Properties.Routes[1].QueryParameters[0].Name)
+	require.Nil(t, versioned.Properties.Routes[1].Weight)
+}
+
+func TestGatewayTrafficPoliciesConvertVersionedToDataModel_Invalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(r *GatewayResource)
+		err    string
+	}{
+		{
+			name:   "invalid method",
+			modify: func(r *GatewayResource) { r.Properties.Routes[2].Method = to.Ptr("get") },
+			err:    "$.properties.routes[2].method must be [GET HEAD POST PUT PATCH DELETE CONNECT OPTIONS TRACE].",
+		},
+		{
+			name:   "negative weight",
+			modify: func(r *GatewayResource) { r.Properties.Routes[1].Weight = to.Ptr(int32(-1)) },
+			err:    "$.properties.routes[1].weight must be a non-negative integer.",
+		},
+		{
+			name:   "missing header name",
+			modify: func(r *GatewayResource) { r.Properties.Routes[2].Headers[0].Name = nil },
+			err:    "$.properties.routes[2].headers[0].name must be a header name.",
+		},
+		{
+			name:   "invalid timeout",
+			modify: func(r *GatewayResource) { r.Properties.Routes[0].TimeoutPolicy.Request = to.Ptr("30") },
+			err:    "$.properties.routes[0].timeoutPolicy.request must be a duration such as 30s.",
+		},
+		{
+			name:   "no retry attempts",
+			modify: func(r *GatewayResource) { r.Properties.Routes[0].RetryPolicy.Attempts = to.Ptr(int32(0)) },
+			err:    "$.properties.routes[0].retryPolicy.attempts must be a positive integer.",
+		},
+		{
+			name:   "cors without origins",
+			modify: func(r *GatewayResource) { r.Properties.Cors.AllowOrigins = nil },
+			err:    "$.properties.cors.allowOrigins must be a non-empty list of origins.",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			rawPayload := testutil.ReadFixture("gatewayresource-with-trafficpolicies.json")
+			r := &GatewayResource{}
+			err := json.Unmarshal(rawPayload, r)
+			require.NoError(t, err)
+			tc.modify(r)
+
+			_, err = r.ConvertTo()
+			require.ErrorIs(t, err, &v1.ErrModelConversion{})
+			require.EqualError(t, err, tc.err)
+		})
+	}
+}
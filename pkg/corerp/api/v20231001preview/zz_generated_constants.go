@@ -26,6 +26,27 @@ func PossibleActionTypeValues() []ActionType {
 	}
 }
 
+// AutoScalingMetricKind - The kind of an autoscaling metric
+type AutoScalingMetricKind string
+
+const (
+	// AutoScalingMetricKindCPU - CPU usage of the replicas
+	AutoScalingMetricKindCPU AutoScalingMetricKind = "cpu"
+	// AutoScalingMetricKindCustom - Custom metric exposed for the replicas
+	AutoScalingMetricKindCustom AutoScalingMetricKind = "custom"
+	// AutoScalingMetricKindMemory - Memory usage of the replicas
+	AutoScalingMetricKindMemory AutoScalingMetricKind = "memory"
+)
+
+// PossibleAutoScalingMetricKindValues returns the possible values for the AutoScalingMetricKind const type.
+func PossibleAutoScalingMetricKindValues() []AutoScalingMetricKind {
+	return []AutoScalingMetricKind{	
+		AutoScalingMetricKindCPU,
+		AutoScalingMetricKindCustom,
+		AutoScalingMetricKindMemory,
+	}
+}
+
 // CertificateFormats - Represents certificate formats
 type CertificateFormats string
 
@@ -142,6 +163,24 @@ func PossibleDirectionValues() []Direction {
 	}
 }
 
+// GatewayKind - The API used to expose the gateways of an environment.
+type GatewayKind string
+
+const (
+	// GatewayKindContour - Exposes gateways with Contour HTTPProxy objects.
+	GatewayKindContour GatewayKind = "contour"
+	// GatewayKindGatewayAPI - Exposes gateways with Kubernetes Gateway API Gateway, HTTPRoute and ReferenceGrant objects.
+	GatewayKindGatewayAPI GatewayKind = "gatewayAPI"
+)
+
+// PossibleGatewayKindValues returns the possible values for the GatewayKind const type.
+func PossibleGatewayKindValues() []GatewayKind {
+	return []GatewayKind{	
+		GatewayKindContour,
+		GatewayKindGatewayAPI,
+	}
+}
+
 // IAMKind - The kind of IAM provider to configure
 type IAMKind string
 
@@ -284,6 +323,36 @@ func PossibleProvisioningStateValues() []ProvisioningState {
 	}
 }
 
+// RecipeResourceChangeAction - The action that the execution of a recipe would take on a resource.
+type RecipeResourceChangeAction string
+
+const (
+	// RecipeResourceChangeActionCreate - The resource would be created.
+	RecipeResourceChangeActionCreate RecipeResourceChangeAction = "create"
+	// RecipeResourceChangeActionDelete - The resource would be deleted.
+	RecipeResourceChangeActionDelete RecipeResourceChangeAction = "delete"
+	// RecipeResourceChangeActionNoChange - The resource would not be changed.
+	RecipeResourceChangeActionNoChange RecipeResourceChangeAction = "noChange"
+	// RecipeResourceChangeActionReplace - The resource would be deleted and created again.
+	RecipeResourceChangeActionReplace RecipeResourceChangeAction = "replace"
+	// RecipeResourceChangeActionUnknown - The change to the resource can't be predicted.
+	RecipeResourceChangeActionUnknown RecipeResourceChangeAction = "unknown"
+	// RecipeResourceChangeActionUpdate - The resource would be updated in place.
+	RecipeResourceChangeActionUpdate RecipeResourceChangeAction = "update"
+)
+
+// PossibleRecipeResourceChangeActionValues returns the possible values for the RecipeResourceChangeAction const type.
+func PossibleRecipeResourceChangeActionValues() []RecipeResourceChangeAction {
+	return []RecipeResourceChangeAction{	
+		RecipeResourceChangeActionCreate,
+		RecipeResourceChangeActionDelete,
+		RecipeResourceChangeActionNoChange,
+		RecipeResourceChangeActionReplace,
+		RecipeResourceChangeActionUnknown,
+		RecipeResourceChangeActionUpdate,
+	}
+}
+
 // ResourceProvisioning - Specifies how the underlying service/resource is provisioned and managed. Available values are 'recipe',
 // where Radius manages the lifecycle of the resource through a Recipe, and 'manual', where a user
 // manages the resource and provides the values.
@@ -361,6 +430,33 @@ func PossibleSecretValueEncodingValues() []SecretValueEncoding {
 	}
 }
 
+// TerraformBackendKind - The kind of the Terraform state backend.
+type TerraformBackendKind string
+
+const (
+	// TerraformBackendKindKubernetes - Stores the Terraform state in a Kubernetes secret.
+	TerraformBackendKindKubernetes TerraformBackendKind = "kubernetes"
+	// TerraformBackendKindLocal - Stores the Terraform state in the filesystem, for example on a persistent volume mounted into
+// Radius.
+	TerraformBackendKindLocal TerraformBackendKind = "local"
+	// TerraformBackendKindPg - Stores the Terraform state in a Postgres database. The connection string is read from the PG_CONN_STR
+// environment variable of Radius.
+	TerraformBackendKindPg TerraformBackendKind = "pg"
+	// TerraformBackendKindS3 - Stores the Terraform state in an S3-compatible bucket. Credentials are read from the AWS environment
+// variables of Radius.
+	TerraformBackendKindS3 TerraformBackendKind = "s3"
+)
+
+// PossibleTerraformBackendKindValues returns the possible values for the TerraformBackendKind const type.
+func PossibleTerraformBackendKindValues() []TerraformBackendKind {
+	return []TerraformBackendKind{	
+		TerraformBackendKindKubernetes,
+		TerraformBackendKindLocal,
+		TerraformBackendKindPg,
+		TerraformBackendKindS3,
+	}
+}
+
 // TLSMinVersion - Tls Minimum versions for Gateway resource.
 type TLSMinVersion string
 
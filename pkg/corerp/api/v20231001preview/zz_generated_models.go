@@ -130,6 +130,44 @@ type AuthConfig struct {
 	Git *GitAuthConfig
 }
 
+// AutoScalingExtension - Specifies the resource should be scaled automatically based on its metrics
+type AutoScalingExtension struct {
+	// REQUIRED; Discriminator property for Extension.
+	Kind *string
+
+	// REQUIRED; The maximum replica count.
+	MaxReplicas *int32
+
+	// The metrics used to compute the replica count. Defaults to an average CPU utilization of 80%.
+	Metrics []*AutoScalingMetric
+
+	// The minimum replica count. Defaults to 1.
+	MinReplicas *int32
+}
+
+// GetExtension implements the ExtensionClassification interface for type AutoScalingExtension.
+func (a *AutoScalingExtension) GetExtension() *Extension {
+	return &Extension{
+		Kind: a.Kind,
+	}
+}
+
+// AutoScalingMetric - A metric used to scale a resource automatically
+type AutoScalingMetric struct {
+	// REQUIRED; The kind of the metric
+	Kind *AutoScalingMetricKind
+
+	// The name of the custom metric. Required for custom metrics.
+	Name *string
+
+	// The target average utilization of the replicas, as a percentage of the requested resource. Only valid for cpu and memory
+// metrics.
+	TargetAverageUtilization *int32
+
+	// The target average value of the metric across the replicas. Ex - 500m or 1Gi
+	TargetAverageValue *string
+}
+
 // AzureKeyVaultVolumeProperties - Represents Azure Key Vault Volume properties
 type AzureKeyVaultVolumeProperties struct {
 	// REQUIRED; Fully qualified resource ID for the application
@@ -179,20 +217,35 @@ type BicepRecipeProperties struct {
 	// REQUIRED; Path to the template provided by the recipe. Currently only link to Azure Container Registry is supported.
 	TemplatePath *string
 
+	// The name of the version used by resources that do not pin a version. Must be one of the keys of versions. Defaults to the
+// template path of the recipe when omitted.
+	DefaultVersion *string
+
 	// Key/value parameters to pass to the recipe template at deployment.
 	Parameters map[string]any
 
 	// Connect to the Bicep registry using HTTP (not-HTTPS). This should be used when the registry is known not to support HTTPS,
 // for example in a locally-hosted registry. Defaults to false (use HTTPS/TLS).
 	PlainHTTP *bool
+
+	// Named versions of the recipe. Each version replaces the template path, template version and parameters of the recipe for
+// the resources that use it.
+	Versions map[string]*RecipeVersionProperties
+
+	// READ-ONLY; The name of the version that was the default before the last change of defaultVersion. Used to roll back to the
+// previous version.
+	PreviousDefaultVersion *string
 }
 
 // GetRecipeProperties implements the RecipePropertiesClassification interface for type BicepRecipeProperties.
 func (b *BicepRecipeProperties) GetRecipeProperties() *RecipeProperties {
 	return &RecipeProperties{
+		DefaultVersion: b.DefaultVersion,
 		Parameters: b.Parameters,
+		PreviousDefaultVersion: b.PreviousDefaultVersion,
 		TemplateKind: b.TemplateKind,
 		TemplatePath: b.TemplatePath,
+		Versions: b.Versions,
 	}
 }
 
@@ -201,6 +254,10 @@ type BicepRecipePropertiesUpdate struct {
 	// REQUIRED; Discriminator property for RecipeProperties.
 	TemplateKind *string
 
+	// The name of the version used by resources that do not pin a version. Must be one of the keys of versions. Defaults to the
+// template path of the recipe when omitted.
+	DefaultVersion *string
+
 	// Key/value parameters to pass to the recipe template at deployment.
 	Parameters map[string]any
 
@@ -210,14 +267,20 @@ type BicepRecipePropertiesUpdate struct {
 
 	// Path to the template provided by the recipe. Currently only link to Azure Container Registry is supported.
 	TemplatePath *string
+
+	// Named versions of the recipe. Each version replaces the template path, template version and parameters of the recipe for
+// the resources that use it.
+	Versions map[string]*RecipeVersionPropertiesUpdate
 }
 
 // GetRecipePropertiesUpdate implements the RecipePropertiesUpdateClassification interface for type BicepRecipePropertiesUpdate.
 func (b *BicepRecipePropertiesUpdate) GetRecipePropertiesUpdate() *RecipePropertiesUpdate {
 	return &RecipePropertiesUpdate{
+		DefaultVersion: b.DefaultVersion,
 		Parameters: b.Parameters,
 		TemplateKind: b.TemplateKind,
 		TemplatePath: b.TemplatePath,
+		Versions: b.Versions,
 	}
 }
 
@@ -292,6 +355,12 @@ type Container struct {
 	// readiness probe properties
 	ReadinessProbe HealthProbePropertiesClassification
 
+	// The compute resources required by the container
+	Resources *ContainerResources
+
+	// Environment variables whose values are read from a key of a secret. The names must not be used in env
+	SecretEnv map[string]*SecretReference
+
 	// container volumes
 	Volumes map[string]VolumeClassification
 
@@ -357,6 +426,9 @@ type ContainerProperties struct {
 	// Configuration for supported external identity providers
 	Identity *IdentitySettings
 
+	// Containers run in order to completion before the main container starts. Ex - database migrations.
+	InitContainers []*SupportingContainer
+
 	// Specifies how the underlying container resource is provisioned and managed.
 	ResourceProvisioning *ContainerResourceProvisioning
 
@@ -369,6 +441,9 @@ type ContainerProperties struct {
 	// Specifies Runtime-specific functionality
 	Runtimes *RuntimesProperties
 
+	// Containers run alongside the main container. Ex - log shippers or proxies.
+	Sidecars []*SupportingContainer
+
 	// READ-ONLY; The status of the asynchronous operation.
 	ProvisioningState *ProvisioningState
 
@@ -409,6 +484,24 @@ type ContainerResourceListResult struct {
 	NextLink *string
 }
 
+// ContainerResourceQuantities - Amounts of compute resources
+type ContainerResourceQuantities struct {
+	// The amount of CPU, in cores or millicores. Ex - 500m or 2
+	CPU *string
+
+	// The amount of memory, in bytes. Ex - 128Mi or 1Gi
+	Memory *string
+}
+
+// ContainerResources - The compute resources required by a container
+type ContainerResources struct {
+	// The maximum amount of compute resources the container is allowed to use
+	Limits *ContainerResourceQuantities
+
+	// The amount of compute resources reserved for the container
+	Requests *ContainerResourceQuantities
+}
+
 // ContainerResourceUpdate - The type used for update operations of the ContainerResource.
 type ContainerResourceUpdate struct {
 	// The updatable properties of the ContainerResource.
@@ -438,6 +531,9 @@ type ContainerResourceUpdateProperties struct {
 	// Configuration for supported external identity providers
 	Identity *IdentitySettingsUpdate
 
+	// Containers run in order to completion before the main container starts. Ex - database migrations.
+	InitContainers []*SupportingContainerUpdate
+
 	// Specifies how the underlying container resource is provisioned and managed.
 	ResourceProvisioning *ContainerResourceProvisioning
 
@@ -449,6 +545,9 @@ type ContainerResourceUpdateProperties struct {
 
 	// Specifies Runtime-specific functionality
 	Runtimes *RuntimesProperties
+
+	// Containers run alongside the main container. Ex - log shippers or proxies.
+	Sidecars []*SupportingContainerUpdate
 }
 
 // ContainerUpdate - Definition of a container
@@ -477,6 +576,12 @@ type ContainerUpdate struct {
 	// readiness probe properties
 	ReadinessProbe HealthProbePropertiesClassification
 
+	// The compute resources required by the container
+	Resources *ContainerResources
+
+	// Environment variables whose values are read from a key of a secret. The names must not be used in env
+	SecretEnv map[string]*SecretReferenceUpdate
+
 	// container volumes
 	Volumes map[string]VolumeClassification
 
@@ -539,6 +644,15 @@ type EnvironmentComputeUpdate struct {
 // GetEnvironmentComputeUpdate implements the EnvironmentComputeUpdateClassification interface for type EnvironmentComputeUpdate.
 func (e *EnvironmentComputeUpdate) GetEnvironmentComputeUpdate() *EnvironmentComputeUpdate { return e }
 
+// EnvironmentGatewayConfig - Configuration for the gateways of an environment.
+type EnvironmentGatewayConfig struct {
+	// The name of the GatewayClass of the Gateway objects. Required when kind is gatewayAPI.
+	GatewayClassName *string
+
+	// The API used to expose the gateways of the environment. Defaults to contour.
+	Kind *GatewayKind
+}
+
 // EnvironmentProperties - Environment properties
 type EnvironmentProperties struct {
 	// REQUIRED; The compute resource used by application environment.
@@ -547,6 +661,9 @@ type EnvironmentProperties struct {
 	// The environment extension.
 	Extensions []ExtensionClassification
 
+	// Configuration for the gateways of the environment. Defaults to Contour.
+	Gateway *EnvironmentGatewayConfig
+
 	// Cloud providers configuration for the environment.
 	Providers *Providers
 
@@ -613,6 +730,9 @@ type EnvironmentResourceUpdateProperties struct {
 	// The environment extension.
 	Extensions []ExtensionClassification
 
+	// Configuration for the gateways of the environment. Defaults to Contour.
+	Gateway *EnvironmentGatewayConfig
+
 	// Cloud providers configuration for the environment.
 	Providers *ProvidersUpdate
 
@@ -808,6 +928,27 @@ type Extension struct {
 // GetExtension implements the ExtensionClassification interface for type Extension.
 func (e *Extension) GetExtension() *Extension { return e }
 
+// GatewayCors - CORS policy of a Gateway.
+type GatewayCors struct {
+	// REQUIRED; The HTTP methods allowed in cross-origin requests. Ex - GET.
+	AllowMethods []*string
+
+	// REQUIRED; The origins allowed to make cross-origin requests. Ex - https://example.com or *.
+	AllowOrigins []*string
+
+	// Allows credentials in cross-origin requests.
+	AllowCredentials *bool
+
+	// The headers allowed in cross-origin requests.
+	AllowHeaders []*string
+
+	// The response headers exposed to the cross-origin requests.
+	ExposeHeaders []*string
+
+	// How long the results of a preflight request can be cached. Ex - 10m.
+	MaxAge *string
+}
+
 // GatewayHostname - Declare hostname information for the Gateway. Leaving the hostname empty auto-assigns one: mygateway.myapp.PUBLICHOSTNAMEORIP.nip.io.
 type GatewayHostname struct {
 	// Specify a fully-qualified domain name: myapp.mydomain.com. Mutually exclusive with 'prefix' and will take priority if both
@@ -827,6 +968,9 @@ type GatewayProperties struct {
 	// REQUIRED; Routes attached to this Gateway
 	Routes []*GatewayRoute
 
+	// CORS policy applied to the requests of the Gateway.
+	Cors *GatewayCors
+
 	// Fully qualified resource ID for the environment that the application is linked to
 	Environment *string
 
@@ -896,6 +1040,9 @@ type GatewayResourceUpdateProperties struct {
 	// Fully qualified resource ID for the application
 	Application *string
 
+	// CORS policy applied to the requests of the Gateway.
+	Cors *GatewayCors
+
 	// Fully qualified resource ID for the environment that the application is linked to
 	Environment *string
 
@@ -917,12 +1064,73 @@ type GatewayRoute struct {
 	// The HttpRoute to route to. Ex - myserviceroute.id.
 	Destination *string
 
+	// The headers to match the incoming request headers on. All the headers must match.
+	Headers []*GatewayRouteHeaderMatch
+
+	// The HTTP method to match the incoming request method on. Ex - GET.
+	Method *string
+
 	// The path to match the incoming request path on. Ex - /myservice.
 	Path *string
 
+	// The query parameters to match the incoming request query parameters on. All the query parameters must match.
+	QueryParameters []*GatewayRouteQueryParameterMatch
+
 	// Optionally update the prefix when sending the request to the service. Ex - replacePrefix: '/' and path: '/myservice' will
 // transform '/myservice/myroute' to '/myroute'
 	ReplacePrefix *string
+
+	// Retry policy of the requests sent to the destination.
+	RetryPolicy *GatewayRouteRetryPolicy
+
+	// Timeout policy of the requests sent to the destination.
+	TimeoutPolicy *GatewayRouteTimeoutPolicy
+
+	// The weight of the destination when several routes share the same match, used to split the traffic between their destinations.
+// Ex - 90 and 10 to send 10% of the traffic to a canary.
+	Weight *int32
+}
+
+// GatewayRouteHeaderMatch - Header of the incoming request to match a route on.
+type GatewayRouteHeaderMatch struct {
+	// REQUIRED; The name of the header.
+	Name *string
+
+	// REQUIRED; The exact value of the header.
+	Value *string
+}
+
+// GatewayRouteQueryParameterMatch - Query parameter of the incoming request to match a route on.
+type GatewayRouteQueryParameterMatch struct {
+	// REQUIRED; The name of the query parameter.
+	Name *string
+
+	// REQUIRED; The exact value of the query parameter.
+	Value *string
+}
+
+// GatewayRouteRetryPolicy - Retry policy of the requests sent to a route destination.
+type GatewayRouteRetryPolicy struct {
+	// REQUIRED; The maximum number of retries.
+	Attempts *int32
+
+	// The timeout of each attempt. Ex - 5s.
+	PerTryTimeout *string
+
+	// The HTTP status codes to retry on when retryOn contains retriable-status-codes. Ex - 503.
+	RetriableStatusCodes []*int32
+
+	// The conditions to retry on. Ex - 5xx, gateway-error, connect-failure or retriable-status-codes.
+	RetryOn []*string
+}
+
+// GatewayRouteTimeoutPolicy - Timeout policy of the requests sent to a route destination.
+type GatewayRouteTimeoutPolicy struct {
+	// The time after which an idle request is closed. Ex - 5m.
+	Idle *string
+
+	// The time to wait for the complete response of the destination. Ex - 30s.
+	Request *string
 }
 
 // GatewayTLS - TLS configuration definition for Gateway resource.
@@ -1086,6 +1294,87 @@ type HealthProbeProperties struct {
 // GetHealthProbeProperties implements the HealthProbePropertiesClassification interface for type HealthProbeProperties.
 func (h *HealthProbeProperties) GetHealthProbeProperties() *HealthProbeProperties { return h }
 
+// HelmRecipeProperties - Represents Helm recipe properties.
+type HelmRecipeProperties struct {
+	// REQUIRED; Discriminator property for RecipeProperties.
+	TemplateKind *string
+
+	// REQUIRED; Path to the template provided by the recipe. Currently only link to Azure Container Registry is supported.
+	TemplatePath *string
+
+	// The name of the version used by resources that do not pin a version. Must be one of the keys of versions. Defaults to the
+// template path of the recipe when omitted.
+	DefaultVersion *string
+
+	// Key/value parameters to pass to the recipe template at deployment.
+	Parameters map[string]any
+
+	// Connect to the Helm chart registry using HTTP (not-HTTPS). This should be used when the registry is known not to support
+// HTTPS, for example in a locally-hosted registry. Defaults to false (use HTTPS/TLS).
+	PlainHTTP *bool
+
+	// Version of the Helm chart to deploy. Defaults to the latest version of the chart.
+	TemplateVersion *string
+
+	// Named versions of the recipe. Each version replaces the template path, template version and parameters of the recipe for
+// the resources that use it.
+	Versions map[string]*RecipeVersionProperties
+
+	// READ-ONLY; The name of the version that was the default before the last change of defaultVersion. Used to roll back to the
+// previous version.
+	PreviousDefaultVersion *string
+}
+
+// GetRecipeProperties implements the RecipePropertiesClassification interface for type HelmRecipeProperties.
+func (h *HelmRecipeProperties) GetRecipeProperties() *RecipeProperties {
+	return &RecipeProperties{
+		DefaultVersion: h.DefaultVersion,
+		Parameters: h.Parameters,
+		PreviousDefaultVersion: h.PreviousDefaultVersion,
+		TemplateKind: h.TemplateKind,
+		TemplatePath: h.TemplatePath,
+		Versions: h.Versions,
+	}
+}
+
+// HelmRecipePropertiesUpdate - Represents Helm recipe properties.
+type HelmRecipePropertiesUpdate struct {
+	// REQUIRED; Discriminator property for RecipeProperties.
+	TemplateKind *string
+
+	// The name of the version used by resources that do not pin a version. Must be one of the keys of versions. Defaults to the
+// template path of the recipe when omitted.
+	DefaultVersion *string
+
+	// Key/value parameters to pass to the recipe template at deployment.
+	Parameters map[string]any
+
+	// Connect to the Helm chart registry using HTTP (not-HTTPS). This should be used when the registry is known not to support
+// HTTPS, for example in a locally-hosted registry. Defaults to false (use HTTPS/TLS).
+	PlainHTTP *bool
+
+	// Path to the template provided by the recipe. Currently only link to Azure Container Registry is supported.
+	TemplatePath *string
+
+	// Version of the Helm chart to deploy. Defaults to the latest version of the chart.
+	TemplateVersion *string
+
+	// Named versions of the recipe. Each version replaces the template path, template version and parameters of the recipe for
+// the resources that use it.
+	Versions map[string]*RecipeVersionPropertiesUpdate
+}
+
+// GetRecipePropertiesUpdate implements the RecipePropertiesUpdateClassification interface for type HelmRecipePropertiesUpdate.
+func (h *HelmRecipePropertiesUpdate) GetRecipePropertiesUpdate() *RecipePropertiesUpdate {
+	return &RecipePropertiesUpdate{
+		DefaultVersion: h.DefaultVersion,
+		Parameters: h.Parameters,
+		TemplateKind: h.TemplateKind,
+		TemplatePath: h.TemplatePath,
+		Versions: h.Versions,
+	}
+}
+
 // IamProperties - IAM properties
 type IamProperties struct {
 	// REQUIRED; The kind of IAM provider to configure
@@ -1223,6 +1512,85 @@ func (k *KubernetesNamespaceExtension) GetExtension() *Extension {
 	}
 }
 
+// KubernetesRecipeProperties - Represents Kubernetes manifest recipe properties. The template path is the OCI reference of
+// an artifact containing Go-templated Kubernetes manifests or a Kustomize overlay, for example oci://myregistry.azurecr.io/recipes/redis:1.0.0,
+// or the URL of a manifest file.
+type KubernetesRecipeProperties struct {
+	// REQUIRED; Discriminator property for RecipeProperties.
+	TemplateKind *string
+
+	// REQUIRED; Path to the template provided by the recipe. Currently only link to Azure Container Registry is supported.
+	TemplatePath *string
+
+	// The name of the version used by resources that do not pin a version. Must be one of the keys of versions. Defaults to the
+// template path of the recipe when omitted.
+	DefaultVersion *string
+
+	// Key/value parameters to pass to the recipe template at deployment.
+	Parameters map[string]any
+
+	// Connect to the OCI registry using HTTP (not-HTTPS). This should be used when the registry is known not to support HTTPS,
+// for example in a locally-hosted registry. Defaults to false (use HTTPS/TLS).
+	PlainHTTP *bool
+
+	// Named versions of the recipe. Each version replaces the template path, template version and parameters of the recipe for
+// the resources that use it.
+	Versions map[string]*RecipeVersionProperties
+
+	// READ-ONLY; The name of the version that was the default before the last change of defaultVersion. Used to roll back to the
+// previous version.
+	PreviousDefaultVersion *string
+}
+
+// GetRecipeProperties implements the RecipePropertiesClassification interface for type KubernetesRecipeProperties.
+func (k *KubernetesRecipeProperties) GetRecipeProperties() *RecipeProperties {
+	return &RecipeProperties{
+		DefaultVersion: k.DefaultVersion,
+		Parameters: k.Parameters,
+		PreviousDefaultVersion: k.PreviousDefaultVersion,
+		TemplateKind: k.TemplateKind,
+		TemplatePath: k.TemplatePath,
+		Versions: k.Versions,
+	}
+}
+
+// KubernetesRecipePropertiesUpdate - Represents Kubernetes manifest recipe properties. The template path is the OCI reference
+// of an artifact containing Go-templated Kubernetes manifests or a Kustomize overlay, for example oci://myregistry.azurecr.io/recipes/redis:1.0.0,
+// or the URL of a manifest file.
+type KubernetesRecipePropertiesUpdate struct {
+	// REQUIRED; Discriminator property for RecipeProperties.
+	TemplateKind *string
+
+	// The name of the version used by resources that do not pin a version. Must be one of the keys of versions. Defaults to the
+// template path of the recipe when omitted.
+	DefaultVersion *string
+
+	// Key/value parameters to pass to the recipe template at deployment.
+	Parameters map[string]any
+
+	// Connect to the OCI registry using HTTP (not-HTTPS). This should be used when the registry is known not to support HTTPS,
+// for example in a locally-hosted registry. Defaults to false (use HTTPS/TLS).
+	PlainHTTP *bool
+
+	// Path to the template provided by the recipe. Currently only link to Azure Container Registry is supported.
+	TemplatePath *string
+
+	// Named versions of the recipe. Each version replaces the template path, template version and parameters of the recipe for
+// the resources that use it.
+	Versions map[string]*RecipeVersionPropertiesUpdate
+}
+
+// GetRecipePropertiesUpdate implements the RecipePropertiesUpdateClassification interface for type KubernetesRecipePropertiesUpdate.
+func (k *KubernetesRecipePropertiesUpdate) GetRecipePropertiesUpdate() *RecipePropertiesUpdate {
+	return &RecipePropertiesUpdate{
+		DefaultVersion: k.DefaultVersion,
+		Parameters: k.Parameters,
+		TemplateKind: k.TemplateKind,
+		TemplatePath: k.TemplatePath,
+		Versions: k.Versions,
+	}
+}
+
 // KubernetesRuntimeProperties - The runtime configuration properties for Kubernetes
 type KubernetesRuntimeProperties struct {
 	// The serialized YAML manifest which represents the base Kubernetes resources to deploy, such as Deployment, Service, ServiceAccount,
@@ -1384,6 +1752,9 @@ type Recipe struct {
 
 	// Key/value parameters to pass into the recipe at deployment
 	Parameters map[string]any
+
+	// The name of the recipe version to use. Pins the resource to the version instead of the default version of the recipe.
+	Version *string
 }
 
 // RecipeConfigProperties - Configuration for Recipes. Defines how each type of Recipe should be configured and run.
@@ -1395,6 +1766,21 @@ type RecipeConfigProperties struct {
 	Terraform *TerraformConfigProperties
 }
 
+// RecipeDriftStatus - Drift of the infrastructure deployed by the recipe of a portable resource.
+type RecipeDriftStatus struct {
+	// REQUIRED; Drifted is true if the deployed infrastructure no longer matches the recipe.
+	Drifted *bool
+
+	// The timestamp of the last drift check (UTC).
+	LastCheckedAt *time.Time
+
+	// The timestamp of the last reconciliation of the drift by running the recipe again (UTC).
+	LastReconciledAt *time.Time
+
+	// The identifiers of the resources whose deployed state no longer matches the recipe.
+	Resources []*string
+}
+
 // RecipeGetMetadata - Represents the request body of the getmetadata action.
 type RecipeGetMetadata struct {
 	// REQUIRED; The name of the recipe registered to the environment.
@@ -1409,7 +1795,7 @@ type RecipeGetMetadataResponse struct {
 	// REQUIRED; The key/value parameters to pass to the recipe template at deployment.
 	Parameters map[string]any
 
-	// REQUIRED; The format of the template provided by the recipe. Allowed values: bicep, terraform.
+	// REQUIRED; The format of the template provided by the recipe. Allowed values: bicep, terraform, helm, kubernetes.
 	TemplateKind *string
 
 	// REQUIRED; The path to the template provided by the recipe. Currently only link to Azure Container Registry is supported.
@@ -1424,7 +1810,34 @@ type RecipeGetMetadataResponse struct {
 	TemplateVersion *string
 }
 
-// RecipeProperties - Format of the template provided by the recipe. Allowed values: bicep, terraform.
+// RecipePlanResult - The changes that the execution of the recipe of a portable resource would make.
+type RecipePlanResult struct {
+	// REQUIRED; The resources that the execution of the recipe would create, update or delete.
+	Changes []*RecipeResourceChange
+
+	// REQUIRED; TemplateKind is the kind of the recipe template.
+	TemplateKind *string
+
+	// REQUIRED; TemplatePath is the path of the recipe template.
+	TemplatePath *string
+
+	// TemplateVersion is the version number of the template.
+	TemplateVersion *string
+}
+
+// RecipeResourceChange - A change that the execution of a recipe would make to a resource.
+type RecipeResourceChange struct {
+	// REQUIRED; The action that would be taken on the resource.
+	Action *RecipeResourceChangeAction
+
+	// REQUIRED; The ID of the resource. For Terraform recipes this is the address of the resource in the module.
+	ID *string
+
+	// The type of the resource.
+	Type *string
+}
+
+// RecipeProperties - Format of the template provided by the recipe. Allowed values: bicep, terraform, helm, kubernetes.
 type RecipeProperties struct {
 	// REQUIRED; Discriminator property for RecipeProperties.
 	TemplateKind *string
@@ -1432,23 +1845,43 @@ type RecipeProperties struct {
 	// REQUIRED; Path to the template provided by the recipe. Currently only link to Azure Container Registry is supported.
 	TemplatePath *string
 
+	// The name of the version used by resources that do not pin a version. Must be one of the keys of versions. Defaults to the
+// template path of the recipe when omitted.
+	DefaultVersion *string
+
 	// Key/value parameters to pass to the recipe template at deployment.
 	Parameters map[string]any
+
+	// Named versions of the recipe. Each version replaces the template path, template version and parameters of the recipe for
+// the resources that use it.
+	Versions map[string]*RecipeVersionProperties
+
+	// READ-ONLY; The name of the version that was the default before the last change of defaultVersion. Used to roll back to the
+// previous version.
+	PreviousDefaultVersion *string
 }
 
 // GetRecipeProperties implements the RecipePropertiesClassification interface for type RecipeProperties.
 func (r *RecipeProperties) GetRecipeProperties() *RecipeProperties { return r }
 
-// RecipePropertiesUpdate - Format of the template provided by the recipe. Allowed values: bicep, terraform.
+// RecipePropertiesUpdate - Format of the template provided by the recipe. Allowed values: bicep, terraform, helm, kubernetes.
 type RecipePropertiesUpdate struct {
 	// REQUIRED; Discriminator property for RecipeProperties.
 	TemplateKind *string
 
+	// The name of the version used by resources that do not pin a version. Must be one of the keys of versions. Defaults to the
+// template path of the recipe when omitted.
+	DefaultVersion *string
+
 	// Key/value parameters to pass to the recipe template at deployment.
 	Parameters map[string]any
 
 	// Path to the template provided by the recipe. Currently only link to Azure Container Registry is supported.
 	TemplatePath *string
+
+	// Named versions of the recipe. Each version replaces the template path, template version and parameters of the recipe for
+// the resources that use it.
+	Versions map[string]*RecipeVersionPropertiesUpdate
 }
 
 // GetRecipePropertiesUpdate implements the RecipePropertiesUpdateClassification interface for type RecipePropertiesUpdate.
@@ -1462,8 +1895,14 @@ type RecipeStatus struct {
 	// REQUIRED; TemplatePath is the path of the recipe consumed by the portable resource upon deployment.
 	TemplatePath *string
 
+	// Drift of the deployed infrastructure from the recipe, detected by the last drift check.
+	Drift *RecipeDriftStatus
+
 	// TemplateVersion is the version number of the template.
 	TemplateVersion *string
+
+	// The name of the recipe version consumed by the portable resource upon deployment. Empty if the recipe has no named versions.
+	Version *string
 }
 
 // RecipeUpdate - The recipe used to automatically deploy underlying infrastructure for a portable resource
@@ -1473,6 +1912,35 @@ type RecipeUpdate struct {
 
 	// Key/value parameters to pass into the recipe at deployment
 	Parameters map[string]any
+
+	// The name of the recipe version to use. Pins the resource to the version instead of the default version of the recipe.
+	Version *string
+}
+
+// RecipeVersionProperties - Represents a named version of a recipe linked to an Environment.
+type RecipeVersionProperties struct {
+	// REQUIRED; Path to the template provided by the recipe version.
+	TemplatePath *string
+
+	// Key/value parameters to pass to the recipe template at deployment. Replaces the parameters of the recipe.
+	Parameters map[string]any
+
+	// Version of the template to deploy. For Terraform recipes using a module registry this is required, but must be omitted for
+// other module sources.
+	TemplateVersion *string
+}
+
+// RecipeVersionPropertiesUpdate - Represents a named version of a recipe linked to an Environment.
+type RecipeVersionPropertiesUpdate struct {
+	// Key/value parameters to pass to the recipe template at deployment. Replaces the parameters of the recipe.
+	Parameters map[string]any
+
+	// Path to the template provided by the recipe version.
+	TemplatePath *string
+
+	// Version of the template to deploy. For Terraform recipes using a module registry this is required, but must be omitted for
+// other module sources.
+	TemplateVersion *string
 }
 
 // Resource - Common fields that are returned in the response for all Azure Resource Manager resources
@@ -1537,6 +2005,26 @@ type SecretObjectProperties struct {
 	Version *string
 }
 
+// SecretReference - A reference to a key of a secret
+type SecretReference struct {
+	// REQUIRED; The key of the secret
+	Key *string
+
+	// REQUIRED; The source of the secret. Either the resource ID of an Applications.Core/secretStores resource or the name of a
+// Kubernetes secret in the namespace of the container
+	Source *string
+}
+
+// SecretReferenceUpdate - A reference to a key of a secret
+type SecretReferenceUpdate struct {
+	// The key of the secret
+	Key *string
+
+	// The source of the secret. Either the resource ID of an Applications.Core/secretStores resource or the name of a Kubernetes
+// secret in the namespace of the container
+	Source *string
+}
+
 // SecretStoreListSecretsResult - The list of secrets
 type SecretStoreListSecretsResult struct {
 	// REQUIRED; An object to represent key-value type secrets
@@ -1642,6 +2130,113 @@ type SecretValueProperties struct {
 	ValueFrom *ValueFromProperties
 }
 
+// SecretVolume - Specifies a volume holding the keys of a secret as files
+type SecretVolume struct {
+	// REQUIRED; Discriminator property for Volume.
+	Kind *string
+
+	// REQUIRED; The source of the secret. Either the resource ID of an Applications.Core/secretStores resource or the name of a
+// Kubernetes secret in the namespace of the container
+	Source *string
+
+	// The path where the volume is mounted
+	MountPath *string
+}
+
+// GetVolume implements the VolumeClassification interface for type SecretVolume.
+func (s *SecretVolume) GetVolume() *Volume {
+	return &Volume{
+		Kind: s.Kind,
+		MountPath: s.MountPath,
+	}
+}
+
+// SupportingContainer - Definition of an init container or a sidecar. It shares the connection environment variables and the volumes
+// of the main container.
+type SupportingContainer struct {
+	// REQUIRED; The registry and image to download and run in your container
+	Image *string
+
+	// REQUIRED; The name of the container. Must be unique within the resource.
+	Name *string
+
+	// Arguments to the entrypoint. Overrides the container image's CMD
+	Args []*string
+
+	// Entrypoint array. Overrides the container image's ENTRYPOINT
+	Command []*string
+
+	// environment
+	Env map[string]*string
+
+	// The pull policy for the container image
+	ImagePullPolicy *ImagePullPolicy
+
+	// liveness probe properties
+	LivenessProbe HealthProbePropertiesClassification
+
+	// container ports
+	Ports map[string]*ContainerPortProperties
+
+	// readiness probe properties
+	ReadinessProbe HealthProbePropertiesClassification
+
+	// The compute resources required by the container
+	Resources *ContainerResources
+
+	// Environment variables whose values are read from a key of a secret. The names must not be used in env
+	SecretEnv map[string]*SecretReference
+
+	// container volumes
+	Volumes map[string]VolumeClassification
+
+	// Working directory for the container
+	WorkingDir *string
+}
+
+// SupportingContainerUpdate - Definition of an init container or a sidecar. It shares the connection environment variables and the volumes
+// of the main container.
+type SupportingContainerUpdate struct {
+	// Arguments to the entrypoint. Overrides the container image's CMD
+	Args []*string
+
+	// Entrypoint array. Overrides the container image's ENTRYPOINT
+	Command []*string
+
+	// environment
+	Env map[string]*string
+
+	// The registry and image to download and run in your container
+	Image *string
+
+	// The pull policy for the container image
+	ImagePullPolicy *ImagePullPolicy
+
+	// liveness probe properties
+	LivenessProbe HealthProbePropertiesClassification
+
+	// The name of the container. Must be unique within the resource.
+	Name *string
+
+	// container ports
+	Ports map[string]*ContainerPortPropertiesUpdate
+
+	// readiness probe properties
+	ReadinessProbe HealthProbePropertiesClassification
+
+	// The compute resources required by the container
+	Resources *ContainerResources
+
+	// Environment variables whose values are read from a key of a secret. The names must not be used in env
+	SecretEnv map[string]*SecretReferenceUpdate
+
+	// container volumes
+	Volumes map[string]VolumeClassification
+
+	// Working directory for the container
+	WorkingDir *string
+}
+
 // SystemData - Metadata pertaining to creation and last modification of the resource.
 type SystemData struct {
 	// The timestamp of resource creation (UTC).
@@ -1695,16 +2290,43 @@ func (t *TCPHealthProbeProperties) GetHealthProbeProperties() *HealthProbeProper
 	}
 }
 
+// TerraformBackendConfig - Configuration for the backend that stores the Terraform state of Recipes.
+type TerraformBackendConfig struct {
+	// The kind of the Terraform state backend. Defaults to kubernetes.
+	Kind *TerraformBackendKind
+
+	// Configuration for the filesystem Terraform state backend. Required when kind is local.
+	Local *TerraformLocalBackendConfig
+
+	// Configuration for the S3-compatible Terraform state backend. Required when kind is s3.
+	S3 *TerraformS3BackendConfig
+}
+
 // TerraformConfigProperties - Configuration for Terraform Recipes. Controls how Terraform plans and applies templates as
 // part of Recipe deployment.
 type TerraformConfigProperties struct {
 	// Authentication information used to access private Terraform module sources. Supported module sources: Git.
 	Authentication *AuthConfig
 
+	// Configuration for the backend that stores the Terraform state of Recipes. Defaults to a Kubernetes secret in the Radius
+// namespace.
+	Backend *TerraformBackendConfig
+
 	// Configuration for Terraform Recipe Providers. Controls how Terraform interacts with cloud providers, SaaS providers, and
 // other APIs. For more information, please see:
 // https://developer.hashicorp.com/terraform/language/providers/configuration.
 	Providers map[string][]map[string]any
+
+	// The version of Terraform used to run Terraform Recipes, for example 1.6.4. Defaults to the version configured for Radius,
+// or the latest version if none is configured.
+	Version *string
+}
+
+// TerraformLocalBackendConfig - Configuration for the filesystem Terraform state backend.
+type TerraformLocalBackendConfig struct {
+	// REQUIRED; The directory that stores the Terraform state. The directory should be on a persistent volume shared by the
+// Radius replicas.
+	Path *string
 }
 
 // TerraformRecipeProperties - Represents Terraform recipe properties.
@@ -1715,20 +2337,35 @@ type TerraformRecipeProperties struct {
 	// REQUIRED; Path to the template provided by the recipe. Currently only link to Azure Container Registry is supported.
 	TemplatePath *string
 
+	// The name of the version used by resources that do not pin a version. Must be one of the keys of versions. Defaults to the
+// template path of the recipe when omitted.
+	DefaultVersion *string
+
 	// Key/value parameters to pass to the recipe template at deployment.
 	Parameters map[string]any
 
 	// Version of the template to deploy. For Terraform recipes using a module registry this is required, but must be omitted
 // for other module sources.
 	TemplateVersion *string
+
+	// Named versions of the recipe. Each version replaces the template path, template version and parameters of the recipe for
+// the resources that use it.
+	Versions map[string]*RecipeVersionProperties
+
+	// READ-ONLY; The name of the version that was the default before the last change of defaultVersion. Used to roll back to the
+// previous version.
+	PreviousDefaultVersion *string
 }
 
 // GetRecipeProperties implements the RecipePropertiesClassification interface for type TerraformRecipeProperties.
 func (t *TerraformRecipeProperties) GetRecipeProperties() *RecipeProperties {
 	return &RecipeProperties{
+		DefaultVersion: t.DefaultVersion,
 		Parameters: t.Parameters,
+		PreviousDefaultVersion: t.PreviousDefaultVersion,
 		TemplateKind: t.TemplateKind,
 		TemplatePath: t.TemplatePath,
+		Versions: t.Versions,
 	}
 }
 
@@ -1737,6 +2374,10 @@ type TerraformRecipePropertiesUpdate struct {
 	// REQUIRED; Discriminator property for RecipeProperties.
 	TemplateKind *string
 
+	// The name of the version used by resources that do not pin a version. Must be one of the keys of versions. Defaults to the
+// template path of the recipe when omitted.
+	DefaultVersion *string
+
 	// Key/value parameters to pass to the recipe template at deployment.
 	Parameters map[string]any
 
@@ -1746,17 +2387,41 @@ type TerraformRecipePropertiesUpdate struct {
 	// Version of the template to deploy. For Terraform recipes using a module registry this is required, but must be omitted
 // for other module sources.
 	TemplateVersion *string
+
+	// Named versions of the recipe. Each version replaces the template path, template version and parameters of the recipe for
+// the resources that use it.
+	Versions map[string]*RecipeVersionPropertiesUpdate
 }
 
 // GetRecipePropertiesUpdate implements the RecipePropertiesUpdateClassification interface for type TerraformRecipePropertiesUpdate.
 func (t *TerraformRecipePropertiesUpdate) GetRecipePropertiesUpdate() *RecipePropertiesUpdate {
 	return &RecipePropertiesUpdate{
+		DefaultVersion: t.DefaultVersion,
 		Parameters: t.Parameters,
 		TemplateKind: t.TemplateKind,
 		TemplatePath: t.TemplatePath,
+		Versions: t.Versions,
 	}
 }
 
+// TerraformS3BackendConfig - Configuration for the S3-compatible Terraform state backend.
+type TerraformS3BackendConfig struct {
+	// REQUIRED; The name of the bucket that stores the Terraform state.
+	Bucket *string
+
+	// The endpoint of the S3-compatible service, for example http://minio.minio-system:9000. Defaults to AWS S3.
+	Endpoint *string
+
+	// The prefix of the keys of the Terraform state objects in the bucket.
+	KeyPrefix *string
+
+	// The region of the bucket. Defaults to us-east-1.
+	Region *string
+
+	// Use path-style addressing for the bucket. Most S3-compatible services, such as MinIO, require it.
+	UsePathStyle *bool
+}
+
 // TrackedResource - The resource model definition for an Azure Resource Manager tracked top level resource which has 'tags'
 // and a 'location'
 type TrackedResource struct {
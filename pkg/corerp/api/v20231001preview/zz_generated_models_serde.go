@@ -364,6 +364,84 @@ func (a *AuthConfig) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type AutoScalingExtension.
+func (a AutoScalingExtension) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	objectMap["kind"] = "autoScaling"
+	populate(objectMap, "maxReplicas", a.MaxReplicas)
+	populate(objectMap, "metrics", a.Metrics)
+	populate(objectMap, "minReplicas", a.MinReplicas)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type AutoScalingExtension.
+func (a *AutoScalingExtension) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", a, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "kind":
+				err = unpopulate(val, "Kind", &a.Kind)
+			delete(rawMsg, key)
+		case "maxReplicas":
+				err = unpopulate(val, "MaxReplicas", &a.MaxReplicas)
+			delete(rawMsg, key)
+		case "metrics":
+				err = unpopulate(val, "Metrics", &a.Metrics)
+			delete(rawMsg, key)
+		case "minReplicas":
+				err = unpopulate(val, "MinReplicas", &a.MinReplicas)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", a, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type AutoScalingMetric.
+func (a AutoScalingMetric) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "kind", a.Kind)
+	populate(objectMap, "name", a.Name)
+	populate(objectMap, "targetAverageUtilization", a.TargetAverageUtilization)
+	populate(objectMap, "targetAverageValue", a.TargetAverageValue)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type AutoScalingMetric.
+func (a *AutoScalingMetric) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", a, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "kind":
+				err = unpopulate(val, "Kind", &a.Kind)
+			delete(rawMsg, key)
+		case "name":
+				err = unpopulate(val, "Name", &a.Name)
+			delete(rawMsg, key)
+		case "targetAverageUtilization":
+				err = unpopulate(val, "TargetAverageUtilization", &a.TargetAverageUtilization)
+			delete(rawMsg, key)
+		case "targetAverageValue":
+				err = unpopulate(val, "TargetAverageValue", &a.TargetAverageValue)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", a, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type AzureKeyVaultVolumeProperties.
 func (a AzureKeyVaultVolumeProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -426,10 +504,13 @@ func (a *AzureKeyVaultVolumeProperties) UnmarshalJSON(data []byte) error {
 // MarshalJSON implements the json.Marshaller interface for type BicepRecipeProperties.
 func (b BicepRecipeProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
+	populate(objectMap, "defaultVersion", b.DefaultVersion)
 	populate(objectMap, "parameters", b.Parameters)
 	populate(objectMap, "plainHttp", b.PlainHTTP)
+	populate(objectMap, "previousDefaultVersion", b.PreviousDefaultVersion)
 	objectMap["templateKind"] = "bicep"
 	populate(objectMap, "templatePath", b.TemplatePath)
+	populate(objectMap, "versions", b.Versions)
 	return json.Marshal(objectMap)
 }
 
@@ -442,18 +523,27 @@ func (b *BicepRecipeProperties) UnmarshalJSON(data []byte) error {
 	for key, val := range rawMsg {
 		var err error
 		switch key {
+		case "defaultVersion":
+				err = unpopulate(val, "DefaultVersion", &b.DefaultVersion)
+			delete(rawMsg, key)
 		case "parameters":
 				err = unpopulate(val, "Parameters", &b.Parameters)
 			delete(rawMsg, key)
 		case "plainHttp":
 				err = unpopulate(val, "PlainHTTP", &b.PlainHTTP)
 			delete(rawMsg, key)
+		case "previousDefaultVersion":
+				err = unpopulate(val, "PreviousDefaultVersion", &b.PreviousDefaultVersion)
+			delete(rawMsg, key)
 		case "templateKind":
 				err = unpopulate(val, "TemplateKind", &b.TemplateKind)
 			delete(rawMsg, key)
 		case "templatePath":
 				err = unpopulate(val, "TemplatePath", &b.TemplatePath)
 			delete(rawMsg, key)
+		case "versions":
+				err = unpopulate(val, "Versions", &b.Versions)
+			delete(rawMsg, key)
 		}
 		if err != nil {
 			return fmt.Errorf("unmarshalling type %T: %v", b, err)
@@ -465,10 +555,12 @@ func (b *BicepRecipeProperties) UnmarshalJSON(data []byte) error {
 // MarshalJSON implements the json.Marshaller interface for type BicepRecipePropertiesUpdate.
 func (b BicepRecipePropertiesUpdate) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
+	populate(objectMap, "defaultVersion", b.DefaultVersion)
 	populate(objectMap, "parameters", b.Parameters)
 	populate(objectMap, "plainHttp", b.PlainHTTP)
 	objectMap["templateKind"] = "bicep"
 	populate(objectMap, "templatePath", b.TemplatePath)
+	populate(objectMap, "versions", b.Versions)
 	return json.Marshal(objectMap)
 }
 
@@ -481,6 +573,9 @@ func (b *BicepRecipePropertiesUpdate) UnmarshalJSON(data []byte) error {
 	for key, val := range rawMsg {
 		var err error
 		switch key {
+		case "defaultVersion":
+				err = unpopulate(val, "DefaultVersion", &b.DefaultVersion)
+			delete(rawMsg, key)
 		case "parameters":
 				err = unpopulate(val, "Parameters", &b.Parameters)
 			delete(rawMsg, key)
@@ -493,6 +588,9 @@ func (b *BicepRecipePropertiesUpdate) UnmarshalJSON(data []byte) error {
 		case "templatePath":
 				err = unpopulate(val, "TemplatePath", &b.TemplatePath)
 			delete(rawMsg, key)
+		case "versions":
+				err = unpopulate(val, "Versions", &b.Versions)
+			delete(rawMsg, key)
 		}
 		if err != nil {
 			return fmt.Errorf("unmarshalling type %T: %v", b, err)
@@ -629,6 +727,8 @@ func (c Container) MarshalJSON() ([]byte, error) {
 	populate(objectMap, "livenessProbe", c.LivenessProbe)
 	populate(objectMap, "ports", c.Ports)
 	populate(objectMap, "readinessProbe", c.ReadinessProbe)
+	populate(objectMap, "resources", c.Resources)
+	populate(objectMap, "secretEnv", c.SecretEnv)
 	populate(objectMap, "volumes", c.Volumes)
 	populate(objectMap, "workingDir", c.WorkingDir)
 	return json.Marshal(objectMap)
@@ -667,6 +767,12 @@ func (c *Container) UnmarshalJSON(data []byte) error {
 		case "readinessProbe":
 			c.ReadinessProbe, err = unmarshalHealthProbePropertiesClassification(val)
 			delete(rawMsg, key)
+		case "resources":
+				err = unpopulate(val, "Resources", &c.Resources)
+			delete(rawMsg, key)
+		case "secretEnv":
+				err = unpopulate(val, "SecretEnv", &c.SecretEnv)
+			delete(rawMsg, key)
 		case "volumes":
 			c.Volumes, err = unmarshalVolumeClassificationMap(val)
 			delete(rawMsg, key)
@@ -776,11 +882,13 @@ func (c ContainerProperties) MarshalJSON() ([]byte, error) {
 	populate(objectMap, "environment", c.Environment)
 	populate(objectMap, "extensions", c.Extensions)
 	populate(objectMap, "identity", c.Identity)
+	populate(objectMap, "initContainers", c.InitContainers)
 	populate(objectMap, "provisioningState", c.ProvisioningState)
 	populate(objectMap, "resourceProvisioning", c.ResourceProvisioning)
 	populate(objectMap, "resources", c.Resources)
 	populate(objectMap, "restartPolicy", c.RestartPolicy)
 	populate(objectMap, "runtimes", c.Runtimes)
+	populate(objectMap, "sidecars", c.Sidecars)
 	populate(objectMap, "status", c.Status)
 	return json.Marshal(objectMap)
 }
@@ -812,6 +920,9 @@ func (c *ContainerProperties) UnmarshalJSON(data []byte) error {
 		case "identity":
 				err = unpopulate(val, "Identity", &c.Identity)
 			delete(rawMsg, key)
+		case "initContainers":
+				err = unpopulate(val, "InitContainers", &c.InitContainers)
+			delete(rawMsg, key)
 		case "provisioningState":
 				err = unpopulate(val, "ProvisioningState", &c.ProvisioningState)
 			delete(rawMsg, key)
@@ -827,6 +938,9 @@ func (c *ContainerProperties) UnmarshalJSON(data []byte) error {
 		case "runtimes":
 				err = unpopulate(val, "Runtimes", &c.Runtimes)
 			delete(rawMsg, key)
+		case "sidecars":
+				err = unpopulate(val, "Sidecars", &c.Sidecars)
+			delete(rawMsg, key)
 		case "status":
 				err = unpopulate(val, "Status", &c.Status)
 			delete(rawMsg, key)
@@ -920,6 +1034,68 @@ func (c *ContainerResourceListResult) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type ContainerResourceQuantities.
+func (c ContainerResourceQuantities) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "cpu", c.CPU)
+	populate(objectMap, "memory", c.Memory)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type ContainerResourceQuantities.
+func (c *ContainerResourceQuantities) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", c, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "cpu":
+				err = unpopulate(val, "CPU", &c.CPU)
+			delete(rawMsg, key)
+		case "memory":
+				err = unpopulate(val, "Memory", &c.Memory)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", c, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type ContainerResources.
+func (c ContainerResources) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "limits", c.Limits)
+	populate(objectMap, "requests", c.Requests)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type ContainerResources.
+func (c *ContainerResources) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", c, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "limits":
+				err = unpopulate(val, "Limits", &c.Limits)
+			delete(rawMsg, key)
+		case "requests":
+				err = unpopulate(val, "Requests", &c.Requests)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", c, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type ContainerResourceUpdate.
 func (c ContainerResourceUpdate) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -960,10 +1136,12 @@ func (c ContainerResourceUpdateProperties) MarshalJSON() ([]byte, error) {
 	populate(objectMap, "environment", c.Environment)
 	populate(objectMap, "extensions", c.Extensions)
 	populate(objectMap, "identity", c.Identity)
+	populate(objectMap, "initContainers", c.InitContainers)
 	populate(objectMap, "resourceProvisioning", c.ResourceProvisioning)
 	populate(objectMap, "resources", c.Resources)
 	populate(objectMap, "restartPolicy", c.RestartPolicy)
 	populate(objectMap, "runtimes", c.Runtimes)
+	populate(objectMap, "sidecars", c.Sidecars)
 	return json.Marshal(objectMap)
 }
 
@@ -994,6 +1172,9 @@ func (c *ContainerResourceUpdateProperties) UnmarshalJSON(data []byte) error {
 		case "identity":
 				err = unpopulate(val, "Identity", &c.Identity)
 			delete(rawMsg, key)
+		case "initContainers":
+				err = unpopulate(val, "InitContainers", &c.InitContainers)
+			delete(rawMsg, key)
 		case "resourceProvisioning":
 				err = unpopulate(val, "ResourceProvisioning", &c.ResourceProvisioning)
 			delete(rawMsg, key)
@@ -1006,6 +1187,9 @@ func (c *ContainerResourceUpdateProperties) UnmarshalJSON(data []byte) error {
 		case "runtimes":
 				err = unpopulate(val, "Runtimes", &c.Runtimes)
 			delete(rawMsg, key)
+		case "sidecars":
+				err = unpopulate(val, "Sidecars", &c.Sidecars)
+			delete(rawMsg, key)
 		}
 		if err != nil {
 			return fmt.Errorf("unmarshalling type %T: %v", c, err)
@@ -1025,6 +1209,8 @@ func (c ContainerUpdate) MarshalJSON() ([]byte, error) {
 	populate(objectMap, "livenessProbe", c.LivenessProbe)
 	populate(objectMap, "ports", c.Ports)
 	populate(objectMap, "readinessProbe", c.ReadinessProbe)
+	populate(objectMap, "resources", c.Resources)
+	populate(objectMap, "secretEnv", c.SecretEnv)
 	populate(objectMap, "volumes", c.Volumes)
 	populate(objectMap, "workingDir", c.WorkingDir)
 	return json.Marshal(objectMap)
@@ -1063,6 +1249,12 @@ func (c *ContainerUpdate) UnmarshalJSON(data []byte) error {
 		case "readinessProbe":
 			c.ReadinessProbe, err = unmarshalHealthProbePropertiesClassification(val)
 			delete(rawMsg, key)
+		case "resources":
+				err = unpopulate(val, "Resources", &c.Resources)
+			delete(rawMsg, key)
+		case "secretEnv":
+				err = unpopulate(val, "SecretEnv", &c.SecretEnv)
+			delete(rawMsg, key)
 		case "volumes":
 			c.Volumes, err = unmarshalVolumeClassificationMap(val)
 			delete(rawMsg, key)
@@ -1190,11 +1382,43 @@ func (e *EnvironmentComputeUpdate) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type EnvironmentGatewayConfig.
+func (e EnvironmentGatewayConfig) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "gatewayClassName", e.GatewayClassName)
+	populate(objectMap, "kind", e.Kind)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type EnvironmentGatewayConfig.
+func (e *EnvironmentGatewayConfig) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", e, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "gatewayClassName":
+				err = unpopulate(val, "GatewayClassName", &e.GatewayClassName)
+			delete(rawMsg, key)
+		case "kind":
+				err = unpopulate(val, "Kind", &e.Kind)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", e, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type EnvironmentProperties.
 func (e EnvironmentProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
 	populate(objectMap, "compute", e.Compute)
 	populate(objectMap, "extensions", e.Extensions)
+	populate(objectMap, "gateway", e.Gateway)
 	populate(objectMap, "providers", e.Providers)
 	populate(objectMap, "provisioningState", e.ProvisioningState)
 	populate(objectMap, "recipeConfig", e.RecipeConfig)
@@ -1218,6 +1442,9 @@ func (e *EnvironmentProperties) UnmarshalJSON(data []byte) error {
 		case "extensions":
 			e.Extensions, err = unmarshalExtensionClassificationArray(val)
 			delete(rawMsg, key)
+		case "gateway":
+				err = unpopulate(val, "Gateway", &e.Gateway)
+			delete(rawMsg, key)
 		case "providers":
 				err = unpopulate(val, "Providers", &e.Providers)
 			delete(rawMsg, key)
@@ -1370,6 +1597,7 @@ func (e EnvironmentResourceUpdateProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
 	populate(objectMap, "compute", e.Compute)
 	populate(objectMap, "extensions", e.Extensions)
+	populate(objectMap, "gateway", e.Gateway)
 	populate(objectMap, "providers", e.Providers)
 	populate(objectMap, "recipeConfig", e.RecipeConfig)
 	populate(objectMap, "recipes", e.Recipes)
@@ -1392,6 +1620,9 @@ func (e *EnvironmentResourceUpdateProperties) UnmarshalJSON(data []byte) error {
 		case "extensions":
 			e.Extensions, err = unmarshalExtensionClassificationArray(val)
 			delete(rawMsg, key)
+		case "gateway":
+				err = unpopulate(val, "Gateway", &e.Gateway)
+			delete(rawMsg, key)
 		case "providers":
 				err = unpopulate(val, "Providers", &e.Providers)
 			delete(rawMsg, key)
@@ -1855,6 +2086,53 @@ func (e *Extension) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type GatewayCors.
+func (g GatewayCors) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "allowCredentials", g.AllowCredentials)
+	populate(objectMap, "allowHeaders", g.AllowHeaders)
+	populate(objectMap, "allowMethods", g.AllowMethods)
+	populate(objectMap, "allowOrigins", g.AllowOrigins)
+	populate(objectMap, "exposeHeaders", g.ExposeHeaders)
+	populate(objectMap, "maxAge", g.MaxAge)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type GatewayCors.
+func (g *GatewayCors) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", g, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "allowCredentials":
+				err = unpopulate(val, "AllowCredentials", &g.AllowCredentials)
+			delete(rawMsg, key)
+		case "allowHeaders":
+				err = unpopulate(val, "AllowHeaders", &g.AllowHeaders)
+			delete(rawMsg, key)
+		case "allowMethods":
+				err = unpopulate(val, "AllowMethods", &g.AllowMethods)
+			delete(rawMsg, key)
+		case "allowOrigins":
+				err = unpopulate(val, "AllowOrigins", &g.AllowOrigins)
+			delete(rawMsg, key)
+		case "exposeHeaders":
+				err = unpopulate(val, "ExposeHeaders", &g.ExposeHeaders)
+			delete(rawMsg, key)
+		case "maxAge":
+				err = unpopulate(val, "MaxAge", &g.MaxAge)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", g, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type GatewayHostname.
 func (g GatewayHostname) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -1890,6 +2168,7 @@ func (g *GatewayHostname) UnmarshalJSON(data []byte) error {
 func (g GatewayProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
 	populate(objectMap, "application", g.Application)
+	populate(objectMap, "cors", g.Cors)
 	populate(objectMap, "environment", g.Environment)
 	populate(objectMap, "hostname", g.Hostname)
 	populate(objectMap, "internal", g.Internal)
@@ -1913,6 +2192,9 @@ func (g *GatewayProperties) UnmarshalJSON(data []byte) error {
 		case "application":
 				err = unpopulate(val, "Application", &g.Application)
 			delete(rawMsg, key)
+		case "cors":
+				err = unpopulate(val, "Cors", &g.Cors)
+			delete(rawMsg, key)
 		case "environment":
 				err = unpopulate(val, "Environment", &g.Environment)
 			delete(rawMsg, key)
@@ -2062,6 +2344,7 @@ func (g *GatewayResourceUpdate) UnmarshalJSON(data []byte) error {
 func (g GatewayResourceUpdateProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
 	populate(objectMap, "application", g.Application)
+	populate(objectMap, "cors", g.Cors)
 	populate(objectMap, "environment", g.Environment)
 	populate(objectMap, "hostname", g.Hostname)
 	populate(objectMap, "internal", g.Internal)
@@ -2082,6 +2365,9 @@ func (g *GatewayResourceUpdateProperties) UnmarshalJSON(data []byte) error {
 		case "application":
 				err = unpopulate(val, "Application", &g.Application)
 			delete(rawMsg, key)
+		case "cors":
+				err = unpopulate(val, "Cors", &g.Cors)
+			delete(rawMsg, key)
 		case "environment":
 				err = unpopulate(val, "Environment", &g.Environment)
 			delete(rawMsg, key)
@@ -2109,8 +2395,14 @@ func (g *GatewayResourceUpdateProperties) UnmarshalJSON(data []byte) error {
 func (g GatewayRoute) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
 	populate(objectMap, "destination", g.Destination)
+	populate(objectMap, "headers", g.Headers)
+	populate(objectMap, "method", g.Method)
 	populate(objectMap, "path", g.Path)
+	populate(objectMap, "queryParameters", g.QueryParameters)
 	populate(objectMap, "replacePrefix", g.ReplacePrefix)
+	populate(objectMap, "retryPolicy", g.RetryPolicy)
+	populate(objectMap, "timeoutPolicy", g.TimeoutPolicy)
+	populate(objectMap, "weight", g.Weight)
 	return json.Marshal(objectMap)
 }
 
@@ -2126,12 +2418,30 @@ func (g *GatewayRoute) UnmarshalJSON(data []byte) error {
 		case "destination":
 				err = unpopulate(val, "Destination", &g.Destination)
 			delete(rawMsg, key)
+		case "headers":
+				err = unpopulate(val, "Headers", &g.Headers)
+			delete(rawMsg, key)
+		case "method":
+				err = unpopulate(val, "Method", &g.Method)
+			delete(rawMsg, key)
 		case "path":
 				err = unpopulate(val, "Path", &g.Path)
 			delete(rawMsg, key)
+		case "queryParameters":
+				err = unpopulate(val, "QueryParameters", &g.QueryParameters)
+			delete(rawMsg, key)
 		case "replacePrefix":
 				err = unpopulate(val, "ReplacePrefix", &g.ReplacePrefix)
 			delete(rawMsg, key)
+		case "retryPolicy":
+				err = unpopulate(val, "RetryPolicy", &g.RetryPolicy)
+			delete(rawMsg, key)
+		case "timeoutPolicy":
+				err = unpopulate(val, "TimeoutPolicy", &g.TimeoutPolicy)
+			delete(rawMsg, key)
+		case "weight":
+				err = unpopulate(val, "Weight", &g.Weight)
+			delete(rawMsg, key)
 		}
 		if err != nil {
 			return fmt.Errorf("unmarshalling type %T: %v", g, err)
@@ -2140,17 +2450,16 @@ func (g *GatewayRoute) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// MarshalJSON implements the json.Marshaller interface for type GatewayTLS.
-func (g GatewayTLS) MarshalJSON() ([]byte, error) {
+// MarshalJSON implements the json.Marshaller interface for type GatewayRouteHeaderMatch.
+func (g GatewayRouteHeaderMatch) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
-	populate(objectMap, "certificateFrom", g.CertificateFrom)
-	populate(objectMap, "minimumProtocolVersion", g.MinimumProtocolVersion)
-	populate(objectMap, "sslPassthrough", g.SSLPassthrough)
+	populate(objectMap, "name", g.Name)
+	populate(objectMap, "value", g.Value)
 	return json.Marshal(objectMap)
 }
 
-// UnmarshalJSON implements the json.Unmarshaller interface for type GatewayTLS.
-func (g *GatewayTLS) UnmarshalJSON(data []byte) error {
+// UnmarshalJSON implements the json.Unmarshaller interface for type GatewayRouteHeaderMatch.
+func (g *GatewayRouteHeaderMatch) UnmarshalJSON(data []byte) error {
 	var rawMsg map[string]json.RawMessage
 	if err := json.Unmarshal(data, &rawMsg); err != nil {
 		return fmt.Errorf("unmarshalling type %T: %v", g, err)
@@ -2158,14 +2467,11 @@ func (g *GatewayTLS) UnmarshalJSON(data []byte) error {
 	for key, val := range rawMsg {
 		var err error
 		switch key {
-		case "certificateFrom":
-				err = unpopulate(val, "CertificateFrom", &g.CertificateFrom)
-			delete(rawMsg, key)
-		case "minimumProtocolVersion":
-				err = unpopulate(val, "MinimumProtocolVersion", &g.MinimumProtocolVersion)
+		case "name":
+				err = unpopulate(val, "Name", &g.Name)
 			delete(rawMsg, key)
-		case "sslPassthrough":
-				err = unpopulate(val, "SSLPassthrough", &g.SSLPassthrough)
+		case "value":
+				err = unpopulate(val, "Value", &g.Value)
 			delete(rawMsg, key)
 		}
 		if err != nil {
@@ -2175,15 +2481,16 @@ func (g *GatewayTLS) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// MarshalJSON implements the json.Marshaller interface for type GitAuthConfig.
-func (g GitAuthConfig) MarshalJSON() ([]byte, error) {
+// MarshalJSON implements the json.Marshaller interface for type GatewayRouteQueryParameterMatch.
+func (g GatewayRouteQueryParameterMatch) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
-	populate(objectMap, "pat", g.Pat)
+	populate(objectMap, "name", g.Name)
+	populate(objectMap, "value", g.Value)
 	return json.Marshal(objectMap)
 }
 
-// UnmarshalJSON implements the json.Unmarshaller interface for type GitAuthConfig.
-func (g *GitAuthConfig) UnmarshalJSON(data []byte) error {
+// UnmarshalJSON implements the json.Unmarshaller interface for type GatewayRouteQueryParameterMatch.
+func (g *GatewayRouteQueryParameterMatch) UnmarshalJSON(data []byte) error {
 	var rawMsg map[string]json.RawMessage
 	if err := json.Unmarshal(data, &rawMsg); err != nil {
 		return fmt.Errorf("unmarshalling type %T: %v", g, err)
@@ -2191,8 +2498,11 @@ func (g *GitAuthConfig) UnmarshalJSON(data []byte) error {
 	for key, val := range rawMsg {
 		var err error
 		switch key {
-		case "pat":
-				err = unpopulate(val, "Pat", &g.Pat)
+		case "name":
+				err = unpopulate(val, "Name", &g.Name)
+			delete(rawMsg, key)
+		case "value":
+				err = unpopulate(val, "Value", &g.Value)
 			delete(rawMsg, key)
 		}
 		if err != nil {
@@ -2202,80 +2512,212 @@ func (g *GitAuthConfig) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// MarshalJSON implements the json.Marshaller interface for type HTTPGetHealthProbeProperties.
-func (h HTTPGetHealthProbeProperties) MarshalJSON() ([]byte, error) {
+// MarshalJSON implements the json.Marshaller interface for type GatewayRouteRetryPolicy.
+func (g GatewayRouteRetryPolicy) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
-	populate(objectMap, "containerPort", h.ContainerPort)
-	populate(objectMap, "failureThreshold", h.FailureThreshold)
-	populate(objectMap, "headers", h.Headers)
-	populate(objectMap, "initialDelaySeconds", h.InitialDelaySeconds)
-	objectMap["kind"] = "httpGet"
-	populate(objectMap, "path", h.Path)
-	populate(objectMap, "periodSeconds", h.PeriodSeconds)
-	populate(objectMap, "timeoutSeconds", h.TimeoutSeconds)
+	populate(objectMap, "attempts", g.Attempts)
+	populate(objectMap, "perTryTimeout", g.PerTryTimeout)
+	populate(objectMap, "retriableStatusCodes", g.RetriableStatusCodes)
+	populate(objectMap, "retryOn", g.RetryOn)
 	return json.Marshal(objectMap)
 }
 
-// UnmarshalJSON implements the json.Unmarshaller interface for type HTTPGetHealthProbeProperties.
-func (h *HTTPGetHealthProbeProperties) UnmarshalJSON(data []byte) error {
+// UnmarshalJSON implements the json.Unmarshaller interface for type GatewayRouteRetryPolicy.
+func (g *GatewayRouteRetryPolicy) UnmarshalJSON(data []byte) error {
 	var rawMsg map[string]json.RawMessage
 	if err := json.Unmarshal(data, &rawMsg); err != nil {
-		return fmt.Errorf("unmarshalling type %T: %v", h, err)
+		return fmt.Errorf("unmarshalling type %T: %v", g, err)
 	}
 	for key, val := range rawMsg {
 		var err error
 		switch key {
-		case "containerPort":
-				err = unpopulate(val, "ContainerPort", &h.ContainerPort)
-			delete(rawMsg, key)
-		case "failureThreshold":
-				err = unpopulate(val, "FailureThreshold", &h.FailureThreshold)
-			delete(rawMsg, key)
-		case "headers":
-				err = unpopulate(val, "Headers", &h.Headers)
-			delete(rawMsg, key)
-		case "initialDelaySeconds":
-				err = unpopulate(val, "InitialDelaySeconds", &h.InitialDelaySeconds)
+		case "attempts":
+				err = unpopulate(val, "Attempts", &g.Attempts)
 			delete(rawMsg, key)
-		case "kind":
-				err = unpopulate(val, "Kind", &h.Kind)
-			delete(rawMsg, key)
-		case "path":
-				err = unpopulate(val, "Path", &h.Path)
+		case "perTryTimeout":
+				err = unpopulate(val, "PerTryTimeout", &g.PerTryTimeout)
 			delete(rawMsg, key)
-		case "periodSeconds":
-				err = unpopulate(val, "PeriodSeconds", &h.PeriodSeconds)
+		case "retriableStatusCodes":
+				err = unpopulate(val, "RetriableStatusCodes", &g.RetriableStatusCodes)
 			delete(rawMsg, key)
-		case "timeoutSeconds":
-				err = unpopulate(val, "TimeoutSeconds", &h.TimeoutSeconds)
+		case "retryOn":
+				err = unpopulate(val, "RetryOn", &g.RetryOn)
 			delete(rawMsg, key)
 		}
 		if err != nil {
-			return fmt.Errorf("unmarshalling type %T: %v", h, err)
+			return fmt.Errorf("unmarshalling type %T: %v", g, err)
 		}
 	}
 	return nil
 }
 
-// MarshalJSON implements the json.Marshaller interface for type HTTPRouteProperties.
-func (h HTTPRouteProperties) MarshalJSON() ([]byte, error) {
+// MarshalJSON implements the json.Marshaller interface for type GatewayRouteTimeoutPolicy.
+func (g GatewayRouteTimeoutPolicy) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
-	populate(objectMap, "application", h.Application)
-	populate(objectMap, "environment", h.Environment)
-	populate(objectMap, "hostname", h.Hostname)
-	populate(objectMap, "port", h.Port)
-	populate(objectMap, "provisioningState", h.ProvisioningState)
-	populate(objectMap, "scheme", h.Scheme)
-	populate(objectMap, "status", h.Status)
-	populate(objectMap, "url", h.URL)
+	populate(objectMap, "idle", g.Idle)
+	populate(objectMap, "request", g.Request)
 	return json.Marshal(objectMap)
 }
 
-// UnmarshalJSON implements the json.Unmarshaller interface for type HTTPRouteProperties.
-func (h *HTTPRouteProperties) UnmarshalJSON(data []byte) error {
+// UnmarshalJSON implements the json.Unmarshaller interface for type GatewayRouteTimeoutPolicy.
+func (g *GatewayRouteTimeoutPolicy) UnmarshalJSON(data []byte) error {
 	var rawMsg map[string]json.RawMessage
 	if err := json.Unmarshal(data, &rawMsg); err != nil {
-		return fmt.Errorf("unmarshalling type %T: %v", h, err)
+		return fmt.Errorf("unmarshalling type %T: %v", g, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "idle":
+				err = unpopulate(val, "Idle", &g.Idle)
+			delete(rawMsg, key)
+		case "request":
+				err = unpopulate(val, "Request", &g.Request)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", g, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type GatewayTLS.
+func (g GatewayTLS) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "certificateFrom", g.CertificateFrom)
+	populate(objectMap, "minimumProtocolVersion", g.MinimumProtocolVersion)
+	populate(objectMap, "sslPassthrough", g.SSLPassthrough)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type GatewayTLS.
+func (g *GatewayTLS) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", g, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "certificateFrom":
+				err = unpopulate(val, "CertificateFrom", &g.CertificateFrom)
+			delete(rawMsg, key)
+		case "minimumProtocolVersion":
+				err = unpopulate(val, "MinimumProtocolVersion", &g.MinimumProtocolVersion)
+			delete(rawMsg, key)
+		case "sslPassthrough":
+				err = unpopulate(val, "SSLPassthrough", &g.SSLPassthrough)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", g, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type GitAuthConfig.
+func (g GitAuthConfig) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "pat", g.Pat)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type GitAuthConfig.
+func (g *GitAuthConfig) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", g, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "pat":
+				err = unpopulate(val, "Pat", &g.Pat)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", g, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type HTTPGetHealthProbeProperties.
+func (h HTTPGetHealthProbeProperties) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "containerPort", h.ContainerPort)
+	populate(objectMap, "failureThreshold", h.FailureThreshold)
+	populate(objectMap, "headers", h.Headers)
+	populate(objectMap, "initialDelaySeconds", h.InitialDelaySeconds)
+	objectMap["kind"] = "httpGet"
+	populate(objectMap, "path", h.Path)
+	populate(objectMap, "periodSeconds", h.PeriodSeconds)
+	populate(objectMap, "timeoutSeconds", h.TimeoutSeconds)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type HTTPGetHealthProbeProperties.
+func (h *HTTPGetHealthProbeProperties) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", h, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "containerPort":
+				err = unpopulate(val, "ContainerPort", &h.ContainerPort)
+			delete(rawMsg, key)
+		case "failureThreshold":
+				err = unpopulate(val, "FailureThreshold", &h.FailureThreshold)
+			delete(rawMsg, key)
+		case "headers":
+				err = unpopulate(val, "Headers", &h.Headers)
+			delete(rawMsg, key)
+		case "initialDelaySeconds":
+				err = unpopulate(val, "InitialDelaySeconds", &h.InitialDelaySeconds)
+			delete(rawMsg, key)
+		case "kind":
+				err = unpopulate(val, "Kind", &h.Kind)
+			delete(rawMsg, key)
+		case "path":
+				err = unpopulate(val, "Path", &h.Path)
+			delete(rawMsg, key)
+		case "periodSeconds":
+				err = unpopulate(val, "PeriodSeconds", &h.PeriodSeconds)
+			delete(rawMsg, key)
+		case "timeoutSeconds":
+				err = unpopulate(val, "TimeoutSeconds", &h.TimeoutSeconds)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", h, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type HTTPRouteProperties.
+func (h HTTPRouteProperties) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "application", h.Application)
+	populate(objectMap, "environment", h.Environment)
+	populate(objectMap, "hostname", h.Hostname)
+	populate(objectMap, "port", h.Port)
+	populate(objectMap, "provisioningState", h.ProvisioningState)
+	populate(objectMap, "scheme", h.Scheme)
+	populate(objectMap, "status", h.Status)
+	populate(objectMap, "url", h.URL)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type HTTPRouteProperties.
+func (h *HTTPRouteProperties) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", h, err)
 	}
 	for key, val := range rawMsg {
 		var err error
@@ -2507,6 +2949,112 @@ func (h *HealthProbeProperties) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type HelmRecipeProperties.
+func (h HelmRecipeProperties) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "defaultVersion", h.DefaultVersion)
+	populate(objectMap, "parameters", h.Parameters)
+	populate(objectMap, "plainHttp", h.PlainHTTP)
+	populate(objectMap, "previousDefaultVersion", h.PreviousDefaultVersion)
+	objectMap["templateKind"] = "helm"
+	populate(objectMap, "templatePath", h.TemplatePath)
+	populate(objectMap, "templateVersion", h.TemplateVersion)
+	populate(objectMap, "versions", h.Versions)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type HelmRecipeProperties.
+func (h *HelmRecipeProperties) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", h, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "defaultVersion":
+				err = unpopulate(val, "DefaultVersion", &h.DefaultVersion)
+			delete(rawMsg, key)
+		case "parameters":
+				err = unpopulate(val, "Parameters", &h.Parameters)
+			delete(rawMsg, key)
+		case "plainHttp":
+				err = unpopulate(val, "PlainHTTP", &h.PlainHTTP)
+			delete(rawMsg, key)
+		case "previousDefaultVersion":
+				err = unpopulate(val, "PreviousDefaultVersion", &h.PreviousDefaultVersion)
+			delete(rawMsg, key)
+		case "templateKind":
+				err = unpopulate(val, "TemplateKind", &h.TemplateKind)
+			delete(rawMsg, key)
+		case "templatePath":
+				err = unpopulate(val, "TemplatePath", &h.TemplatePath)
+			delete(rawMsg, key)
+		case "templateVersion":
+				err = unpopulate(val, "TemplateVersion", &h.TemplateVersion)
+			delete(rawMsg, key)
+		case "versions":
+				err = unpopulate(val, "Versions", &h.Versions)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", h, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type HelmRecipePropertiesUpdate.
+func (h HelmRecipePropertiesUpdate) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "defaultVersion", h.DefaultVersion)
+	populate(objectMap, "parameters", h.Parameters)
+	populate(objectMap, "plainHttp", h.PlainHTTP)
+	objectMap["templateKind"] = "helm"
+	populate(objectMap, "templatePath", h.TemplatePath)
+	populate(objectMap, "templateVersion", h.TemplateVersion)
+	populate(objectMap, "versions", h.Versions)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type HelmRecipePropertiesUpdate.
+func (h *HelmRecipePropertiesUpdate) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", h, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "defaultVersion":
+				err = unpopulate(val, "DefaultVersion", &h.DefaultVersion)
+			delete(rawMsg, key)
+		case "parameters":
+				err = unpopulate(val, "Parameters", &h.Parameters)
+			delete(rawMsg, key)
+		case "plainHttp":
+				err = unpopulate(val, "PlainHTTP", &h.PlainHTTP)
+			delete(rawMsg, key)
+		case "templateKind":
+				err = unpopulate(val, "TemplateKind", &h.TemplateKind)
+			delete(rawMsg, key)
+		case "templatePath":
+				err = unpopulate(val, "TemplatePath", &h.TemplatePath)
+			delete(rawMsg, key)
+		case "templateVersion":
+				err = unpopulate(val, "TemplateVersion", &h.TemplateVersion)
+			delete(rawMsg, key)
+		case "versions":
+				err = unpopulate(val, "Versions", &h.Versions)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", h, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type IamProperties.
 func (i IamProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -2818,6 +3366,104 @@ func (k *KubernetesNamespaceExtension) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type KubernetesRecipeProperties.
+func (k KubernetesRecipeProperties) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "defaultVersion", k.DefaultVersion)
+	populate(objectMap, "parameters", k.Parameters)
+	populate(objectMap, "plainHttp", k.PlainHTTP)
+	populate(objectMap, "previousDefaultVersion", k.PreviousDefaultVersion)
+	objectMap["templateKind"] = "kubernetes"
+	populate(objectMap, "templatePath", k.TemplatePath)
+	populate(objectMap, "versions", k.Versions)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type KubernetesRecipeProperties.
+func (k *KubernetesRecipeProperties) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", k, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "defaultVersion":
+				err = unpopulate(val, "DefaultVersion", &k.DefaultVersion)
+			delete(rawMsg, key)
+		case "parameters":
+				err = unpopulate(val, "Parameters", &k.Parameters)
+			delete(rawMsg, key)
+		case "plainHttp":
+				err = unpopulate(val, "PlainHTTP", &k.PlainHTTP)
+			delete(rawMsg, key)
+		case "previousDefaultVersion":
+				err = unpopulate(val, "PreviousDefaultVersion", &k.PreviousDefaultVersion)
+			delete(rawMsg, key)
+		case "templateKind":
+				err = unpopulate(val, "TemplateKind", &k.TemplateKind)
+			delete(rawMsg, key)
+		case "templatePath":
+				err = unpopulate(val, "TemplatePath", &k.TemplatePath)
+			delete(rawMsg, key)
+		case "versions":
+				err = unpopulate(val, "Versions", &k.Versions)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", k, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type KubernetesRecipePropertiesUpdate.
+func (k KubernetesRecipePropertiesUpdate) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "defaultVersion", k.DefaultVersion)
+	populate(objectMap, "parameters", k.Parameters)
+	populate(objectMap, "plainHttp", k.PlainHTTP)
+	objectMap["templateKind"] = "kubernetes"
+	populate(objectMap, "templatePath", k.TemplatePath)
+	populate(objectMap, "versions", k.Versions)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type KubernetesRecipePropertiesUpdate.
+func (k *KubernetesRecipePropertiesUpdate) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", k, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "defaultVersion":
+				err = unpopulate(val, "DefaultVersion", &k.DefaultVersion)
+			delete(rawMsg, key)
+		case "parameters":
+				err = unpopulate(val, "Parameters", &k.Parameters)
+			delete(rawMsg, key)
+		case "plainHttp":
+				err = unpopulate(val, "PlainHTTP", &k.PlainHTTP)
+			delete(rawMsg, key)
+		case "templateKind":
+				err = unpopulate(val, "TemplateKind", &k.TemplateKind)
+			delete(rawMsg, key)
+		case "templatePath":
+				err = unpopulate(val, "TemplatePath", &k.TemplatePath)
+			delete(rawMsg, key)
+		case "versions":
+				err = unpopulate(val, "Versions", &k.Versions)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", k, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type KubernetesRuntimeProperties.
 func (k KubernetesRuntimeProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -3242,6 +3888,7 @@ func (r Recipe) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
 	populate(objectMap, "name", r.Name)
 	populate(objectMap, "parameters", r.Parameters)
+	populate(objectMap, "version", r.Version)
 	return json.Marshal(objectMap)
 }
 
@@ -3260,6 +3907,9 @@ func (r *Recipe) UnmarshalJSON(data []byte) error {
 		case "parameters":
 				err = unpopulate(val, "Parameters", &r.Parameters)
 			delete(rawMsg, key)
+		case "version":
+				err = unpopulate(val, "Version", &r.Version)
+			delete(rawMsg, key)
 		}
 		if err != nil {
 			return fmt.Errorf("unmarshalling type %T: %v", r, err)
@@ -3299,6 +3949,45 @@ func (r *RecipeConfigProperties) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type RecipeDriftStatus.
+func (r RecipeDriftStatus) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "drifted", r.Drifted)
+	populateTimeRFC3339(objectMap, "lastCheckedAt", r.LastCheckedAt)
+	populateTimeRFC3339(objectMap, "lastReconciledAt", r.LastReconciledAt)
+	populate(objectMap, "resources", r.Resources)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type RecipeDriftStatus.
+func (r *RecipeDriftStatus) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", r, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "drifted":
+				err = unpopulate(val, "Drifted", &r.Drifted)
+			delete(rawMsg, key)
+		case "lastCheckedAt":
+				err = unpopulateTimeRFC3339(val, "LastCheckedAt", &r.LastCheckedAt)
+			delete(rawMsg, key)
+		case "lastReconciledAt":
+				err = unpopulateTimeRFC3339(val, "LastReconciledAt", &r.LastReconciledAt)
+			delete(rawMsg, key)
+		case "resources":
+				err = unpopulate(val, "Resources", &r.Resources)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", r, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type RecipeGetMetadata.
 func (r RecipeGetMetadata) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -3373,12 +4062,89 @@ func (r *RecipeGetMetadataResponse) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type RecipePlanResult.
+func (r RecipePlanResult) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "changes", r.Changes)
+	populate(objectMap, "templateKind", r.TemplateKind)
+	populate(objectMap, "templatePath", r.TemplatePath)
+	populate(objectMap, "templateVersion", r.TemplateVersion)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type RecipePlanResult.
+func (r *RecipePlanResult) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", r, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "changes":
+				err = unpopulate(val, "Changes", &r.Changes)
+			delete(rawMsg, key)
+		case "templateKind":
+				err = unpopulate(val, "TemplateKind", &r.TemplateKind)
+			delete(rawMsg, key)
+		case "templatePath":
+				err = unpopulate(val, "TemplatePath", &r.TemplatePath)
+			delete(rawMsg, key)
+		case "templateVersion":
+				err = unpopulate(val, "TemplateVersion", &r.TemplateVersion)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", r, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type RecipeResourceChange.
+func (r RecipeResourceChange) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "action", r.Action)
+	populate(objectMap, "id", r.ID)
+	populate(objectMap, "type", r.Type)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type RecipeResourceChange.
+func (r *RecipeResourceChange) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", r, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "action":
+				err = unpopulate(val, "Action", &r.Action)
+			delete(rawMsg, key)
+		case "id":
+				err = unpopulate(val, "ID", &r.ID)
+			delete(rawMsg, key)
+		case "type":
+				err = unpopulate(val, "Type", &r.Type)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", r, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type RecipeProperties.
 func (r RecipeProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
+	populate(objectMap, "defaultVersion", r.DefaultVersion)
 	populate(objectMap, "parameters", r.Parameters)
+	populate(objectMap, "previousDefaultVersion", r.PreviousDefaultVersion)
 	objectMap["templateKind"] = r.TemplateKind
 	populate(objectMap, "templatePath", r.TemplatePath)
+	populate(objectMap, "versions", r.Versions)
 	return json.Marshal(objectMap)
 }
 
@@ -3391,15 +4157,24 @@ func (r *RecipeProperties) UnmarshalJSON(data []byte) error {
 	for key, val := range rawMsg {
 		var err error
 		switch key {
+		case "defaultVersion":
+				err = unpopulate(val, "DefaultVersion", &r.DefaultVersion)
+			delete(rawMsg, key)
 		case "parameters":
 				err = unpopulate(val, "Parameters", &r.Parameters)
 			delete(rawMsg, key)
+		case "previousDefaultVersion":
+				err = unpopulate(val, "PreviousDefaultVersion", &r.PreviousDefaultVersion)
+			delete(rawMsg, key)
 		case "templateKind":
 				err = unpopulate(val, "TemplateKind", &r.TemplateKind)
 			delete(rawMsg, key)
 		case "templatePath":
 				err = unpopulate(val, "TemplatePath", &r.TemplatePath)
 			delete(rawMsg, key)
+		case "versions":
+				err = unpopulate(val, "Versions", &r.Versions)
+			delete(rawMsg, key)
 		}
 		if err != nil {
 			return fmt.Errorf("unmarshalling type %T: %v", r, err)
@@ -3411,9 +4186,11 @@ func (r *RecipeProperties) UnmarshalJSON(data []byte) error {
 // MarshalJSON implements the json.Marshaller interface for type RecipePropertiesUpdate.
 func (r RecipePropertiesUpdate) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
+	populate(objectMap, "defaultVersion", r.DefaultVersion)
 	populate(objectMap, "parameters", r.Parameters)
 	objectMap["templateKind"] = r.TemplateKind
 	populate(objectMap, "templatePath", r.TemplatePath)
+	populate(objectMap, "versions", r.Versions)
 	return json.Marshal(objectMap)
 }
 
@@ -3426,6 +4203,9 @@ func (r *RecipePropertiesUpdate) UnmarshalJSON(data []byte) error {
 	for key, val := range rawMsg {
 		var err error
 		switch key {
+		case "defaultVersion":
+				err = unpopulate(val, "DefaultVersion", &r.DefaultVersion)
+			delete(rawMsg, key)
 		case "parameters":
 				err = unpopulate(val, "Parameters", &r.Parameters)
 			delete(rawMsg, key)
@@ -3435,6 +4215,9 @@ func (r *RecipePropertiesUpdate) UnmarshalJSON(data []byte) error {
 		case "templatePath":
 				err = unpopulate(val, "TemplatePath", &r.TemplatePath)
 			delete(rawMsg, key)
+		case "versions":
+				err = unpopulate(val, "Versions", &r.Versions)
+			delete(rawMsg, key)
 		}
 		if err != nil {
 			return fmt.Errorf("unmarshalling type %T: %v", r, err)
@@ -3446,9 +4229,11 @@ func (r *RecipePropertiesUpdate) UnmarshalJSON(data []byte) error {
 // MarshalJSON implements the json.Marshaller interface for type RecipeStatus.
 func (r RecipeStatus) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
+	populate(objectMap, "drift", r.Drift)
 	populate(objectMap, "templateKind", r.TemplateKind)
 	populate(objectMap, "templatePath", r.TemplatePath)
 	populate(objectMap, "templateVersion", r.TemplateVersion)
+	populate(objectMap, "version", r.Version)
 	return json.Marshal(objectMap)
 }
 
@@ -3461,6 +4246,9 @@ func (r *RecipeStatus) UnmarshalJSON(data []byte) error {
 	for key, val := range rawMsg {
 		var err error
 		switch key {
+		case "drift":
+				err = unpopulate(val, "Drift", &r.Drift)
+			delete(rawMsg, key)
 		case "templateKind":
 				err = unpopulate(val, "TemplateKind", &r.TemplateKind)
 			delete(rawMsg, key)
@@ -3470,6 +4258,79 @@ func (r *RecipeStatus) UnmarshalJSON(data []byte) error {
 		case "templateVersion":
 				err = unpopulate(val, "TemplateVersion", &r.TemplateVersion)
 			delete(rawMsg, key)
+		case "version":
+				err = unpopulate(val, "Version", &r.Version)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", r, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type RecipeUpdate.
+func (r RecipeUpdate) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "name", r.Name)
+	populate(objectMap, "parameters", r.Parameters)
+	populate(objectMap, "version", r.Version)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type RecipeUpdate.
+func (r *RecipeUpdate) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", r, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "name":
+				err = unpopulate(val, "Name", &r.Name)
+			delete(rawMsg, key)
+		case "parameters":
+				err = unpopulate(val, "Parameters", &r.Parameters)
+			delete(rawMsg, key)
+		case "version":
+				err = unpopulate(val, "Version", &r.Version)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", r, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type RecipeVersionProperties.
+func (r RecipeVersionProperties) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "parameters", r.Parameters)
+	populate(objectMap, "templatePath", r.TemplatePath)
+	populate(objectMap, "templateVersion", r.TemplateVersion)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type RecipeVersionProperties.
+func (r *RecipeVersionProperties) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", r, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "parameters":
+				err = unpopulate(val, "Parameters", &r.Parameters)
+			delete(rawMsg, key)
+		case "templatePath":
+				err = unpopulate(val, "TemplatePath", &r.TemplatePath)
+			delete(rawMsg, key)
+		case "templateVersion":
+				err = unpopulate(val, "TemplateVersion", &r.TemplateVersion)
+			delete(rawMsg, key)
 		}
 		if err != nil {
 			return fmt.Errorf("unmarshalling type %T: %v", r, err)
@@ -3478,16 +4339,17 @@ func (r *RecipeStatus) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// MarshalJSON implements the json.Marshaller interface for type RecipeUpdate.
-func (r RecipeUpdate) MarshalJSON() ([]byte, error) {
+// MarshalJSON implements the json.Marshaller interface for type RecipeVersionPropertiesUpdate.
+func (r RecipeVersionPropertiesUpdate) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
-	populate(objectMap, "name", r.Name)
 	populate(objectMap, "parameters", r.Parameters)
+	populate(objectMap, "templatePath", r.TemplatePath)
+	populate(objectMap, "templateVersion", r.TemplateVersion)
 	return json.Marshal(objectMap)
 }
 
-// UnmarshalJSON implements the json.Unmarshaller interface for type RecipeUpdate.
-func (r *RecipeUpdate) UnmarshalJSON(data []byte) error {
+// UnmarshalJSON implements the json.Unmarshaller interface for type RecipeVersionPropertiesUpdate.
+func (r *RecipeVersionPropertiesUpdate) UnmarshalJSON(data []byte) error {
 	var rawMsg map[string]json.RawMessage
 	if err := json.Unmarshal(data, &rawMsg); err != nil {
 		return fmt.Errorf("unmarshalling type %T: %v", r, err)
@@ -3495,12 +4357,15 @@ func (r *RecipeUpdate) UnmarshalJSON(data []byte) error {
 	for key, val := range rawMsg {
 		var err error
 		switch key {
-		case "name":
-				err = unpopulate(val, "Name", &r.Name)
-			delete(rawMsg, key)
 		case "parameters":
 				err = unpopulate(val, "Parameters", &r.Parameters)
 			delete(rawMsg, key)
+		case "templatePath":
+				err = unpopulate(val, "TemplatePath", &r.TemplatePath)
+			delete(rawMsg, key)
+		case "templateVersion":
+				err = unpopulate(val, "TemplateVersion", &r.TemplateVersion)
+			delete(rawMsg, key)
 		}
 		if err != nil {
 			return fmt.Errorf("unmarshalling type %T: %v", r, err)
@@ -3703,6 +4568,68 @@ func (s *SecretObjectProperties) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type SecretReference.
+func (s SecretReference) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "key", s.Key)
+	populate(objectMap, "source", s.Source)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type SecretReference.
+func (s *SecretReference) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", s, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "key":
+				err = unpopulate(val, "Key", &s.Key)
+			delete(rawMsg, key)
+		case "source":
+				err = unpopulate(val, "Source", &s.Source)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", s, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type SecretReferenceUpdate.
+func (s SecretReferenceUpdate) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "key", s.Key)
+	populate(objectMap, "source", s.Source)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type SecretReferenceUpdate.
+func (s *SecretReferenceUpdate) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", s, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "key":
+				err = unpopulate(val, "Key", &s.Key)
+			delete(rawMsg, key)
+		case "source":
+				err = unpopulate(val, "Source", &s.Source)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", s, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type SecretStoreListSecretsResult.
 func (s SecretStoreListSecretsResult) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -3976,6 +4903,191 @@ func (s *SecretValueProperties) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type SecretVolume.
+func (s SecretVolume) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	objectMap["kind"] = "secret"
+	populate(objectMap, "mountPath", s.MountPath)
+	populate(objectMap, "source", s.Source)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type SecretVolume.
+func (s *SecretVolume) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", s, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "kind":
+				err = unpopulate(val, "Kind", &s.Kind)
+			delete(rawMsg, key)
+		case "mountPath":
+				err = unpopulate(val, "MountPath", &s.MountPath)
+			delete(rawMsg, key)
+		case "source":
+				err = unpopulate(val, "Source", &s.Source)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", s, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type SupportingContainer.
+func (s SupportingContainer) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "args", s.Args)
+	populate(objectMap, "command", s.Command)
+	populate(objectMap, "env", s.Env)
+	populate(objectMap, "image", s.Image)
+	populate(objectMap, "imagePullPolicy", s.ImagePullPolicy)
+	populate(objectMap, "livenessProbe", s.LivenessProbe)
+	populate(objectMap, "name", s.Name)
+	populate(objectMap, "ports", s.Ports)
+	populate(objectMap, "readinessProbe", s.ReadinessProbe)
+	populate(objectMap, "resources", s.Resources)
+	populate(objectMap, "secretEnv", s.SecretEnv)
+	populate(objectMap, "volumes", s.Volumes)
+	populate(objectMap, "workingDir", s.WorkingDir)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type SupportingContainer.
+func (s *SupportingContainer) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", s, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "args":
+				err = unpopulate(val, "Args", &s.Args)
+			delete(rawMsg, key)
+		case "command":
+				err = unpopulate(val, "Command", &s.Command)
+			delete(rawMsg, key)
+		case "env":
+				err = unpopulate(val, "Env", &s.Env)
+			delete(rawMsg, key)
+		case "image":
+				err = unpopulate(val, "Image", &s.Image)
+			delete(rawMsg, key)
+		case "imagePullPolicy":
+				err = unpopulate(val, "ImagePullPolicy", &s.ImagePullPolicy)
+			delete(rawMsg, key)
+		case "livenessProbe":
+			s.LivenessProbe, err = unmarshalHealthProbePropertiesClassification(val)
+			delete(rawMsg, key)
+		case "name":
+				err = unpopulate(val, "Name", &s.Name)
+			delete(rawMsg, key)
+		case "ports":
+				err = unpopulate(val, "Ports", &s.Ports)
+			delete(rawMsg, key)
+		case "readinessProbe":
+			s.ReadinessProbe, err = unmarshalHealthProbePropertiesClassification(val)
+			delete(rawMsg, key)
+		case "resources":
+				err = unpopulate(val, "Resources", &s.Resources)
+			delete(rawMsg, key)
+		case "secretEnv":
+				err = unpopulate(val, "SecretEnv", &s.SecretEnv)
+			delete(rawMsg, key)
+		case "volumes":
+			s.Volumes, err = unmarshalVolumeClassificationMap(val)
+			delete(rawMsg, key)
+		case "workingDir":
+				err = unpopulate(val, "WorkingDir", &s.WorkingDir)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", s, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type SupportingContainerUpdate.
+func (s SupportingContainerUpdate) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "args", s.Args)
+	populate(objectMap, "command", s.Command)
+	populate(objectMap, "env", s.Env)
+	populate(objectMap, "image", s.Image)
+	populate(objectMap, "imagePullPolicy", s.ImagePullPolicy)
+	populate(objectMap, "livenessProbe", s.LivenessProbe)
+	populate(objectMap, "name", s.Name)
+	populate(objectMap, "ports", s.Ports)
+	populate(objectMap, "readinessProbe", s.ReadinessProbe)
+	populate(objectMap, "resources", s.Resources)
+	populate(objectMap, "secretEnv", s.SecretEnv)
+	populate(objectMap, "volumes", s.Volumes)
+	populate(objectMap, "workingDir", s.WorkingDir)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type SupportingContainerUpdate.
+func (s *SupportingContainerUpdate) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", s, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "args":
+				err = unpopulate(val, "Args", &s.Args)
+			delete(rawMsg, key)
+		case "command":
+				err = unpopulate(val, "Command", &s.Command)
+			delete(rawMsg, key)
+		case "env":
+				err = unpopulate(val, "Env", &s.Env)
+			delete(rawMsg, key)
+		case "image":
+				err = unpopulate(val, "Image", &s.Image)
+			delete(rawMsg, key)
+		case "imagePullPolicy":
+				err = unpopulate(val, "ImagePullPolicy", &s.ImagePullPolicy)
+			delete(rawMsg, key)
+		case "livenessProbe":
+			s.LivenessProbe, err = unmarshalHealthProbePropertiesClassification(val)
+			delete(rawMsg, key)
+		case "name":
+				err = unpopulate(val, "Name", &s.Name)
+			delete(rawMsg, key)
+		case "ports":
+				err = unpopulate(val, "Ports", &s.Ports)
+			delete(rawMsg, key)
+		case "readinessProbe":
+			s.ReadinessProbe, err = unmarshalHealthProbePropertiesClassification(val)
+			delete(rawMsg, key)
+		case "resources":
+				err = unpopulate(val, "Resources", &s.Resources)
+			delete(rawMsg, key)
+		case "secretEnv":
+				err = unpopulate(val, "SecretEnv", &s.SecretEnv)
+			delete(rawMsg, key)
+		case "volumes":
+			s.Volumes, err = unmarshalVolumeClassificationMap(val)
+			delete(rawMsg, key)
+		case "workingDir":
+				err = unpopulate(val, "WorkingDir", &s.WorkingDir)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", s, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type SystemData.
 func (s SystemData) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -4070,11 +5182,48 @@ func (t *TCPHealthProbeProperties) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type TerraformBackendConfig.
+func (t TerraformBackendConfig) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "kind", t.Kind)
+	populate(objectMap, "local", t.Local)
+	populate(objectMap, "s3", t.S3)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type TerraformBackendConfig.
+func (t *TerraformBackendConfig) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", t, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "kind":
+				err = unpopulate(val, "Kind", &t.Kind)
+			delete(rawMsg, key)
+		case "local":
+				err = unpopulate(val, "Local", &t.Local)
+			delete(rawMsg, key)
+		case "s3":
+				err = unpopulate(val, "S3", &t.S3)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", t, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type TerraformConfigProperties.
 func (t TerraformConfigProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
 	populate(objectMap, "authentication", t.Authentication)
+	populate(objectMap, "backend", t.Backend)
 	populate(objectMap, "providers", t.Providers)
+	populate(objectMap, "version", t.Version)
 	return json.Marshal(objectMap)
 }
 
@@ -4090,9 +5239,42 @@ func (t *TerraformConfigProperties) UnmarshalJSON(data []byte) error {
 		case "authentication":
 				err = unpopulate(val, "Authentication", &t.Authentication)
 			delete(rawMsg, key)
+		case "backend":
+				err = unpopulate(val, "Backend", &t.Backend)
+			delete(rawMsg, key)
 		case "providers":
 				err = unpopulate(val, "Providers", &t.Providers)
 			delete(rawMsg, key)
+		case "version":
+				err = unpopulate(val, "Version", &t.Version)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", t, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type TerraformLocalBackendConfig.
+func (t TerraformLocalBackendConfig) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "path", t.Path)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type TerraformLocalBackendConfig.
+func (t *TerraformLocalBackendConfig) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", t, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "path":
+				err = unpopulate(val, "Path", &t.Path)
+			delete(rawMsg, key)
 		}
 		if err != nil {
 			return fmt.Errorf("unmarshalling type %T: %v", t, err)
@@ -4104,10 +5286,13 @@ func (t *TerraformConfigProperties) UnmarshalJSON(data []byte) error {
 // MarshalJSON implements the json.Marshaller interface for type TerraformRecipeProperties.
 func (t TerraformRecipeProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
+	populate(objectMap, "defaultVersion", t.DefaultVersion)
 	populate(objectMap, "parameters", t.Parameters)
+	populate(objectMap, "previousDefaultVersion", t.PreviousDefaultVersion)
 	objectMap["templateKind"] = "terraform"
 	populate(objectMap, "templatePath", t.TemplatePath)
 	populate(objectMap, "templateVersion", t.TemplateVersion)
+	populate(objectMap, "versions", t.Versions)
 	return json.Marshal(objectMap)
 }
 
@@ -4120,9 +5305,15 @@ func (t *TerraformRecipeProperties) UnmarshalJSON(data []byte) error {
 	for key, val := range rawMsg {
 		var err error
 		switch key {
+		case "defaultVersion":
+				err = unpopulate(val, "DefaultVersion", &t.DefaultVersion)
+			delete(rawMsg, key)
 		case "parameters":
 				err = unpopulate(val, "Parameters", &t.Parameters)
 			delete(rawMsg, key)
+		case "previousDefaultVersion":
+				err = unpopulate(val, "PreviousDefaultVersion", &t.PreviousDefaultVersion)
+			delete(rawMsg, key)
 		case "templateKind":
 				err = unpopulate(val, "TemplateKind", &t.TemplateKind)
 			delete(rawMsg, key)
@@ -4132,6 +5323,9 @@ func (t *TerraformRecipeProperties) UnmarshalJSON(data []byte) error {
 		case "templateVersion":
 				err = unpopulate(val, "TemplateVersion", &t.TemplateVersion)
 			delete(rawMsg, key)
+		case "versions":
+				err = unpopulate(val, "Versions", &t.Versions)
+			delete(rawMsg, key)
 		}
 		if err != nil {
 			return fmt.Errorf("unmarshalling type %T: %v", t, err)
@@ -4143,10 +5337,12 @@ func (t *TerraformRecipeProperties) UnmarshalJSON(data []byte) error {
 // MarshalJSON implements the json.Marshaller interface for type TerraformRecipePropertiesUpdate.
 func (t TerraformRecipePropertiesUpdate) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
+	populate(objectMap, "defaultVersion", t.DefaultVersion)
 	populate(objectMap, "parameters", t.Parameters)
 	objectMap["templateKind"] = "terraform"
 	populate(objectMap, "templatePath", t.TemplatePath)
 	populate(objectMap, "templateVersion", t.TemplateVersion)
+	populate(objectMap, "versions", t.Versions)
 	return json.Marshal(objectMap)
 }
 
@@ -4159,6 +5355,9 @@ func (t *TerraformRecipePropertiesUpdate) UnmarshalJSON(data []byte) error {
 	for key, val := range rawMsg {
 		var err error
 		switch key {
+		case "defaultVersion":
+				err = unpopulate(val, "DefaultVersion", &t.DefaultVersion)
+			delete(rawMsg, key)
 		case "parameters":
 				err = unpopulate(val, "Parameters", &t.Parameters)
 			delete(rawMsg, key)
@@ -4171,6 +5370,52 @@ func (t *TerraformRecipePropertiesUpdate) UnmarshalJSON(data []byte) error {
 		case "templateVersion":
 				err = unpopulate(val, "TemplateVersion", &t.TemplateVersion)
 			delete(rawMsg, key)
+		case "versions":
+				err = unpopulate(val, "Versions", &t.Versions)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", t, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type TerraformS3BackendConfig.
+func (t TerraformS3BackendConfig) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "bucket", t.Bucket)
+	populate(objectMap, "endpoint", t.Endpoint)
+	populate(objectMap, "keyPrefix", t.KeyPrefix)
+	populate(objectMap, "region", t.Region)
+	populate(objectMap, "usePathStyle", t.UsePathStyle)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type TerraformS3BackendConfig.
+func (t *TerraformS3BackendConfig) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", t, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "bucket":
+				err = unpopulate(val, "Bucket", &t.Bucket)
+			delete(rawMsg, key)
+		case "endpoint":
+				err = unpopulate(val, "Endpoint", &t.Endpoint)
+			delete(rawMsg, key)
+		case "keyPrefix":
+				err = unpopulate(val, "KeyPrefix", &t.KeyPrefix)
+			delete(rawMsg, key)
+		case "region":
+				err = unpopulate(val, "Region", &t.Region)
+			delete(rawMsg, key)
+		case "usePathStyle":
+				err = unpopulate(val, "UsePathStyle", &t.UsePathStyle)
+			delete(rawMsg, key)
 		}
 		if err != nil {
 			return fmt.Errorf("unmarshalling type %T: %v", t, err)
@@ -61,6 +61,8 @@ func unmarshalExtensionClassification(rawMsg json.RawMessage) (ExtensionClassifi
 	}
 	var b ExtensionClassification
 	switch m["kind"] {
+	case "autoScaling":
+		b = &AutoScalingExtension{}
 	case "daprSidecar":
 		b = &DaprSidecarExtension{}
 	case "kubernetesMetadata":
@@ -134,6 +136,10 @@ func unmarshalRecipePropertiesClassification(rawMsg json.RawMessage) (RecipeProp
 	switch m["templateKind"] {
 	case "bicep":
 		b = &BicepRecipeProperties{}
+	case "helm":
+		b = &HelmRecipeProperties{}
+	case "kubernetes":
+		b = &KubernetesRecipeProperties{}
 	case "terraform":
 		b = &TerraformRecipeProperties{}
 	default:
@@ -176,6 +182,10 @@ func unmarshalRecipePropertiesUpdateClassification(rawMsg json.RawMessage) (Reci
 	switch m["templateKind"] {
 	case "bicep":
 		b = &BicepRecipePropertiesUpdate{}
+	case "helm":
+		b = &HelmRecipePropertiesUpdate{}
+	case "kubernetes":
+		b = &KubernetesRecipePropertiesUpdate{}
 	case "terraform":
 		b = &TerraformRecipePropertiesUpdate{}
 	default:
@@ -220,6 +230,8 @@ func unmarshalVolumeClassification(rawMsg json.RawMessage) (VolumeClassification
 		b = &EphemeralVolume{}
 	case "persistent":
 		b = &PersistentVolume{}
+	case "secret":
+		b = &SecretVolume{}
 	default:
 		b = &Volume{}
 	}
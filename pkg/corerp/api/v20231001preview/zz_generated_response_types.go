@@ -135,6 +135,12 @@ type ExtendersClientListSecretsResponse struct {
 	Object map[string]any
 }
 
+// ExtendersClientPlanResponse contains the response from method ExtendersClient.Plan.
+type ExtendersClientPlanResponse struct {
+	// The changes that the execution of the recipe of a portable resource would make.
+	RecipePlanResult
+}
+
 // ExtendersClientUpdateResponse contains the response from method ExtendersClient.BeginUpdate.
 type ExtendersClientUpdateResponse struct {
 	// ExtenderResource portable resource
@@ -417,6 +417,12 @@ func (dp *deploymentProcessor) getEnvOptions(ctx context.Context, env *corerp_dm
 		envOpts.KubernetesMetadata = envExt.KubernetesMetadata
 	}
 
+	envOpts.Gateway.Kind = env.Properties.Gateway.Kind
+	if envOpts.Gateway.Kind == "" {
+		envOpts.Gateway.Kind = corerp_dm.GatewayKindContour
+	}
+	envOpts.Gateway.GatewayClassName = env.Properties.Gateway.GatewayClassName
+
 	if publicEndpointOverride != "" {
 		// Check if publicEndpointOverride contains a scheme,
 		// and if so, throw an error to the user
@@ -432,16 +438,17 @@ func (dp *deploymentProcessor) getEnvOptions(ctx context.Context, env *corerp_dm
 			port = ""
 		}
 
-		envOpts.Gateway = renderers.GatewayOptions{
-			PublicEndpointOverride: true,
-			Hostname:               hostname,
-			Port:                   port,
-		}
+		envOpts.Gateway.PublicEndpointOverride = true
+		envOpts.Gateway.Hostname = hostname
+		envOpts.Gateway.Port = port
 
 		return envOpts, nil
 	}
 
-	if dp.k8sClient != nil {
+	// The public endpoint of Gateway API gateways is assigned by the Gateway implementation of the cluster when the
+	// Gateway object is created, so it can't be looked up in advance. The gateway renderer resolves it from the
+	// address in the status of the deployed Gateway.
+	if dp.k8sClient != nil && envOpts.Gateway.Kind != corerp_dm.GatewayKindGatewayAPI {
 		// Find the public endpoint of the cluster (External IP or hostname of the contour-envoy service)
 		var services corev1.ServiceList
 		err := dp.k8sClient.List(ctx, &services, &controller_runtime.ListOptions{Namespace: "radius-system"})
@@ -452,11 +459,8 @@ func (dp *deploymentProcessor) getEnvOptions(ctx context.Context, env *corerp_dm
 		for _, service := range services.Items {
 			if service.Name == "contour-envoy" {
 				for _, in := range service.Status.LoadBalancer.Ingress {
-					envOpts.Gateway = renderers.GatewayOptions{
-						PublicEndpointOverride: false,
-						Hostname:               in.Hostname,
-						ExternalIP:             in.IP,
-					}
+					envOpts.Gateway.Hostname = in.Hostname
+					envOpts.Gateway.ExternalIP = in.IP
 					return envOpts, nil
 				}
 			}
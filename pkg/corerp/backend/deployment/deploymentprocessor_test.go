@@ -1099,6 +1099,48 @@ func Test_Delete(t *testing.T) {
 	})
 }
 
+func Test_getEnvOptions_GatewayKind(t *testing.T) {
+	ctx := testcontext.New(t)
+	mocks := setup(t)
+	dp := deploymentProcessor{mocks.model, nil, nil, nil}
+
+	env := &datamodel.Environment{
+		Properties: datamodel.EnvironmentProperties{
+			Compute: rpv1.EnvironmentCompute{
+				Kind: rpv1.KubernetesComputeKind,
+				KubernetesCompute: rpv1.KubernetesComputeProperties{
+					Namespace: "radius-system",
+				},
+			},
+		},
+	}
+
+	t.Run("defaults to contour", func(t *testing.T) {
+		options, err := dp.getEnvOptions(ctx, env)
+		require.NoError(t, err)
+		require.Equal(t, datamodel.GatewayKindContour, options.Gateway.Kind)
+		require.Empty(t, options.Gateway.GatewayClassName)
+	})
+
+	t.Run("gateway API", func(t *testing.T) {
+		os.Setenv("RADIUS_PUBLIC_ENDPOINT_OVERRIDE", "localhost:8000")
+		defer os.Unsetenv("RADIUS_PUBLIC_ENDPOINT_OVERRIDE")
+
+		gatewayAPIEnv := *env
+		gatewayAPIEnv.Properties.Gateway = datamodel.EnvironmentGatewayConfig{
+			Kind:             datamodel.GatewayKindGatewayAPI,
+			GatewayClassName: "eg",
+		}
+
+		options, err := dp.getEnvOptions(ctx, &gatewayAPIEnv)
+		require.NoError(t, err)
+		require.Equal(t, datamodel.GatewayKindGatewayAPI, options.Gateway.Kind)
+		require.Equal(t, "eg", options.Gateway.GatewayClassName)
+		require.True(t, options.Gateway.PublicEndpointOverride)
+		require.Equal(t, "localhost", options.Gateway.Hostname)
+	})
+}
+
 func Test_getEnvOptions_PublicEndpointOverride(t *testing.T) {
 	ctx := testcontext.New(t)
 	mocks := setup(t)
@@ -79,6 +79,20 @@ type ContainerProperties struct {
 	Resources            []ResourceReference             `json:"resources,omitempty"`
 	ResourceProvisioning ContainerResourceProvisioning   `json:"resourceProvisioning,omitempty"`
 	RestartPolicy        string                          `json:"restartPolicy,omitempty"`
+	InitContainers       []SupportingContainer           `json:"initContainers,omitempty"`
+	Sidecars             []SupportingContainer           `json:"sidecars,omitempty"`
+}
+
+// AllContainers returns the definitions of the main container followed by the ones of the init containers and the sidecars.
+func (p ContainerProperties) AllContainers() []Container {
+	containers := []Container{p.Container}
+	for _, c := range p.InitContainers {
+		containers = append(containers, c.Container)
+	}
+	for _, c := range p.Sidecars {
+		containers = append(containers, c.Container)
+	}
+	return containers
 }
 
 // ContainerResourceProvisioning specifies how resources should be created for the container.
@@ -119,6 +133,7 @@ type Container struct {
 	Image           string                      `json:"image,omitempty"`
 	ImagePullPolicy string                      `json:"imagePullPolicy,omitempty"`
 	Env             map[string]string           `json:"env,omitempty"`
+	SecretEnv       map[string]SecretReference  `json:"secretEnv,omitempty"`
 	LivenessProbe   HealthProbeProperties       `json:"livenessProbe,omitempty"`
 	Ports           map[string]ContainerPort    `json:"ports,omitempty"`
 	ReadinessProbe  HealthProbeProperties       `json:"readinessProbe,omitempty"`
@@ -126,6 +141,38 @@ type Container struct {
 	Command         []string                    `json:"command,omitempty"`
 	Args            []string                    `json:"args,omitempty"`
 	WorkingDir      string                      `json:"workingDir,omitempty"`
+	Resources       *ContainerResources         `json:"resources,omitempty"`
+}
+
+// SecretReference - A reference to a key of a secret. Source is either the resource ID of an
+// Applications.Core/secretStores resource or the name of a Kubernetes secret in the namespace of the container.
+type SecretReference struct {
+	Source string `json:"source,omitempty"`
+	Key    string `json:"key,omitempty"`
+}
+
+// SupportingContainer - Definition of an init container or a sidecar. It shares the connection environment variables
+// and the volumes of the main container.
+type SupportingContainer struct {
+	Name string `json:"name,omitempty"`
+	Container
+}
+
+// ContainerResources - The compute resources required by a container
+type ContainerResources struct {
+	Requests ContainerResourceQuantities `json:"requests,omitempty"`
+	Limits   ContainerResourceQuantities `json:"limits,omitempty"`
+}
+
+// ContainerResourceQuantities - Amounts of compute resources, in the Kubernetes quantity format
+type ContainerResourceQuantities struct {
+	CPU    string `json:"cpu,omitempty"`
+	Memory string `json:"memory,omitempty"`
+}
+
+// IsEmpty checks if no quantity is set.
+func (q ContainerResourceQuantities) IsEmpty() bool {
+	return q == ContainerResourceQuantities{}
 }
 
 // ContainerPort - Specifies a listening port for the container
@@ -156,6 +203,7 @@ type VolumeKind string
 const (
 	Ephemeral  VolumeKind = "ephemeral"
 	Persistent VolumeKind = "persistent"
+	Secret     VolumeKind = "secret"
 )
 
 // VolumeProperties - Specifies a volume for a container
@@ -163,6 +211,7 @@ type VolumeProperties struct {
 	Kind       VolumeKind        `json:"kind,omitempty"`
 	Ephemeral  *EphemeralVolume  `json:"ephemeralVolume,omitempty"`
 	Persistent *PersistentVolume `json:"persistentVolume,omitempty"`
+	Secret     *SecretVolume     `json:"secretVolume,omitempty"`
 }
 
 // Volume - Specifies a volume for a container
@@ -183,6 +232,13 @@ type PersistentVolume struct {
 	Permission VolumePermission `json:"permission,omitempty"`
 }
 
+// SecretVolume - Specifies a volume holding the keys of a secret as files. Source is either the resource ID of an
+// Applications.Core/secretStores resource or the name of a Kubernetes secret in the namespace of the container.
+type SecretVolume struct {
+	VolumeBase
+	Source string `json:"source,omitempty"`
+}
+
 // ManagedStore - Backing store for the ephemeral volume
 type ManagedStore string
 
@@ -253,6 +309,30 @@ type ManualScalingExtension struct {
 	Replicas *int32 `json:"replicas,omitempty"`
 }
 
+// AutoScalingExtension - Specifies the resource should be scaled automatically based on its metrics
+type AutoScalingExtension struct {
+	MinReplicas *int32              `json:"minReplicas,omitempty"`
+	MaxReplicas int32               `json:"maxReplicas,omitempty"`
+	Metrics     []AutoScalingMetric `json:"metrics,omitempty"`
+}
+
+// AutoScalingMetricKind - The kind of an autoscaling metric
+type AutoScalingMetricKind string
+
+const (
+	AutoScalingMetricCPU    AutoScalingMetricKind = "cpu"
+	AutoScalingMetricMemory AutoScalingMetricKind = "memory"
+	AutoScalingMetricCustom AutoScalingMetricKind = "custom"
+)
+
+// AutoScalingMetric - A metric used to scale a resource automatically
+type AutoScalingMetric struct {
+	Kind                     AutoScalingMetricKind `json:"kind,omitempty"`
+	Name                     string                `json:"name,omitempty"`
+	TargetAverageUtilization *int32                `json:"targetAverageUtilization,omitempty"`
+	TargetAverageValue       string                `json:"targetAverageValue,omitempty"`
+}
+
 // DaprSidecarExtension - Specifies the resource should have a Dapr sidecar injected
 type DaprSidecarExtension struct {
 	AppID    string   `json:"appId,omitempty"`
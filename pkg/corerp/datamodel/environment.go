@@ -17,6 +17,8 @@ limitations under the License.
 package datamodel
 
 import (
+	"fmt"
+
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 )
@@ -44,6 +46,22 @@ type EnvironmentProperties struct {
 	RecipeConfig RecipeConfigProperties                            `json:"recipeConfig,omitempty"`
 	Extensions   []Extension                                       `json:"extensions,omitempty"`
 	Simulated    bool                                              `json:"simulated,omitempty"`
+	Gateway      EnvironmentGatewayConfig                          `json:"gateway,omitempty"`
+}
+
+const (
+	// GatewayKindContour exposes the gateways of an environment with Contour HTTPProxy objects.
+	GatewayKindContour = "contour"
+	// GatewayKindGatewayAPI exposes the gateways of an environment with Kubernetes Gateway API objects.
+	GatewayKindGatewayAPI = "gatewayAPI"
+)
+
+// EnvironmentGatewayConfig represents the configuration for the gateways of an environment.
+type EnvironmentGatewayConfig struct {
+	// Kind is the API used to expose the gateways of the environment. Defaults to contour.
+	Kind string `json:"kind,omitempty"`
+	// GatewayClassName is the name of the GatewayClass of the Gateway objects when Kind is gatewayAPI.
+	GatewayClassName string `json:"gatewayClassName,omitempty"`
 }
 
 // EnvironmentRecipeProperties represents the properties of environment's recipe.
@@ -53,6 +71,43 @@ type EnvironmentRecipeProperties struct {
 	TemplateVersion string         `json:"templateVersion,omitempty"`
 	Parameters      map[string]any `json:"parameters,omitempty"`
 	PlainHTTP       bool           `json:"plainHttp,omitempty"`
+
+	// Versions is the set of named versions of the recipe, keyed by version name.
+	Versions map[string]RecipeVersionProperties `json:"versions,omitempty"`
+	// DefaultVersion is the name of the version used by resources that do not pin a version.
+	// The recipe's own template is used when empty.
+	DefaultVersion string `json:"defaultVersion,omitempty"`
+	// PreviousDefaultVersion is the name of the version that was the default before the last change of DefaultVersion.
+	PreviousDefaultVersion string `json:"previousDefaultVersion,omitempty"`
+}
+
+// RecipeVersionProperties represents a named version of an environment's recipe.
+type RecipeVersionProperties struct {
+	TemplatePath    string         `json:"templatePath"`
+	TemplateVersion string         `json:"templateVersion,omitempty"`
+	Parameters      map[string]any `json:"parameters,omitempty"`
+}
+
+// ForVersion returns the recipe properties for the named version of the recipe. The default version is
+// used when version is empty, and the recipe's own template when the recipe has no default version.
+func (e *EnvironmentRecipeProperties) ForVersion(version string) (EnvironmentRecipeProperties, error) {
+	if version == "" {
+		version = e.DefaultVersion
+	}
+
+	result := *e
+	if version == "" {
+		return result, nil
+	}
+
+	v, ok := e.Versions[version]
+	if !ok {
+		return EnvironmentRecipeProperties{}, fmt.Errorf("recipe version %q is not defined", version)
+	}
+	result.TemplatePath = v.TemplatePath
+	result.TemplateVersion = v.TemplateVersion
+	result.Parameters = v.Parameters
+	return result, nil
 }
 
 // Recipe represents input properties for recipe getMetadata api.
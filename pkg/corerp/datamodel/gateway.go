@@ -66,15 +66,64 @@ type GatewayProperties struct {
 	Internal bool                       `json:"internal,omitempty"`
 	Hostname *GatewayPropertiesHostname `json:"hostname,omitempty"`
 	TLS      *GatewayPropertiesTLS      `json:"tls,omitempty"`
+	CORS     *GatewayCORS               `json:"cors,omitempty"`
 	Routes   []GatewayRoute             `json:"routes,omitempty"`
 	URL      string                     `json:"url,omitempty"`
 }
 
 // GatewayRoute represents the route attached to Gateway.
 type GatewayRoute struct {
-	Destination   string `json:"destination,omitempty"`
-	Path          string `json:"path,omitempty"`
-	ReplacePrefix string `json:"replacePrefix,omitempty"`
+	Destination     string                            `json:"destination,omitempty"`
+	Path            string                            `json:"path,omitempty"`
+	ReplacePrefix   string                            `json:"replacePrefix,omitempty"`
+	Method          string                            `json:"method,omitempty"`
+	Headers         []GatewayRouteHeaderMatch         `json:"headers,omitempty"`
+	QueryParameters []GatewayRouteQueryParameterMatch `json:"queryParameters,omitempty"`
+	// Weight is the weight of the destination among the routes sharing the same match. nil if not set.
+	Weight        *int32                     `json:"weight,omitempty"`
+	TimeoutPolicy *GatewayRouteTimeoutPolicy `json:"timeoutPolicy,omitempty"`
+	RetryPolicy   *GatewayRouteRetryPolicy   `json:"retryPolicy,omitempty"`
+}
+
+// GatewayRouteHeaderMatch - Header of the incoming request to match a route on.
+type GatewayRouteHeaderMatch struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// GatewayRouteQueryParameterMatch - Query parameter of the incoming request to match a route on.
+type GatewayRouteQueryParameterMatch struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// GatewayRouteTimeoutPolicy - Timeout policy of the requests sent to a route destination. The timeouts are durations, ex - 30s.
+type GatewayRouteTimeoutPolicy struct {
+	Request string `json:"request,omitempty"`
+	Idle    string `json:"idle,omitempty"`
+}
+
+// GatewayRouteRetryPolicy - Retry policy of the requests sent to a route destination.
+type GatewayRouteRetryPolicy struct {
+	Attempts             int32    `json:"attempts"`
+	PerTryTimeout        string   `json:"perTryTimeout,omitempty"`
+	RetryOn              []string `json:"retryOn,omitempty"`
+	RetriableStatusCodes []int32  `json:"retriableStatusCodes,omitempty"`
+}
+
+// GatewayCORS - CORS policy of a Gateway.
+type GatewayCORS struct {
+	AllowOrigins     []string `json:"allowOrigins"`
+	AllowMethods     []string `json:"allowMethods"`
+	AllowHeaders     []string `json:"allowHeaders,omitempty"`
+	ExposeHeaders    []string `json:"exposeHeaders,omitempty"`
+	AllowCredentials bool     `json:"allowCredentials,omitempty"`
+	MaxAge           string   `json:"maxAge,omitempty"`
+}
+
+// HasMatch returns true if the route matches the requests on more than their path.
+func (r GatewayRoute) HasMatch() bool {
+	return r.Method != "" || len(r.Headers) > 0 || len(r.QueryParameters) > 0
 }
 
 // GatewayPropertiesHostname - Declare hostname information for the Gateway.
@@ -33,6 +33,50 @@ type TerraformConfigProperties struct {
 
 	// Providers specifies the Terraform provider configurations. Controls how Terraform interacts with cloud providers, SaaS providers, and other APIs: https://developer.hashicorp.com/terraform/language/providers/configuration.// Providers specifies the Terraform provider configurations.
 	Providers map[string][]ProviderConfigProperties `json:"providers,omitempty"`
+
+	// Version is the version of Terraform used to run Terraform Recipes. Defaults to the version configured for Radius,
+	// or the latest version if none is configured.
+	Version string `json:"version,omitempty"`
+
+	// Backend is the configuration for the backend that stores the Terraform state of Recipes. Defaults to a Kubernetes secret
+	// in the Radius namespace.
+	Backend TerraformBackendConfig `json:"backend,omitempty"`
+}
+
+// TerraformBackendConfig - Configuration for the backend that stores the Terraform state of Recipes.
+type TerraformBackendConfig struct {
+	// Kind is the kind of the Terraform state backend: kubernetes, pg, s3 or local. Defaults to kubernetes.
+	Kind string `json:"kind,omitempty"`
+
+	// S3 is the configuration for the S3-compatible Terraform state backend.
+	S3 *S3BackendConfig `json:"s3,omitempty"`
+
+	// Local is the configuration for the filesystem Terraform state backend.
+	Local *LocalBackendConfig `json:"local,omitempty"`
+}
+
+// S3BackendConfig - Configuration for the S3-compatible Terraform state backend.
+type S3BackendConfig struct {
+	// Bucket is the name of the bucket that stores the Terraform state.
+	Bucket string `json:"bucket"`
+
+	// Region is the region of the bucket.
+	Region string `json:"region,omitempty"`
+
+	// Endpoint is the endpoint of the S3-compatible service. Defaults to AWS S3.
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// KeyPrefix is the prefix of the keys of the Terraform state objects in the bucket.
+	KeyPrefix string `json:"keyPrefix,omitempty"`
+
+	// UsePathStyle enables path-style addressing for the bucket.
+	UsePathStyle bool `json:"usePathStyle,omitempty"`
+}
+
+// LocalBackendConfig - Configuration for the filesystem Terraform state backend.
+type LocalBackendConfig struct {
+	// Path is the directory that stores the Terraform state.
+	Path string `json:"path"`
 }
 
 // AuthConfig - Authentication information used to access private Terraform module sources. Supported module sources: Git.
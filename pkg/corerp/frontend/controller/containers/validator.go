@@ -20,22 +20,30 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/rest"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/kubernetes"
 	"github.com/radius-project/radius/pkg/kubeutil"
 )
 
 const (
-	manifestTargetProperty = "$.properties.runtimes.kubernetes.base"
-	podTargetProperty      = "$.properties.runtimes.kubernetes.pod"
+	manifestTargetProperty       = "$.properties.runtimes.kubernetes.base"
+	podTargetProperty            = "$.properties.runtimes.kubernetes.pod"
+	containerTargetProperty      = "$.properties.container"
+	initContainersTargetProperty = "$.properties.initContainers"
+	sidecarsTargetProperty       = "$.properties.sidecars"
 )
 
 // ValidateAndMutateRequest checks if the newResource has a user-defined identity and if so, returns a bad request
@@ -52,6 +60,10 @@ func ValidateAndMutateRequest(ctx context.Context, newResource, oldResource *dat
 		newResource.Properties.Identity = oldResource.Properties.Identity
 	}
 
+	if err := validateSupportingContainers(newResource); err != nil {
+		return rest.NewBadRequestARMResponse(v1.ErrorResponse{Error: err.(v1.ErrorDetails)}), nil
+	}
+
 	runtimes := newResource.Properties.Runtimes
 	if runtimes != nil && runtimes.Kubernetes != nil {
 		if runtimes.Kubernetes.Base != "" {
@@ -72,6 +84,138 @@ func ValidateAndMutateRequest(ctx context.Context, newResource, oldResource *dat
 	return nil, nil
 }
 
+// validateSupportingContainers validates the init containers and the sidecars of the container resource. They run in the
+// same pod as the main container and the volumes of the main container are mounted in all of them, so their names, ports,
+// volumes and mount paths must not conflict with the ones of the other containers.
+func validateSupportingContainers(newResource *datamodel.ContainerResource) error {
+	properties := newResource.Properties
+	if len(properties.InitContainers) == 0 && len(properties.Sidecars) == 0 {
+		return nil
+	}
+
+	errDetails := []v1.ErrorDetails{}
+	addError := func(target string, format string, args ...any) {
+		errDetails = append(errDetails, v1.ErrorDetails{
+			Code:    v1.CodeInvalidRequestContent,
+			Target:  target,
+			Message: fmt.Sprintf(format, args...),
+		})
+	}
+
+	// Each map records the target of the property which first used a name, port or path.
+	containerNames := map[string]string{kubernetes.NormalizeResourceName(newResource.Name): containerTargetProperty}
+	portNames := map[string]string{}
+	containerPorts := map[int32]string{}
+	volumeNames := map[string]string{}
+	sharedMountPaths := map[string]string{}
+
+	for _, name := range sortedKeys(properties.Container.Ports) {
+		target := containerTargetProperty + ".ports." + name
+		portNames[name] = target
+		if _, ok := containerPorts[properties.Container.Ports[name].ContainerPort]; !ok {
+			containerPorts[properties.Container.Ports[name].ContainerPort] = target
+		}
+	}
+	for _, name := range sortedKeys(properties.Container.Volumes) {
+		target := containerTargetProperty + ".volumes." + name
+		volumeNames[name] = target
+		if _, ok := sharedMountPaths[volumeMountPath(properties.Container.Volumes[name])]; !ok {
+			sharedMountPaths[volumeMountPath(properties.Container.Volumes[name])] = target
+		}
+	}
+
+	groups := []struct {
+		target     string
+		containers []datamodel.SupportingContainer
+		init       bool
+	}{
+		{target: initContainersTargetProperty, containers: properties.InitContainers, init: true},
+		{target: sidecarsTargetProperty, containers: properties.Sidecars},
+	}
+	for _, group := range groups {
+		for i, c := range group.containers {
+			target := fmt.Sprintf("%s[%d]", group.target, i)
+
+			if errs := validation.IsDNS1123Label(c.Name); len(errs) > 0 {
+				addError(target+".name", "container name %q is invalid: %s.", c.Name, strings.Join(errs, ", "))
+			} else if existing, ok := containerNames[c.Name]; ok {
+				addError(target+".name", "container name %q conflicts with %s.", c.Name, existing)
+			} else {
+				containerNames[c.Name] = target
+			}
+
+			if group.init {
+				if len(c.Ports) > 0 {
+					addError(target+".ports", "init containers cannot expose ports.")
+				}
+				if !c.ReadinessProbe.IsEmpty() || !c.LivenessProbe.IsEmpty() {
+					addError(target, "init containers cannot define readiness or liveness probes.")
+				}
+			}
+
+			for _, name := range sortedKeys(c.Ports) {
+				port := c.Ports[name]
+				portTarget := target + ".ports." + name
+				if port.Provides != "" {
+					addError(portTarget+".provides", "provides is only supported on the ports of the main container.")
+				}
+				if existing, ok := portNames[name]; ok {
+					addError(portTarget, "port name %s conflicts with %s.", name, existing)
+				} else {
+					portNames[name] = portTarget
+				}
+				if existing, ok := containerPorts[port.ContainerPort]; ok {
+					addError(portTarget+".containerPort", "container port %d conflicts with %s.", port.ContainerPort, existing)
+				} else {
+					containerPorts[port.ContainerPort] = portTarget
+				}
+			}
+
+			for _, name := range sortedKeys(c.Volumes) {
+				volumeTarget := target + ".volumes." + name
+				if existing, ok := volumeNames[name]; ok {
+					addError(volumeTarget, "volume name %s conflicts with %s.", name, existing)
+				} else {
+					volumeNames[name] = volumeTarget
+				}
+				if existing, ok := sharedMountPaths[volumeMountPath(c.Volumes[name])]; ok {
+					addError(volumeTarget, "mount path %s conflicts with %s, which is mounted in every container.", volumeMountPath(c.Volumes[name]), existing)
+				}
+			}
+		}
+	}
+
+	if len(errDetails) > 0 {
+		return v1.ErrorDetails{
+			Code:    v1.CodeInvalidRequestContent,
+			Target:  "$.properties",
+			Message: "The containers of the resource conflict with each other.",
+			Details: errDetails,
+		}
+	}
+
+	return nil
+}
+
+func volumeMountPath(volume datamodel.VolumeProperties) string {
+	switch {
+	case volume.Ephemeral != nil:
+		return volume.Ephemeral.MountPath
+	case volume.Persistent != nil:
+		return volume.Persistent.MountPath
+	case volume.Secret != nil:
+		return volume.Secret.MountPath
+	default:
+		return ""
+	}
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := maps.Keys(m)
+	slices.Sort(keys)
+	return keys
+}
+
 // validatePodSpec is doing only syntactic validation for PodSpec by deserialzing the given JSON patch
 // to PodSpec object at this time. The semantic validation will be done when Radius applies the
 // patched object to Kubernetes API server.
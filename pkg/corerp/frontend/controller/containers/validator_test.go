@@ -444,3 +444,203 @@ func TestValidatePodSpec(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateSupportingContainers(t *testing.T) {
+	ephemeralVolume := func(mountPath string) datamodel.VolumeProperties {
+		return datamodel.VolumeProperties{
+			Kind: datamodel.Ephemeral,
+			Ephemeral: &datamodel.EphemeralVolume{
+				VolumeBase:   datamodel.VolumeBase{MountPath: mountPath},
+				ManagedStore: datamodel.ManagedStoreDisk,
+			},
+		}
+	}
+
+	mainContainer := datamodel.Container{
+		Image: "magpie:latest",
+		Ports: map[string]datamodel.ContainerPort{
+			"web": {ContainerPort: 8080},
+		},
+		Volumes: map[string]datamodel.VolumeProperties{
+			"data": ephemeralVolume("/data"),
+		},
+	}
+
+	tests := []struct {
+		name           string
+		initContainers []datamodel.SupportingContainer
+		sidecars       []datamodel.SupportingContainer
+		details        []v1.ErrorDetails
+	}{
+		{
+			name: "no supporting containers",
+		},
+		{
+			name: "valid supporting containers",
+			initContainers: []datamodel.SupportingContainer{
+				{Name: "migrate", Container: datamodel.Container{Image: "migrate:latest"}},
+			},
+			sidecars: []datamodel.SupportingContainer{
+				{
+					Name: "proxy",
+					Container: datamodel.Container{
+						Image: "proxy:latest",
+						Ports: map[string]datamodel.ContainerPort{
+							"proxy": {ContainerPort: 9090},
+						},
+						Volumes: map[string]datamodel.VolumeProperties{
+							"cache": ephemeralVolume("/cache"),
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "invalid and conflicting names",
+			initContainers: []datamodel.SupportingContainer{
+				{Name: "Migrate_DB", Container: datamodel.Container{Image: "migrate:latest"}},
+				{Name: "magpie", Container: datamodel.Container{Image: "migrate:latest"}},
+			},
+			sidecars: []datamodel.SupportingContainer{
+				{Name: "proxy", Container: datamodel.Container{Image: "proxy:latest"}},
+				{Name: "proxy", Container: datamodel.Container{Image: "proxy:latest"}},
+			},
+			details: []v1.ErrorDetails{
+				{
+					Code:    v1.CodeInvalidRequestContent,
+					Target:  "$.properties.initContainers[0].name",
+					Message: "container name \"Migrate_DB\" is invalid: a lowercase RFC 1123 label must consist of lower case alphanumeric characters or '-', and must start and end with an alphanumeric character (e.g. 'my-name',  or '123-abc', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?').",
+				},
+				{
+					Code:    v1.CodeInvalidRequestContent,
+					Target:  "$.properties.initContainers[1].name",
+					Message: "container name \"magpie\" conflicts with $.properties.container.",
+				},
+				{
+					Code:    v1.CodeInvalidRequestContent,
+					Target:  "$.properties.sidecars[1].name",
+					Message: "container name \"proxy\" conflicts with $.properties.sidecars[0].",
+				},
+			},
+		},
+		{
+			name: "init container with ports and probes",
+			initContainers: []datamodel.SupportingContainer{
+				{
+					Name: "migrate",
+					Container: datamodel.Container{
+						Image: "migrate:latest",
+						Ports: map[string]datamodel.ContainerPort{
+							"admin": {ContainerPort: 9000},
+						},
+						ReadinessProbe: datamodel.HealthProbeProperties{
+							Kind: datamodel.TCPHealthProbe,
+							TCP:  &datamodel.TCPHealthProbeProperties{ContainerPort: 9000},
+						},
+					},
+				},
+			},
+			details: []v1.ErrorDetails{
+				{
+					Code:    v1.CodeInvalidRequestContent,
+					Target:  "$.properties.initContainers[0].ports",
+					Message: "init containers cannot expose ports.",
+				},
+				{
+					Code:    v1.CodeInvalidRequestContent,
+					Target:  "$.properties.initContainers[0]",
+					Message: "init containers cannot define readiness or liveness probes.",
+				},
+			},
+		},
+		{
+			name: "conflicting ports",
+			sidecars: []datamodel.SupportingContainer{
+				{
+					Name: "proxy",
+					Container: datamodel.Container{
+						Image: "proxy:latest",
+						Ports: map[string]datamodel.ContainerPort{
+							"web":   {ContainerPort: 9090},
+							"proxy": {ContainerPort: 8080, Provides: "/planes/radius/local/resourceGroups/test/providers/Applications.Core/httpRoutes/route"},
+						},
+					},
+				},
+			},
+			details: []v1.ErrorDetails{
+				{
+					Code:    v1.CodeInvalidRequestContent,
+					Target:  "$.properties.sidecars[0].ports.proxy.provides",
+					Message: "provides is only supported on the ports of the main container.",
+				},
+				{
+					Code:    v1.CodeInvalidRequestContent,
+					Target:  "$.properties.sidecars[0].ports.proxy.containerPort",
+					Message: "container port 8080 conflicts with $.properties.container.ports.web.",
+				},
+				{
+					Code:    v1.CodeInvalidRequestContent,
+					Target:  "$.properties.sidecars[0].ports.web",
+					Message: "port name web conflicts with $.properties.container.ports.web.",
+				},
+			},
+		},
+		{
+			name: "conflicting volumes",
+			sidecars: []datamodel.SupportingContainer{
+				{
+					Name: "shipper",
+					Container: datamodel.Container{
+						Image: "shipper:latest",
+						Volumes: map[string]datamodel.VolumeProperties{
+							"data":   ephemeralVolume("/shipper"),
+							"buffer": ephemeralVolume("/data"),
+						},
+					},
+				},
+			},
+			details: []v1.ErrorDetails{
+				{
+					Code:    v1.CodeInvalidRequestContent,
+					Target:  "$.properties.sidecars[0].volumes.buffer",
+					Message: "mount path /data conflicts with $.properties.container.volumes.data, which is mounted in every container.",
+				},
+				{
+					Code:    v1.CodeInvalidRequestContent,
+					Target:  "$.properties.sidecars[0].volumes.data",
+					Message: "volume name data conflicts with $.properties.container.volumes.data.",
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			resource := &datamodel.ContainerResource{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						Name: "magpie",
+					},
+				},
+				Properties: datamodel.ContainerProperties{
+					Container:      mainContainer,
+					InitContainers: tc.initContainers,
+					Sidecars:       tc.sidecars,
+				},
+			}
+
+			err := validateSupportingContainers(resource)
+			if tc.details == nil {
+				require.NoError(t, err)
+				return
+			}
+
+			require.Equal(t, v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  "$.properties",
+				Message: "The containers of the resource conflict with each other.",
+				Details: tc.details,
+			}, err)
+		})
+	}
+}
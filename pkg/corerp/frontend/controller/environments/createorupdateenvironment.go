@@ -20,6 +20,10 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
@@ -27,24 +31,40 @@ import (
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/corerp/datamodel/converter"
 	"github.com/radius-project/radius/pkg/corerp/frontend/controller/util"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+	"golang.org/x/exp/maps"
 )
 
 var _ ctrl.Controller = (*CreateOrUpdateEnvironment)(nil)
 
+const (
+	// defaultValidationTimeout is the maximum time spent retrieving the parameters declared by the recipe templates to
+	// validate the recipe parameters. The templates are retrieved within the request, so the validation is skipped
+	// rather than delaying the request when the registries are slow.
+	defaultValidationTimeout = 30 * time.Second
+)
+
 // CreateOrUpdateEnvironments is the controller implementation to create or update environment resource.
 type CreateOrUpdateEnvironment struct {
 	ctrl.Operation[*datamodel.Environment, datamodel.Environment]
+	engine            engine.Engine
+	validationTimeout time.Duration
 }
 
-// NewCreateOrUpdateEnvironment creates a new controller for creating or updating an environment resource.
-func NewCreateOrUpdateEnvironment(opts ctrl.Options) (ctrl.Controller, error) {
+// NewCreateOrUpdateEnvironment creates a new controller for creating or updating an environment resource. The engine
+// is used to validate the parameters of the recipes against the parameters declared by their templates.
+func NewCreateOrUpdateEnvironment(opts ctrl.Options, engine engine.Engine) (ctrl.Controller, error) {
 	return &CreateOrUpdateEnvironment{
-		ctrl.NewOperation(opts,
+		Operation: ctrl.NewOperation(opts,
 			ctrl.ResourceOptions[datamodel.Environment]{
 				RequestConverter:  converter.EnvironmentDataModelFromVersioned,
 				ResponseConverter: converter.EnvironmentDataModelToVersioned,
 			},
 		),
+		engine:            engine,
+		validationTimeout: defaultValidationTimeout,
 	}, nil
 }
 
@@ -69,6 +89,12 @@ func (e *CreateOrUpdateEnvironment) Run(ctx context.Context, w http.ResponseWrit
 		return rest.NewBadRequestResponse(err.Error()), nil
 	}
 
+	recordPreviousDefaultVersions(newResource, old)
+
+	if err := e.validateRecipeParameters(ctx, newResource, old); err != nil {
+		return rest.NewBadRequestResponse(err.Error()), nil
+	}
+
 	// Create Query filter to query kubernetes namespace used by the other environment resources.
 	namespace := newResource.Properties.Compute.KubernetesCompute.Namespace
 	result, err := util.FindResources(ctx, serviceCtx.ResourceID.RootScope(), serviceCtx.ResourceID.Type(), "properties.compute.kubernetes.namespace", namespace, e.StorageClient())
@@ -97,3 +123,85 @@ func (e *CreateOrUpdateEnvironment) Run(ctx context.Context, w http.ResponseWrit
 
 	return e.ConstructSyncResponse(ctx, req.Method, newEtag, newResource)
 }
+
+// validateRecipeParameters validates the parameters of the new or updated recipes of the environment against the
+// parameters declared by their templates. Recipes whose template can't be retrieved are not validated, their parameters
+// are validated when the recipe is deployed. The validation of the remaining recipes is skipped when the templates can't be
+// retrieved within the validation timeout.
+func (e *CreateOrUpdateEnvironment) validateRecipeParameters(ctx context.Context, newResource *datamodel.Environment, old *datamodel.Environment) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	validationCtx, cancel := context.WithTimeout(ctx, e.validationTimeout)
+	defer cancel()
+
+	msgs := []string{}
+	resourceTypes := maps.Keys(newResource.Properties.Recipes)
+	sort.Strings(resourceTypes)
+	for _, resourceType := range resourceTypes {
+		names := maps.Keys(newResource.Properties.Recipes[resourceType])
+		sort.Strings(names)
+		for _, name := range names {
+			recipe := newResource.Properties.Recipes[resourceType][name]
+			if len(recipe.Parameters) == 0 || !recipeChanged(old, resourceType, name, recipe) {
+				continue
+			}
+
+			if validationCtx.Err() != nil {
+				logger.Info(fmt.Sprintf("Skipping the validation of the parameters of recipe %q for resource type %q: the recipe templates were not retrieved within %s", name, resourceType, e.validationTimeout))
+				continue
+			}
+
+			parameters, err := getRecipeParameters(validationCtx, e.engine, recipe, &datamodel.Recipe{Name: name, ResourceType: resourceType})
+			if err != nil {
+				logger.Info(fmt.Sprintf("Skipping the validation of the parameters of recipe %q for resource type %q: %s", name, resourceType, err.Error()))
+				continue
+			}
+
+			schema, err := recipes.NewParameterSchema(recipe.TemplateKind, parameters)
+			if err != nil {
+				logger.Info(fmt.Sprintf("Skipping the validation of the parameters of recipe %q for resource type %q: %s", name, resourceType, err.Error()))
+				continue
+			}
+
+			if err := schema.Validate(recipe.Parameters); err != nil {
+				msgs = append(msgs, fmt.Sprintf("invalid parameters for recipe %q of resource type %q: %s", name, resourceType, err.Error()))
+			}
+		}
+	}
+
+	if len(msgs) > 0 {
+		return fmt.Errorf("%s", strings.Join(msgs, "\n"))
+	}
+
+	return nil
+}
+
+// recordPreviousDefaultVersions records, for every recipe whose default version changes, the default version it
+// replaces so that the recipe can be rolled back to it. The previously recorded version is kept otherwise.
+func recordPreviousDefaultVersions(newResource *datamodel.Environment, old *datamodel.Environment) {
+	for resourceType, recipes := range newResource.Properties.Recipes {
+		for name, recipe := range recipes {
+			recipe.PreviousDefaultVersion = ""
+			if old != nil {
+				if existing, ok := old.Properties.Recipes[resourceType][name]; ok {
+					if existing.DefaultVersion != recipe.DefaultVersion {
+						recipe.PreviousDefaultVersion = existing.DefaultVersion
+					} else {
+						recipe.PreviousDefaultVersion = existing.PreviousDefaultVersion
+					}
+				}
+			}
+			recipes[name] = recipe
+		}
+	}
+}
+
+// recipeChanged returns true if the recipe is new or if it is different from the recipe of the existing environment.
+func recipeChanged(old *datamodel.Environment, resourceType string, name string, recipe datamodel.EnvironmentRecipeProperties) bool {
+	if old == nil {
+		return true
+	}
+
+	existing, ok := old.Properties.Recipes[resourceType][name]
+	return !ok || !reflect.DeepEqual(existing, recipe)
+}
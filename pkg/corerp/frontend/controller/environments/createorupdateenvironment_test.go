@@ -19,14 +19,20 @@ package environments
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/rpctest"
 	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/engine"
 	"github.com/radius-project/radius/pkg/ucp/store"
 
 	"github.com/golang/mock/gomock"
@@ -39,6 +45,11 @@ func TestCreateOrUpdateEnvironmentRun_20231001Preview(t *testing.T) {
 	defer mctrl.Finish()
 
 	mStorageClient := store.NewMockStorageClient(mctrl)
+	mEngine := engine.NewMockEngine(mctrl)
+	mEngine.EXPECT().
+		GetRecipeMetadata(gomock.Any(), gomock.Any()).
+		Return(map[string]any{"parameters": map[string]any{"throughput": map[string]any{"type": "int"}}}, nil).
+		AnyTimes()
 	ctx := context.Background()
 
 	createNewResourceCases := []struct {
@@ -101,7 +112,7 @@ func TestCreateOrUpdateEnvironmentRun_20231001Preview(t *testing.T) {
 				StorageClient: mStorageClient,
 			}
 
-			ctl, err := NewCreateOrUpdateEnvironment(opts)
+			ctl, err := NewCreateOrUpdateEnvironment(opts, mEngine)
 			require.NoError(t, err)
 			resp, err := ctl.Run(ctx, w, req)
 			require.NoError(t, err)
@@ -178,7 +189,7 @@ func TestCreateOrUpdateEnvironmentRun_20231001Preview(t *testing.T) {
 				StorageClient: mStorageClient,
 			}
 
-			ctl, err := NewCreateOrUpdateEnvironment(opts)
+			ctl, err := NewCreateOrUpdateEnvironment(opts, mEngine)
 			require.NoError(t, err)
 			resp, err := ctl.Run(ctx, w, req)
 			require.NoError(t, err)
@@ -241,7 +252,7 @@ func TestCreateOrUpdateEnvironmentRun_20231001Preview(t *testing.T) {
 				StorageClient: mStorageClient,
 			}
 
-			ctl, err := NewCreateOrUpdateEnvironment(opts)
+			ctl, err := NewCreateOrUpdateEnvironment(opts, mEngine)
 			require.NoError(t, err)
 			resp, err := ctl.Run(ctx, w, req)
 			require.NoError(t, err)
@@ -310,7 +321,7 @@ func TestCreateOrUpdateEnvironmentRun_20231001Preview(t *testing.T) {
 				StorageClient: mStorageClient,
 			}
 
-			ctl, err := NewCreateOrUpdateEnvironment(opts)
+			ctl, err := NewCreateOrUpdateEnvironment(opts, mEngine)
 			require.NoError(t, err)
 			resp, err := ctl.Run(ctx, w, req)
 			require.NoError(t, err)
@@ -399,7 +410,7 @@ func TestCreateOrUpdateEnvironmentRun_20231001Preview(t *testing.T) {
 				StorageClient: mStorageClient,
 			}
 
-			ctl, err := NewCreateOrUpdateEnvironment(opts)
+			ctl, err := NewCreateOrUpdateEnvironment(opts, mEngine)
 			require.NoError(t, err)
 			resp, err := ctl.Run(ctx, w, req)
 			require.NoError(t, err)
@@ -409,3 +420,167 @@ func TestCreateOrUpdateEnvironmentRun_20231001Preview(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateOrUpdateEnvironmentRun_InvalidRecipeParameters(t *testing.T) {
+	tests := []struct {
+		desc               string
+		recipeMetadata     map[string]any
+		metadataErr        error
+		metadataTimeout    bool
+		expectedStatusCode int
+		expectedMessage    string
+	}{
+		{
+			desc:               "undeclared-parameter",
+			recipeMetadata:     map[string]any{"parameters": map[string]any{"location": map[string]any{"type": "string"}}},
+			expectedStatusCode: 400,
+			expectedMessage:    `invalid parameters for recipe "mongo-azure" of resource type "Applications.Datastores/mongoDatabases": parameter "throughput" is not declared by the recipe`,
+		},
+		{
+			desc:               "mistyped-parameter",
+			recipeMetadata:     map[string]any{"parameters": map[string]any{"throughput": map[string]any{"type": "string"}}},
+			expectedStatusCode: 400,
+			expectedMessage:    `invalid parameters for recipe "mongo-azure" of resource type "Applications.Datastores/mongoDatabases": parameter "throughput" must be of type string, got float64`,
+		},
+		{
+			desc:               "out-of-range-parameter",
+			recipeMetadata:     map[string]any{"parameters": map[string]any{"throughput": map[string]any{"type": "int", "maxValue": float64(100)}}},
+			expectedStatusCode: 400,
+			expectedMessage:    `invalid parameters for recipe "mongo-azure" of resource type "Applications.Datastores/mongoDatabases": parameter "throughput" must be less than or equal to 100, got 400`,
+		},
+		{
+			desc:               "metadata-unavailable",
+			metadataErr:        errors.New("registry unavailable"),
+			expectedStatusCode: 200,
+		},
+		{
+			desc:               "metadata-timeout",
+			metadataTimeout:    true,
+			expectedStatusCode: 200,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			mctrl := gomock.NewController(t)
+			mStorageClient := store.NewMockStorageClient(mctrl)
+			mEngine := engine.NewMockEngine(mctrl)
+
+			envInput, envDataModel, _ := getTestModels20231001preview()
+			w := httptest.NewRecorder()
+			req, err := rpctest.NewHTTPRequestFromJSON(context.Background(), http.MethodGet, testHeaderfile, envInput)
+			require.NoError(t, err)
+			ctx := rpctest.NewARMRequestContext(req)
+
+			mStorageClient.
+				EXPECT().
+				Get(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
+					return nil, &store.ErrNotFound{ID: id}
+				})
+
+			if tt.metadataTimeout {
+				mEngine.EXPECT().
+					GetRecipeMetadata(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, _ recipes.EnvironmentDefinition) (map[string]any, error) {
+						<-ctx.Done()
+						return nil, ctx.Err()
+					}).
+					Times(1)
+			} else {
+				mEngine.EXPECT().
+					GetRecipeMetadata(gomock.Any(), gomock.Any()).
+					Return(tt.recipeMetadata, tt.metadataErr).
+					Times(1)
+			}
+
+			if tt.expectedStatusCode == 200 {
+				mStorageClient.
+					EXPECT().
+					Query(gomock.Any(), gomock.Any()).
+					Return(&store.ObjectQueryResult{Items: []store.Object{}}, nil)
+				mStorageClient.
+					EXPECT().
+					Save(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, obj *store.Object, opts ...store.SaveOptions) error {
+						obj.Data = envDataModel
+						return nil
+					})
+			}
+
+			ctl, err := NewCreateOrUpdateEnvironment(ctrl.Options{StorageClient: mStorageClient}, mEngine)
+			require.NoError(t, err)
+			if tt.metadataTimeout {
+				ctl.(*CreateOrUpdateEnvironment).validationTimeout = 10 * time.Millisecond
+			}
+			resp, err := ctl.Run(ctx, w, req)
+			require.NoError(t, err)
+			_ = resp.Apply(ctx, w, req)
+			require.Equal(t, tt.expectedStatusCode, w.Result().StatusCode)
+
+			if tt.expectedMessage != "" {
+				actualOutput := v1.ErrorResponse{}
+				_ = json.Unmarshal(w.Body.Bytes(), &actualOutput)
+				require.Equal(t, tt.expectedMessage, actualOutput.Error.Message)
+			}
+		})
+	}
+}
+
+func TestRecordPreviousDefaultVersions(t *testing.T) {
+	const resourceType = "Applications.Datastores/mongoDatabases"
+	newEnvironment := func(defaultVersion string, previousDefaultVersion string) *datamodel.Environment {
+		return &datamodel.Environment{
+			Properties: datamodel.EnvironmentProperties{
+				Recipes: map[string]map[string]datamodel.EnvironmentRecipeProperties{
+					resourceType: {
+						"default": {
+							TemplateKind:           recipes.TemplateKindBicep,
+							TemplatePath:           "ghcr.io/radius-project/recipes/mongo:1.0",
+							DefaultVersion:         defaultVersion,
+							PreviousDefaultVersion: previousDefaultVersion,
+						},
+					},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		desc     string
+		new      *datamodel.Environment
+		old      *datamodel.Environment
+		expected string
+	}{
+		{
+			desc:     "new-environment",
+			new:      newEnvironment("v1", "ignored"),
+			expected: "",
+		},
+		{
+			desc:     "default-version-changed",
+			new:      newEnvironment("v2", ""),
+			old:      newEnvironment("v1", "v0"),
+			expected: "v1",
+		},
+		{
+			desc:     "default-version-unchanged",
+			new:      newEnvironment("v1", ""),
+			old:      newEnvironment("v1", "v0"),
+			expected: "v0",
+		},
+		{
+			desc:     "new-recipe",
+			new:      newEnvironment("v1", ""),
+			old:      &datamodel.Environment{},
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			recordPreviousDefaultVersions(tt.new, tt.old)
+			require.Equal(t, tt.expected, tt.new.Properties.Recipes[resourceType]["default"].PreviousDefaultVersion)
+		})
+	}
+}
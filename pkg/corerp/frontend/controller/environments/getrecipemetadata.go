@@ -82,6 +82,12 @@ func (r *GetRecipeMetadata) Run(ctx context.Context, w http.ResponseWriter, req
 		return rest.NewNotFoundMessageResponse(fmt.Sprintf("Either recipe with name %q or resource type %q not found on environment with id %q", recipeDatamodel.Name, recipeDatamodel.ResourceType, serviceCtx.ResourceID)), nil
 	}
 
+	// The metadata describes the template of the default version of the recipe, which is used by resources that don't pin a version.
+	recipeProperties, err = recipeProperties.ForVersion("")
+	if err != nil {
+		return rest.NewNotFoundMessageResponse(fmt.Sprintf("The default version of recipe with name %q and resource type %q not found on environment with id %q", recipeDatamodel.Name, recipeDatamodel.ResourceType, serviceCtx.ResourceID)), nil
+	}
+
 	recipeParams, err := r.GetRecipeMetadataFromRegistry(ctx, recipeProperties, recipeDatamodel)
 	if err != nil {
 		return nil, err
@@ -101,7 +107,14 @@ func (r *GetRecipeMetadata) Run(ctx context.Context, w http.ResponseWriter, req
 	return rest.NewOKResponse(versioned), nil
 }
 
+// GetRecipeMetadataFromRegistry retrieves the parameters declared by the template of the recipe from its registry.
 func (r *GetRecipeMetadata) GetRecipeMetadataFromRegistry(ctx context.Context, recipeProperties datamodel.EnvironmentRecipeProperties, recipeDataModel *datamodel.Recipe) (recipeParameters map[string]any, err error) {
+	return getRecipeParameters(ctx, r.Engine, recipeProperties, recipeDataModel)
+}
+
+// getRecipeParameters retrieves the parameters declared by the template of the recipe using the recipe engine. The
+// recipe context parameter is omitted.
+func getRecipeParameters(ctx context.Context, eng engine.Engine, recipeProperties datamodel.EnvironmentRecipeProperties, recipeDataModel *datamodel.Recipe) (map[string]any, error) {
 	recipeDefinition := recipes.EnvironmentDefinition{
 		Name:            recipeDataModel.Name,
 		Driver:          recipeProperties.TemplateKind,
@@ -112,8 +125,8 @@ func (r *GetRecipeMetadata) GetRecipeMetadataFromRegistry(ctx context.Context, r
 		PlainHTTP:       recipeProperties.PlainHTTP,
 	}
 
-	recipeParameters = make(map[string]any)
-	recipeData, err := r.Engine.GetRecipeMetadata(ctx, recipeDefinition)
+	recipeParameters := make(map[string]any)
+	recipeData, err := eng.GetRecipeMetadata(ctx, recipeDefinition)
 	if err != nil {
 		return recipeParameters, err
 	}
@@ -153,7 +166,10 @@ func parseAndFormatRecipeParams(recipeData map[string]any, recipeParameters map[
 			sort.Sort(sort.Reverse(sort.StringSlice(keys)))
 			for _, paramDetailName := range keys {
 				if paramDetailName == "metadata" {
-					// skip metadata for now as it is a nested object.
+					// metadata is a nested object, only its description is returned.
+					if metadata, ok := paramDetails[paramDetailName].(map[string]any); ok && metadata["description"] != nil {
+						details["description"] = metadata["description"]
+					}
 					continue
 				}
 
@@ -281,6 +281,7 @@ func TestParseAndFormatRecipeParams(t *testing.T) {
 			"location": map[string]any{
 				"type":         "string",
 				"defaultValue": "[resourceGroup().location]",
+				"description":  "Location for all resources.",
 			},
 		}
 
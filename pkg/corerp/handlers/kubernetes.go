@@ -42,11 +42,15 @@ import (
 	k8s "k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 )
 
 const (
 	// DefaultCacheResyncInterval is the interval for resyncing informer.
 	DefaultCacheResyncInterval = time.Second * time.Duration(30)
+
+	// GatewayAddressKey is the key of the property holding the first address of a programmed Gateway API Gateway.
+	GatewayAddressKey = "gatewayaddress"
 )
 
 // Create an interface for deployment waiter and http proxy waiter
@@ -63,6 +67,7 @@ func NewKubernetesHandler(client client.Client, clientSet k8s.Interface, discove
 		k8sDiscoveryClient: discoveryClient,
 		httpProxyWaiter:    NewHTTPProxyWaiter(dynamicClientSet),
 		deploymentWaiter:   NewDeploymentWaiter(clientSet),
+		gatewayAPIWaiter:   NewGatewayAPIWaiter(dynamicClientSet),
 	}
 }
 
@@ -72,6 +77,7 @@ type kubernetesHandler struct {
 	k8sDiscoveryClient discovery.ServerResourcesInterface
 	httpProxyWaiter    ResourceWaiter
 	deploymentWaiter   ResourceWaiter
+	gatewayAPIWaiter   ResourceWaiter
 }
 
 // Put stores the Kubernetes resource in the cluster and returns the properties of the resource. If the resource is a
@@ -137,12 +143,55 @@ func (handler *kubernetesHandler) Put(ctx context.Context, options *PutOptions)
 		}
 		logger.Info(fmt.Sprintf("HTTP Proxy %s in namespace %s is ready", item.GetName(), item.GetNamespace()))
 		return properties, nil
+	case "gateway", "httproute", "tlsroute":
+		if groupVersion.Group != gatewayv1beta1.GroupName {
+			return properties, nil
+		}
+
+		err = handler.gatewayAPIWaiter.waitUntilReady(ctx, &item)
+		if err != nil {
+			return nil, err
+		}
+		logger.Info(fmt.Sprintf("%s %s in namespace %s is ready", item.GetKind(), item.GetName(), item.GetNamespace()))
+
+		// The address of the Gateway is assigned by the implementation once the Gateway is programmed.
+		if strings.EqualFold(item.GetKind(), "gateway") {
+			address, err := handler.getGatewayAddress(ctx, &item)
+			if err != nil {
+				return nil, err
+			}
+			if address != "" {
+				properties[GatewayAddressKey] = address
+			}
+		}
+		return properties, nil
 	default:
 		// We do not monitor the other resource types.
 		return properties, nil
 	}
 }
 
+// getGatewayAddress returns the first address in the status of the Gateway API Gateway, or an empty string if the
+// Gateway has no address.
+func (handler *kubernetesHandler) getGatewayAddress(ctx context.Context, item *unstructured.Unstructured) (string, error) {
+	current := &unstructured.Unstructured{}
+	current.SetGroupVersionKind(item.GroupVersionKind())
+	err := handler.client.Get(ctx, client.ObjectKeyFromObject(item), current)
+	if err != nil {
+		return "", err
+	}
+
+	status := gatewayv1beta1.GatewayStatus{}
+	if err := fromUnstructuredStatus(current, &status); err != nil {
+		return "", err
+	}
+
+	if len(status.Addresses) == 0 {
+		return "", nil
+	}
+	return status.Addresses[0].Value, nil
+}
+
 // Delete decodes the identity data from the DeleteOptions, creates an unstructured object from the identity data,
 // and then attempts to delete the object from the Kubernetes cluster, returning an error if one occurs.
 func (handler *kubernetesHandler) Delete(ctx context.Context, options *DeleteOptions) error {
@@ -0,0 +1,264 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+const (
+	MaxGatewayAPIDeploymentTimeout = time.Minute * time.Duration(10)
+)
+
+type gatewayAPIWaiter struct {
+	dynamicClientSet         dynamic.Interface
+	gatewayDeploymentTimeout time.Duration
+	cacheResyncInterval      time.Duration
+}
+
+// NewGatewayAPIWaiter returns a new instance of the waiter for the Kubernetes Gateway API resources (Gateways,
+// HTTPRoutes and TLSRoutes).
+func NewGatewayAPIWaiter(dynamicClientSet dynamic.Interface) *gatewayAPIWaiter {
+	return &gatewayAPIWaiter{
+		dynamicClientSet:         dynamicClientSet,
+		gatewayDeploymentTimeout: MaxGatewayAPIDeploymentTimeout,
+		cacheResyncInterval:      DefaultCacheResyncInterval,
+	}
+}
+
+func (handler *gatewayAPIWaiter) addDynamicEventHandler(ctx context.Context, informerFactory dynamicinformer.DynamicSharedInformerFactory, informer cache.SharedIndexInformer, item client.Object, doneCh chan<- error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	_, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj any) {
+			handler.checkStatus(ctx, obj, item, doneCh)
+		},
+		UpdateFunc: func(_, newObj any) {
+			handler.checkStatus(ctx, newObj, item, doneCh)
+		},
+	})
+
+	if err != nil {
+		logger.Error(err, "failed to add event handler")
+	}
+}
+
+// addEventHandler is not implemented for gatewayAPIWaiter
+func (handler *gatewayAPIWaiter) addEventHandler(ctx context.Context, informerFactory informers.SharedInformerFactory, informer cache.SharedIndexInformer, item client.Object, doneCh chan<- error) {
+}
+
+func (handler *gatewayAPIWaiter) waitUntilReady(ctx context.Context, obj client.Object) error {
+	logger := ucplog.FromContextOrDiscard(ctx).WithValues("kind", obj.GetObjectKind().GroupVersionKind().Kind, "name", obj.GetName(), "namespace", obj.GetNamespace())
+
+	gvr, err := gatewayAPIResource(obj)
+	if err != nil {
+		return err
+	}
+
+	doneCh := make(chan error, 1)
+
+	ctx, cancel := context.WithTimeout(ctx, handler.gatewayDeploymentTimeout)
+	// This ensures that the informer is stopped when this function is returned.
+	defer cancel()
+
+	dynamicInformerFactory := dynamicinformer.NewFilteredDynamicSharedInformerFactory(handler.dynamicClientSet, 0, obj.GetNamespace(), nil)
+	informer := dynamicInformerFactory.ForResource(gvr)
+	handler.addDynamicEventHandler(ctx, dynamicInformerFactory, informer.Informer(), obj, doneCh)
+
+	// Start the informers
+	dynamicInformerFactory.Start(ctx.Done())
+
+	// Wait for the cache to be synced.
+	dynamicInformerFactory.WaitForCacheSync(ctx.Done())
+
+	select {
+	case <-ctx.Done():
+		// Get the final status
+		current, err := informer.Lister().ByNamespace(obj.GetNamespace()).Get(obj.GetName())
+		if err != nil {
+			return fmt.Errorf("%s deployment timed out, name: %s, namespace %s, error occurred while fetching latest status: %w", gvr.Resource, obj.GetName(), obj.GetNamespace(), err)
+		}
+
+		conditions, err := gatewayAPIConditions(current.(*unstructured.Unstructured))
+		if err != nil {
+			return fmt.Errorf("%s deployment timed out, name: %s, namespace %s, error occurred while fetching latest status: %w", gvr.Resource, obj.GetName(), obj.GetNamespace(), err)
+		}
+
+		status := metav1.Condition{}
+		if len(conditions) > 0 {
+			status = conditions[len(conditions)-1]
+		}
+		return fmt.Errorf("%s deployment timed out, name: %s, namespace %s, status: %s, reason: %s", gvr.Resource, obj.GetName(), obj.GetNamespace(), status.Message, status.Reason)
+	case err := <-doneCh:
+		if err == nil {
+			logger.Info(fmt.Sprintf("Marking %s %s in namespace %s as complete", gvr.Resource, obj.GetName(), obj.GetNamespace()))
+		}
+		return err
+	}
+}
+
+// checkStatus reports on doneCh whether the watched Gateway API resource is ready or failed. A Gateway is ready once
+// it is programmed, and a route is ready once all of its parent gateways accepted it.
+func (handler *gatewayAPIWaiter) checkStatus(ctx context.Context, obj any, item client.Object, doneCh chan<- error) {
+	logger := ucplog.FromContextOrDiscard(ctx).WithValues("name", item.GetName(), "namespace", item.GetNamespace())
+
+	current, ok := obj.(*unstructured.Unstructured)
+	if !ok || current.GetName() != item.GetName() || current.GetNamespace() != item.GetNamespace() {
+		return
+	}
+
+	ready, err := isGatewayAPIResourceReady(current)
+	if err != nil {
+		notify(doneCh, err)
+		return
+	}
+
+	if ready {
+		logger.Info(fmt.Sprintf("%s %s is ready", current.GetKind(), current.GetName()))
+		notify(doneCh, nil)
+	}
+}
+
+// isGatewayAPIResourceReady returns true when the Gateway API resource is ready, and an error when the controller of
+// the gateway rejected it.
+func isGatewayAPIResourceReady(obj *unstructured.Unstructured) (bool, error) {
+	if strings.EqualFold(obj.GetKind(), "Gateway") {
+		status := gatewayv1beta1.GatewayStatus{}
+		if err := fromUnstructuredStatus(obj, &status); err != nil {
+			return false, err
+		}
+
+		for _, c := range status.Conditions {
+			if c.ObservedGeneration != obj.GetGeneration() {
+				continue
+			}
+			if c.Type == string(gatewayv1beta1.GatewayConditionAccepted) && c.Status == metav1.ConditionFalse {
+				return false, fmt.Errorf("Failed to deploy Gateway %s. Reason: %s, Message: %s", obj.GetName(), c.Reason, c.Message)
+			}
+		}
+
+		programmed := findCondition(status.Conditions, string(gatewayv1beta1.GatewayConditionProgrammed))
+		return programmed != nil && programmed.ObservedGeneration == obj.GetGeneration() && programmed.Status == metav1.ConditionTrue, nil
+	}
+
+	status := gatewayv1beta1.RouteStatus{}
+	if err := fromUnstructuredStatus(obj, &status); err != nil {
+		return false, err
+	}
+
+	if len(status.Parents) == 0 {
+		return false, nil
+	}
+
+	for _, parent := range status.Parents {
+		for _, c := range parent.Conditions {
+			if c.ObservedGeneration != obj.GetGeneration() {
+				continue
+			}
+			if (c.Type == string(gatewayv1beta1.RouteConditionAccepted) || c.Type == string(gatewayv1beta1.RouteConditionResolvedRefs)) && c.Status == metav1.ConditionFalse {
+				return false, fmt.Errorf("Failed to deploy %s %s. Reason: %s, Message: %s", obj.GetKind(), obj.GetName(), c.Reason, c.Message)
+			}
+		}
+
+		accepted := findCondition(parent.Conditions, string(gatewayv1beta1.RouteConditionAccepted))
+		if accepted == nil || accepted.ObservedGeneration != obj.GetGeneration() || accepted.Status != metav1.ConditionTrue {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// gatewayAPIConditions returns the status conditions of the Gateway API resource. The conditions of a route are the
+// conditions of all of its parents.
+func gatewayAPIConditions(obj *unstructured.Unstructured) ([]metav1.Condition, error) {
+	if strings.EqualFold(obj.GetKind(), "Gateway") {
+		status := gatewayv1beta1.GatewayStatus{}
+		if err := fromUnstructuredStatus(obj, &status); err != nil {
+			return nil, err
+		}
+		return status.Conditions, nil
+	}
+
+	status := gatewayv1beta1.RouteStatus{}
+	if err := fromUnstructuredStatus(obj, &status); err != nil {
+		return nil, err
+	}
+
+	conditions := []metav1.Condition{}
+	for _, parent := range status.Parents {
+		conditions = append(conditions, parent.Conditions...)
+	}
+	return conditions, nil
+}
+
+// gatewayAPIResource returns the GroupVersionResource of the Gateway API object.
+func gatewayAPIResource(obj client.Object) (schema.GroupVersionResource, error) {
+	gvk := obj.GetObjectKind().GroupVersionKind()
+	switch strings.ToLower(gvk.Kind) {
+	case "gateway":
+		return gvk.GroupVersion().WithResource("gateways"), nil
+	case "httproute":
+		return gvk.GroupVersion().WithResource("httproutes"), nil
+	case "tlsroute":
+		return gvk.GroupVersion().WithResource("tlsroutes"), nil
+	default:
+		return schema.GroupVersionResource{}, fmt.Errorf("unsupported Gateway API kind %q", gvk.Kind)
+	}
+}
+
+func fromUnstructuredStatus(obj *unstructured.Unstructured, status any) error {
+	raw, found, err := unstructured.NestedMap(obj.Object, "status")
+	if err != nil || !found {
+		return err
+	}
+	return runtime.DefaultUnstructuredConverter.FromUnstructured(raw, status)
+}
+
+func findCondition(conditions []metav1.Condition, conditionType string) *metav1.Condition {
+	for i := range conditions {
+		if conditions[i].Type == conditionType {
+			return &conditions[i]
+		}
+	}
+	return nil
+}
+
+// notify sends the result to doneCh without blocking, since the informer can report several events after the first
+// result was received.
+func notify(doneCh chan<- error, err error) {
+	select {
+	case doneCh <- err:
+	default:
+	}
+}
@@ -0,0 +1,219 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	fakedynamic "k8s.io/client-go/dynamic/fake"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+func toUnstructured(t *testing.T, obj runtime.Object) *unstructured.Unstructured {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	require.NoError(t, err)
+	return &unstructured.Unstructured{Object: content}
+}
+
+// newFakeGatewayAPIClient creates a fake dynamic client holding the given Gateway API objects. The objects are created
+// through the client since the fake object tracker can't guess the resource name of the Gateway kind.
+func newFakeGatewayAPIClient(t *testing.T, objs ...*unstructured.Unstructured) *fakedynamic.FakeDynamicClient {
+	fakeClient := fakedynamic.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		gatewayv1beta1.SchemeGroupVersion.WithResource("gateways"):   "GatewayList",
+		gatewayv1beta1.SchemeGroupVersion.WithResource("httproutes"): "HTTPRouteList",
+	})
+
+	for _, obj := range objs {
+		gvr, err := gatewayAPIResource(obj)
+		require.NoError(t, err)
+		_, err = fakeClient.Resource(gvr).Namespace(obj.GetNamespace()).Create(context.Background(), obj, metav1.CreateOptions{})
+		require.NoError(t, err)
+	}
+
+	return fakeClient
+}
+
+func makeTestGateway(conditions ...metav1.Condition) *gatewayv1beta1.Gateway {
+	return &gatewayv1beta1.Gateway{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Gateway",
+			APIVersion: gatewayv1beta1.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:  "default",
+			Name:       "test-gateway",
+			Generation: 2,
+		},
+		Status: gatewayv1beta1.GatewayStatus{
+			Conditions: conditions,
+		},
+	}
+}
+
+func makeTestHTTPRoute(parents ...gatewayv1beta1.RouteParentStatus) *gatewayv1beta1.HTTPRoute {
+	return &gatewayv1beta1.HTTPRoute{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "HTTPRoute",
+			APIVersion: gatewayv1beta1.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:  "default",
+			Name:       "test-gateway-frontend",
+			Generation: 1,
+		},
+		Status: gatewayv1beta1.HTTPRouteStatus{
+			RouteStatus: gatewayv1beta1.RouteStatus{
+				Parents: parents,
+			},
+		},
+	}
+}
+
+func makeTestRouteParent(conditions ...metav1.Condition) gatewayv1beta1.RouteParentStatus {
+	return gatewayv1beta1.RouteParentStatus{
+		ParentRef:      gatewayv1beta1.ParentReference{Name: "test-gateway"},
+		ControllerName: "example.com/gateway-controller",
+		Conditions:     conditions,
+	}
+}
+
+func Test_IsGatewayAPIResourceReady(t *testing.T) {
+	tests := []struct {
+		name  string
+		obj   runtime.Object
+		ready bool
+		err   string
+	}{
+		{
+			name: "gateway without status",
+			obj:  makeTestGateway(),
+		},
+		{
+			name: "gateway programmed",
+			obj: makeTestGateway(
+				metav1.Condition{Type: string(gatewayv1beta1.GatewayConditionAccepted), Status: metav1.ConditionTrue, ObservedGeneration: 2},
+				metav1.Condition{Type: string(gatewayv1beta1.GatewayConditionProgrammed), Status: metav1.ConditionTrue, ObservedGeneration: 2},
+			),
+			ready: true,
+		},
+		{
+			name: "gateway programmed for a previous generation",
+			obj: makeTestGateway(
+				metav1.Condition{Type: string(gatewayv1beta1.GatewayConditionProgrammed), Status: metav1.ConditionTrue, ObservedGeneration: 1},
+			),
+		},
+		{
+			name: "gateway not accepted",
+			obj: makeTestGateway(
+				metav1.Condition{Type: string(gatewayv1beta1.GatewayConditionAccepted), Status: metav1.ConditionFalse, ObservedGeneration: 2, Reason: "InvalidParameters", Message: "unknown GatewayClass"},
+			),
+			err: "Failed to deploy Gateway test-gateway. Reason: InvalidParameters, Message: unknown GatewayClass",
+		},
+		{
+			name: "route without parents",
+			obj:  makeTestHTTPRoute(),
+		},
+		{
+			name: "route accepted",
+			obj: makeTestHTTPRoute(makeTestRouteParent(
+				metav1.Condition{Type: string(gatewayv1beta1.RouteConditionAccepted), Status: metav1.ConditionTrue, ObservedGeneration: 1},
+				metav1.Condition{Type: string(gatewayv1beta1.RouteConditionResolvedRefs), Status: metav1.ConditionTrue, ObservedGeneration: 1},
+			)),
+			ready: true,
+		},
+		{
+			name: "route with unresolved backend",
+			obj: makeTestHTTPRoute(makeTestRouteParent(
+				metav1.Condition{Type: string(gatewayv1beta1.RouteConditionAccepted), Status: metav1.ConditionTrue, ObservedGeneration: 1},
+				metav1.Condition{Type: string(gatewayv1beta1.RouteConditionResolvedRefs), Status: metav1.ConditionFalse, ObservedGeneration: 1, Reason: "BackendNotFound", Message: "service frontend not found"},
+			)),
+			err: "Failed to deploy HTTPRoute test-gateway-frontend. Reason: BackendNotFound, Message: service frontend not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ready, err := isGatewayAPIResourceReady(toUnstructured(t, tt.obj))
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.ready, ready)
+		})
+	}
+}
+
+func Test_GatewayAPIWaiter_CheckStatus_IgnoresOtherObjects(t *testing.T) {
+	gateway := toUnstructured(t, makeTestGateway(
+		metav1.Condition{Type: string(gatewayv1beta1.GatewayConditionProgrammed), Status: metav1.ConditionTrue, ObservedGeneration: 2},
+	))
+	other := gateway.DeepCopy()
+	other.SetName("other-gateway")
+
+	doneCh := make(chan error, 1)
+	waiter := &gatewayAPIWaiter{}
+	waiter.checkStatus(context.Background(), gateway, other, doneCh)
+	require.Len(t, doneCh, 0)
+
+	waiter.checkStatus(context.Background(), gateway, gateway, doneCh)
+	// A second notification must not block once the result is pending.
+	waiter.checkStatus(context.Background(), gateway, gateway, doneCh)
+	require.NoError(t, <-doneCh)
+}
+
+func Test_GatewayAPIWaiter_WaitUntilReady(t *testing.T) {
+	gateway := toUnstructured(t, makeTestGateway(
+		metav1.Condition{Type: string(gatewayv1beta1.GatewayConditionProgrammed), Status: metav1.ConditionTrue, ObservedGeneration: 2},
+	))
+	route := toUnstructured(t, makeTestHTTPRoute(makeTestRouteParent(
+		metav1.Condition{Type: string(gatewayv1beta1.RouteConditionAccepted), Status: metav1.ConditionFalse, ObservedGeneration: 1, Reason: "NotAllowedByListeners", Message: "no matching listener"},
+	)))
+
+	fakeClient := newFakeGatewayAPIClient(t, gateway, route)
+
+	waiter := NewGatewayAPIWaiter(fakeClient)
+	waiter.gatewayDeploymentTimeout = time.Second * 10
+
+	err := waiter.waitUntilReady(context.Background(), gateway)
+	require.NoError(t, err)
+
+	err = waiter.waitUntilReady(context.Background(), route)
+	require.EqualError(t, err, "Failed to deploy HTTPRoute test-gateway-frontend. Reason: NotAllowedByListeners, Message: no matching listener")
+}
+
+func Test_GatewayAPIWaiter_WaitUntilReady_Timeout(t *testing.T) {
+	gateway := toUnstructured(t, makeTestGateway(
+		metav1.Condition{Type: string(gatewayv1beta1.GatewayConditionProgrammed), Status: metav1.ConditionFalse, ObservedGeneration: 2, Reason: "AddressNotAssigned", Message: "no addresses available"},
+	))
+
+	fakeClient := newFakeGatewayAPIClient(t, gateway)
+
+	waiter := NewGatewayAPIWaiter(fakeClient)
+	waiter.gatewayDeploymentTimeout = time.Second
+
+	err := waiter.waitUntilReady(context.Background(), gateway)
+	require.EqualError(t, err, "gateways deployment timed out, name: test-gateway, namespace default, status: no addresses available, reason: AddressNotAssigned")
+}
@@ -33,6 +33,9 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/fake"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 )
 
 func TestPut(t *testing.T) {
@@ -268,3 +271,95 @@ func TestConvertToUnstructured(t *testing.T) {
 		})
 	}
 }
+
+// programmingGatewayWaiter is a ResourceWaiter acting as the Gateway API implementation: it assigns the address to
+// the Gateway it waits for.
+type programmingGatewayWaiter struct {
+	ResourceWaiter
+	client  client.Client
+	address string
+}
+
+func (w *programmingGatewayWaiter) waitUntilReady(ctx context.Context, obj client.Object) error {
+	gateway := &unstructured.Unstructured{}
+	gateway.SetGroupVersionKind(obj.GetObjectKind().GroupVersionKind())
+	if err := w.client.Get(ctx, client.ObjectKeyFromObject(obj), gateway); err != nil {
+		return err
+	}
+
+	if w.address != "" {
+		addresses := []any{map[string]any{"type": string(gatewayv1beta1.IPAddressType), "value": w.address}}
+		if err := unstructured.SetNestedSlice(gateway.Object, addresses, "status", "addresses"); err != nil {
+			return err
+		}
+	}
+	return w.client.Update(ctx, gateway)
+}
+
+func TestPut_Gateway(t *testing.T) {
+	tests := []struct {
+		name    string
+		address string
+		out     map[string]string
+	}{
+		{
+			name:    "gateway with address",
+			address: "10.0.0.1",
+			out: map[string]string{
+				"kubernetesapiversion": gatewayv1beta1.GroupVersion.String(),
+				"kuberneteskind":       "Gateway",
+				"kubernetesnamespace":  "test-namespace",
+				"resourcename":         "test-gateway",
+				GatewayAddressKey:      "10.0.0.1",
+			},
+		},
+		{
+			name: "gateway without address",
+			out: map[string]string{
+				"kubernetesapiversion": gatewayv1beta1.GroupVersion.String(),
+				"kuberneteskind":       "Gateway",
+				"kubernetesnamespace":  "test-namespace",
+				"resourcename":         "test-gateway",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			require.NoError(t, clientgoscheme.AddToScheme(scheme))
+			require.NoError(t, gatewayv1beta1.AddToScheme(scheme))
+			kubeClient := k8sutil.NewFakeKubeClient(scheme)
+
+			handler := kubernetesHandler{
+				client:           kubeClient,
+				gatewayAPIWaiter: &programmingGatewayWaiter{client: kubeClient, address: tc.address},
+			}
+
+			gateway := &gatewayv1beta1.Gateway{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "Gateway",
+					APIVersion: gatewayv1beta1.GroupVersion.String(),
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-gateway",
+					Namespace: "test-namespace",
+				},
+			}
+
+			props, err := handler.Put(context.Background(), &PutOptions{
+				Resource: &rpv1.OutputResource{
+					CreateResource: &rpv1.Resource{
+						ResourceType: resourcemodel.ResourceType{
+							Provider: resourcemodel.ProviderKubernetes,
+							Type:     "gateway.networking.k8s.io/Gateway",
+						},
+						Data: gateway,
+					},
+				},
+			})
+			require.NoError(t, err)
+			require.Equal(t, tc.out, props)
+		})
+	}
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscale
+
+import (
+	"context"
+	"fmt"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/renderers"
+	"github.com/radius-project/radius/pkg/kubernetes"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Renderer is the renderers.Renderer implementation for the autoscale extension.
+type Renderer struct {
+	Inner renderers.Renderer
+}
+
+// GetDependencyIDs gets the IDs of the dependencies of the given resource.
+func (r *Renderer) GetDependencyIDs(ctx context.Context, resource v1.DataModelInterface) ([]resources.ID, []resources.ID, error) {
+	// Let the inner renderer do its work
+	return r.Inner.GetDependencyIDs(ctx, resource)
+}
+
+// Render checks if the DataModelInterface is a ContainerResource and if so, checks for an AutoScaling
+// extension and renders a HorizontalPodAutoscaler scaling the deployment of the container.
+func (r *Renderer) Render(ctx context.Context, dm v1.DataModelInterface, options renderers.RenderOptions) (renderers.RendererOutput, error) {
+	// Let the inner renderer do its work
+	output, err := r.Inner.Render(ctx, dm, options)
+	if err != nil {
+		return renderers.RendererOutput{}, err
+	}
+
+	resource, ok := dm.(*datamodel.ContainerResource)
+	if !ok {
+		return renderers.RendererOutput{}, v1.ErrInvalidModelConversion
+	}
+
+	ext := datamodel.FindExtension(resource.Properties.Extensions, datamodel.AutoScaling)
+	if ext == nil || ext.AutoScaling == nil {
+		return output, nil
+	}
+
+	deployment, _ := kubernetes.FindDeployment(output.Resources)
+	if deployment == nil {
+		// Nothing to scale, for example when the resources of the container are provisioned manually.
+		return output, nil
+	}
+
+	appId, err := resources.ParseResource(resource.Properties.Application)
+	if err != nil {
+		return renderers.RendererOutput{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid application id: %s. id: %s", err.Error(), resource.Properties.Application))
+	}
+
+	hpa, err := makeHorizontalPodAutoscaler(deployment, appId.Name(), resource, ext.AutoScaling)
+	if err != nil {
+		return renderers.RendererOutput{}, err
+	}
+
+	// The replica count is owned by the HorizontalPodAutoscaler.
+	deployment.Spec.Replicas = nil
+
+	hpaOutput := rpv1.NewKubernetesOutputResource(rpv1.LocalIDHorizontalPodAutoscaler, hpa, hpa.ObjectMeta)
+	hpaOutput.CreateResource.Dependencies = []string{rpv1.LocalIDDeployment}
+	output.Resources = append(output.Resources, hpaOutput)
+
+	return output, nil
+}
+
+// makeHorizontalPodAutoscaler creates the HorizontalPodAutoscaler scaling the given deployment.
+func makeHorizontalPodAutoscaler(deployment *appsv1.Deployment, applicationName string, resource *datamodel.ContainerResource, ext *datamodel.AutoScalingExtension) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	metrics := []autoscalingv2.MetricSpec{}
+	for _, m := range ext.Metrics {
+		metric, err := makeMetricSpec(m)
+		if err != nil {
+			return nil, v1.NewClientErrInvalidRequest(err.Error())
+		}
+		metrics = append(metrics, metric)
+	}
+
+	hpa := &autoscalingv2.HorizontalPodAutoscaler{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "HorizontalPodAutoscaler",
+			APIVersion: "autoscaling/v2",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      deployment.Name,
+			Namespace: deployment.Namespace,
+			Labels:    kubernetes.MakeDescriptiveLabels(applicationName, resource.Name, resource.ResourceTypeName()),
+		},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+				APIVersion: "apps/v1",
+				Kind:       "Deployment",
+				Name:       deployment.Name,
+			},
+			MinReplicas: ext.MinReplicas,
+			MaxReplicas: ext.MaxReplicas,
+		},
+	}
+
+	// Kubernetes defaults to an average CPU utilization of 80% when no metric is specified.
+	if len(metrics) > 0 {
+		hpa.Spec.Metrics = metrics
+	}
+
+	return hpa, nil
+}
+
+func makeMetricSpec(m datamodel.AutoScalingMetric) (autoscalingv2.MetricSpec, error) {
+	target := autoscalingv2.MetricTarget{}
+	if m.TargetAverageUtilization != nil {
+		target.Type = autoscalingv2.UtilizationMetricType
+		target.AverageUtilization = m.TargetAverageUtilization
+	} else {
+		value, err := resource.ParseQuantity(m.TargetAverageValue)
+		if err != nil {
+			return autoscalingv2.MetricSpec{}, fmt.Errorf("the target average value %q of the %s metric is invalid: %w", m.TargetAverageValue, m.Kind, err)
+		}
+		target.Type = autoscalingv2.AverageValueMetricType
+		target.AverageValue = &value
+	}
+
+	switch m.Kind {
+	case datamodel.AutoScalingMetricCPU, datamodel.AutoScalingMetricMemory:
+		name := corev1.ResourceCPU
+		if m.Kind == datamodel.AutoScalingMetricMemory {
+			name = corev1.ResourceMemory
+		}
+		return autoscalingv2.MetricSpec{
+			Type: autoscalingv2.ResourceMetricSourceType,
+			Resource: &autoscalingv2.ResourceMetricSource{
+				Name:   name,
+				Target: target,
+			},
+		}, nil
+	case datamodel.AutoScalingMetricCustom:
+		if target.Type != autoscalingv2.AverageValueMetricType {
+			return autoscalingv2.MetricSpec{}, fmt.Errorf("the custom metric %q must specify a target average value", m.Name)
+		}
+		return autoscalingv2.MetricSpec{
+			Type: autoscalingv2.PodsMetricSourceType,
+			Pods: &autoscalingv2.PodsMetricSource{
+				Metric: autoscalingv2.MetricIdentifier{Name: m.Name},
+				Target: target,
+			},
+		}, nil
+	default:
+		return autoscalingv2.MetricSpec{}, fmt.Errorf("the metric kind %q is not supported", m.Kind)
+	}
+}
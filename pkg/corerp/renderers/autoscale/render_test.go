@@ -0,0 +1,223 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscale
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/renderers"
+	"github.com/radius-project/radius/pkg/kubernetes"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	k8sresource "k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/stretchr/testify/require"
+)
+
+var _ renderers.Renderer = (*noop)(nil)
+
+type noop struct {
+}
+
+func (r *noop) GetDependencyIDs(ctx context.Context, resource v1.DataModelInterface) ([]resources.ID, []resources.ID, error) {
+	return nil, nil, nil
+}
+
+func (r *noop) Render(ctx context.Context, dm v1.DataModelInterface, options renderers.RenderOptions) (renderers.RendererOutput, error) {
+	// Return a deployment so the autoscale extension can scale it
+	deployment := appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-deployment",
+			Namespace: "test-namespace",
+		},
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Deployment",
+			APIVersion: "apps/v1",
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: to.Ptr[int32](1),
+		},
+	}
+	resources := []rpv1.OutputResource{rpv1.NewKubernetesOutputResource(rpv1.LocalIDDeployment, &deployment, deployment.ObjectMeta)}
+	return renderers.RendererOutput{Resources: resources}, nil
+}
+
+func Test_Render_Success(t *testing.T) {
+	renderer := &Renderer{Inner: &noop{}}
+
+	properties := makeProperties(t, &datamodel.AutoScalingExtension{
+		MinReplicas: to.Ptr[int32](2),
+		MaxReplicas: 10,
+		Metrics: []datamodel.AutoScalingMetric{
+			{Kind: datamodel.AutoScalingMetricCPU, TargetAverageUtilization: to.Ptr[int32](70)},
+			{Kind: datamodel.AutoScalingMetricMemory, TargetAverageValue: "512Mi"},
+			{Kind: datamodel.AutoScalingMetricCustom, Name: "requests_per_second", TargetAverageValue: "100"},
+		},
+	})
+	resource := makeResource(t, properties)
+	dependencies := map[string]renderers.RendererDependency{}
+
+	output, err := renderer.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: dependencies})
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 2)
+
+	deployment, _ := kubernetes.FindDeployment(output.Resources)
+	require.NotNil(t, deployment)
+	require.Nil(t, deployment.Spec.Replicas)
+
+	hpaOutput := output.Resources[1]
+	require.Equal(t, rpv1.LocalIDHorizontalPodAutoscaler, hpaOutput.LocalID)
+	require.Equal(t, []string{rpv1.LocalIDDeployment}, hpaOutput.CreateResource.Dependencies)
+
+	hpa, ok := hpaOutput.CreateResource.Data.(*autoscalingv2.HorizontalPodAutoscaler)
+	require.True(t, ok)
+	require.Equal(t, "test-deployment", hpa.Name)
+	require.Equal(t, "test-namespace", hpa.Namespace)
+	require.Equal(t, kubernetes.MakeDescriptiveLabels("test-app", "test-container", datamodel.ContainerResourceType), hpa.Labels)
+
+	memory := k8sresource.MustParse("512Mi")
+	rps := k8sresource.MustParse("100")
+	expected := autoscalingv2.HorizontalPodAutoscalerSpec{
+		ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+			APIVersion: "apps/v1",
+			Kind:       "Deployment",
+			Name:       "test-deployment",
+		},
+		MinReplicas: to.Ptr[int32](2),
+		MaxReplicas: 10,
+		Metrics: []autoscalingv2.MetricSpec{
+			{
+				Type: autoscalingv2.ResourceMetricSourceType,
+				Resource: &autoscalingv2.ResourceMetricSource{
+					Name:   corev1.ResourceCPU,
+					Target: autoscalingv2.MetricTarget{Type: autoscalingv2.UtilizationMetricType, AverageUtilization: to.Ptr[int32](70)},
+				},
+			},
+			{
+				Type: autoscalingv2.ResourceMetricSourceType,
+				Resource: &autoscalingv2.ResourceMetricSource{
+					Name:   corev1.ResourceMemory,
+					Target: autoscalingv2.MetricTarget{Type: autoscalingv2.AverageValueMetricType, AverageValue: &memory},
+				},
+			},
+			{
+				Type: autoscalingv2.PodsMetricSourceType,
+				Pods: &autoscalingv2.PodsMetricSource{
+					Metric: autoscalingv2.MetricIdentifier{Name: "requests_per_second"},
+					Target: autoscalingv2.MetricTarget{Type: autoscalingv2.AverageValueMetricType, AverageValue: &rps},
+				},
+			},
+		},
+	}
+	require.Equal(t, expected, hpa.Spec)
+}
+
+func Test_Render_DefaultMetrics(t *testing.T) {
+	renderer := &Renderer{Inner: &noop{}}
+
+	properties := makeProperties(t, &datamodel.AutoScalingExtension{MaxReplicas: 5})
+	resource := makeResource(t, properties)
+
+	output, err := renderer.Render(context.Background(), resource, renderers.RenderOptions{})
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 2)
+
+	hpa := output.Resources[1].CreateResource.Data.(*autoscalingv2.HorizontalPodAutoscaler)
+	require.Nil(t, hpa.Spec.MinReplicas)
+	require.Equal(t, int32(5), hpa.Spec.MaxReplicas)
+	require.Nil(t, hpa.Spec.Metrics)
+}
+
+func Test_Render_InvalidMetric(t *testing.T) {
+	renderer := &Renderer{Inner: &noop{}}
+
+	properties := makeProperties(t, &datamodel.AutoScalingExtension{
+		MaxReplicas: 5,
+		Metrics: []datamodel.AutoScalingMetric{
+			{Kind: datamodel.AutoScalingMetricCustom, Name: "queue_length", TargetAverageUtilization: to.Ptr[int32](50)},
+		},
+	})
+	resource := makeResource(t, properties)
+
+	_, err := renderer.Render(context.Background(), resource, renderers.RenderOptions{})
+	require.Error(t, err)
+	require.Equal(t, v1.CodeInvalid, err.(*v1.ErrClientRP).Code)
+	require.Equal(t, "the custom metric \"queue_length\" must specify a target average value", err.(*v1.ErrClientRP).Message)
+}
+
+func Test_Render_NoExtension(t *testing.T) {
+	renderer := &Renderer{Inner: &noop{}}
+
+	properties := datamodel.ContainerProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-app",
+		},
+		Container: datamodel.Container{
+			Image: "someimage:latest",
+		},
+	}
+
+	resource := makeResource(t, properties)
+	dependencies := map[string]renderers.RendererDependency{}
+
+	output, err := renderer.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: dependencies})
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 1)
+
+	deployment, _ := kubernetes.FindDeployment(output.Resources)
+	require.NotNil(t, deployment)
+
+	require.Equal(t, int32(1), *deployment.Spec.Replicas)
+}
+
+func makeResource(t *testing.T, properties datamodel.ContainerProperties) *datamodel.ContainerResource {
+	resource := datamodel.ContainerResource{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:   "/subscriptions/test-sub-id/resourceGroups/test-group/providers/Applications.Core/containers/test-container",
+				Name: "test-container",
+				Type: "Applications.Core/containers",
+			},
+		},
+		Properties: properties,
+	}
+	return &resource
+}
+
+func makeProperties(t *testing.T, ext *datamodel.AutoScalingExtension) datamodel.ContainerProperties {
+	properties := datamodel.ContainerProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-app",
+		},
+		Container: datamodel.Container{
+			Image: "someimage:latest",
+		},
+		Extensions: []datamodel.Extension{{
+			Kind:        datamodel.AutoScaling,
+			AutoScaling: ext,
+		}},
+	}
+	return properties
+}
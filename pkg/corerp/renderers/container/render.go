@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"net"
 	"net/url"
 	"sort"
@@ -27,6 +28,7 @@ import (
 	"strings"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -44,6 +46,7 @@ import (
 	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	resources_azure "github.com/radius-project/radius/pkg/ucp/resources/azure"
+	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
 	resources_radius "github.com/radius-project/radius/pkg/ucp/resources/radius"
 )
 
@@ -131,19 +134,41 @@ func (r Renderer) GetDependencyIDs(ctx context.Context, dm v1.DataModelInterface
 		}
 	}
 
-	for _, volume := range properties.Container.Volumes {
-		switch volume.Kind {
-		case datamodel.Persistent:
-			resourceID, err := resources.ParseResource(volume.Persistent.Source)
-			if err != nil {
-				return nil, nil, v1.NewClientErrInvalidRequest(err.Error())
-			}
+	secretSources := []string{}
+	for _, container := range properties.AllContainers() {
+		for _, volume := range container.Volumes {
+			switch volume.Kind {
+			case datamodel.Persistent:
+				resourceID, err := resources.ParseResource(volume.Persistent.Source)
+				if err != nil {
+					return nil, nil, v1.NewClientErrInvalidRequest(err.Error())
+				}
 
-			if resources_radius.IsRadiusResource(resourceID) {
-				radiusResourceIDs = append(radiusResourceIDs, resourceID)
-				continue
+				if resources_radius.IsRadiusResource(resourceID) {
+					radiusResourceIDs = append(radiusResourceIDs, resourceID)
+					continue
+				}
+			case datamodel.Secret:
+				secretSources = append(secretSources, volume.Secret.Source)
 			}
 		}
+
+		for _, ref := range container.SecretEnv {
+			secretSources = append(secretSources, ref.Source)
+		}
+	}
+
+	// Secrets are referenced either by the resource ID of a secretStore resource or by the name of a Kubernetes secret.
+	for _, source := range secretSources {
+		if !strings.Contains(source, "/") {
+			continue
+		}
+
+		resourceID, err := resources.ParseResource(source)
+		if err != nil {
+			return nil, nil, v1.NewClientErrInvalidRequest(err.Error())
+		}
+		radiusResourceIDs = append(radiusResourceIDs, resourceID)
 	}
 
 	return radiusResourceIDs, azureResourceIDs, nil
@@ -197,21 +222,23 @@ func (r Renderer) Render(ctx context.Context, dm v1.DataModelInterface, options
 		}
 	}
 
-	for portName, port := range properties.Container.Ports {
-		// if the container has an exposed port, note that down.
-		// A single service will be generated for a container with one or more exposed ports.
-		if port.ContainerPort == 0 {
-			return renderers.RendererOutput{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid ports definition: must define a ContainerPort, but ContainerPort is: %d.", port.ContainerPort))
-		}
+	for _, container := range exposedContainers(properties) {
+		for portName, port := range container.Ports {
+			// if the container has an exposed port, note that down.
+			// A single service will be generated for a container with one or more exposed ports.
+			if port.ContainerPort == 0 {
+				return renderers.RendererOutput{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid ports definition: must define a ContainerPort, but ContainerPort is: %d.", port.ContainerPort))
+			}
 
-		if port.Port == 0 {
-			port.Port = port.ContainerPort
-			properties.Container.Ports[portName] = port
-		}
+			if port.Port == 0 {
+				port.Port = port.ContainerPort
+				container.Ports[portName] = port
+			}
 
-		// if the container has an exposed port, but no 'provides' field, it requires DNS service generation.
-		if port.Provides == "" {
-			needsServiceGeneration = true
+			// if the container has an exposed port, but no 'provides' field, it requires DNS service generation.
+			if port.Provides == "" {
+				needsServiceGeneration = true
+			}
 		}
 	}
 
@@ -261,15 +288,17 @@ func (r Renderer) Render(ctx context.Context, dm v1.DataModelInterface, options
 
 	// If the container has an exposed port and uses DNS-SD, generate a service for it.
 	if needsServiceGeneration {
-		for portName, port := range resource.Properties.Container.Ports {
-			// store portNames and portValues for use in service generation.
-			servicePort := corev1.ServicePort{
-				Name:       portName,
-				Port:       port.Port,
-				TargetPort: intstr.FromInt(int(port.ContainerPort)),
-				Protocol:   corev1.ProtocolTCP,
+		for _, container := range exposedContainers(resource.Properties) {
+			for portName, port := range container.Ports {
+				// store portNames and portValues for use in service generation.
+				servicePort := corev1.ServicePort{
+					Name:       portName,
+					Port:       port.Port,
+					TargetPort: intstr.FromInt(int(port.ContainerPort)),
+					Protocol:   corev1.ProtocolTCP,
+				}
+				servicePorts = append(servicePorts, servicePort)
 			}
-			servicePorts = append(servicePorts, servicePort)
 		}
 
 		// if a container has an exposed port, then we need to create a service for it.
@@ -290,6 +319,15 @@ func (r Renderer) Render(ctx context.Context, dm v1.DataModelInterface, options
 	}, nil
 }
 
+// exposedContainers returns the definitions of the main container and the sidecars, whose ports are exposed by the service.
+func exposedContainers(properties datamodel.ContainerProperties) []datamodel.Container {
+	containers := []datamodel.Container{properties.Container}
+	for _, c := range properties.Sidecars {
+		containers = append(containers, c.Container)
+	}
+	return containers
+}
+
 func (r Renderer) makeService(base *corev1.Service, resource *datamodel.ContainerResource, options renderers.RenderOptions, ctx context.Context, servicePorts []corev1.ServicePort) (rpv1.OutputResource, error) {
 	appId, err := resources.ParseResource(resource.Properties.Application)
 	if err != nil {
@@ -395,6 +433,18 @@ func (r Renderer) makeDeployment(
 	}
 
 	var err error
+	if properties.Container.Resources != nil {
+		// Merge the requests and limits with the ones of the base manifest so that unspecified resources are preserved.
+		container.Resources.Requests, err = makeResourceList(container.Resources.Requests, properties.Container.Resources.Requests)
+		if err != nil {
+			return []rpv1.OutputResource{}, nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid resource requests: %s", err.Error()))
+		}
+		container.Resources.Limits, err = makeResourceList(container.Resources.Limits, properties.Container.Resources.Limits)
+		if err != nil {
+			return []rpv1.OutputResource{}, nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid resource limits: %s", err.Error()))
+		}
+	}
+
 	if !properties.Container.ReadinessProbe.IsEmpty() {
 		container.ReadinessProbe, err = r.makeHealthProbe(properties.Container.ReadinessProbe)
 		if err != nil {
@@ -416,10 +466,20 @@ func (r Renderer) makeDeployment(
 		return []rpv1.OutputResource{}, nil, fmt.Errorf("failed to obtain environment variables and secret data: %w", err)
 	}
 
+	// The init containers and the sidecars only share the environment variables of the connections.
+	connectionEnv := maps.Clone(env)
+
 	for k, v := range properties.Container.Env {
 		env[k] = corev1.EnvVar{Name: k, Value: v}
 	}
 
+	for k, v := range properties.Container.SecretEnv {
+		env[k], err = makeSecretEnvVar(k, v, options)
+		if err != nil {
+			return []rpv1.OutputResource{}, nil, err
+		}
+	}
+
 	// Append in sorted order
 	for _, key := range getSortedKeys(env) {
 		container.Env = append(container.Env, env[key])
@@ -441,30 +501,30 @@ func (r Renderer) makeDeployment(
 	// To avoid the naming conflicts, we add the application name prefix to resource name.
 	azIdentityName := azrenderer.MakeResourceName(applicationName, resource.Name, azrenderer.Separator)
 
-	for volumeName, volumeProperties := range properties.Container.Volumes {
+	// addVolume adds the volume to the pod and returns the mount of the volume for the container declaring it.
+	addVolume := func(volumeName string, volumeProperties datamodel.VolumeProperties) (corev1.VolumeMount, error) {
 		// Based on the kind, create a persistent/ephemeral volume
 		switch volumeProperties.Kind {
 		case datamodel.Ephemeral:
 			volumeSpec, volumeMountSpec, err := makeEphemeralVolume(volumeName, volumeProperties.Ephemeral)
 			if err != nil {
-				return []rpv1.OutputResource{}, nil, fmt.Errorf("unable to create ephemeral volume spec for volume: %s - %w", volumeName, err)
+				return corev1.VolumeMount{}, fmt.Errorf("unable to create ephemeral volume spec for volume: %s - %w", volumeName, err)
 			}
-			// Add the volume mount to the Container spec
-			container.VolumeMounts = append(container.VolumeMounts, volumeMountSpec)
 			// Add the volume to the list of volumes to be added to the Volumes spec
 			volumes = append(volumes, volumeSpec)
+			return volumeMountSpec, nil
 		case datamodel.Persistent:
 			var volumeSpec corev1.Volume
 			var volumeMountSpec corev1.VolumeMount
 
 			properties, ok := dependencies[volumeProperties.Persistent.Source]
 			if !ok {
-				return []rpv1.OutputResource{}, nil, errors.New("volume dependency resource not found")
+				return corev1.VolumeMount{}, errors.New("volume dependency resource not found")
 			}
 
 			vol, ok := properties.Resource.(*datamodel.VolumeResource)
 			if !ok {
-				return []rpv1.OutputResource{}, nil, errors.New("invalid dependency resource")
+				return corev1.VolumeMount{}, errors.New("invalid dependency resource")
 			}
 
 			switch vol.Properties.Kind {
@@ -491,13 +551,13 @@ func (r Renderer) makeDeployment(
 				// csiobjectspec must be generated when volume is updated.
 				objectSpec, err := handlers.GetMapValue[string](properties.ComputedValues, azvolrenderer.SPCVolumeObjectSpecKey)
 				if err != nil {
-					return []rpv1.OutputResource{}, nil, err
+					return corev1.VolumeMount{}, err
 				}
 
 				spcName := kubernetes.NormalizeResourceName(vol.Name)
 				secretProvider, err := azrenderer.MakeKeyVaultSecretProviderClass(applicationName, spcName, vol, objectSpec, &options.Environment)
 				if err != nil {
-					return []rpv1.OutputResource{}, nil, err
+					return corev1.VolumeMount{}, err
 				}
 				outputResources = append(outputResources, *secretProvider)
 				deps = append(deps, rpv1.LocalIDSecretProviderClass)
@@ -505,14 +565,12 @@ func (r Renderer) makeDeployment(
 				// Create volume spec which associated with secretProviderClass.
 				volumeSpec, volumeMountSpec, err = azrenderer.MakeKeyVaultVolumeSpec(volumeName, volumeProperties.Persistent.MountPath, spcName)
 				if err != nil {
-					return []rpv1.OutputResource{}, nil, fmt.Errorf("unable to create secretstore volume spec for volume: %s - %w", volumeName, err)
+					return corev1.VolumeMount{}, fmt.Errorf("unable to create secretstore volume spec for volume: %s - %w", volumeName, err)
 				}
 			default:
-				return []rpv1.OutputResource{}, nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("Unsupported volume kind: %s for volume: %s. Supported kinds are: %v", vol.Properties.Kind, volumeName, GetSupportedKinds()))
+				return corev1.VolumeMount{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("Unsupported volume kind: %s for volume: %s. Supported kinds are: %v", vol.Properties.Kind, volumeName, GetSupportedKinds()))
 			}
 
-			// Add the volume mount to the Container spec
-			container.VolumeMounts = append(container.VolumeMounts, volumeMountSpec)
 			// Add the volume to the list of volumes to be added to the Volumes spec
 			volumes = append(volumes, volumeSpec)
 
@@ -528,9 +586,47 @@ func (r Renderer) makeDeployment(
 				}
 				secretData[key] = []byte(value.(string))
 			}
+			return volumeMountSpec, nil
+		case datamodel.Secret:
+			secretName, err := getSecretName(options, volumeProperties.Secret.Source, "")
+			if err != nil {
+				return corev1.VolumeMount{}, err
+			}
+			volumeSpec, volumeMountSpec := makeSecretVolume(volumeName, secretName, volumeProperties.Secret)
+			volumes = append(volumes, volumeSpec)
+			return volumeMountSpec, nil
 		default:
-			return []rpv1.OutputResource{}, secretData, v1.NewClientErrInvalidRequest(fmt.Sprintf("Only ephemeral or persistent volumes are supported. Got kind: %v", volumeProperties.Kind))
+			return corev1.VolumeMount{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("Only ephemeral, persistent or secret volumes are supported. Got kind: %v", volumeProperties.Kind))
+		}
+	}
+
+	// The volumes of the main container are mounted in the init containers and the sidecars too.
+	sharedVolumeMounts := []corev1.VolumeMount{}
+	for volumeName, volumeProperties := range properties.Container.Volumes {
+		volumeMountSpec, err := addVolume(volumeName, volumeProperties)
+		if err != nil {
+			return []rpv1.OutputResource{}, nil, err
+		}
+		// Add the volume mount to the Container spec
+		container.VolumeMounts = append(container.VolumeMounts, volumeMountSpec)
+		sharedVolumeMounts = append(sharedVolumeMounts, volumeMountSpec)
+	}
+
+	for _, c := range properties.InitContainers {
+		initContainer, err := r.makeSupportingContainer(c, options, connectionEnv, sharedVolumeMounts, addVolume)
+		if err != nil {
+			return []rpv1.OutputResource{}, nil, err
+		}
+		podSpec.InitContainers = append(podSpec.InitContainers, initContainer)
+	}
+
+	// Appending the sidecars may reallocate the containers of the pod, so the main container must not be modified afterwards.
+	for _, c := range properties.Sidecars {
+		sidecar, err := r.makeSupportingContainer(c, options, connectionEnv, sharedVolumeMounts, addVolume)
+		if err != nil {
+			return []rpv1.OutputResource{}, nil, err
 		}
+		podSpec.Containers = append(podSpec.Containers, sidecar)
 	}
 
 	// In addition to the descriptive labels, we need to attach labels for each route
@@ -744,6 +840,155 @@ func getEnvVarsAndSecretData(resource *datamodel.ContainerResource, applicationN
 	return env, secretData, nil
 }
 
+// makeSecretEnvVar creates the Kubernetes environment variable of a container whose value is read from a secret. It
+// is rendered as a reference to the key of the Kubernetes secret, so that the value is never stored in the deployment.
+func makeSecretEnvVar(name string, ref datamodel.SecretReference, options renderers.RenderOptions) (corev1.EnvVar, error) {
+	secretName, err := getSecretName(options, ref.Source, ref.Key)
+	if err != nil {
+		return corev1.EnvVar{}, err
+	}
+
+	return corev1.EnvVar{
+		Name: name,
+		ValueFrom: &corev1.EnvVarSource{
+			SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{
+					Name: secretName,
+				},
+				Key: ref.Key,
+			},
+		},
+	}, nil
+}
+
+// getSecretName returns the name of the Kubernetes secret referenced by source. The source is either the name of
+// a Kubernetes secret or the resource ID of a secretStore resource, in which case the secret of the secretStore
+// must be in the namespace of the container and, if key is set, must hold the key.
+func getSecretName(options renderers.RenderOptions, source string, key string) (string, error) {
+	if !strings.Contains(source, "/") {
+		return source, nil
+	}
+
+	dependency, ok := options.Dependencies[source]
+	if !ok {
+		return "", v1.NewClientErrInvalidRequest(fmt.Sprintf("secretStore resource %s not found", source))
+	}
+
+	secretStore, ok := dependency.Resource.(*datamodel.SecretStore)
+	if !ok {
+		return "", v1.NewClientErrInvalidRequest(fmt.Sprintf("%s must reference a secretStore resource", source))
+	}
+
+	if key != "" {
+		if _, ok := secretStore.Properties.Data[key]; !ok {
+			return "", v1.NewClientErrInvalidRequest(fmt.Sprintf("secretStore resource %s does not have key %s", source, key))
+		}
+	}
+
+	secretID, ok := dependency.OutputResources[rpv1.LocalIDSecret]
+	if !ok {
+		return "", v1.NewClientErrInvalidRequest(fmt.Sprintf("secretStore resource %s has no Kubernetes secret", source))
+	}
+
+	namespace := secretID.FindScope(resources_kubernetes.ScopeNamespaces)
+	if namespace != options.Environment.Namespace {
+		return "", v1.NewClientErrInvalidRequest(fmt.Sprintf("the Kubernetes secret of secretStore resource %s must be in namespace %s, got %s", source, options.Environment.Namespace, namespace))
+	}
+
+	return secretID.Name(), nil
+}
+
+// makeSupportingContainer creates the Kubernetes container of an init container or a sidecar. The container gets the
+// connection environment variables and the volume mounts of the main container, and addVolume is used to add its own volumes to the pod.
+func (r Renderer) makeSupportingContainer(c datamodel.SupportingContainer, options renderers.RenderOptions, connectionEnv map[string]corev1.EnvVar, sharedVolumeMounts []corev1.VolumeMount, addVolume func(string, datamodel.VolumeProperties) (corev1.VolumeMount, error)) (corev1.Container, error) {
+	container := corev1.Container{
+		Name:            c.Name,
+		Image:           c.Image,
+		ImagePullPolicy: corev1.PullPolicy(c.ImagePullPolicy),
+		Command:         c.Command,
+		Args:            c.Args,
+		WorkingDir:      c.WorkingDir,
+	}
+
+	for _, name := range getSortedKeys(c.Ports) {
+		container.Ports = append(container.Ports, corev1.ContainerPort{
+			ContainerPort: c.Ports[name].ContainerPort,
+			Protocol:      corev1.ProtocolTCP,
+		})
+	}
+
+	var err error
+	if c.Resources != nil {
+		container.Resources.Requests, err = makeResourceList(nil, c.Resources.Requests)
+		if err != nil {
+			return corev1.Container{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid resource requests of container %s: %s", c.Name, err.Error()))
+		}
+		container.Resources.Limits, err = makeResourceList(nil, c.Resources.Limits)
+		if err != nil {
+			return corev1.Container{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid resource limits of container %s: %s", c.Name, err.Error()))
+		}
+	}
+
+	if !c.ReadinessProbe.IsEmpty() {
+		container.ReadinessProbe, err = r.makeHealthProbe(c.ReadinessProbe)
+		if err != nil {
+			return corev1.Container{}, fmt.Errorf("readiness probe of container %s encountered errors: %w ", c.Name, err)
+		}
+	}
+	if !c.LivenessProbe.IsEmpty() {
+		container.LivenessProbe, err = r.makeHealthProbe(c.LivenessProbe)
+		if err != nil {
+			return corev1.Container{}, fmt.Errorf("liveness probe of container %s encountered errors: %w ", c.Name, err)
+		}
+	}
+
+	env := maps.Clone(connectionEnv)
+	for k, v := range c.Env {
+		env[k] = corev1.EnvVar{Name: k, Value: v}
+	}
+	for k, v := range c.SecretEnv {
+		env[k], err = makeSecretEnvVar(k, v, options)
+		if err != nil {
+			return corev1.Container{}, err
+		}
+	}
+	for _, key := range getSortedKeys(env) {
+		container.Env = append(container.Env, env[key])
+	}
+
+	container.VolumeMounts = append(container.VolumeMounts, sharedVolumeMounts...)
+	for _, volumeName := range getSortedKeys(c.Volumes) {
+		volumeMountSpec, err := addVolume(volumeName, c.Volumes[volumeName])
+		if err != nil {
+			return corev1.Container{}, err
+		}
+		container.VolumeMounts = append(container.VolumeMounts, volumeMountSpec)
+	}
+
+	return container, nil
+}
+
+// makeResourceList adds the CPU and memory quantities to the given resource list.
+func makeResourceList(list corev1.ResourceList, q datamodel.ContainerResourceQuantities) (corev1.ResourceList, error) {
+	for name, value := range map[corev1.ResourceName]string{corev1.ResourceCPU: q.CPU, corev1.ResourceMemory: q.Memory} {
+		if value == "" {
+			continue
+		}
+
+		quantity, err := resource.ParseQuantity(value)
+		if err != nil {
+			return nil, fmt.Errorf("%s quantity %q is invalid: %w", name, value, err)
+		}
+
+		if list == nil {
+			list = corev1.ResourceList{}
+		}
+		list[name] = quantity
+	}
+
+	return list, nil
+}
+
 func (r Renderer) makeHealthProbe(p datamodel.HealthProbeProperties) (*corev1.Probe, error) {
 	probeSpec := corev1.Probe{}
 
@@ -916,9 +1161,9 @@ func (r Renderer) makeRoleAssignmentsForResource(ctx context.Context, connection
 	return outputResources, nil
 }
 
-func getSortedKeys(env map[string]corev1.EnvVar) []string {
+func getSortedKeys[T any](m map[string]T) []string {
 	keys := []string{}
-	for k := range env {
+	for k := range m {
 		key := k
 		keys = append(keys, key)
 	}
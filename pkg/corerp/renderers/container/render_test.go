@@ -41,6 +41,7 @@ import (
 	"github.com/stretchr/testify/require"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	k8sresource "k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
@@ -1695,6 +1696,410 @@ func Test_Render_ImagePullPolicySpecified(t *testing.T) {
 	})
 }
 
+func Test_Render_Resources(t *testing.T) {
+	properties := datamodel.ContainerProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: applicationResourceID,
+		},
+		Container: datamodel.Container{
+			Image: "someimage:latest",
+			Resources: &datamodel.ContainerResources{
+				Requests: datamodel.ContainerResourceQuantities{CPU: "250m", Memory: "128Mi"},
+				Limits:   datamodel.ContainerResourceQuantities{Memory: "1Gi"},
+			},
+		},
+	}
+	resource := makeResource(t, properties)
+	dependencies := map[string]renderers.RendererDependency{}
+
+	ctx := testcontext.New(t)
+	renderer := Renderer{}
+	output, err := renderer.Render(ctx, resource, renderers.RenderOptions{Dependencies: dependencies})
+	require.NoError(t, err)
+
+	deployment, _ := kubernetes.FindDeployment(output.Resources)
+	require.NotNil(t, deployment)
+	require.Len(t, deployment.Spec.Template.Spec.Containers, 1)
+
+	expected := corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{
+			corev1.ResourceCPU:    k8sresource.MustParse("250m"),
+			corev1.ResourceMemory: k8sresource.MustParse("128Mi"),
+		},
+		Limits: corev1.ResourceList{
+			corev1.ResourceMemory: k8sresource.MustParse("1Gi"),
+		},
+	}
+	require.Equal(t, expected, deployment.Spec.Template.Spec.Containers[0].Resources)
+}
+
+func Test_Render_InvalidResources(t *testing.T) {
+	properties := datamodel.ContainerProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: applicationResourceID,
+		},
+		Container: datamodel.Container{
+			Image: "someimage:latest",
+			Resources: &datamodel.ContainerResources{
+				Limits: datamodel.ContainerResourceQuantities{CPU: "two"},
+			},
+		},
+	}
+	resource := makeResource(t, properties)
+
+	ctx := testcontext.New(t)
+	renderer := Renderer{}
+	_, err := renderer.Render(ctx, resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}})
+	require.Error(t, err)
+	require.Equal(t, apiv1.CodeInvalid, err.(*apiv1.ErrClientRP).Code)
+	require.Contains(t, err.(*apiv1.ErrClientRP).Message, "invalid resource limits: cpu quantity \"two\" is invalid")
+}
+
+func Test_Render_SupportingContainers(t *testing.T) {
+	containerConnectionHostname := "containerB"
+	properties := datamodel.ContainerProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: applicationResourceID,
+		},
+		Connections: map[string]datamodel.ConnectionProperties{
+			"containerB": {
+				Source: fmt.Sprintf("http://%s:80", containerConnectionHostname),
+			},
+		},
+		Container: datamodel.Container{
+			Image: "someimage:latest",
+			Env: map[string]string{
+				envVarName1: envVarValue1,
+			},
+			Ports: map[string]datamodel.ContainerPort{
+				"web": {ContainerPort: 5000},
+			},
+			Volumes: map[string]datamodel.VolumeProperties{
+				"logs": {
+					Kind: datamodel.Ephemeral,
+					Ephemeral: &datamodel.EphemeralVolume{
+						VolumeBase:   datamodel.VolumeBase{MountPath: "/var/log/app"},
+						ManagedStore: datamodel.ManagedStoreDisk,
+					},
+				},
+			},
+		},
+		InitContainers: []datamodel.SupportingContainer{
+			{
+				Name: "migrate",
+				Container: datamodel.Container{
+					Image:   "migrations:latest",
+					Command: []string{"/migrate"},
+				},
+			},
+		},
+		Sidecars: []datamodel.SupportingContainer{
+			{
+				Name: "proxy",
+				Container: datamodel.Container{
+					Image: "proxy:latest",
+					Env: map[string]string{
+						"UPSTREAM": "localhost:5000",
+					},
+					Ports: map[string]datamodel.ContainerPort{
+						"proxy": {ContainerPort: 8080, Port: 80},
+					},
+					Volumes: map[string]datamodel.VolumeProperties{
+						"cache": {
+							Kind: datamodel.Ephemeral,
+							Ephemeral: &datamodel.EphemeralVolume{
+								VolumeBase:   datamodel.VolumeBase{MountPath: "/cache"},
+								ManagedStore: datamodel.ManagedStoreMemory,
+							},
+						},
+					},
+					Resources: &datamodel.ContainerResources{
+						Limits: datamodel.ContainerResourceQuantities{Memory: "64Mi"},
+					},
+				},
+			},
+		},
+	}
+	resource := makeResource(t, properties)
+
+	ctx := testcontext.New(t)
+	renderer := Renderer{}
+	output, err := renderer.Render(ctx, resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}})
+	require.NoError(t, err)
+
+	connectionEnv := []corev1.EnvVar{
+		{Name: "CONNECTION_CONTAINERB_HOSTNAME", Value: containerConnectionHostname},
+		{Name: "CONNECTION_CONTAINERB_PORT", Value: "80"},
+		{Name: "CONNECTION_CONTAINERB_SCHEME", Value: "http"},
+	}
+	logsMount := corev1.VolumeMount{Name: "logs", MountPath: "/var/log/app"}
+
+	t.Run("verify deployment", func(t *testing.T) {
+		deployment, _ := kubernetes.FindDeployment(output.Resources)
+		require.NotNil(t, deployment)
+
+		podSpec := deployment.Spec.Template.Spec
+		require.Len(t, podSpec.Containers, 2)
+		require.Len(t, podSpec.InitContainers, 1)
+
+		container := podSpec.Containers[0]
+		require.Equal(t, resourceName, container.Name)
+		require.Equal(t, append(connectionEnv, corev1.EnvVar{Name: envVarName1, Value: envVarValue1}), container.Env)
+		require.Equal(t, []corev1.VolumeMount{logsMount}, container.VolumeMounts)
+
+		expectedInitContainer := corev1.Container{
+			Name:         "migrate",
+			Image:        "migrations:latest",
+			Command:      []string{"/migrate"},
+			Env:          connectionEnv,
+			VolumeMounts: []corev1.VolumeMount{logsMount},
+		}
+		require.Equal(t, expectedInitContainer, podSpec.InitContainers[0])
+
+		expectedSidecar := corev1.Container{
+			Name:  "proxy",
+			Image: "proxy:latest",
+			Ports: []corev1.ContainerPort{{ContainerPort: 8080, Protocol: corev1.ProtocolTCP}},
+			Env:   append(connectionEnv, corev1.EnvVar{Name: "UPSTREAM", Value: "localhost:5000"}),
+			Resources: corev1.ResourceRequirements{
+				Limits: corev1.ResourceList{corev1.ResourceMemory: k8sresource.MustParse("64Mi")},
+			},
+			VolumeMounts: []corev1.VolumeMount{logsMount, {Name: "cache", MountPath: "/cache"}},
+		}
+		require.Equal(t, expectedSidecar, podSpec.Containers[1])
+
+		expectedVolumes := []corev1.Volume{
+			{Name: "logs", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{Medium: corev1.StorageMediumDefault}}},
+			{Name: "cache", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{Medium: corev1.StorageMediumMemory}}},
+		}
+		require.Equal(t, expectedVolumes, podSpec.Volumes)
+	})
+
+	t.Run("verify service", func(t *testing.T) {
+		service, _ := kubernetes.FindService(output.Resources)
+		require.NotNil(t, service)
+
+		expectedPorts := []corev1.ServicePort{
+			{Name: "proxy", Port: 80, TargetPort: intstr.FromInt(8080), Protocol: corev1.ProtocolTCP},
+			{Name: "web", Port: 5000, TargetPort: intstr.FromInt(5000), Protocol: corev1.ProtocolTCP},
+		}
+		require.ElementsMatch(t, expectedPorts, service.Spec.Ports)
+	})
+}
+
+func makeSecretStoreDependency(t *testing.T, name string, namespace string, keys ...string) renderers.RendererDependency {
+	data := map[string]*datamodel.SecretStoreDataValue{}
+	for _, key := range keys {
+		data[key] = &datamodel.SecretStoreDataValue{Value: to.Ptr("secret-value")}
+	}
+
+	return renderers.RendererDependency{
+		ResourceID: makeRadiusResourceID(t, datamodel.SecretStoreResourceType, name),
+		Resource: &datamodel.SecretStore{
+			Properties: &datamodel.SecretStoreProperties{
+				Type: datamodel.SecretTypeGeneric,
+				Data: data,
+			},
+		},
+		OutputResources: map[string]resources.ID{
+			rpv1.LocalIDSecret: resources_kubernetes.IDFromParts(
+				resources_kubernetes.PlaneNameTODO,
+				"",
+				resources_kubernetes.KindSecret,
+				namespace,
+				name+"-secret"),
+		},
+	}
+}
+
+func Test_GetDependencyIDs_SecretReferences(t *testing.T) {
+	dbSecretsID := makeRadiusResourceID(t, datamodel.SecretStoreResourceType, "dbsecrets")
+	certsID := makeRadiusResourceID(t, datamodel.SecretStoreResourceType, "certs")
+	properties := datamodel.ContainerProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: applicationResourceID,
+		},
+		Container: datamodel.Container{
+			Image: "someimage:latest",
+			SecretEnv: map[string]datamodel.SecretReference{
+				"DB_PASSWORD": {Source: dbSecretsID.String(), Key: "password"},
+				"API_TOKEN":   {Source: "api-credentials", Key: "token"},
+			},
+		},
+		Sidecars: []datamodel.SupportingContainer{
+			{
+				Name: "proxy",
+				Container: datamodel.Container{
+					Image: "proxy:latest",
+					Volumes: map[string]datamodel.VolumeProperties{
+						"certs": {
+							Kind: datamodel.Secret,
+							Secret: &datamodel.SecretVolume{
+								VolumeBase: datamodel.VolumeBase{MountPath: "/etc/certs"},
+								Source:     certsID.String(),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	resource := makeResource(t, properties)
+
+	ctx := testcontext.New(t)
+	renderer := Renderer{}
+	radiusResourceIDs, azureResourceIDs, err := renderer.GetDependencyIDs(ctx, resource)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []resources.ID{dbSecretsID, certsID}, radiusResourceIDs)
+	require.Empty(t, azureResourceIDs)
+}
+
+func Test_Render_SecretReferences(t *testing.T) {
+	dbSecretsID := makeRadiusResourceID(t, datamodel.SecretStoreResourceType, "dbsecrets").String()
+	certsID := makeRadiusResourceID(t, datamodel.SecretStoreResourceType, "certs").String()
+	properties := datamodel.ContainerProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: applicationResourceID,
+		},
+		Container: datamodel.Container{
+			Image: "someimage:latest",
+			Env: map[string]string{
+				envVarName1: envVarValue1,
+			},
+			SecretEnv: map[string]datamodel.SecretReference{
+				"DB_PASSWORD": {Source: dbSecretsID, Key: "password"},
+				"API_TOKEN":   {Source: "api-credentials", Key: "token"},
+			},
+			Volumes: map[string]datamodel.VolumeProperties{
+				"certs": {
+					Kind: datamodel.Secret,
+					Secret: &datamodel.SecretVolume{
+						VolumeBase: datamodel.VolumeBase{MountPath: "/etc/certs"},
+						Source:     certsID,
+					},
+				},
+			},
+		},
+		Sidecars: []datamodel.SupportingContainer{
+			{
+				Name: "shipper",
+				Container: datamodel.Container{
+					Image: "shipper:latest",
+					SecretEnv: map[string]datamodel.SecretReference{
+						"SHIPPER_TOKEN": {Source: "shipper-credentials", Key: "token"},
+					},
+				},
+			},
+		},
+	}
+	resource := makeResource(t, properties)
+	dependencies := map[string]renderers.RendererDependency{
+		dbSecretsID: makeSecretStoreDependency(t, "dbsecrets", "default", "username", "password"),
+		certsID:     makeSecretStoreDependency(t, "certs", "default", "tls.crt", "tls.key"),
+	}
+
+	ctx := testcontext.New(t)
+	renderer := Renderer{}
+	output, err := renderer.Render(ctx, resource, renderers.RenderOptions{Dependencies: dependencies, Environment: renderers.EnvironmentOptions{Namespace: "default"}})
+	require.NoError(t, err)
+
+	secretKeyRef := func(name string, key string) *corev1.EnvVarSource {
+		return &corev1.EnvVarSource{
+			SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: name},
+				Key:                  key,
+			},
+		}
+	}
+
+	deployment, _ := kubernetes.FindDeployment(output.Resources)
+	require.NotNil(t, deployment)
+	podSpec := deployment.Spec.Template.Spec
+	require.Len(t, podSpec.Containers, 2)
+
+	expectedEnv := []corev1.EnvVar{
+		{Name: "API_TOKEN", ValueFrom: secretKeyRef("api-credentials", "token")},
+		{Name: "DB_PASSWORD", ValueFrom: secretKeyRef("dbsecrets-secret", "password")},
+		{Name: envVarName1, Value: envVarValue1},
+	}
+	require.Equal(t, expectedEnv, podSpec.Containers[0].Env)
+	require.Equal(t, []corev1.EnvVar{{Name: "SHIPPER_TOKEN", ValueFrom: secretKeyRef("shipper-credentials", "token")}}, podSpec.Containers[1].Env)
+
+	certsMount := corev1.VolumeMount{Name: "certs", MountPath: "/etc/certs", ReadOnly: true}
+	require.Equal(t, []corev1.VolumeMount{certsMount}, podSpec.Containers[0].VolumeMounts)
+	require.Equal(t, []corev1.VolumeMount{certsMount}, podSpec.Containers[1].VolumeMounts)
+
+	expectedVolumes := []corev1.Volume{
+		{Name: "certs", VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "certs-secret"}}},
+	}
+	require.Equal(t, expectedVolumes, podSpec.Volumes)
+
+	// The values of the secrets must not be copied to the secret of the container.
+	secret, _ := kubernetes.FindSecret(output.Resources)
+	require.Nil(t, secret)
+}
+
+func Test_Render_InvalidSecretReferences(t *testing.T) {
+	dbSecretsID := makeRadiusResourceID(t, datamodel.SecretStoreResourceType, "dbsecrets").String()
+	tests := []struct {
+		name         string
+		secretEnv    map[string]datamodel.SecretReference
+		dependencies map[string]renderers.RendererDependency
+		message      string
+	}{
+		{
+			name: "secretStore not found",
+			secretEnv: map[string]datamodel.SecretReference{
+				"DB_PASSWORD": {Source: dbSecretsID, Key: "password"},
+			},
+			dependencies: map[string]renderers.RendererDependency{},
+			message:      fmt.Sprintf("secretStore resource %s not found", dbSecretsID),
+		},
+		{
+			name: "missing key",
+			secretEnv: map[string]datamodel.SecretReference{
+				"DB_PASSWORD": {Source: dbSecretsID, Key: "password"},
+			},
+			dependencies: map[string]renderers.RendererDependency{
+				dbSecretsID: makeSecretStoreDependency(t, "dbsecrets", "default", "username"),
+			},
+			message: fmt.Sprintf("secretStore resource %s does not have key password", dbSecretsID),
+		},
+		{
+			name: "secret in another namespace",
+			secretEnv: map[string]datamodel.SecretReference{
+				"DB_PASSWORD": {Source: dbSecretsID, Key: "password"},
+			},
+			dependencies: map[string]renderers.RendererDependency{
+				dbSecretsID: makeSecretStoreDependency(t, "dbsecrets", "other", "password"),
+			},
+			message: fmt.Sprintf("the Kubernetes secret of secretStore resource %s must be in namespace default, got other", dbSecretsID),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			properties := datamodel.ContainerProperties{
+				BasicResourceProperties: rpv1.BasicResourceProperties{
+					Application: applicationResourceID,
+				},
+				Container: datamodel.Container{
+					Image:     "someimage:latest",
+					SecretEnv: tc.secretEnv,
+				},
+			}
+			resource := makeResource(t, properties)
+
+			ctx := testcontext.New(t)
+			renderer := Renderer{}
+			_, err := renderer.Render(ctx, resource, renderers.RenderOptions{Dependencies: tc.dependencies, Environment: renderers.EnvironmentOptions{Namespace: "default"}})
+			require.Error(t, err)
+			require.Equal(t, apiv1.CodeInvalid, err.(*apiv1.ErrClientRP).Code)
+			require.Equal(t, tc.message, err.(*apiv1.ErrClientRP).Message)
+		})
+	}
+}
+
 func Test_Render_StrategicPatchMerge(t *testing.T) {
 	const containerPatchObject = `
 {
@@ -41,3 +41,23 @@ func makeEphemeralVolume(volumeName string, volume *datamodel.EphemeralVolume) (
 
 	return volumeSpec, volumeMountSpec, nil
 }
+
+// makeSecretVolume creates the volume spec for a secret volume, which holds each key of the secret as a file.
+func makeSecretVolume(volumeName string, secretName string, volume *datamodel.SecretVolume) (corev1.Volume, corev1.VolumeMount) {
+	volumeSpec := corev1.Volume{
+		Name: volumeName,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName: secretName,
+			},
+		},
+	}
+
+	volumeMountSpec := corev1.VolumeMount{
+		Name:      volumeName,
+		MountPath: volume.MountPath,
+		ReadOnly:  true,
+	}
+
+	return volumeSpec, volumeMountSpec
+}
@@ -0,0 +1,412 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/renderers"
+	"github.com/radius-project/radius/pkg/kubernetes"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+)
+
+const (
+	// MinimumProtocolVersionOption is the TLS option of the Gateway listeners holding the minimum TLS version of the
+	// gateway. The Gateway API has no field for it, so it is only honored by the implementations supporting this option.
+	MinimumProtocolVersionOption = "radapp.io/minimum-protocol-version"
+
+	httpListenerName  = "http"
+	httpsListenerName = "https"
+	tlsListenerName   = "tls"
+)
+
+// MakeGatewayAPIResources validates the Gateway resource and its dependencies, and creates the Kubernetes Gateway API
+// objects exposing it: a Gateway, an HTTPRoute for each destination (a TLSRoute when sslPassthrough is enabled) and a
+// ReferenceGrant when the certificate is stored in another namespace.
+func MakeGatewayAPIResources(ctx context.Context, options renderers.RenderOptions, gateway *datamodel.Gateway, applicationName string, hostname string) ([]rpv1.OutputResource, error) {
+	if len(gateway.Properties.Routes) < 1 {
+		return nil, v1.NewClientErrInvalidRequest("must have at least one route when declaring a Gateway resource")
+	}
+
+	gatewayName := kubernetes.NormalizeResourceName(gateway.Name)
+	namespace := options.Environment.Namespace
+
+	// The listener and the routes accept any host when no hostname is given. The hostnames of the Gateway API can't
+	// be IP addresses either, so the listener accepts any host in that case too.
+	var listenerHostname *gatewayv1beta1.Hostname
+	if hostname != "" && net.ParseIP(hostname) == nil {
+		listenerHostname = to.Ptr(gatewayv1beta1.Hostname(hostname))
+	}
+
+	sslPassthrough := gateway.Properties.TLS != nil && gateway.Properties.TLS.SSLPassthrough
+	if sslPassthrough {
+		if err := validateSSLPassthrough(gateway); err != nil {
+			return nil, err
+		}
+	}
+
+	// The Gateway API has no CORS, timeout or retry policies in the versions we support.
+	if gateway.Properties.CORS != nil {
+		return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("`cors` is not supported by the %s gateway kind", datamodel.GatewayKindGatewayAPI))
+	}
+	for _, route := range gateway.Properties.Routes {
+		if route.TimeoutPolicy != nil || route.RetryPolicy != nil {
+			return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("`timeoutPolicy` and `retryPolicy` are not supported by the %s gateway kind", datamodel.GatewayKindGatewayAPI))
+		}
+	}
+
+	outputResources := []rpv1.OutputResource{}
+	listener := gatewayv1beta1.Listener{
+		Name:     httpListenerName,
+		Hostname: listenerHostname,
+		Port:     gatewayv1beta1.PortNumber(80),
+		Protocol: gatewayv1beta1.HTTPProtocolType,
+	}
+
+	var referenceGrant *rpv1.OutputResource
+	if sslPassthrough {
+		listener = gatewayv1beta1.Listener{
+			Name:     tlsListenerName,
+			Hostname: listenerHostname,
+			Port:     gatewayv1beta1.PortNumber(443),
+			Protocol: gatewayv1beta1.TLSProtocolType,
+			TLS: &gatewayv1beta1.GatewayTLSConfig{
+				Mode: to.Ptr(gatewayv1beta1.TLSModePassthrough),
+			},
+		}
+	} else if gateway.Properties.TLS != nil && gateway.Properties.TLS.CertificateFrom != "" {
+		secretName, secretNamespace, err := getCertificateSecret(options, gateway)
+		if err != nil {
+			return nil, err
+		}
+
+		tls := &gatewayv1beta1.GatewayTLSConfig{
+			Mode: to.Ptr(gatewayv1beta1.TLSModeTerminate),
+			CertificateRefs: []gatewayv1beta1.SecretObjectReference{
+				{
+					Name:      gatewayv1beta1.ObjectName(secretName),
+					Namespace: to.Ptr(gatewayv1beta1.Namespace(secretNamespace)),
+				},
+			},
+		}
+		if gateway.Properties.TLS.MinimumProtocolVersion != "" {
+			tls.Options = map[gatewayv1beta1.AnnotationKey]gatewayv1beta1.AnnotationValue{
+				MinimumProtocolVersionOption: gatewayv1beta1.AnnotationValue(gateway.Properties.TLS.MinimumProtocolVersion),
+			}
+		}
+
+		listener = gatewayv1beta1.Listener{
+			Name:     httpsListenerName,
+			Hostname: listenerHostname,
+			Port:     gatewayv1beta1.PortNumber(443),
+			Protocol: gatewayv1beta1.HTTPSProtocolType,
+			TLS:      tls,
+		}
+
+		// A Gateway can only reference a secret of another namespace if the namespace of the secret allows it.
+		if secretNamespace != namespace {
+			grant := makeReferenceGrant(options, gateway, gatewayName, applicationName, secretName, secretNamespace)
+			referenceGrant = &grant
+		}
+	}
+
+	gatewayObject := &gatewayv1beta1.Gateway{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Gateway",
+			APIVersion: gatewayv1beta1.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        gatewayName,
+			Namespace:   namespace,
+			Labels:      renderers.GetLabels(options, applicationName, gateway.Name, gateway.ResourceTypeName()),
+			Annotations: renderers.GetAnnotations(options),
+		},
+		Spec: gatewayv1beta1.GatewaySpec{
+			GatewayClassName: gatewayv1beta1.ObjectName(options.Environment.Gateway.GatewayClassName),
+			Listeners:        []gatewayv1beta1.Listener{listener},
+		},
+	}
+
+	gatewayOutputResource := rpv1.NewKubernetesOutputResource(rpv1.LocalIDGateway, gatewayObject, gatewayObject.ObjectMeta)
+	if referenceGrant != nil {
+		gatewayOutputResource.CreateResource.Dependencies = append(gatewayOutputResource.CreateResource.Dependencies, rpv1.LocalIDReferenceGrant)
+		outputResources = append(outputResources, *referenceGrant)
+	}
+	outputResources = append(outputResources, gatewayOutputResource)
+
+	var routes []rpv1.OutputResource
+	var err error
+	if sslPassthrough {
+		routes, err = makeTLSRoutes(options, gateway, gatewayName, applicationName, listenerHostname)
+	} else {
+		routes, err = makeHTTPRoutes(options, gateway, gatewayName, applicationName, listenerHostname)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return append(outputResources, routes...), nil
+}
+
+// makeHTTPRoutes creates an HTTPRoute for each destination of the gateway. The routes to the same destination are
+// rules of the same HTTPRoute, and the routes matching the same requests are a single rule splitting the traffic
+// between their destinations. The HTTPRoutes are named after the gateway and the destination since their hostnames,
+// matches and rewrites are specific to the gateway.
+func makeHTTPRoutes(options renderers.RenderOptions, gateway *datamodel.Gateway, gatewayName string, applicationName string, hostname *gatewayv1beta1.Hostname) ([]rpv1.OutputResource, error) {
+	rules, err := groupRoutes(gateway.Properties.Routes)
+	if err != nil {
+		return nil, err
+	}
+
+	objects := map[string]*gatewayv1beta1.HTTPRoute{}
+	localIDs := []string{}
+	for _, routeRule := range rules {
+		route := routeRule.primary()
+		routeName, err := getRouteName(&route)
+		if err != nil {
+			return nil, err
+		}
+
+		backendRefs := []gatewayv1beta1.HTTPBackendRef{}
+		for _, destination := range routeRule.routes {
+			port, err := getRoutePort(options, destination)
+			if err != nil {
+				return nil, err
+			}
+
+			destinationName, err := getRouteName(&destination)
+			if err != nil {
+				return nil, err
+			}
+
+			backendRef := makeBackendRef(destinationName, port)
+			backendRef.Weight = destination.Weight
+			backendRefs = append(backendRefs, gatewayv1beta1.HTTPBackendRef{BackendRef: backendRef})
+		}
+
+		rule := gatewayv1beta1.HTTPRouteRule{
+			Matches:     []gatewayv1beta1.HTTPRouteMatch{makeHTTPRouteMatch(route)},
+			BackendRefs: backendRefs,
+		}
+
+		if route.ReplacePrefix != "" {
+			rule.Filters = []gatewayv1beta1.HTTPRouteFilter{
+				{
+					Type: gatewayv1beta1.HTTPRouteFilterURLRewrite,
+					URLRewrite: &gatewayv1beta1.HTTPURLRewriteFilter{
+						Path: &gatewayv1beta1.HTTPPathModifier{
+							Type:               gatewayv1beta1.PrefixMatchHTTPPathModifier,
+							ReplacePrefixMatch: to.Ptr(route.ReplacePrefix),
+						},
+					},
+				},
+			}
+		}
+
+		// Create unique localID for dependency graph
+		localID := fmt.Sprintf("%s-%s", rpv1.LocalIDHttpRoute, routeName)
+
+		// If this route already exists, add a rule to it
+		if object, exists := objects[localID]; exists {
+			object.Spec.Rules = append(object.Spec.Rules, rule)
+			continue
+		}
+
+		httpRoute := &gatewayv1beta1.HTTPRoute{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "HTTPRoute",
+				APIVersion: gatewayv1beta1.GroupVersion.String(),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        kubernetes.NormalizeResourceName(fmt.Sprintf("%s-%s", gatewayName, routeName)),
+				Namespace:   options.Environment.Namespace,
+				Labels:      renderers.GetLabels(options, applicationName, routeName, gateway.ResourceTypeName()),
+				Annotations: renderers.GetAnnotations(options),
+			},
+			Spec: gatewayv1beta1.HTTPRouteSpec{
+				CommonRouteSpec: gatewayv1beta1.CommonRouteSpec{
+					ParentRefs: []gatewayv1beta1.ParentReference{
+						{
+							Name: gatewayv1beta1.ObjectName(gatewayName),
+						},
+					},
+				},
+				Rules: []gatewayv1beta1.HTTPRouteRule{rule},
+			},
+		}
+		if hostname != nil {
+			httpRoute.Spec.Hostnames = []gatewayv1beta1.Hostname{*hostname}
+		}
+
+		objects[localID] = httpRoute
+		localIDs = append(localIDs, localID)
+	}
+
+	outputResources := []rpv1.OutputResource{}
+	for _, localID := range localIDs {
+		object := objects[localID]
+		outputResource := rpv1.NewKubernetesOutputResource(localID, object, object.ObjectMeta)
+
+		// The routes are attached to the Gateway, so they are created once the Gateway is ready.
+		outputResource.CreateResource.Dependencies = []string{rpv1.LocalIDGateway}
+		outputResources = append(outputResources, outputResource)
+	}
+
+	return outputResources, nil
+}
+
+// makeHTTPRouteMatch creates the match of the path, method, headers and query parameters of the route.
+func makeHTTPRouteMatch(route datamodel.GatewayRoute) gatewayv1beta1.HTTPRouteMatch {
+	path := route.Path
+	if path == "" {
+		path = "/"
+	}
+
+	match := gatewayv1beta1.HTTPRouteMatch{
+		Path: &gatewayv1beta1.HTTPPathMatch{
+			Type:  to.Ptr(gatewayv1beta1.PathMatchPathPrefix),
+			Value: to.Ptr(path),
+		},
+	}
+
+	if route.Method != "" {
+		match.Method = to.Ptr(gatewayv1beta1.HTTPMethod(route.Method))
+	}
+
+	for _, h := range route.Headers {
+		match.Headers = append(match.Headers, gatewayv1beta1.HTTPHeaderMatch{
+			Type:  to.Ptr(gatewayv1beta1.HeaderMatchExact),
+			Name:  gatewayv1beta1.HTTPHeaderName(h.Name),
+			Value: h.Value,
+		})
+	}
+
+	for _, q := range route.QueryParameters {
+		match.QueryParams = append(match.QueryParams, gatewayv1beta1.HTTPQueryParamMatch{
+			Type:  to.Ptr(gatewayv1beta1.QueryParamMatchExact),
+			Name:  gatewayv1beta1.HTTPHeaderName(q.Name),
+			Value: q.Value,
+		})
+	}
+
+	return match
+}
+
+// makeTLSRoutes creates the TLSRoute forwarding the TLS connections of a gateway with sslPassthrough enabled to its
+// only destination.
+func makeTLSRoutes(options renderers.RenderOptions, gateway *datamodel.Gateway, gatewayName string, applicationName string, hostname *gatewayv1beta1.Hostname) ([]rpv1.OutputResource, error) {
+	route := gateway.Properties.Routes[0]
+	routeName, err := getRouteName(&route)
+	if err != nil {
+		return nil, err
+	}
+
+	port := renderers.DefaultSecurePort
+	routePort, ok := options.Dependencies[route.Destination].ComputedValues["port"].(float64)
+	if ok {
+		port = int32(routePort)
+	}
+
+	tlsRoute := &gatewayv1alpha2.TLSRoute{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "TLSRoute",
+			APIVersion: gatewayv1alpha2.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        kubernetes.NormalizeResourceName(fmt.Sprintf("%s-%s", gatewayName, routeName)),
+			Namespace:   options.Environment.Namespace,
+			Labels:      renderers.GetLabels(options, applicationName, routeName, gateway.ResourceTypeName()),
+			Annotations: renderers.GetAnnotations(options),
+		},
+		Spec: gatewayv1alpha2.TLSRouteSpec{
+			CommonRouteSpec: gatewayv1alpha2.CommonRouteSpec{
+				ParentRefs: []gatewayv1alpha2.ParentReference{
+					{
+						Name: gatewayv1alpha2.ObjectName(gatewayName),
+					},
+				},
+			},
+			Rules: []gatewayv1alpha2.TLSRouteRule{
+				{
+					BackendRefs: []gatewayv1alpha2.BackendRef{makeBackendRef(routeName, port)},
+				},
+			},
+		},
+	}
+	if hostname != nil {
+		tlsRoute.Spec.Hostnames = []gatewayv1alpha2.Hostname{*hostname}
+	}
+
+	outputResource := rpv1.NewKubernetesOutputResource(fmt.Sprintf("%s-%s", rpv1.LocalIDTLSRoute, routeName), tlsRoute, tlsRoute.ObjectMeta)
+	outputResource.CreateResource.Dependencies = []string{rpv1.LocalIDGateway}
+
+	return []rpv1.OutputResource{outputResource}, nil
+}
+
+// makeReferenceGrant creates the ReferenceGrant allowing the Gateway to reference the certificate secret of another
+// namespace.
+func makeReferenceGrant(options renderers.RenderOptions, gateway *datamodel.Gateway, gatewayName string, applicationName string, secretName string, secretNamespace string) rpv1.OutputResource {
+	referenceGrant := &gatewayv1beta1.ReferenceGrant{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ReferenceGrant",
+			APIVersion: gatewayv1beta1.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        gatewayName,
+			Namespace:   secretNamespace,
+			Labels:      renderers.GetLabels(options, applicationName, gateway.Name, gateway.ResourceTypeName()),
+			Annotations: renderers.GetAnnotations(options),
+		},
+		Spec: gatewayv1beta1.ReferenceGrantSpec{
+			From: []gatewayv1beta1.ReferenceGrantFrom{
+				{
+					Group:     gatewayv1beta1.GroupName,
+					Kind:      "Gateway",
+					Namespace: gatewayv1beta1.Namespace(options.Environment.Namespace),
+				},
+			},
+			To: []gatewayv1beta1.ReferenceGrantTo{
+				{
+					Group: "",
+					Kind:  "Secret",
+					Name:  to.Ptr(gatewayv1beta1.ObjectName(secretName)),
+				},
+			},
+		},
+	}
+
+	return rpv1.NewKubernetesOutputResource(rpv1.LocalIDReferenceGrant, referenceGrant, referenceGrant.ObjectMeta)
+}
+
+// makeBackendRef creates a reference to the Kubernetes service of a route destination.
+func makeBackendRef(routeName string, port int32) gatewayv1beta1.BackendRef {
+	return gatewayv1beta1.BackendRef{
+		BackendObjectReference: gatewayv1beta1.BackendObjectReference{
+			Name: gatewayv1beta1.ObjectName(kubernetes.NormalizeResourceName(routeName)),
+			Port: to.Ptr(gatewayv1beta1.PortNumber(port)),
+		},
+	}
+}
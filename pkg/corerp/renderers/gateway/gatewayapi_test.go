@@ -0,0 +1,484 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/handlers"
+	"github.com/radius-project/radius/pkg/corerp/renderers"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
+)
+
+const testGatewayClassName = "test-gateway-class"
+
+func getGatewayAPIEnvironmentOptions() renderers.EnvironmentOptions {
+	environmentOptions := getEnvironmentOptions("", testExternalIP, "", false, false)
+	environmentOptions.Gateway.Kind = datamodel.GatewayKindGatewayAPI
+	environmentOptions.Gateway.GatewayClassName = testGatewayClassName
+	return environmentOptions
+}
+
+func findOutputResource(t *testing.T, outputResources []rpv1.OutputResource, localID string) rpv1.OutputResource {
+	for _, outputResource := range outputResources {
+		if outputResource.LocalID == localID {
+			return outputResource
+		}
+	}
+
+	require.Failf(t, "output resource not found", "no output resource with localID %s", localID)
+	return rpv1.OutputResource{}
+}
+
+func Test_Render_GatewayAPI_Routes(t *testing.T) {
+	r := &Renderer{}
+
+	routeA := datamodel.GatewayRoute{
+		Destination: makeRouteResourceID("routea"),
+		Path:        "/",
+	}
+	routeB := datamodel.GatewayRoute{
+		Destination:   makeRouteResourceID("routeb"),
+		Path:          "/api",
+		ReplacePrefix: "/",
+	}
+	resource := makeResource(t, datamodel.GatewayProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+		},
+		Routes: []datamodel.GatewayRoute{routeA, routeB},
+	})
+	dependencies := map[string]renderers.RendererDependency{
+		routeB.Destination: {
+			ComputedValues: map[string]any{
+				"port": float64(3000),
+			},
+		},
+	}
+	environmentOptions := getGatewayAPIEnvironmentOptions()
+
+	output, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: dependencies, Environment: environmentOptions})
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 3)
+	require.Empty(t, output.SecretValues)
+
+	expectedHostname := fmt.Sprintf("%s.%s.%s.nip.io", resourceName, applicationName, testExternalIP)
+	require.Equal(t, "http://"+expectedHostname, output.ComputedValues["url"].Value)
+
+	gatewayResource := findOutputResource(t, output.Resources, rpv1.LocalIDGateway)
+	gateway, ok := gatewayResource.CreateResource.Data.(*gatewayv1beta1.Gateway)
+	require.True(t, ok)
+	require.Equal(t, resourceName, gateway.Name)
+	require.Equal(t, environmentOptions.Namespace, gateway.Namespace)
+	require.Equal(t, gatewayv1beta1.ObjectName(testGatewayClassName), gateway.Spec.GatewayClassName)
+	require.Equal(t, []gatewayv1beta1.Listener{
+		{
+			Name:     httpListenerName,
+			Hostname: to.Ptr(gatewayv1beta1.Hostname(expectedHostname)),
+			Port:     80,
+			Protocol: gatewayv1beta1.HTTPProtocolType,
+		},
+	}, gateway.Spec.Listeners)
+	require.Empty(t, gatewayResource.CreateResource.Dependencies)
+
+	routeAResource := findOutputResource(t, output.Resources, rpv1.LocalIDHttpRoute+"-routea")
+	require.Equal(t, []string{rpv1.LocalIDGateway}, routeAResource.CreateResource.Dependencies)
+	httpRouteA, ok := routeAResource.CreateResource.Data.(*gatewayv1beta1.HTTPRoute)
+	require.True(t, ok)
+	require.Equal(t, "test-gateway-routea", httpRouteA.Name)
+	require.Equal(t, []gatewayv1beta1.Hostname{gatewayv1beta1.Hostname(expectedHostname)}, httpRouteA.Spec.Hostnames)
+	require.Equal(t, []gatewayv1beta1.ParentReference{{Name: resourceName}}, httpRouteA.Spec.ParentRefs)
+	require.Equal(t, []gatewayv1beta1.HTTPRouteRule{
+		{
+			Matches: []gatewayv1beta1.HTTPRouteMatch{
+				{
+					Path: &gatewayv1beta1.HTTPPathMatch{
+						Type:  to.Ptr(gatewayv1beta1.PathMatchPathPrefix),
+						Value: to.Ptr("/"),
+					},
+				},
+			},
+			BackendRefs: []gatewayv1beta1.HTTPBackendRef{
+				{
+					BackendRef: makeBackendRef("routea", renderers.DefaultPort),
+				},
+			},
+		},
+	}, httpRouteA.Spec.Rules)
+
+	routeBResource := findOutputResource(t, output.Resources, rpv1.LocalIDHttpRoute+"-routeb")
+	httpRouteB, ok := routeBResource.CreateResource.Data.(*gatewayv1beta1.HTTPRoute)
+	require.True(t, ok)
+	require.Len(t, httpRouteB.Spec.Rules, 1)
+	require.Equal(t, []gatewayv1beta1.HTTPRouteFilter{
+		{
+			Type: gatewayv1beta1.HTTPRouteFilterURLRewrite,
+			URLRewrite: &gatewayv1beta1.HTTPURLRewriteFilter{
+				Path: &gatewayv1beta1.HTTPPathModifier{
+					Type:               gatewayv1beta1.PrefixMatchHTTPPathModifier,
+					ReplacePrefixMatch: to.Ptr("/"),
+				},
+			},
+		},
+	}, httpRouteB.Spec.Rules[0].Filters)
+	require.Equal(t, to.Ptr(gatewayv1beta1.PortNumber(3000)), httpRouteB.Spec.Rules[0].BackendRefs[0].Port)
+}
+
+func Test_Render_GatewayAPI_NoPublicEndpoint(t *testing.T) {
+	r := &Renderer{}
+
+	route := datamodel.GatewayRoute{
+		Destination: makeRouteResourceID("routename"),
+		Path:        "/",
+	}
+	environmentOptions := getGatewayAPIEnvironmentOptions()
+	environmentOptions.Gateway.ExternalIP = ""
+
+	tests := []struct {
+		name     string
+		hostname *datamodel.GatewayPropertiesHostname
+		address  string
+		url      string
+	}{
+		{
+			name:    "ip address",
+			address: "10.0.0.1",
+			url:     fmt.Sprintf("http://%s.%s.10.0.0.1.nip.io", resourceName, applicationName),
+		},
+		{
+			name:     "ip address with prefix",
+			hostname: &datamodel.GatewayPropertiesHostname{Prefix: "prefix"},
+			address:  "10.0.0.1",
+			url:      fmt.Sprintf("http://prefix.%s.10.0.0.1.nip.io", applicationName),
+		},
+		{
+			name:    "hostname address",
+			address: "gateway.example.com",
+			url:     "http://gateway.example.com",
+		},
+		{
+			name: "no address",
+			url:  "unknown",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			resource := makeResource(t, datamodel.GatewayProperties{
+				BasicResourceProperties: rpv1.BasicResourceProperties{
+					Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+				},
+				Hostname: tc.hostname,
+				Routes:   []datamodel.GatewayRoute{route},
+			})
+
+			output, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}, Environment: environmentOptions})
+			require.NoError(t, err)
+
+			// The listener and the routes accept any host.
+			gateway, ok := findOutputResource(t, output.Resources, rpv1.LocalIDGateway).CreateResource.Data.(*gatewayv1beta1.Gateway)
+			require.True(t, ok)
+			require.Len(t, gateway.Spec.Listeners, 1)
+			require.Nil(t, gateway.Spec.Listeners[0].Hostname)
+			httpRoute, ok := findOutputResource(t, output.Resources, rpv1.LocalIDHttpRoute+"-routename").CreateResource.Data.(*gatewayv1beta1.HTTPRoute)
+			require.True(t, ok)
+			require.Empty(t, httpRoute.Spec.Hostnames)
+
+			// The url is resolved from the address of the deployed Gateway.
+			url := output.ComputedValues["url"]
+			require.Equal(t, rpv1.LocalIDGateway, url.LocalID)
+			require.Equal(t, handlers.GatewayAddressKey, url.PropertyReference)
+			require.NotNil(t, url.Transformer)
+
+			computedValues := map[string]any{"url": tc.address}
+			err = url.Transformer(resource, computedValues)
+			require.NoError(t, err)
+			require.Equal(t, tc.url, computedValues["url"])
+		})
+	}
+}
+
+func Test_Render_GatewayAPI_TLSTermination_CrossNamespaceSecret(t *testing.T) {
+	r := &Renderer{}
+
+	secretName := "myapp-tls-secret"
+	secretNamespace := "certificates"
+	secretStoreResourceID := makeSecretStoreResourceID(secretName)
+	properties, _ := makeTestGateway(datamodel.GatewayProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+		},
+		TLS: &datamodel.GatewayPropertiesTLS{
+			MinimumProtocolVersion: "1.2",
+			CertificateFrom:        secretStoreResourceID,
+		},
+	})
+	resource := makeResource(t, properties)
+	environmentOptions := getGatewayAPIEnvironmentOptions()
+
+	dependencies := map[string]renderers.RendererDependency{
+		(makeResourceID(t, secretStoreResourceID).String()): {
+			ResourceID: makeResourceID(t, secretStoreResourceID),
+			Resource: &datamodel.SecretStore{
+				Properties: &datamodel.SecretStoreProperties{
+					Type: "certificate",
+					Data: map[string]*datamodel.SecretStoreDataValue{
+						"tls.crt": {
+							Value: to.Ptr("test-crt"),
+						},
+						"tls.key": {
+							Value: to.Ptr("test-crt"),
+						},
+					},
+				},
+			},
+			OutputResources: map[string]resources.ID{
+				"Secret": resources_kubernetes.IDFromParts(
+					resources_kubernetes.PlaneNameTODO,
+					"",
+					"Secret",
+					secretNamespace,
+					secretName),
+			},
+		},
+	}
+
+	output, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: dependencies, Environment: environmentOptions})
+	require.NoError(t, err)
+
+	expectedHostname := fmt.Sprintf("%s.%s.%s.nip.io", resourceName, applicationName, testExternalIP)
+	require.Equal(t, "https://"+expectedHostname, output.ComputedValues["url"].Value)
+
+	gatewayResource := findOutputResource(t, output.Resources, rpv1.LocalIDGateway)
+	require.Equal(t, []string{rpv1.LocalIDReferenceGrant}, gatewayResource.CreateResource.Dependencies)
+	gateway, ok := gatewayResource.CreateResource.Data.(*gatewayv1beta1.Gateway)
+	require.True(t, ok)
+	require.Equal(t, []gatewayv1beta1.Listener{
+		{
+			Name:     httpsListenerName,
+			Hostname: to.Ptr(gatewayv1beta1.Hostname(expectedHostname)),
+			Port:     443,
+			Protocol: gatewayv1beta1.HTTPSProtocolType,
+			TLS: &gatewayv1beta1.GatewayTLSConfig{
+				Mode: to.Ptr(gatewayv1beta1.TLSModeTerminate),
+				CertificateRefs: []gatewayv1beta1.SecretObjectReference{
+					{
+						Name:      gatewayv1beta1.ObjectName(secretName),
+						Namespace: to.Ptr(gatewayv1beta1.Namespace(secretNamespace)),
+					},
+				},
+				Options: map[gatewayv1beta1.AnnotationKey]gatewayv1beta1.AnnotationValue{
+					MinimumProtocolVersionOption: "1.2",
+				},
+			},
+		},
+	}, gateway.Spec.Listeners)
+
+	grantResource := findOutputResource(t, output.Resources, rpv1.LocalIDReferenceGrant)
+	grant, ok := grantResource.CreateResource.Data.(*gatewayv1beta1.ReferenceGrant)
+	require.True(t, ok)
+	require.Equal(t, secretNamespace, grant.Namespace)
+	require.Equal(t, []gatewayv1beta1.ReferenceGrantFrom{
+		{
+			Group:     gatewayv1beta1.GroupName,
+			Kind:      "Gateway",
+			Namespace: gatewayv1beta1.Namespace(environmentOptions.Namespace),
+		},
+	}, grant.Spec.From)
+	require.Equal(t, []gatewayv1beta1.ReferenceGrantTo{
+		{
+			Kind: "Secret",
+			Name: to.Ptr(gatewayv1beta1.ObjectName(secretName)),
+		},
+	}, grant.Spec.To)
+}
+
+func Test_Render_GatewayAPI_SSLPassthrough(t *testing.T) {
+	r := &Renderer{}
+
+	route := datamodel.GatewayRoute{
+		Destination: makeRouteResourceID("routename"),
+	}
+	resource := makeResource(t, datamodel.GatewayProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+		},
+		TLS: &datamodel.GatewayPropertiesTLS{
+			SSLPassthrough: true,
+		},
+		Routes: []datamodel.GatewayRoute{route},
+	})
+	environmentOptions := getGatewayAPIEnvironmentOptions()
+
+	output, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}, Environment: environmentOptions})
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 2)
+
+	gatewayResource := findOutputResource(t, output.Resources, rpv1.LocalIDGateway)
+	gateway, ok := gatewayResource.CreateResource.Data.(*gatewayv1beta1.Gateway)
+	require.True(t, ok)
+	require.Len(t, gateway.Spec.Listeners, 1)
+	require.Equal(t, gatewayv1beta1.TLSProtocolType, gateway.Spec.Listeners[0].Protocol)
+	require.Equal(t, to.Ptr(gatewayv1beta1.TLSModePassthrough), gateway.Spec.Listeners[0].TLS.Mode)
+
+	routeResource := findOutputResource(t, output.Resources, rpv1.LocalIDTLSRoute+"-routename")
+	require.Equal(t, []string{rpv1.LocalIDGateway}, routeResource.CreateResource.Dependencies)
+	tlsRoute, ok := routeResource.CreateResource.Data.(*gatewayv1alpha2.TLSRoute)
+	require.True(t, ok)
+	require.Equal(t, []gatewayv1alpha2.TLSRouteRule{
+		{
+			BackendRefs: []gatewayv1alpha2.BackendRef{makeBackendRef("routename", renderers.DefaultSecurePort)},
+		},
+	}, tlsRoute.Spec.Rules)
+}
+
+func Test_Render_GatewayAPI_Fails_SSLPassthroughWithRoutePath(t *testing.T) {
+	r := &Renderer{}
+
+	resource := makeResource(t, datamodel.GatewayProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+		},
+		TLS: &datamodel.GatewayPropertiesTLS{
+			SSLPassthrough: true,
+		},
+		Routes: []datamodel.GatewayRoute{
+			{
+				Destination: makeRouteResourceID("routename"),
+				Path:        "/api",
+			},
+		},
+	})
+
+	_, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}, Environment: getGatewayAPIEnvironmentOptions()})
+	require.Error(t, err)
+	require.Equal(t, "cannot support `path` or `replacePrefix` in routes with sslPassthrough set to true", err.(*v1.ErrClientRP).Message)
+}
+
+func Test_Render_GatewayAPI_MatchesAndWeights(t *testing.T) {
+	r := &Renderer{}
+
+	resource := makeResource(t, datamodel.GatewayProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+		},
+		Routes: []datamodel.GatewayRoute{
+			{Destination: makeRouteResourceID("frontend"), Path: "/", Weight: to.Ptr(int32(90))},
+			{Destination: makeRouteResourceID("frontend-canary"), Path: "/", Weight: to.Ptr(int32(10))},
+			{
+				Destination:     makeRouteResourceID("frontend"),
+				Path:            "/api",
+				Method:          "POST",
+				Headers:         []datamodel.GatewayRouteHeaderMatch{{Name: "x-version", Value: "v2"}},
+				QueryParameters: []datamodel.GatewayRouteQueryParameterMatch{{Name: "debug", Value: "true"}},
+			},
+		},
+	})
+
+	output, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}, Environment: getGatewayAPIEnvironmentOptions()})
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 2)
+
+	routeResource := findOutputResource(t, output.Resources, rpv1.LocalIDHttpRoute+"-frontend")
+	httpRoute, ok := routeResource.CreateResource.Data.(*gatewayv1beta1.HTTPRoute)
+	require.True(t, ok)
+
+	canaryBackendRef := makeBackendRef("frontend-canary", renderers.DefaultPort)
+	canaryBackendRef.Weight = to.Ptr(int32(10))
+	frontendBackendRef := makeBackendRef("frontend", renderers.DefaultPort)
+	weightedFrontendBackendRef := makeBackendRef("frontend", renderers.DefaultPort)
+	weightedFrontendBackendRef.Weight = to.Ptr(int32(90))
+
+	require.Equal(t, []gatewayv1beta1.HTTPRouteRule{
+		{
+			Matches: []gatewayv1beta1.HTTPRouteMatch{
+				{
+					Path: &gatewayv1beta1.HTTPPathMatch{
+						Type:  to.Ptr(gatewayv1beta1.PathMatchPathPrefix),
+						Value: to.Ptr("/"),
+					},
+				},
+			},
+			BackendRefs: []gatewayv1beta1.HTTPBackendRef{
+				{BackendRef: weightedFrontendBackendRef},
+				{BackendRef: canaryBackendRef},
+			},
+		},
+		{
+			Matches: []gatewayv1beta1.HTTPRouteMatch{
+				{
+					Path: &gatewayv1beta1.HTTPPathMatch{
+						Type:  to.Ptr(gatewayv1beta1.PathMatchPathPrefix),
+						Value: to.Ptr("/api"),
+					},
+					Method: to.Ptr(gatewayv1beta1.HTTPMethodPost),
+					Headers: []gatewayv1beta1.HTTPHeaderMatch{
+						{
+							Type:  to.Ptr(gatewayv1beta1.HeaderMatchExact),
+							Name:  "x-version",
+							Value: "v2",
+						},
+					},
+					QueryParams: []gatewayv1beta1.HTTPQueryParamMatch{
+						{
+							Type:  to.Ptr(gatewayv1beta1.QueryParamMatchExact),
+							Name:  "debug",
+							Value: "true",
+						},
+					},
+				},
+			},
+			BackendRefs: []gatewayv1beta1.HTTPBackendRef{
+				{BackendRef: frontendBackendRef},
+			},
+		},
+	}, httpRoute.Spec.Rules)
+}
+
+func Test_Render_GatewayAPI_Fails_UnsupportedPolicies(t *testing.T) {
+	r := &Renderer{}
+
+	properties := datamodel.GatewayProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+		},
+		Routes: []datamodel.GatewayRoute{
+			{Destination: makeRouteResourceID("frontend"), RetryPolicy: &datamodel.GatewayRouteRetryPolicy{Attempts: 3}},
+		},
+	}
+
+	_, err := r.Render(context.Background(), makeResource(t, properties), renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}, Environment: getGatewayAPIEnvironmentOptions()})
+	require.Error(t, err)
+	require.Equal(t, "`timeoutPolicy` and `retryPolicy` are not supported by the gatewayAPI gateway kind", err.(*v1.ErrClientRP).Message)
+
+	properties.Routes[0].RetryPolicy = nil
+	properties.CORS = &datamodel.GatewayCORS{AllowOrigins: []string{"*"}, AllowMethods: []string{"GET"}}
+	_, err = r.Render(context.Background(), makeResource(t, properties), renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}, Environment: getGatewayAPIEnvironmentOptions()})
+	require.Error(t, err)
+	require.Equal(t, "`cors` is not supported by the gatewayAPI gateway kind", err.(*v1.ErrClientRP).Message)
+}
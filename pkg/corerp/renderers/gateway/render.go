@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"net"
 	"net/url"
+	"reflect"
 	"strconv"
 	"strings"
 
@@ -30,6 +31,7 @@ import (
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/handlers"
 	"github.com/radius-project/radius/pkg/corerp/renderers"
 	"github.com/radius-project/radius/pkg/kubernetes"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
@@ -92,17 +94,42 @@ func (r Renderer) Render(ctx context.Context, dm v1.DataModelInterface, options
 	applicationName := appId.Name()
 	gatewayName := kubernetes.NormalizeResourceName(gateway.Name)
 	hostname, err := getHostname(*gateway, &gateway.Properties, applicationName, options.Environment.Gateway)
+	isHttps := gateway.Properties.TLS != nil && (gateway.Properties.TLS.SSLPassthrough || gateway.Properties.TLS.CertificateFrom != "")
 
+	noPublicEndpoint := errors.Is(err, &ErrNoPublicEndpoint{})
 	var publicEndpoint string
-	if errors.Is(err, &ErrNoPublicEndpoint{}) {
+	if noPublicEndpoint {
 		publicEndpoint = "unknown"
 	} else if err != nil {
 		return renderers.RendererOutput{}, fmt.Errorf("getting hostname failed with error: %s", err)
 	} else {
-		isHttps := gateway.Properties.TLS != nil && (gateway.Properties.TLS.SSLPassthrough || gateway.Properties.TLS.CertificateFrom != "")
 		publicEndpoint = getPublicEndpoint(hostname, options.Environment.Gateway.Port, isHttps)
 	}
 
+	computedValues := map[string]rpv1.ComputedValueReference{
+		"url": {
+			Value: publicEndpoint,
+		},
+	}
+
+	if options.Environment.Gateway.Kind == datamodel.GatewayKindGatewayAPI {
+		if noPublicEndpoint {
+			// The Gateway accepts any host, and its public endpoint is resolved from the address assigned to it.
+			hostname = ""
+			computedValues["url"] = makeGatewayAddressURL(*gateway, applicationName, options.Environment.Gateway, isHttps)
+		}
+
+		gatewayAPIObjects, err := MakeGatewayAPIResources(ctx, options, gateway, applicationName, hostname)
+		if err != nil {
+			return renderers.RendererOutput{}, err
+		}
+
+		return renderers.RendererOutput{
+			Resources:      gatewayAPIObjects,
+			ComputedValues: computedValues,
+		}, nil
+	}
+
 	gatewayObject, err := MakeRootHTTPProxy(ctx, options, gateway, gateway.Name, applicationName, hostname)
 	if err != nil {
 		return renderers.RendererOutput{}, err
@@ -110,12 +137,6 @@ func (r Renderer) Render(ctx context.Context, dm v1.DataModelInterface, options
 
 	outputResources = append(outputResources, gatewayObject)
 
-	computedValues := map[string]rpv1.ComputedValueReference{
-		"url": {
-			Value: publicEndpoint,
-		},
-	}
-
 	httpRouteObjects, err := MakeRoutesHTTPProxies(ctx, options, *gateway, &gateway.Properties, gatewayName, gatewayObject, applicationName)
 	if err != nil {
 		return renderers.RendererOutput{}, err
@@ -132,7 +153,6 @@ func (r Renderer) Render(ctx context.Context, dm v1.DataModelInterface, options
 // to act as the Gateway.
 func MakeRootHTTPProxy(ctx context.Context, options renderers.RenderOptions, gateway *datamodel.Gateway, resourceName string, applicationName string, hostname string) (rpv1.OutputResource, error) {
 	includes := []contourv1.Include{}
-	dependencies := options.Dependencies
 
 	if len(gateway.Properties.Routes) < 1 {
 		return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest("must have at least one route when declaring a Gateway resource")
@@ -146,49 +166,9 @@ func MakeRootHTTPProxy(ctx context.Context, options renderers.RenderOptions, gat
 		sslPassthrough = gateway.Properties.TLS.SSLPassthrough
 
 		if gateway.Properties.TLS.CertificateFrom != "" {
-			secretStoreResourceId := gateway.Properties.TLS.CertificateFrom
-			secretStoreResource, ok := dependencies[secretStoreResourceId]
-			if !ok {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("secretStore resource %s not found", secretStoreResourceId))
-			}
-
-			referencedResource := dependencies[secretStoreResourceId].Resource
-			if !strings.EqualFold(referencedResource.ResourceTypeName(), datamodel.SecretStoreResourceType) {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest("certificateFrom must reference a secretStore resource")
-			}
-
-			// Validate the secretStore resource: it must be of type certificate and have tls.crt and tls.key
-			secretStore, ok := referencedResource.(*datamodel.SecretStore)
-			if !ok {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest("certificateFrom must reference a secretStore resource")
-			}
-
-			if secretStore.Properties.Type != datamodel.SecretTypeCert {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest("certificateFrom must reference a secretStore resource with type certificate")
-			}
-
-			if secretStore.Properties.Data["tls.crt"] == nil {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest("certificateFrom must reference a secretStore resource with tls.crt")
-			}
-
-			if secretStore.Properties.Data["tls.key"] == nil {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest("certificateFrom must reference a secretStore resource with tls.key")
-			}
-
-			// Get the name and namespace of the Kubernetes secret resource from the secretStore OutputResources
-			if secretStoreResource.OutputResources == nil {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("secretStore resource %s not found", secretStoreResourceId))
-			}
-
-			secretResourceID, ok := secretStoreResource.OutputResources[rpv1.LocalIDSecret]
-			if !ok {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("secretStore resource %s not found", secretStoreResourceId))
-			}
-
-			secretName := secretResourceID.Name()
-			secretNamespace := secretResourceID.FindScope(resources_kubernetes.ScopeNamespaces)
-			if secretNamespace == "" {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("secretStore resource %s not found", secretStoreResourceId))
+			secretName, secretNamespace, err := getCertificateSecret(options, gateway)
+			if err != nil {
+				return rpv1.OutputResource{}, err
 			}
 
 			contourTLSConfig = &contourv1.TLS{
@@ -198,33 +178,40 @@ func MakeRootHTTPProxy(ctx context.Context, options renderers.RenderOptions, gat
 		}
 	}
 
-	// If SSL Passthrough is enabled, then we can only have one route
-	if sslPassthrough && len(gateway.Properties.Routes) > 1 {
-		return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest("cannot support multiple routes with sslPassthrough set to true")
+	if sslPassthrough {
+		if err := validateSSLPassthrough(gateway); err != nil {
+			return rpv1.OutputResource{}, err
+		}
 	}
 
-	var route datamodel.GatewayRoute //route will hold the one sslPassthrough route, if sslPassthrough is true
-	for _, route = range gateway.Properties.Routes {
-		if sslPassthrough && (route.Path != "" || route.ReplacePrefix != "") {
-			return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest("cannot support `path` or `replacePrefix` in routes with sslPassthrough set to true")
-		}
-		routeName, err := getRouteName(&route)
+	rules, err := groupRoutes(gateway.Properties.Routes)
+	if err != nil {
+		return rpv1.OutputResource{}, err
+	}
+
+	route := gateway.Properties.Routes[0] // route holds the one sslPassthrough route, if sslPassthrough is true
+	for _, rule := range rules {
+		primary := rule.primary()
+		routeName, err := getRouteName(&primary)
 		if err != nil {
 			return rpv1.OutputResource{}, err
 		}
 
 		routeResourceName := kubernetes.NormalizeResourceName(routeName)
-		prefix := route.Path
+		prefix := primary.Path
 		if sslPassthrough {
 			prefix = "/"
 		}
-		includes = append(includes, contourv1.Include{
-			Name: routeResourceName,
-			Conditions: []contourv1.MatchCondition{
-				{
-					Prefix: prefix,
-				},
+
+		conditions := []contourv1.MatchCondition{
+			{
+				Prefix: prefix,
 			},
+		}
+		conditions = append(conditions, makeMatchConditions(primary)...)
+		includes = append(includes, contourv1.Include{
+			Name:       routeResourceName,
+			Conditions: conditions,
 		})
 	}
 
@@ -236,8 +223,9 @@ func MakeRootHTTPProxy(ctx context.Context, options renderers.RenderOptions, gat
 	}
 
 	virtualHost := &contourv1.VirtualHost{
-		Fqdn: virtualHostname,
-		TLS:  contourTLSConfig,
+		Fqdn:       virtualHostname,
+		TLS:        contourTLSConfig,
+		CORSPolicy: makeCORSPolicy(gateway.Properties.CORS),
 	}
 
 	var tcpProxy *contourv1.TCPProxy
@@ -295,26 +283,18 @@ func MakeRootHTTPProxy(ctx context.Context, options renderers.RenderOptions, gat
 // MakeRoutesHTTPProxies creates HTTPProxy objects for each route in the gateway and returns them as OutputResources. It returns
 // an error if it fails to get the route name.
 func MakeRoutesHTTPProxies(ctx context.Context, options renderers.RenderOptions, resource datamodel.Gateway, gateway *datamodel.GatewayProperties, gatewayName string, gatewayOutPutResource rpv1.OutputResource, applicationName string) ([]rpv1.OutputResource, error) {
-	dependencies := options.Dependencies
 	objects := make(map[string]*contourv1.HTTPProxy)
 
-	for _, route := range gateway.Routes {
-		port := renderers.DefaultPort
-
-		if isURL(route.Destination) {
-			_, _, urlPort, err := parseURL(route.Destination)
-			if err != nil {
-				return []rpv1.OutputResource{}, err
-			}
-			port = urlPort
-
-		} else {
-			routeProperties := dependencies[route.Destination]
-			routePort, ok := routeProperties.ComputedValues["port"].(float64)
-			if ok {
-				port = int32(routePort)
-			}
+	rules, err := groupRoutes(gateway.Routes)
+	if err != nil {
+		return []rpv1.OutputResource{}, err
+	}
 
+	for _, rule := range rules {
+		route := rule.primary()
+		services, err := makeRouteServices(options, rule)
+		if err != nil {
+			return []rpv1.OutputResource{}, err
 		}
 
 		routeName, err := getRouteName(&route)
@@ -338,8 +318,18 @@ func MakeRoutesHTTPProxies(ctx context.Context, options renderers.RenderOptions,
 			}
 		}
 
+		timeoutPolicy := makeTimeoutPolicy(route.TimeoutPolicy)
+		retryPolicy := makeRetryPolicy(route.RetryPolicy)
+
 		// If this route already exists, append to it
 		if object, exists := objects[localID]; exists {
+			// The route of the HTTPProxy is shared by all the gateway routes to the same destination, so they must agree on
+			// its services and policies.
+			existing := object.Spec.Routes[0]
+			if !reflect.DeepEqual(existing.Services, services) || !reflect.DeepEqual(existing.TimeoutPolicy, timeoutPolicy) || !reflect.DeepEqual(existing.RetryPolicy, retryPolicy) {
+				return []rpv1.OutputResource{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("routes to the destination %s must have the same weights, timeoutPolicy and retryPolicy", route.Destination))
+			}
+
 			if pathRewritePolicy != nil {
 			outer:
 				for i := range object.Spec.Routes {
@@ -374,13 +364,10 @@ func MakeRoutesHTTPProxies(ctx context.Context, options renderers.RenderOptions,
 			Spec: contourv1.HTTPProxySpec{
 				Routes: []contourv1.Route{
 					{
-						Services: []contourv1.Service{
-							{
-								Name: routeResourceName,
-								Port: int(port),
-							},
-						},
+						Services:          services,
 						PathRewritePolicy: pathRewritePolicy,
+						TimeoutPolicy:     timeoutPolicy,
+						RetryPolicy:       retryPolicy,
 					},
 				},
 			},
@@ -400,6 +387,77 @@ func MakeRoutesHTTPProxies(ctx context.Context, options renderers.RenderOptions,
 	return outputResources, nil
 }
 
+// getCertificateSecret validates the secretStore resource referenced by the certificateFrom property of the gateway and
+// returns the name and namespace of the Kubernetes secret holding the certificate.
+func getCertificateSecret(options renderers.RenderOptions, gateway *datamodel.Gateway) (string, string, error) {
+	dependencies := options.Dependencies
+	secretStoreResourceId := gateway.Properties.TLS.CertificateFrom
+	secretStoreResource, ok := dependencies[secretStoreResourceId]
+	if !ok {
+		return "", "", v1.NewClientErrInvalidRequest(fmt.Sprintf("secretStore resource %s not found", secretStoreResourceId))
+	}
+
+	referencedResource := dependencies[secretStoreResourceId].Resource
+	if !strings.EqualFold(referencedResource.ResourceTypeName(), datamodel.SecretStoreResourceType) {
+		return "", "", v1.NewClientErrInvalidRequest("certificateFrom must reference a secretStore resource")
+	}
+
+	// Validate the secretStore resource: it must be of type certificate and have tls.crt and tls.key
+	secretStore, ok := referencedResource.(*datamodel.SecretStore)
+	if !ok {
+		return "", "", v1.NewClientErrInvalidRequest("certificateFrom must reference a secretStore resource")
+	}
+
+	if secretStore.Properties.Type != datamodel.SecretTypeCert {
+		return "", "", v1.NewClientErrInvalidRequest("certificateFrom must reference a secretStore resource with type certificate")
+	}
+
+	if secretStore.Properties.Data["tls.crt"] == nil {
+		return "", "", v1.NewClientErrInvalidRequest("certificateFrom must reference a secretStore resource with tls.crt")
+	}
+
+	if secretStore.Properties.Data["tls.key"] == nil {
+		return "", "", v1.NewClientErrInvalidRequest("certificateFrom must reference a secretStore resource with tls.key")
+	}
+
+	// Get the name and namespace of the Kubernetes secret resource from the secretStore OutputResources
+	if secretStoreResource.OutputResources == nil {
+		return "", "", v1.NewClientErrInvalidRequest(fmt.Sprintf("secretStore resource %s not found", secretStoreResourceId))
+	}
+
+	secretResourceID, ok := secretStoreResource.OutputResources[rpv1.LocalIDSecret]
+	if !ok {
+		return "", "", v1.NewClientErrInvalidRequest(fmt.Sprintf("secretStore resource %s not found", secretStoreResourceId))
+	}
+
+	secretName := secretResourceID.Name()
+	secretNamespace := secretResourceID.FindScope(resources_kubernetes.ScopeNamespaces)
+	if secretNamespace == "" {
+		return "", "", v1.NewClientErrInvalidRequest(fmt.Sprintf("secretStore resource %s not found", secretStoreResourceId))
+	}
+
+	return secretName, secretNamespace, nil
+}
+
+// getRoutePort returns the port of the route destination: the port of the URL if the destination is a URL, otherwise
+// the port computed by the destination resource, and the default port if neither is set.
+func getRoutePort(options renderers.RenderOptions, route datamodel.GatewayRoute) (int32, error) {
+	if isURL(route.Destination) {
+		_, _, port, err := parseURL(route.Destination)
+		if err != nil {
+			return 0, err
+		}
+		return port, nil
+	}
+
+	port := renderers.DefaultPort
+	routePort, ok := options.Dependencies[route.Destination].ComputedValues["port"].(float64)
+	if ok {
+		port = int32(routePort)
+	}
+	return port, nil
+}
+
 func getRouteName(route *datamodel.GatewayRoute) (string, error) {
 	// if isURL, then name is hostname (DNS-SD case)
 	if isURL(route.Destination) {
@@ -474,6 +532,37 @@ func getHostname(resource datamodel.Gateway, gateway *datamodel.GatewayPropertie
 	return baseHostname, nil
 }
 
+// makeGatewayAddressURL returns the computed value of the public endpoint of a Gateway API gateway, resolved from the
+// address assigned to the deployed Gateway. The hostname is derived from the address as it is from the public endpoint
+// of the Contour gateways, e.g. prefix.appname.ip.nip.io.
+func makeGatewayAddressURL(resource datamodel.Gateway, applicationName string, options renderers.GatewayOptions, isHttps bool) rpv1.ComputedValueReference {
+	return rpv1.ComputedValueReference{
+		LocalID:           rpv1.LocalIDGateway,
+		PropertyReference: handlers.GatewayAddressKey,
+		Transformer: func(r v1.DataModelInterface, cv map[string]any) error {
+			address, _ := cv["url"].(string)
+			if address == "" {
+				cv["url"] = "unknown"
+				return nil
+			}
+
+			if net.ParseIP(address) != nil {
+				options.ExternalIP = address
+			} else {
+				options.Hostname = address
+			}
+
+			hostname, err := getHostname(resource, &resource.Properties, applicationName, options)
+			if err != nil {
+				return err
+			}
+
+			cv["url"] = getPublicEndpoint(hostname, options.Port, isHttps)
+			return nil
+		},
+	}
+}
+
 // getPublicEndpoint adds http:// or https:// and the port (if it exists) to the given hostname
 func getPublicEndpoint(hostname string, port string, isHttps bool) string {
 	authority := hostname
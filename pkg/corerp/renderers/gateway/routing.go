@@ -0,0 +1,241 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateway
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+
+	contourv1 "github.com/projectcontour/contour/apis/projectcontour/v1"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/renderers"
+	"github.com/radius-project/radius/pkg/kubernetes"
+)
+
+// methodHeaderName is the pseudo-header Envoy uses to match the HTTP method of the requests.
+const methodHeaderName = ":method"
+
+// routeRule is a set of gateway routes matching the same requests. The routes of a rule split the traffic between
+// their destinations according to their weights.
+type routeRule struct {
+	routes []datamodel.GatewayRoute
+}
+
+// primary returns the first route of the rule, which holds the match and the policies of the rule.
+func (r *routeRule) primary() datamodel.GatewayRoute {
+	return r.routes[0]
+}
+
+// groupRoutes groups the gateway routes matching the same requests into rules, in the order of the routes. The routes
+// of a rule must all set a weight and must share the same replacePrefix, timeoutPolicy and retryPolicy.
+func groupRoutes(routes []datamodel.GatewayRoute) ([]*routeRule, error) {
+	rules := []*routeRule{}
+	rulesByMatch := map[string]*routeRule{}
+	for _, route := range routes {
+		key := routeMatchKey(route)
+		if rule, ok := rulesByMatch[key]; ok {
+			rule.routes = append(rule.routes, route)
+			continue
+		}
+
+		rule := &routeRule{routes: []datamodel.GatewayRoute{route}}
+		rulesByMatch[key] = rule
+		rules = append(rules, rule)
+	}
+
+	for _, rule := range rules {
+		if len(rule.routes) == 1 {
+			continue
+		}
+
+		primary := rule.primary()
+		for _, route := range rule.routes {
+			if route.Weight == nil {
+				return nil, v1.NewClientErrInvalidRequest("routes matching the same requests must set a weight to split the traffic between their destinations")
+			}
+
+			if route.ReplacePrefix != primary.ReplacePrefix || !reflect.DeepEqual(route.TimeoutPolicy, primary.TimeoutPolicy) || !reflect.DeepEqual(route.RetryPolicy, primary.RetryPolicy) {
+				return nil, v1.NewClientErrInvalidRequest("routes splitting the traffic of the same requests must have the same replacePrefix, timeoutPolicy and retryPolicy")
+			}
+		}
+	}
+
+	return rules, nil
+}
+
+// routeMatchKey returns a key identifying the requests matched by the route.
+func routeMatchKey(route datamodel.GatewayRoute) string {
+	parts := []string{route.Path, route.Method}
+
+	headers := []string{}
+	for _, h := range route.Headers {
+		headers = append(headers, strings.ToLower(h.Name)+"="+h.Value)
+	}
+	sort.Strings(headers)
+
+	queryParameters := []string{}
+	for _, q := range route.QueryParameters {
+		queryParameters = append(queryParameters, q.Name+"="+q.Value)
+	}
+	sort.Strings(queryParameters)
+
+	parts = append(parts, strings.Join(headers, "&"), strings.Join(queryParameters, "&"))
+	return strings.Join(parts, "\n")
+}
+
+// validateSSLPassthrough validates the routes of a gateway with sslPassthrough enabled. The TLS connections are
+// forwarded as is to the only destination of the gateway, so the routes can't inspect or alter the requests.
+func validateSSLPassthrough(gateway *datamodel.Gateway) error {
+	if len(gateway.Properties.Routes) > 1 {
+		return v1.NewClientErrInvalidRequest("cannot support multiple routes with sslPassthrough set to true")
+	}
+
+	route := gateway.Properties.Routes[0]
+	if route.Path != "" || route.ReplacePrefix != "" {
+		return v1.NewClientErrInvalidRequest("cannot support `path` or `replacePrefix` in routes with sslPassthrough set to true")
+	}
+
+	if route.HasMatch() || route.Weight != nil || route.TimeoutPolicy != nil || route.RetryPolicy != nil {
+		return v1.NewClientErrInvalidRequest("cannot support `method`, `headers`, `queryParameters`, `weight`, `timeoutPolicy` or `retryPolicy` in routes with sslPassthrough set to true")
+	}
+
+	if gateway.Properties.CORS != nil {
+		return v1.NewClientErrInvalidRequest("cannot support `cors` with sslPassthrough set to true")
+	}
+
+	return nil
+}
+
+// makeMatchConditions creates the Contour conditions matching the method, headers and query parameters of the route.
+func makeMatchConditions(route datamodel.GatewayRoute) []contourv1.MatchCondition {
+	conditions := []contourv1.MatchCondition{}
+	if route.Method != "" {
+		conditions = append(conditions, contourv1.MatchCondition{
+			Header: &contourv1.HeaderMatchCondition{
+				Name:  methodHeaderName,
+				Exact: route.Method,
+			},
+		})
+	}
+
+	for _, h := range route.Headers {
+		conditions = append(conditions, contourv1.MatchCondition{
+			Header: &contourv1.HeaderMatchCondition{
+				Name:  h.Name,
+				Exact: h.Value,
+			},
+		})
+	}
+
+	for _, q := range route.QueryParameters {
+		conditions = append(conditions, contourv1.MatchCondition{
+			QueryParameter: &contourv1.QueryParameterMatchCondition{
+				Name:  q.Name,
+				Exact: q.Value,
+			},
+		})
+	}
+
+	return conditions
+}
+
+// makeRouteServices creates the Contour services of the destinations of the rule, weighted when the rule splits the
+// traffic between several destinations.
+func makeRouteServices(options renderers.RenderOptions, rule *routeRule) ([]contourv1.Service, error) {
+	services := []contourv1.Service{}
+	for _, route := range rule.routes {
+		port, err := getRoutePort(options, route)
+		if err != nil {
+			return nil, err
+		}
+
+		routeName, err := getRouteName(&route)
+		if err != nil {
+			return nil, err
+		}
+
+		service := contourv1.Service{
+			Name: kubernetes.NormalizeResourceName(routeName),
+			Port: int(port),
+		}
+		if route.Weight != nil {
+			service.Weight = int64(*route.Weight)
+		}
+		services = append(services, service)
+	}
+
+	return services, nil
+}
+
+// makeTimeoutPolicy creates the Contour timeout policy of a route.
+func makeTimeoutPolicy(policy *datamodel.GatewayRouteTimeoutPolicy) *contourv1.TimeoutPolicy {
+	if policy == nil {
+		return nil
+	}
+
+	return &contourv1.TimeoutPolicy{
+		Response: policy.Request,
+		Idle:     policy.Idle,
+	}
+}
+
+// makeRetryPolicy creates the Contour retry policy of a route.
+func makeRetryPolicy(policy *datamodel.GatewayRouteRetryPolicy) *contourv1.RetryPolicy {
+	if policy == nil {
+		return nil
+	}
+
+	retryPolicy := &contourv1.RetryPolicy{
+		NumRetries:    int64(policy.Attempts),
+		PerTryTimeout: policy.PerTryTimeout,
+	}
+	for _, retryOn := range policy.RetryOn {
+		retryPolicy.RetryOn = append(retryPolicy.RetryOn, contourv1.RetryOn(retryOn))
+	}
+	for _, code := range policy.RetriableStatusCodes {
+		retryPolicy.RetriableStatusCodes = append(retryPolicy.RetriableStatusCodes, uint32(code))
+	}
+
+	return retryPolicy
+}
+
+// makeCORSPolicy creates the Contour CORS policy of the virtual host of the gateway.
+func makeCORSPolicy(cors *datamodel.GatewayCORS) *contourv1.CORSPolicy {
+	if cors == nil {
+		return nil
+	}
+
+	policy := &contourv1.CORSPolicy{
+		AllowCredentials: cors.AllowCredentials,
+		AllowOrigin:      cors.AllowOrigins,
+		MaxAge:           cors.MaxAge,
+	}
+	for _, method := range cors.AllowMethods {
+		policy.AllowMethods = append(policy.AllowMethods, contourv1.CORSHeaderValue(method))
+	}
+	for _, header := range cors.AllowHeaders {
+		policy.AllowHeaders = append(policy.AllowHeaders, contourv1.CORSHeaderValue(header))
+	}
+	for _, header := range cors.ExposeHeaders {
+		policy.ExposeHeaders = append(policy.ExposeHeaders, contourv1.CORSHeaderValue(header))
+	}
+
+	return policy
+}
@@ -0,0 +1,215 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateway
+
+import (
+	"context"
+	"testing"
+
+	contourv1 "github.com/projectcontour/contour/apis/projectcontour/v1"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/renderers"
+	"github.com/radius-project/radius/pkg/kubernetes"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+)
+
+func Test_GroupRoutes(t *testing.T) {
+	t.Run("groups the routes matching the same requests", func(t *testing.T) {
+		routes := []datamodel.GatewayRoute{
+			{Destination: makeRouteResourceID("frontend"), Path: "/", Weight: to.Ptr(int32(90))},
+			{Destination: makeRouteResourceID("backend"), Path: "/api"},
+			{Destination: makeRouteResourceID("frontend-canary"), Path: "/", Weight: to.Ptr(int32(10))},
+			{Destination: makeRouteResourceID("backend-v2"), Path: "/api", Headers: []datamodel.GatewayRouteHeaderMatch{{Name: "x-version", Value: "v2"}}},
+		}
+
+		rules, err := groupRoutes(routes)
+		require.NoError(t, err)
+		require.Len(t, rules, 3)
+		require.Equal(t, []datamodel.GatewayRoute{routes[0], routes[2]}, rules[0].routes)
+		require.Equal(t, []datamodel.GatewayRoute{routes[1]}, rules[1].routes)
+		require.Equal(t, []datamodel.GatewayRoute{routes[3]}, rules[2].routes)
+	})
+
+	t.Run("header names are case insensitive", func(t *testing.T) {
+		routes := []datamodel.GatewayRoute{
+			{Destination: makeRouteResourceID("a"), Path: "/", Weight: to.Ptr(int32(1)), Headers: []datamodel.GatewayRouteHeaderMatch{{Name: "X-Version", Value: "v2"}}},
+			{Destination: makeRouteResourceID("b"), Path: "/", Weight: to.Ptr(int32(1)), Headers: []datamodel.GatewayRouteHeaderMatch{{Name: "x-version", Value: "v2"}}},
+		}
+
+		rules, err := groupRoutes(routes)
+		require.NoError(t, err)
+		require.Len(t, rules, 1)
+	})
+
+	t.Run("routes matching the same requests must set a weight", func(t *testing.T) {
+		_, err := groupRoutes([]datamodel.GatewayRoute{
+			{Destination: makeRouteResourceID("frontend"), Path: "/", Weight: to.Ptr(int32(90))},
+			{Destination: makeRouteResourceID("frontend-canary"), Path: "/"},
+		})
+		require.Error(t, err)
+		require.Equal(t, v1.CodeInvalid, err.(*v1.ErrClientRP).Code)
+		require.Equal(t, "routes matching the same requests must set a weight to split the traffic between their destinations", err.(*v1.ErrClientRP).Message)
+	})
+
+	t.Run("routes matching the same requests must share their policies", func(t *testing.T) {
+		_, err := groupRoutes([]datamodel.GatewayRoute{
+			{Destination: makeRouteResourceID("frontend"), Path: "/", Weight: to.Ptr(int32(90)), TimeoutPolicy: &datamodel.GatewayRouteTimeoutPolicy{Request: "30s"}},
+			{Destination: makeRouteResourceID("frontend-canary"), Path: "/", Weight: to.Ptr(int32(10))},
+		})
+		require.Error(t, err)
+		require.Equal(t, "routes splitting the traffic of the same requests must have the same replacePrefix, timeoutPolicy and retryPolicy", err.(*v1.ErrClientRP).Message)
+	})
+}
+
+func Test_Render_TrafficPolicies(t *testing.T) {
+	r := &Renderer{}
+
+	timeoutPolicy := &datamodel.GatewayRouteTimeoutPolicy{Request: "30s", Idle: "5m"}
+	retryPolicy := &datamodel.GatewayRouteRetryPolicy{
+		Attempts:             3,
+		PerTryTimeout:        "5s",
+		RetryOn:              []string{"retriable-status-codes"},
+		RetriableStatusCodes: []int32{503},
+	}
+	properties := datamodel.GatewayProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+		},
+		CORS: &datamodel.GatewayCORS{
+			AllowOrigins:     []string{"https://example.com"},
+			AllowMethods:     []string{"GET", "POST"},
+			AllowHeaders:     []string{"authorization"},
+			AllowCredentials: true,
+			MaxAge:           "10m",
+		},
+		Routes: []datamodel.GatewayRoute{
+			{Destination: makeRouteResourceID("frontend"), Path: "/", Weight: to.Ptr(int32(90)), TimeoutPolicy: timeoutPolicy, RetryPolicy: retryPolicy},
+			{Destination: makeRouteResourceID("frontend-canary"), Path: "/", Weight: to.Ptr(int32(10)), TimeoutPolicy: timeoutPolicy, RetryPolicy: retryPolicy},
+			{
+				Destination:     makeRouteResourceID("backend"),
+				Path:            "/api",
+				Method:          "POST",
+				Headers:         []datamodel.GatewayRouteHeaderMatch{{Name: "x-version", Value: "v2"}},
+				QueryParameters: []datamodel.GatewayRouteQueryParameterMatch{{Name: "debug", Value: "true"}},
+			},
+		},
+	}
+	resource := makeResource(t, properties)
+	dependencies := map[string]renderers.RendererDependency{
+		makeRouteResourceID("frontend-canary"): {
+			ComputedValues: map[string]any{
+				"port": float64(8080),
+			},
+		},
+	}
+	environmentOptions := getEnvironmentOptions("", testExternalIP, "", false, false)
+
+	output, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: dependencies, Environment: environmentOptions})
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 3)
+
+	rootProxy, _ := kubernetes.FindContourHTTPProxy(output.Resources)
+	require.Equal(t, &contourv1.CORSPolicy{
+		AllowCredentials: true,
+		AllowOrigin:      []string{"https://example.com"},
+		AllowMethods:     []contourv1.CORSHeaderValue{"GET", "POST"},
+		AllowHeaders:     []contourv1.CORSHeaderValue{"authorization"},
+		MaxAge:           "10m",
+	}, rootProxy.Spec.VirtualHost.CORSPolicy)
+	require.Equal(t, []contourv1.Include{
+		{
+			Name:       "frontend",
+			Conditions: []contourv1.MatchCondition{{Prefix: "/"}},
+		},
+		{
+			Name: "backend",
+			Conditions: []contourv1.MatchCondition{
+				{Prefix: "/api"},
+				{Header: &contourv1.HeaderMatchCondition{Name: methodHeaderName, Exact: "POST"}},
+				{Header: &contourv1.HeaderMatchCondition{Name: "x-version", Exact: "v2"}},
+				{QueryParameter: &contourv1.QueryParameterMatchCondition{Name: "debug", Exact: "true"}},
+			},
+		},
+	}, rootProxy.Spec.Includes)
+
+	frontend, _ := kubernetes.FindContourHTTPProxyByLocalID(output.Resources, rpv1.LocalIDHttpRoute+"-frontend")
+	require.Equal(t, []contourv1.Route{
+		{
+			Services: []contourv1.Service{
+				{Name: "frontend", Port: int(renderers.DefaultPort), Weight: 90},
+				{Name: "frontend-canary", Port: 8080, Weight: 10},
+			},
+			TimeoutPolicy: &contourv1.TimeoutPolicy{Response: "30s", Idle: "5m"},
+			RetryPolicy: &contourv1.RetryPolicy{
+				NumRetries:           3,
+				PerTryTimeout:        "5s",
+				RetryOn:              []contourv1.RetryOn{"retriable-status-codes"},
+				RetriableStatusCodes: []uint32{503},
+			},
+		},
+	}, frontend.Spec.Routes)
+
+	backend, _ := kubernetes.FindContourHTTPProxyByLocalID(output.Resources, rpv1.LocalIDHttpRoute+"-backend")
+	require.Equal(t, []contourv1.Route{
+		{
+			Services: []contourv1.Service{{Name: "backend", Port: int(renderers.DefaultPort)}},
+		},
+	}, backend.Spec.Routes)
+}
+
+func Test_Render_Fails_ConflictingPoliciesForSameDestination(t *testing.T) {
+	r := &Renderer{}
+
+	resource := makeResource(t, datamodel.GatewayProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+		},
+		Routes: []datamodel.GatewayRoute{
+			{Destination: makeRouteResourceID("frontend"), Path: "/", TimeoutPolicy: &datamodel.GatewayRouteTimeoutPolicy{Request: "30s"}},
+			{Destination: makeRouteResourceID("frontend"), Path: "/static"},
+		},
+	})
+
+	_, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}, Environment: getEnvironmentOptions("", testExternalIP, "", false, false)})
+	require.Error(t, err)
+	require.Equal(t, v1.CodeInvalid, err.(*v1.ErrClientRP).Code)
+	require.Equal(t, "routes to the destination "+makeRouteResourceID("frontend")+" must have the same weights, timeoutPolicy and retryPolicy", err.(*v1.ErrClientRP).Message)
+}
+
+func Test_Render_Fails_SSLPassthroughWithTrafficPolicies(t *testing.T) {
+	r := &Renderer{}
+
+	resource := makeResource(t, datamodel.GatewayProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+		},
+		TLS: &datamodel.GatewayPropertiesTLS{
+			SSLPassthrough: true,
+		},
+		Routes: []datamodel.GatewayRoute{
+			{Destination: makeRouteResourceID("frontend"), Method: "GET"},
+		},
+	})
+
+	_, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}, Environment: getEnvironmentOptions("", testExternalIP, "", false, false)})
+	require.Error(t, err)
+	require.Equal(t, "cannot support `method`, `headers`, `queryParameters`, `weight`, `timeoutPolicy` or `retryPolicy` in routes with sslPassthrough set to true", err.(*v1.ErrClientRP).Message)
+}
@@ -87,6 +87,11 @@ type GatewayOptions struct {
 	Hostname               string
 	Port                   string
 	ExternalIP             string
+
+	// Kind is the API used to expose the gateways of the environment: contour or gatewayAPI.
+	Kind string
+	// GatewayClassName is the name of the GatewayClass of the Gateway objects when Kind is gatewayAPI.
+	GatewayClassName string
 }
 
 type RendererOutput struct {
@@ -249,6 +249,16 @@ var operationList = []v1.Operation{
 		},
 		IsDataAction: false,
 	},
+	{
+		Name: "Applications.Core/extenders/plan/action",
+		Display: &v1.OperationDisplayProperties{
+			Provider:    "Applications.Core",
+			Resource:    "extenders",
+			Operation:   "Plan recipe",
+			Description: "Plans the recipe of a extender resource.",
+		},
+		IsDataAction: false,
+	},
 	{
 		Name: "Applications.Core/extenders/listsecrets/action",
 		Display: &v1.OperationDisplayProperties{
@@ -37,7 +37,10 @@ import (
 	rp_frontend "github.com/radius-project/radius/pkg/rp/frontend"
 
 	ext_processor "github.com/radius-project/radius/pkg/corerp/processors/extenders"
+	pr_api "github.com/radius-project/radius/pkg/portableresources/api"
 	pr_ctrl "github.com/radius-project/radius/pkg/portableresources/backend/controller"
+	pr_drift "github.com/radius-project/radius/pkg/portableresources/backend/drift"
+	pr_frontend "github.com/radius-project/radius/pkg/portableresources/frontend/controller"
 )
 
 const (
@@ -54,10 +57,14 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 		ResponseConverter: converter.EnvironmentDataModelToVersioned,
 
 		Put: builder.Operation[datamodel.Environment]{
-			APIController: env_ctrl.NewCreateOrUpdateEnvironment,
+			APIController: func(opt apictrl.Options) (apictrl.Controller, error) {
+				return env_ctrl.NewCreateOrUpdateEnvironment(opt, recipeControllerConfig.Engine)
+			},
 		},
 		Patch: builder.Operation[datamodel.Environment]{
-			APIController: env_ctrl.NewCreateOrUpdateEnvironment,
+			APIController: func(opt apictrl.Options) (apictrl.Controller, error) {
+				return env_ctrl.NewCreateOrUpdateEnvironment(opt, recipeControllerConfig.Engine)
+			},
 		},
 		Custom: map[string]builder.Operation[datamodel.Environment]{
 			"getmetadata": {
@@ -254,6 +261,16 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 			"listsecrets": {
 				APIController: ext_ctrl.NewListSecretsExtender,
 			},
+			"plan": {
+				APIController: func(opt apictrl.Options) (apictrl.Controller, error) {
+					return pr_frontend.NewPlanResource[*datamodel.Extender, datamodel.Extender](opt, apictrl.ResourceOptions[datamodel.Extender]{
+						RequestConverter: converter.ExtenderDataModelFromVersioned,
+						UpdateFilters: []apictrl.UpdateFilter[datamodel.Extender]{
+							rp_frontend.PrepareRadiusResource[*datamodel.Extender],
+						},
+					}, recipeControllerConfig.Engine, pr_api.RecipePlanDataModelToVersioned)
+				},
+			},
 		},
 	})
 
@@ -262,3 +279,11 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 
 	return ns
 }
+
+// SetupDriftCheckers returns the drift checkers of the core resource types deployed by recipes.
+func SetupDriftCheckers(options pr_drift.CheckerOptions) []pr_drift.ResourceChecker {
+	config := options.RecipeControllerConfig
+	return []pr_drift.ResourceChecker{
+		pr_drift.NewChecker[*datamodel.Extender, datamodel.Extender](datamodel.ExtenderResourceType, config.Engine, config.ConfigLoader, options.StatusManager, ext_ctrl.AsyncCreateOrUpdateExtenderTimeout),
+	}
+}
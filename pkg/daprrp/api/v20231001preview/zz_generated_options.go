@@ -42,6 +42,11 @@ type PubSubBrokersClientListByScopeOptions struct {
 	// placeholder for future optional parameters
 }
 
+// PubSubBrokersClientPlanOptions contains the optional parameters for the PubSubBrokersClient.Plan method.
+type PubSubBrokersClientPlanOptions struct {
+	// placeholder for future optional parameters
+}
+
 // SecretStoresClientBeginCreateOrUpdateOptions contains the optional parameters for the SecretStoresClient.BeginCreateOrUpdate
 // method.
 type SecretStoresClientBeginCreateOrUpdateOptions struct {
@@ -71,6 +76,11 @@ type SecretStoresClientListByScopeOptions struct {
 	// placeholder for future optional parameters
 }
 
+// SecretStoresClientPlanOptions contains the optional parameters for the SecretStoresClient.Plan method.
+type SecretStoresClientPlanOptions struct {
+	// placeholder for future optional parameters
+}
+
 // StateStoresClientBeginCreateOrUpdateOptions contains the optional parameters for the StateStoresClient.BeginCreateOrUpdate
 // method.
 type StateStoresClientBeginCreateOrUpdateOptions struct {
@@ -100,3 +110,8 @@ type StateStoresClientListByScopeOptions struct {
 	// placeholder for future optional parameters
 }
 
+// StateStoresClientPlanOptions contains the optional parameters for the StateStoresClient.Plan method.
+type StateStoresClientPlanOptions struct {
+	// placeholder for future optional parameters
+}
+
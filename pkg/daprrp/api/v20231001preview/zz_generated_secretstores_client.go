@@ -281,6 +281,63 @@ func (client *SecretStoresClient) listByScopeHandleResponse(resp *http.Response)
 	return result, nil
 }
 
+// Plan - Plans the recipe of the specified DaprSecretStore resource and returns the changes that its deployment would make, without
+// deploying it
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - secretStoreName - SecretStore name
+//   - body - The content of the action request
+//   - options - SecretStoresClientPlanOptions contains the optional parameters for the SecretStoresClient.Plan method.
+func (client *SecretStoresClient) Plan(ctx context.Context, secretStoreName string, body DaprSecretStoreResource, options *SecretStoresClientPlanOptions) (SecretStoresClientPlanResponse, error) {
+	var err error
+	req, err := client.planCreateRequest(ctx, secretStoreName, body, options)
+	if err != nil {
+		return SecretStoresClientPlanResponse{}, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return SecretStoresClientPlanResponse{}, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK) {
+		err = runtime.NewResponseError(httpResp)
+		return SecretStoresClientPlanResponse{}, err
+	}
+	resp, err := client.planHandleResponse(httpResp)
+	return resp, err
+}
+
+// planCreateRequest creates the Plan request.
+func (client *SecretStoresClient) planCreateRequest(ctx context.Context, secretStoreName string, body DaprSecretStoreResource, options *SecretStoresClientPlanOptions) (*policy.Request, error) {
+	urlPath := "/{rootScope}/providers/Applications.Dapr/secretStores/{secretStoreName}/plan"
+	urlPath = strings.ReplaceAll(urlPath, "{rootScope}", client.rootScope)
+	if secretStoreName == "" {
+		return nil, errors.New("parameter secretStoreName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{secretStoreName}", url.PathEscape(secretStoreName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	if err := runtime.MarshalAsJSON(req, body); err != nil {
+	return nil, err
+}
+	return req, nil
+}
+
+// planHandleResponse handles the Plan response.
+func (client *SecretStoresClient) planHandleResponse(resp *http.Response) (SecretStoresClientPlanResponse, error) {
+	result := SecretStoresClientPlanResponse{}
+	if err := runtime.UnmarshalAsJSON(resp, &result.RecipePlanResult); err != nil {
+		return SecretStoresClientPlanResponse{}, err
+	}
+	return result, nil
+}
+
 // BeginUpdate - Update a DaprSecretStoreResource
 // If the operation fails it returns an *azcore.ResponseError type.
 //
@@ -280,6 +280,63 @@ func (client *StateStoresClient) listByScopeHandleResponse(resp *http.Response)
 	return result, nil
 }
 
+// Plan - Plans the recipe of the specified DaprStateStore resource and returns the changes that its deployment would make, without
+// deploying it
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - stateStoreName - StateStore name
+//   - body - The content of the action request
+//   - options - StateStoresClientPlanOptions contains the optional parameters for the StateStoresClient.Plan method.
+func (client *StateStoresClient) Plan(ctx context.Context, stateStoreName string, body DaprStateStoreResource, options *StateStoresClientPlanOptions) (StateStoresClientPlanResponse, error) {
+	var err error
+	req, err := client.planCreateRequest(ctx, stateStoreName, body, options)
+	if err != nil {
+		return StateStoresClientPlanResponse{}, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return StateStoresClientPlanResponse{}, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK) {
+		err = runtime.NewResponseError(httpResp)
+		return StateStoresClientPlanResponse{}, err
+	}
+	resp, err := client.planHandleResponse(httpResp)
+	return resp, err
+}
+
+// planCreateRequest creates the Plan request.
+func (client *StateStoresClient) planCreateRequest(ctx context.Context, stateStoreName string, body DaprStateStoreResource, options *StateStoresClientPlanOptions) (*policy.Request, error) {
+	urlPath := "/{rootScope}/providers/Applications.Dapr/stateStores/{stateStoreName}/plan"
+	urlPath = strings.ReplaceAll(urlPath, "{rootScope}", client.rootScope)
+	if stateStoreName == "" {
+		return nil, errors.New("parameter stateStoreName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{stateStoreName}", url.PathEscape(stateStoreName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	if err := runtime.MarshalAsJSON(req, body); err != nil {
+	return nil, err
+}
+	return req, nil
+}
+
+// planHandleResponse handles the Plan response.
+func (client *StateStoresClient) planHandleResponse(resp *http.Response) (StateStoresClientPlanResponse, error) {
+	result := StateStoresClientPlanResponse{}
+	if err := runtime.UnmarshalAsJSON(resp, &result.RecipePlanResult); err != nil {
+		return StateStoresClientPlanResponse{}, err
+	}
+	return result, nil
+}
+
 // BeginUpdate - Update a DaprStateStoreResource
 // If the operation fails it returns an *azcore.ResponseError type.
 //
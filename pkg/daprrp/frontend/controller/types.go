@@ -21,6 +21,9 @@ import (
 )
 
 const (
+	// User defined operation names
+	OperationPlan = "PLAN"
+
 	// DaprStateStoresResourceType represents the resource type for Dapr State stores.
 	DaprStateStoresResourceType = "Applications.Dapr/stateStores"
 	// AsyncCreateOrUpdateDaprStateStoreTimeout is the timeout for async create or update dapr state store
@@ -26,7 +26,9 @@ import (
 	"github.com/radius-project/radius/pkg/portableresources"
 	"github.com/radius-project/radius/pkg/portableresources/handlers"
 	"github.com/radius-project/radius/pkg/portableresources/processors"
+	"github.com/radius-project/radius/pkg/portableresources/renderers"
 	"github.com/radius-project/radius/pkg/portableresources/renderers/dapr"
+	"github.com/radius-project/radius/pkg/recipes"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/pkg/ucp/resources"
@@ -36,6 +38,13 @@ import (
 	runtime_client "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// OutputContract is the contract of the outputs of the recipes of Applications.Dapr/secretStores resources.
+var OutputContract = recipes.OutputContract{
+	Fields: []recipes.OutputField{
+		{Name: renderers.ComponentNameKey, Type: recipes.OutputTypeString},
+	},
+}
+
 type Processor struct {
 	Client runtime_client.Client
 }
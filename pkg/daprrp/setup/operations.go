@@ -79,6 +79,16 @@ var operationList = []v1.Operation{
 		},
 		IsDataAction: false,
 	},
+	{
+		Name: "Applications.Dapr/secretStores/plan/action",
+		Display: &v1.OperationDisplayProperties{
+			Provider:    "Applications.Dapr",
+			Resource:    "secretStores",
+			Operation:   "Plan recipe",
+			Description: "Plans the recipe of a Dapr secretStore resource.",
+		},
+		IsDataAction: false,
+	},
 	{
 		Name: "Applications.Dapr/stateStores/read",
 		Display: &v1.OperationDisplayProperties{
@@ -109,6 +119,16 @@ var operationList = []v1.Operation{
 		},
 		IsDataAction: false,
 	},
+	{
+		Name: "Applications.Dapr/stateStores/plan/action",
+		Display: &v1.OperationDisplayProperties{
+			Provider:    "Applications.Dapr",
+			Resource:    "stateStores",
+			Operation:   "Plan recipe",
+			Description: "Plans the recipe of a Dapr stateStore resource.",
+		},
+		IsDataAction: false,
+	},
 	{
 		Name: "Applications.Dapr/pubSubBrokers/read",
 		Display: &v1.OperationDisplayProperties{
@@ -139,4 +159,14 @@ var operationList = []v1.Operation{
 		},
 		IsDataAction: false,
 	},
+	{
+		Name: "Applications.Dapr/pubSubBrokers/plan/action",
+		Display: &v1.OperationDisplayProperties{
+			Provider:    "Applications.Dapr",
+			Resource:    "pubSubBrokers",
+			Operation:   "Plan recipe",
+			Description: "Plans the recipe of a Dapr pubSubBroker resource.",
+		},
+		IsDataAction: false,
+	},
 }
@@ -30,7 +30,10 @@ import (
 	pubsub_proc "github.com/radius-project/radius/pkg/daprrp/processors/pubsubbrokers"
 	secretstore_proc "github.com/radius-project/radius/pkg/daprrp/processors/secretstores"
 	statestore_proc "github.com/radius-project/radius/pkg/daprrp/processors/statestores"
+	pr_api "github.com/radius-project/radius/pkg/portableresources/api"
 	pr_ctrl "github.com/radius-project/radius/pkg/portableresources/backend/controller"
+	pr_drift "github.com/radius-project/radius/pkg/portableresources/backend/drift"
+	pr_frontend "github.com/radius-project/radius/pkg/portableresources/frontend/controller"
 	rp_frontend "github.com/radius-project/radius/pkg/rp/frontend"
 )
 
@@ -74,6 +77,18 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 			AsyncOperationTimeout:    dapr_ctrl.AsyncDeleteDaprPubSubBrokerTimeout,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
 		},
+		Custom: map[string]builder.Operation[datamodel.DaprPubSubBroker]{
+			"plan": {
+				APIController: func(opt apictrl.Options) (apictrl.Controller, error) {
+					return pr_frontend.NewPlanResource[*datamodel.DaprPubSubBroker, datamodel.DaprPubSubBroker](opt, apictrl.ResourceOptions[datamodel.DaprPubSubBroker]{
+						RequestConverter: converter.PubSubBrokerDataModelFromVersioned,
+						UpdateFilters: []apictrl.UpdateFilter[datamodel.DaprPubSubBroker]{
+							rp_frontend.PrepareRadiusResource[*datamodel.DaprPubSubBroker],
+						},
+					}, recipeControllerConfig.Engine, pr_api.RecipePlanDataModelToVersioned)
+				},
+			},
+		},
 	})
 
 	_ = ns.AddResource("stateStores", &builder.ResourceOption[*datamodel.DaprStateStore, datamodel.DaprStateStore]{
@@ -107,6 +122,18 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 			AsyncOperationTimeout:    dapr_ctrl.AsyncDeleteDaprStateStoreTimeout,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
 		},
+		Custom: map[string]builder.Operation[datamodel.DaprStateStore]{
+			"plan": {
+				APIController: func(opt apictrl.Options) (apictrl.Controller, error) {
+					return pr_frontend.NewPlanResource[*datamodel.DaprStateStore, datamodel.DaprStateStore](opt, apictrl.ResourceOptions[datamodel.DaprStateStore]{
+						RequestConverter: converter.StateStoreDataModelFromVersioned,
+						UpdateFilters: []apictrl.UpdateFilter[datamodel.DaprStateStore]{
+							rp_frontend.PrepareRadiusResource[*datamodel.DaprStateStore],
+						},
+					}, recipeControllerConfig.Engine, pr_api.RecipePlanDataModelToVersioned)
+				},
+			},
+		},
 	})
 
 	_ = ns.AddResource("secretStores", &builder.ResourceOption[*datamodel.DaprSecretStore, datamodel.DaprSecretStore]{
@@ -140,6 +167,18 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 			AsyncOperationTimeout:    dapr_ctrl.AsyncDeleteDaprSecretStoreTimeout,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
 		},
+		Custom: map[string]builder.Operation[datamodel.DaprSecretStore]{
+			"plan": {
+				APIController: func(opt apictrl.Options) (apictrl.Controller, error) {
+					return pr_frontend.NewPlanResource[*datamodel.DaprSecretStore, datamodel.DaprSecretStore](opt, apictrl.ResourceOptions[datamodel.DaprSecretStore]{
+						RequestConverter: converter.SecretStoreDataModelFromVersioned,
+						UpdateFilters: []apictrl.UpdateFilter[datamodel.DaprSecretStore]{
+							rp_frontend.PrepareRadiusResource[*datamodel.DaprSecretStore],
+						},
+					}, recipeControllerConfig.Engine, pr_api.RecipePlanDataModelToVersioned)
+				},
+			},
+		},
 	})
 
 	// Optional
@@ -147,3 +186,13 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 
 	return ns
 }
+
+// SetupDriftCheckers returns the drift checkers of the Dapr resource types deployed by recipes.
+func SetupDriftCheckers(options pr_drift.CheckerOptions) []pr_drift.ResourceChecker {
+	config := options.RecipeControllerConfig
+	return []pr_drift.ResourceChecker{
+		pr_drift.NewChecker[*datamodel.DaprPubSubBroker, datamodel.DaprPubSubBroker](dapr_ctrl.DaprPubSubBrokersResourceType, config.Engine, config.ConfigLoader, options.StatusManager, dapr_ctrl.AsyncCreateOrUpdateDaprPubSubBrokerTimeout),
+		pr_drift.NewChecker[*datamodel.DaprStateStore, datamodel.DaprStateStore](dapr_ctrl.DaprStateStoresResourceType, config.Engine, config.ConfigLoader, options.StatusManager, dapr_ctrl.AsyncCreateOrUpdateDaprStateStoreTimeout),
+		pr_drift.NewChecker[*datamodel.DaprSecretStore, datamodel.DaprSecretStore](dapr_ctrl.DaprSecretStoresResourceType, config.Engine, config.ConfigLoader, options.StatusManager, dapr_ctrl.AsyncCreateOrUpdateDaprSecretStoreTimeout),
+	}
+}
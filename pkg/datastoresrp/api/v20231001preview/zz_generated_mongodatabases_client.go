@@ -338,6 +338,63 @@ func (client *MongoDatabasesClient) listSecretsHandleResponse(resp *http.Respons
 	return result, nil
 }
 
+// Plan - Plans the recipe of the specified MongoDatabase resource and returns the changes that its deployment would make, without
+// deploying it
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - mongoDatabaseName - The name of the MongoDatabase portable resource resource
+//   - body - The content of the action request
+//   - options - MongoDatabasesClientPlanOptions contains the optional parameters for the MongoDatabasesClient.Plan method.
+func (client *MongoDatabasesClient) Plan(ctx context.Context, mongoDatabaseName string, body MongoDatabaseResource, options *MongoDatabasesClientPlanOptions) (MongoDatabasesClientPlanResponse, error) {
+	var err error
+	req, err := client.planCreateRequest(ctx, mongoDatabaseName, body, options)
+	if err != nil {
+		return MongoDatabasesClientPlanResponse{}, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return MongoDatabasesClientPlanResponse{}, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK) {
+		err = runtime.NewResponseError(httpResp)
+		return MongoDatabasesClientPlanResponse{}, err
+	}
+	resp, err := client.planHandleResponse(httpResp)
+	return resp, err
+}
+
+// planCreateRequest creates the Plan request.
+func (client *MongoDatabasesClient) planCreateRequest(ctx context.Context, mongoDatabaseName string, body MongoDatabaseResource, options *MongoDatabasesClientPlanOptions) (*policy.Request, error) {
+	urlPath := "/{rootScope}/providers/Applications.Datastores/mongoDatabases/{mongoDatabaseName}/plan"
+	urlPath = strings.ReplaceAll(urlPath, "{rootScope}", client.rootScope)
+	if mongoDatabaseName == "" {
+		return nil, errors.New("parameter mongoDatabaseName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{mongoDatabaseName}", url.PathEscape(mongoDatabaseName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	if err := runtime.MarshalAsJSON(req, body); err != nil {
+	return nil, err
+}
+	return req, nil
+}
+
+// planHandleResponse handles the Plan response.
+func (client *MongoDatabasesClient) planHandleResponse(resp *http.Response) (MongoDatabasesClientPlanResponse, error) {
+	result := MongoDatabasesClientPlanResponse{}
+	if err := runtime.UnmarshalAsJSON(resp, &result.RecipePlanResult); err != nil {
+		return MongoDatabasesClientPlanResponse{}, err
+	}
+	return result, nil
+}
+
 // BeginUpdate - Update a MongoDatabaseResource
 // If the operation fails it returns an *azcore.ResponseError type.
 //
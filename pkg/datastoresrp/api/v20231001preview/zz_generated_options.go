@@ -42,6 +42,11 @@ type MongoDatabasesClientListSecretsOptions struct {
 	// placeholder for future optional parameters
 }
 
+// MongoDatabasesClientPlanOptions contains the optional parameters for the MongoDatabasesClient.Plan method.
+type MongoDatabasesClientPlanOptions struct {
+	// placeholder for future optional parameters
+}
+
 // OperationsClientListOptions contains the optional parameters for the OperationsClient.NewListPager method.
 type OperationsClientListOptions struct {
 	// placeholder for future optional parameters
@@ -81,6 +86,11 @@ type RedisCachesClientListSecretsOptions struct {
 	// placeholder for future optional parameters
 }
 
+// RedisCachesClientPlanOptions contains the optional parameters for the RedisCachesClient.Plan method.
+type RedisCachesClientPlanOptions struct {
+	// placeholder for future optional parameters
+}
+
 // SQLDatabasesClientBeginCreateOrUpdateOptions contains the optional parameters for the SQLDatabasesClient.BeginCreateOrUpdate
 // method.
 type SQLDatabasesClientBeginCreateOrUpdateOptions struct {
@@ -115,3 +125,8 @@ type SQLDatabasesClientListSecretsOptions struct {
 	// placeholder for future optional parameters
 }
 
+// SQLDatabasesClientPlanOptions contains the optional parameters for the SQLDatabasesClient.Plan method.
+type SQLDatabasesClientPlanOptions struct {
+	// placeholder for future optional parameters
+}
+
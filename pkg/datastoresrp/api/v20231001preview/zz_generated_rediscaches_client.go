@@ -336,6 +336,63 @@ func (client *RedisCachesClient) listSecretsHandleResponse(resp *http.Response)
 	return result, nil
 }
 
+// Plan - Plans the recipe of the specified RedisCache resource and returns the changes that its deployment would make, without
+// deploying it
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - redisCacheName - The name of the RedisCache portable resource resource
+//   - body - The content of the action request
+//   - options - RedisCachesClientPlanOptions contains the optional parameters for the RedisCachesClient.Plan method.
+func (client *RedisCachesClient) Plan(ctx context.Context, redisCacheName string, body RedisCacheResource, options *RedisCachesClientPlanOptions) (RedisCachesClientPlanResponse, error) {
+	var err error
+	req, err := client.planCreateRequest(ctx, redisCacheName, body, options)
+	if err != nil {
+		return RedisCachesClientPlanResponse{}, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return RedisCachesClientPlanResponse{}, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK) {
+		err = runtime.NewResponseError(httpResp)
+		return RedisCachesClientPlanResponse{}, err
+	}
+	resp, err := client.planHandleResponse(httpResp)
+	return resp, err
+}
+
+// planCreateRequest creates the Plan request.
+func (client *RedisCachesClient) planCreateRequest(ctx context.Context, redisCacheName string, body RedisCacheResource, options *RedisCachesClientPlanOptions) (*policy.Request, error) {
+	urlPath := "/{rootScope}/providers/Applications.Datastores/redisCaches/{redisCacheName}/plan"
+	urlPath = strings.ReplaceAll(urlPath, "{rootScope}", client.rootScope)
+	if redisCacheName == "" {
+		return nil, errors.New("parameter redisCacheName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{redisCacheName}", url.PathEscape(redisCacheName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	if err := runtime.MarshalAsJSON(req, body); err != nil {
+	return nil, err
+}
+	return req, nil
+}
+
+// planHandleResponse handles the Plan response.
+func (client *RedisCachesClient) planHandleResponse(resp *http.Response) (RedisCachesClientPlanResponse, error) {
+	result := RedisCachesClientPlanResponse{}
+	if err := runtime.UnmarshalAsJSON(resp, &result.RecipePlanResult); err != nil {
+		return RedisCachesClientPlanResponse{}, err
+	}
+	return result, nil
+}
+
 // BeginUpdate - Update a RedisCacheResource
 // If the operation fails it returns an *azcore.ResponseError type.
 //
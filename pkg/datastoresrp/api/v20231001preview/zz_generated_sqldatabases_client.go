@@ -338,6 +338,63 @@ func (client *SQLDatabasesClient) listSecretsHandleResponse(resp *http.Response)
 	return result, nil
 }
 
+// Plan - Plans the recipe of the specified SqlDatabase resource and returns the changes that its deployment would make, without
+// deploying it
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - sqlDatabaseName - The name of the SqlDatabase portable resource resource
+//   - body - The content of the action request
+//   - options - SQLDatabasesClientPlanOptions contains the optional parameters for the SQLDatabasesClient.Plan method.
+func (client *SQLDatabasesClient) Plan(ctx context.Context, sqlDatabaseName string, body SQLDatabaseResource, options *SQLDatabasesClientPlanOptions) (SQLDatabasesClientPlanResponse, error) {
+	var err error
+	req, err := client.planCreateRequest(ctx, sqlDatabaseName, body, options)
+	if err != nil {
+		return SQLDatabasesClientPlanResponse{}, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return SQLDatabasesClientPlanResponse{}, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK) {
+		err = runtime.NewResponseError(httpResp)
+		return SQLDatabasesClientPlanResponse{}, err
+	}
+	resp, err := client.planHandleResponse(httpResp)
+	return resp, err
+}
+
+// planCreateRequest creates the Plan request.
+func (client *SQLDatabasesClient) planCreateRequest(ctx context.Context, sqlDatabaseName string, body SQLDatabaseResource, options *SQLDatabasesClientPlanOptions) (*policy.Request, error) {
+	urlPath := "/{rootScope}/providers/Applications.Datastores/sqlDatabases/{sqlDatabaseName}/plan"
+	urlPath = strings.ReplaceAll(urlPath, "{rootScope}", client.rootScope)
+	if sqlDatabaseName == "" {
+		return nil, errors.New("parameter sqlDatabaseName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{sqlDatabaseName}", url.PathEscape(sqlDatabaseName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	if err := runtime.MarshalAsJSON(req, body); err != nil {
+	return nil, err
+}
+	return req, nil
+}
+
+// planHandleResponse handles the Plan response.
+func (client *SQLDatabasesClient) planHandleResponse(resp *http.Response) (SQLDatabasesClientPlanResponse, error) {
+	result := SQLDatabasesClientPlanResponse{}
+	if err := runtime.UnmarshalAsJSON(resp, &result.RecipePlanResult); err != nil {
+		return SQLDatabasesClientPlanResponse{}, err
+	}
+	return result, nil
+}
+
 // BeginUpdate - Update a SqlDatabaseResource
 // If the operation fails it returns an *azcore.ResponseError type.
 //
@@ -23,6 +23,7 @@ import (
 const (
 	// User defined operation names
 	OperationListSecret = "LISTSECRETS"
+	OperationPlan       = "PLAN"
 
 	// MongoDatabasesResourceType represents the resource type for Mongo database.
 	MongoDatabasesResourceType = "Applications.Datastores/mongoDatabases"
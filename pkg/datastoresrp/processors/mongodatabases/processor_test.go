@@ -234,4 +234,48 @@ the connection value "host" should be provided by the recipe, set '.properties.h
 the connection value "port" should be provided by the recipe, set '.properties.port' to provide a value manually
 the connection value "database" should be provided by the recipe, set '.properties.database' to provide a value manually`, err.Error())
 	})
+
+	t.Run("success - recipe outputs completed by the resource properties", func(t *testing.T) {
+		resource := &datamodel.MongoDatabase{
+			Properties: datamodel.MongoDatabaseProperties{
+				Host:     host,
+				Port:     port,
+				Database: database,
+			},
+		}
+		options := processors.Options{
+			RecipeOutput: &recipes.RecipeOutput{
+				Resources: []string{azureMongoResourceID1},
+				Values:    map[string]any{"username": username},
+				Secrets:   map[string]any{"password": password},
+			},
+		}
+
+		properties := map[string]any{"host": host, "port": float64(port), "database": database}
+		require.NoError(t, OutputContract.Validate(options.RecipeOutput, properties))
+
+		err := processor.Process(context.Background(), resource, options)
+		require.NoError(t, err)
+		require.Equal(t, host, resource.Properties.Host)
+		require.Equal(t, int32(port), resource.Properties.Port)
+		require.Equal(t, database, resource.Properties.Database)
+	})
+
+	t.Run("failure - required recipe outputs not set in the resource properties", func(t *testing.T) {
+		output := &recipes.RecipeOutput{
+			Values:  map[string]any{"username": username},
+			Secrets: map[string]any{"password": password},
+		}
+
+		err := OutputContract.Validate(output, map[string]any{})
+		recipeError, ok := err.(*recipes.RecipeError)
+		require.True(t, ok)
+		require.Equal(t, recipes.RecipeOutputContractViolation, recipeError.ErrorDetails.Code)
+
+		targets := []string{}
+		for _, detail := range recipeError.ErrorDetails.Details {
+			targets = append(targets, detail.Target)
+		}
+		require.Equal(t, []string{"values.host", "values.port", "values.database"}, targets)
+	})
 }
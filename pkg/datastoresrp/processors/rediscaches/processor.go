@@ -23,6 +23,7 @@ import (
 	"github.com/radius-project/radius/pkg/datastoresrp/datamodel"
 	"github.com/radius-project/radius/pkg/portableresources/processors"
 	"github.com/radius-project/radius/pkg/portableresources/renderers"
+	"github.com/radius-project/radius/pkg/recipes"
 )
 
 const (
@@ -33,6 +34,19 @@ const (
 	RedisSSLPort = 6380
 )
 
+// OutputContract is the contract of the outputs of the recipes of Applications.Datastores/redisCaches resources.
+var OutputContract = recipes.OutputContract{
+	Fields: []recipes.OutputField{
+		{Name: renderers.Host, Type: recipes.OutputTypeString, Required: true, Property: renderers.Host},
+		{Name: renderers.Port, Type: recipes.OutputTypeInteger, Required: true, Property: renderers.Port},
+		{Name: renderers.UsernameStringValue, Type: recipes.OutputTypeString},
+		{Name: renderers.TLS, Type: recipes.OutputTypeBoolean},
+		{Name: renderers.PasswordStringHolder, Secret: true},
+		{Name: renderers.ConnectionStringValue, Secret: true},
+		{Name: renderers.ConnectionURIValue, Secret: true},
+	},
+}
+
 // Processor is a processor for RedisCache resources.
 type Processor struct {
 }
@@ -12,8 +12,21 @@ import (
 	"github.com/radius-project/radius/pkg/datastoresrp/datamodel"
 	"github.com/radius-project/radius/pkg/portableresources/processors"
 	"github.com/radius-project/radius/pkg/portableresources/renderers"
+	"github.com/radius-project/radius/pkg/recipes"
 )
 
+// OutputContract is the contract of the outputs of the recipes of Applications.Datastores/sqlDatabases resources.
+var OutputContract = recipes.OutputContract{
+	Fields: []recipes.OutputField{
+		{Name: renderers.DatabaseNameValue, Type: recipes.OutputTypeString, Required: true, Property: renderers.DatabaseNameValue},
+		{Name: renderers.ServerNameValue, Type: recipes.OutputTypeString, Required: true, Property: renderers.ServerNameValue},
+		{Name: renderers.Port, Type: recipes.OutputTypeInteger, Required: true, Property: renderers.Port},
+		{Name: renderers.UsernameStringValue, Type: recipes.OutputTypeString},
+		{Name: renderers.PasswordStringHolder, Secret: true},
+		{Name: renderers.ConnectionStringValue, Secret: true},
+	},
+}
+
 // Processor is a processor for SQL database resources.
 type Processor struct {
 }
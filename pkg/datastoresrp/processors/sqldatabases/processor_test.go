@@ -221,4 +221,48 @@ the connection value "server" should be provided by the recipe, set '.properties
 the connection value "port" should be provided by the recipe, set '.properties.port' to provide a value manually`, err.Error())
 
 	})
+
+	t.Run("success - recipe outputs completed by the resource properties", func(t *testing.T) {
+		resource := &datamodel.SqlDatabase{
+			Properties: datamodel.SqlDatabaseProperties{
+				Server:   server,
+				Database: database,
+				Port:     port,
+			},
+		}
+		options := processors.Options{
+			RecipeOutput: &recipes.RecipeOutput{
+				Resources: []string{azureSqlResourceID},
+				Values:    map[string]any{"username": username},
+				Secrets:   map[string]any{"password": password},
+			},
+		}
+
+		properties := map[string]any{"database": database, "server": server, "port": float64(port)}
+		require.NoError(t, OutputContract.Validate(options.RecipeOutput, properties))
+
+		err := processor.Process(context.Background(), resource, options)
+		require.NoError(t, err)
+		require.Equal(t, server, resource.Properties.Server)
+		require.Equal(t, database, resource.Properties.Database)
+		require.Equal(t, int32(port), resource.Properties.Port)
+	})
+
+	t.Run("failure - required recipe outputs not set in the resource properties", func(t *testing.T) {
+		output := &recipes.RecipeOutput{
+			Values:  map[string]any{"username": username},
+			Secrets: map[string]any{"password": password},
+		}
+
+		err := OutputContract.Validate(output, map[string]any{})
+		recipeError, ok := err.(*recipes.RecipeError)
+		require.True(t, ok)
+		require.Equal(t, recipes.RecipeOutputContractViolation, recipeError.ErrorDetails.Code)
+
+		targets := []string{}
+		for _, detail := range recipeError.ErrorDetails.Details {
+			targets = append(targets, detail.Target)
+		}
+		require.Equal(t, []string{"values.database", "values.server", "values.port"}, targets)
+	})
 }
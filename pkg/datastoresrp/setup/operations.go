@@ -59,6 +59,16 @@ var operationList = []v1.Operation{
 		},
 		IsDataAction: false,
 	},
+	{
+		Name: "Applications.Datastores/redisCaches/plan/action",
+		Display: &v1.OperationDisplayProperties{
+			Provider:    "Applications.Datastores",
+			Resource:    "redisCaches",
+			Operation:   "Plan recipe",
+			Description: "Plans the recipe of a Redis cache resource.",
+		},
+		IsDataAction: false,
+	},
 	{
 		Name: "Applications.Datastores/redisCaches/listsecrets/action",
 		Display: &v1.OperationDisplayProperties{
@@ -119,6 +129,16 @@ var operationList = []v1.Operation{
 		},
 		IsDataAction: false,
 	},
+	{
+		Name: "Applications.Datastores/mongoDatabases/plan/action",
+		Display: &v1.OperationDisplayProperties{
+			Provider:    "Applications.Datastores",
+			Resource:    "mongoDatabases",
+			Operation:   "Plan recipe",
+			Description: "Plans the recipe of a Mongo database resource.",
+		},
+		IsDataAction: false,
+	},
 	{
 		Name: "Applications.Datastores/mongoDatabases/listsecrets/action",
 		Display: &v1.OperationDisplayProperties{
@@ -159,4 +179,14 @@ var operationList = []v1.Operation{
 		},
 		IsDataAction: false,
 	},
+	{
+		Name: "Applications.Datastores/sqlDatabases/plan/action",
+		Display: &v1.OperationDisplayProperties{
+			Provider:    "Applications.Datastores",
+			Resource:    "sqlDatabases",
+			Operation:   "Plan recipe",
+			Description: "Plans the recipe of a SQL database resource.",
+		},
+		IsDataAction: false,
+	},
 }
@@ -33,7 +33,10 @@ import (
 	mongo_proc "github.com/radius-project/radius/pkg/datastoresrp/processors/mongodatabases"
 	rds_proc "github.com/radius-project/radius/pkg/datastoresrp/processors/rediscaches"
 	sql_proc "github.com/radius-project/radius/pkg/datastoresrp/processors/sqldatabases"
+	pr_api "github.com/radius-project/radius/pkg/portableresources/api"
 	pr_ctrl "github.com/radius-project/radius/pkg/portableresources/backend/controller"
+	pr_drift "github.com/radius-project/radius/pkg/portableresources/backend/drift"
+	pr_frontend "github.com/radius-project/radius/pkg/portableresources/frontend/controller"
 	rp_frontend "github.com/radius-project/radius/pkg/rp/frontend"
 )
 
@@ -81,6 +84,16 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 			"listsecrets": {
 				APIController: rds_ctrl.NewListSecretsRedisCache,
 			},
+			"plan": {
+				APIController: func(opt apictrl.Options) (apictrl.Controller, error) {
+					return pr_frontend.NewPlanResource[*datamodel.RedisCache, datamodel.RedisCache](opt, apictrl.ResourceOptions[datamodel.RedisCache]{
+						RequestConverter: converter.RedisCacheDataModelFromVersioned,
+						UpdateFilters: []apictrl.UpdateFilter[datamodel.RedisCache]{
+							rp_frontend.PrepareRadiusResource[*datamodel.RedisCache],
+						},
+					}, recipeControllerConfig.Engine, pr_api.RecipePlanDataModelToVersioned)
+				},
+			},
 		},
 	})
 
@@ -119,6 +132,16 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 			"listsecrets": {
 				APIController: mongo_ctrl.NewListSecretsMongoDatabase,
 			},
+			"plan": {
+				APIController: func(opt apictrl.Options) (apictrl.Controller, error) {
+					return pr_frontend.NewPlanResource[*datamodel.MongoDatabase, datamodel.MongoDatabase](opt, apictrl.ResourceOptions[datamodel.MongoDatabase]{
+						RequestConverter: converter.MongoDatabaseDataModelFromVersioned,
+						UpdateFilters: []apictrl.UpdateFilter[datamodel.MongoDatabase]{
+							rp_frontend.PrepareRadiusResource[*datamodel.MongoDatabase],
+						},
+					}, recipeControllerConfig.Engine, pr_api.RecipePlanDataModelToVersioned)
+				},
+			},
 		},
 	})
 
@@ -157,6 +180,16 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 			"listsecrets": {
 				APIController: sql_ctrl.NewListSecretsSqlDatabase,
 			},
+			"plan": {
+				APIController: func(opt apictrl.Options) (apictrl.Controller, error) {
+					return pr_frontend.NewPlanResource[*datamodel.SqlDatabase, datamodel.SqlDatabase](opt, apictrl.ResourceOptions[datamodel.SqlDatabase]{
+						RequestConverter: converter.SqlDatabaseDataModelFromVersioned,
+						UpdateFilters: []apictrl.UpdateFilter[datamodel.SqlDatabase]{
+							rp_frontend.PrepareRadiusResource[*datamodel.SqlDatabase],
+						},
+					}, recipeControllerConfig.Engine, pr_api.RecipePlanDataModelToVersioned)
+				},
+			},
 		},
 	})
 
@@ -165,3 +198,13 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 
 	return ns
 }
+
+// SetupDriftCheckers returns the drift checkers of the datastores resource types deployed by recipes.
+func SetupDriftCheckers(options pr_drift.CheckerOptions) []pr_drift.ResourceChecker {
+	config := options.RecipeControllerConfig
+	return []pr_drift.ResourceChecker{
+		pr_drift.NewChecker[*datamodel.RedisCache, datamodel.RedisCache](ds_ctrl.RedisCachesResourceType, config.Engine, config.ConfigLoader, options.StatusManager, ds_ctrl.AsyncCreateOrUpdateRedisCacheTimeout),
+		pr_drift.NewChecker[*datamodel.MongoDatabase, datamodel.MongoDatabase](ds_ctrl.MongoDatabasesResourceType, config.Engine, config.ConfigLoader, options.StatusManager, ds_ctrl.AsyncCreateOrUpdateMongoDatabaseTimeout),
+		pr_drift.NewChecker[*datamodel.SqlDatabase, datamodel.SqlDatabase](ds_ctrl.SqlDatabasesResourceType, config.Engine, config.ConfigLoader, options.StatusManager, ds_ctrl.AsyncCreateOrUpdateSqlDatabaseTimeout),
+	}
+}
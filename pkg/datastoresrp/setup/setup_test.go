@@ -64,6 +64,10 @@ var handlerTests = []rpctest.HandlerTestSpec{
 		OperationType: v1.OperationType{Type: ds_ctrl.MongoDatabasesResourceType, Method: ds_ctrl.OperationListSecret},
 		Path:          "/resourcegroups/testrg/providers/applications.datastores/mongodatabases/mongo/listsecrets",
 		Method:        http.MethodPost,
+	}, {
+		OperationType: v1.OperationType{Type: ds_ctrl.MongoDatabasesResourceType, Method: ds_ctrl.OperationPlan},
+		Path:          "/resourcegroups/testrg/providers/applications.datastores/mongodatabases/mongo/plan",
+		Method:        http.MethodPost,
 	}, {
 		OperationType: v1.OperationType{Type: ds_ctrl.RedisCachesResourceType, Method: v1.OperationPlaneScopeList},
 		Path:          "/providers/applications.datastores/rediscaches",
@@ -92,6 +96,10 @@ var handlerTests = []rpctest.HandlerTestSpec{
 		OperationType: v1.OperationType{Type: ds_ctrl.RedisCachesResourceType, Method: ds_ctrl.OperationListSecret},
 		Path:          "/resourcegroups/testrg/providers/applications.datastores/rediscaches/redis/listsecrets",
 		Method:        http.MethodPost,
+	}, {
+		OperationType: v1.OperationType{Type: ds_ctrl.RedisCachesResourceType, Method: ds_ctrl.OperationPlan},
+		Path:          "/resourcegroups/testrg/providers/applications.datastores/rediscaches/redis/plan",
+		Method:        http.MethodPost,
 	}, {
 		OperationType: v1.OperationType{Type: ds_ctrl.SqlDatabasesResourceType, Method: v1.OperationPlaneScopeList},
 		Path:          "/providers/applications.datastores/sqldatabases",
@@ -120,6 +128,10 @@ var handlerTests = []rpctest.HandlerTestSpec{
 		OperationType: v1.OperationType{Type: ds_ctrl.SqlDatabasesResourceType, Method: ds_ctrl.OperationListSecret},
 		Path:          "/resourcegroups/testrg/providers/applications.datastores/sqldatabases/sql/listsecrets",
 		Method:        http.MethodPost,
+	}, {
+		OperationType: v1.OperationType{Type: ds_ctrl.SqlDatabasesResourceType, Method: ds_ctrl.OperationPlan},
+		Path:          "/resourcegroups/testrg/providers/applications.datastores/sqldatabases/sql/plan",
+		Method:        http.MethodPost,
 	},
 }
 
@@ -112,6 +112,31 @@ func fromRecipeStatus(recipeStatus *rpv1.RecipeStatus) *RecipeStatus {
 		status.TemplateVersion = to.Ptr(recipeStatus.TemplateVersion)
 	}
 
+	if recipeStatus.Version != "" {
+		status.Version = to.Ptr(recipeStatus.Version)
+	}
+
+	if recipeStatus.Drift != nil {
+		status.Drift = fromRecipeDrift(recipeStatus.Drift)
+	}
+
+	return status
+}
+
+func fromRecipeDrift(drift *rpv1.RecipeDrift) *RecipeDriftStatus {
+	status := &RecipeDriftStatus{
+		Drifted:          to.Ptr(drift.Drifted),
+		LastReconciledAt: drift.LastReconciledAt,
+	}
+
+	if len(drift.Resources) > 0 {
+		status.Resources = to.SliceOfPtrs(drift.Resources...)
+	}
+
+	if !drift.LastCheckedAt.IsZero() {
+		status.LastCheckedAt = to.Ptr(drift.LastCheckedAt)
+	}
+
 	return status
 }
 
@@ -141,14 +166,19 @@ func toRecipeDataModel(r *Recipe) portableresources.ResourceRecipe {
 	if r.Parameters != nil {
 		recipe.Parameters = r.Parameters
 	}
+	recipe.Version = to.String(r.Version)
 	return recipe
 }
 
 func fromRecipeDataModel(r portableresources.ResourceRecipe) *Recipe {
-	return &Recipe{
+	recipe := &Recipe{
 		Name:       to.Ptr(r.Name),
 		Parameters: r.Parameters,
 	}
+	if r.Version != "" {
+		recipe.Version = to.Ptr(r.Version)
+	}
+	return recipe
 }
 
 func toResourcesDataModel(r []*ResourceReference) []*portableresources.ResourceReference {
@@ -18,6 +18,7 @@ package v20231001preview
 
 import (
 	"testing"
+	"time"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/portableresources"
@@ -90,6 +91,23 @@ func Test_fromRecipeStatus(t *testing.T) {
 			TemplatePath:    to.Ptr("/path/to/template.bicep"),
 			TemplateVersion: nil,
 		}},
+		{&rpv1.RecipeStatus{
+			TemplateKind: recipes.TemplateKindTerraform,
+			TemplatePath: "/path/to/template.tf",
+			Drift: &rpv1.RecipeDrift{
+				Drifted:       true,
+				Resources:     []string{"module.redis.azurerm_redis_cache.redis"},
+				LastCheckedAt: time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC),
+			},
+		}, &RecipeStatus{
+			TemplateKind: to.Ptr(recipes.TemplateKindTerraform),
+			TemplatePath: to.Ptr("/path/to/template.tf"),
+			Drift: &RecipeDriftStatus{
+				Drifted:       to.Ptr(true),
+				Resources:     []*string{to.Ptr("module.redis.azurerm_redis_cache.redis")},
+				LastCheckedAt: to.Ptr(time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)),
+			},
+		}},
 	}
 
 	for _, tt := range testCases {
@@ -116,6 +116,36 @@ func PossibleProvisioningStateValues() []ProvisioningState {
 	}
 }
 
+// RecipeResourceChangeAction - The action that the execution of a recipe would take on a resource.
+type RecipeResourceChangeAction string
+
+const (
+	// RecipeResourceChangeActionCreate - The resource would be created.
+	RecipeResourceChangeActionCreate RecipeResourceChangeAction = "create"
+	// RecipeResourceChangeActionDelete - The resource would be deleted.
+	RecipeResourceChangeActionDelete RecipeResourceChangeAction = "delete"
+	// RecipeResourceChangeActionNoChange - The resource would not be changed.
+	RecipeResourceChangeActionNoChange RecipeResourceChangeAction = "noChange"
+	// RecipeResourceChangeActionReplace - The resource would be deleted and created again.
+	RecipeResourceChangeActionReplace RecipeResourceChangeAction = "replace"
+	// RecipeResourceChangeActionUnknown - The change to the resource can't be predicted.
+	RecipeResourceChangeActionUnknown RecipeResourceChangeAction = "unknown"
+	// RecipeResourceChangeActionUpdate - The resource would be updated in place.
+	RecipeResourceChangeActionUpdate RecipeResourceChangeAction = "update"
+)
+
+// PossibleRecipeResourceChangeActionValues returns the possible values for the RecipeResourceChangeAction const type.
+func PossibleRecipeResourceChangeActionValues() []RecipeResourceChangeAction {
+	return []RecipeResourceChangeAction{	
+		RecipeResourceChangeActionCreate,
+		RecipeResourceChangeActionDelete,
+		RecipeResourceChangeActionNoChange,
+		RecipeResourceChangeActionReplace,
+		RecipeResourceChangeActionUnknown,
+		RecipeResourceChangeActionUpdate,
+	}
+}
+
 // ResourceProvisioning - Specifies how the underlying service/resource is provisioned and managed. Available values are 'recipe',
 // where Radius manages the lifecycle of the resource through a Recipe, and 'manual', where a user
 // manages the resource and provides the values.
@@ -310,6 +310,51 @@ type Recipe struct {
 
 	// Key/value parameters to pass into the recipe at deployment
 	Parameters map[string]any
+
+	// The name of the recipe version to use. Pins the resource to the version instead of the default version of the recipe.
+	Version *string
+}
+
+// RecipeDriftStatus - Drift of the infrastructure deployed by the recipe of a portable resource.
+type RecipeDriftStatus struct {
+	// REQUIRED; Drifted is true if the deployed infrastructure no longer matches the recipe.
+	Drifted *bool
+
+	// The timestamp of the last drift check (UTC).
+	LastCheckedAt *time.Time
+
+	// The timestamp of the last reconciliation of the drift by running the recipe again (UTC).
+	LastReconciledAt *time.Time
+
+	// The identifiers of the resources whose deployed state no longer matches the recipe.
+	Resources []*string
+}
+
+// RecipePlanResult - The changes that the execution of the recipe of a portable resource would make.
+type RecipePlanResult struct {
+	// REQUIRED; The resources that the execution of the recipe would create, update or delete.
+	Changes []*RecipeResourceChange
+
+	// REQUIRED; TemplateKind is the kind of the recipe template.
+	TemplateKind *string
+
+	// REQUIRED; TemplatePath is the path of the recipe template.
+	TemplatePath *string
+
+	// TemplateVersion is the version number of the template.
+	TemplateVersion *string
+}
+
+// RecipeResourceChange - A change that the execution of a recipe would make to a resource.
+type RecipeResourceChange struct {
+	// REQUIRED; The action that would be taken on the resource.
+	Action *RecipeResourceChangeAction
+
+	// REQUIRED; The ID of the resource. For Terraform recipes this is the address of the resource in the module.
+	ID *string
+
+	// The type of the resource.
+	Type *string
 }
 
 // RecipeStatus - Recipe status at deployment time for a resource.
@@ -320,8 +365,14 @@ type RecipeStatus struct {
 	// REQUIRED; TemplatePath is the path of the recipe consumed by the portable resource upon deployment.
 	TemplatePath *string
 
+	// Drift of the deployed infrastructure from the recipe, detected by the last drift check.
+	Drift *RecipeDriftStatus
+
 	// TemplateVersion is the version number of the template.
 	TemplateVersion *string
+
+	// The name of the recipe version consumed by the portable resource upon deployment. Empty if the recipe has no named versions.
+	Version *string
 }
 
 // RecipeUpdate - The recipe used to automatically deploy underlying infrastructure for a portable resource
@@ -331,6 +382,9 @@ type RecipeUpdate struct {
 
 	// Key/value parameters to pass into the recipe at deployment
 	Parameters map[string]any
+
+	// The name of the recipe version to use. Pins the resource to the version instead of the default version of the recipe.
+	Version *string
 }
 
 // Resource - Common fields that are returned in the response for all Azure Resource Manager resources
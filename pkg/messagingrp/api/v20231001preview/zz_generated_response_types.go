@@ -42,6 +42,12 @@ type RabbitMqQueuesClientListSecretsResponse struct {
 	RabbitMQListSecretsResult
 }
 
+// RabbitMqQueuesClientPlanResponse contains the response from method RabbitMqQueuesClient.Plan.
+type RabbitMqQueuesClientPlanResponse struct {
+	// The changes that the execution of the recipe of a portable resource would make.
+	RecipePlanResult
+}
+
 // RabbitMqQueuesClientUpdateResponse contains the response from method RabbitMqQueuesClient.BeginUpdate.
 type RabbitMqQueuesClientUpdateResponse struct {
 	// RabbitMQQueue portable resource
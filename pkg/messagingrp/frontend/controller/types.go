@@ -23,6 +23,7 @@ import (
 const (
 	// User defined operation names
 	OperationListSecret = "LISTSECRETS"
+	OperationPlan       = "PLAN"
 
 	// RabbitMQQueuesResourceType represents the resource type for RabbitMQ queue.
 	RabbitMQQueuesResourceType = "Applications.Messaging/rabbitMQQueues"
@@ -23,6 +23,7 @@ import (
 	msg_dm "github.com/radius-project/radius/pkg/messagingrp/datamodel"
 	"github.com/radius-project/radius/pkg/portableresources/processors"
 	"github.com/radius-project/radius/pkg/portableresources/renderers"
+	"github.com/radius-project/radius/pkg/recipes"
 )
 
 const (
@@ -31,6 +32,20 @@ const (
 	RabbitMQSSLPort = 5671
 )
 
+// OutputContract is the contract of the outputs of the recipes of Applications.Messaging/rabbitMQQueues resources.
+var OutputContract = recipes.OutputContract{
+	Fields: []recipes.OutputField{
+		{Name: Queue, Type: recipes.OutputTypeString, Required: true, Property: Queue},
+		{Name: renderers.Host, Type: recipes.OutputTypeString, Required: true, Property: renderers.Host},
+		{Name: renderers.Port, Type: recipes.OutputTypeInteger, Required: true, Property: renderers.Port},
+		{Name: renderers.VHost, Type: recipes.OutputTypeString},
+		{Name: renderers.UsernameStringValue, Type: recipes.OutputTypeString},
+		{Name: renderers.TLS, Type: recipes.OutputTypeBoolean},
+		{Name: renderers.PasswordStringHolder, Secret: true},
+		{Name: renderers.URI, Secret: true},
+	},
+}
+
 // Processor is a processor for RabbitMQQueue resource.
 type Processor struct {
 }
@@ -185,4 +185,48 @@ the connection value "queue" should be provided by the recipe, set '.properties.
 the connection value "host" should be provided by the recipe, set '.properties.host' to provide a value manually
 the connection value "port" should be provided by the recipe, set '.properties.port' to provide a value manually`, err.Error())
 	})
+
+	t.Run("success - recipe outputs completed by the resource properties", func(t *testing.T) {
+		resource := &datamodel.RabbitMQQueue{
+			Properties: datamodel.RabbitMQQueueProperties{
+				Queue: queue,
+				Host:  host,
+				Port:  port,
+			},
+		}
+		options := processors.Options{
+			RecipeOutput: &recipes.RecipeOutput{
+				Resources: rabbitMQOutputResources,
+				Values:    map[string]any{"username": username},
+				Secrets:   map[string]any{"password": password},
+			},
+		}
+
+		properties := map[string]any{"queue": queue, "host": host, "port": float64(port)}
+		require.NoError(t, OutputContract.Validate(options.RecipeOutput, properties))
+
+		err := processor.Process(context.Background(), resource, options)
+		require.NoError(t, err)
+		require.Equal(t, queue, resource.Properties.Queue)
+		require.Equal(t, host, resource.Properties.Host)
+		require.Equal(t, port, resource.Properties.Port)
+	})
+
+	t.Run("failure - required recipe outputs not set in the resource properties", func(t *testing.T) {
+		output := &recipes.RecipeOutput{
+			Values:  map[string]any{"username": username},
+			Secrets: map[string]any{"password": password},
+		}
+
+		err := OutputContract.Validate(output, map[string]any{})
+		recipeError, ok := err.(*recipes.RecipeError)
+		require.True(t, ok)
+		require.Equal(t, recipes.RecipeOutputContractViolation, recipeError.ErrorDetails.Code)
+
+		targets := []string{}
+		for _, detail := range recipeError.ErrorDetails.Details {
+			targets = append(targets, detail.Target)
+		}
+		require.Equal(t, []string{"values.queue", "values.host", "values.port"}, targets)
+	})
 }
@@ -26,7 +26,10 @@ import (
 	msrp_ctrl "github.com/radius-project/radius/pkg/messagingrp/frontend/controller"
 	rmq_ctrl "github.com/radius-project/radius/pkg/messagingrp/frontend/controller/rabbitmqqueues"
 	rmq_proc "github.com/radius-project/radius/pkg/messagingrp/processors/rabbitmqqueues"
+	pr_api "github.com/radius-project/radius/pkg/portableresources/api"
 	pr_ctrl "github.com/radius-project/radius/pkg/portableresources/backend/controller"
+	pr_drift "github.com/radius-project/radius/pkg/portableresources/backend/drift"
+	pr_frontend "github.com/radius-project/radius/pkg/portableresources/frontend/controller"
 	rp_frontend "github.com/radius-project/radius/pkg/rp/frontend"
 )
 
@@ -74,6 +77,16 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 			"listsecrets": {
 				APIController: rmq_ctrl.NewListSecretsRabbitMQQueue,
 			},
+			"plan": {
+				APIController: func(opt apictrl.Options) (apictrl.Controller, error) {
+					return pr_frontend.NewPlanResource[*datamodel.RabbitMQQueue, datamodel.RabbitMQQueue](opt, apictrl.ResourceOptions[datamodel.RabbitMQQueue]{
+						RequestConverter: converter.RabbitMQQueueDataModelFromVersioned,
+						UpdateFilters: []apictrl.UpdateFilter[datamodel.RabbitMQQueue]{
+							rp_frontend.PrepareRadiusResource[*datamodel.RabbitMQQueue],
+						},
+					}, recipeControllerConfig.Engine, pr_api.RecipePlanDataModelToVersioned)
+				},
+			},
 		},
 	})
 
@@ -82,3 +95,11 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 
 	return ns
 }
+
+// SetupDriftCheckers returns the drift checkers of the messaging resource types deployed by recipes.
+func SetupDriftCheckers(options pr_drift.CheckerOptions) []pr_drift.ResourceChecker {
+	config := options.RecipeControllerConfig
+	return []pr_drift.ResourceChecker{
+		pr_drift.NewChecker[*datamodel.RabbitMQQueue, datamodel.RabbitMQQueue](msrp_ctrl.RabbitMQQueuesResourceType, config.Engine, config.ConfigLoader, options.StatusManager, msrp_ctrl.AsyncCreateOrUpdateRabbitMQTimeout),
+	}
+}
@@ -46,9 +46,27 @@ const (
 	// terraformInstallVerificationDuration is the metric name for verifying the completion of a Terraform installation duration.
 	terraformInstallVerificationDuration = "recipe.tf.install.verification.duration"
 
+	// recipeDriftDetectedCount is the metric name for the number of drift checks that detected drift.
+	recipeDriftDetectedCount = "recipe.drift.detected.count"
+
+	// recipeQueueDepth is the metric name for the number of recipe operations waiting for an execution slot.
+	recipeQueueDepth = "recipe.queue.depth"
+
+	// recipeQueueWaitDuration is the metric name for the time recipe operations waited for an execution slot.
+	recipeQueueWaitDuration = "recipe.queue.wait.duration"
+
+	// RecipeQueueEnvironment represents the queue limiting the concurrent recipe operations of an environment.
+	RecipeQueueEnvironment = "environment"
+
+	// RecipeQueueDriver represents the queue limiting the concurrent recipe operations of a driver.
+	RecipeQueueDriver = "driver"
+
 	// RecipeEngineOperationExecute represents the Execute operation of the Recipe Engine.
 	RecipeEngineOperationExecute = "execute"
 
+	// RecipeEngineOperationPlan represents the Plan operation of the Recipe Engine.
+	RecipeEngineOperationPlan = "plan"
+
 	// RecipeEngineOperationDelete represents the Delete operation of the Recipe Engine.
 	RecipeEngineOperationDelete = "delete"
 
@@ -57,16 +75,21 @@ const (
 
 	// RecipeEngineOperationGC represents the Garbage Collection operation of the Recipe Engine.
 	RecipeEngineOperationGC = "garbage.collection.recipe"
+
+	// RecipeEngineOperationDriftCheck represents the drift check of the resources deployed by a recipe.
+	RecipeEngineOperationDriftCheck = "drift.check"
 )
 
 type recipeEngineMetrics struct {
 	counters       map[string]metric.Int64Counter
+	upDownCounters map[string]metric.Int64UpDownCounter
 	valueRecorders map[string]metric.Float64Histogram
 }
 
 func newRecipeEngineMetrics() *recipeEngineMetrics {
 	return &recipeEngineMetrics{
 		counters:       make(map[string]metric.Int64Counter),
+		upDownCounters: make(map[string]metric.Int64UpDownCounter),
 		valueRecorders: make(map[string]metric.Float64Histogram),
 	}
 }
@@ -96,6 +119,21 @@ func (m *recipeEngineMetrics) Init() error {
 		return err
 	}
 
+	m.counters[recipeDriftDetectedCount], err = meter.Int64Counter(recipeDriftDetectedCount)
+	if err != nil {
+		return err
+	}
+
+	m.upDownCounters[recipeQueueDepth], err = meter.Int64UpDownCounter(recipeQueueDepth)
+	if err != nil {
+		return err
+	}
+
+	m.valueRecorders[recipeQueueWaitDuration], err = meter.Float64Histogram(recipeQueueWaitDuration)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -148,6 +186,39 @@ func (m *recipeEngineMetrics) RecordRecipeGarbageCollectionDuration(ctx context.
 	}
 }
 
+// RecordRecipeDriftDetected increments the number of drift checks that detected drift with the given attributes.
+func (m *recipeEngineMetrics) RecordRecipeDriftDetected(ctx context.Context, attrs []attribute.KeyValue) {
+	if m.counters[recipeDriftDetectedCount] != nil {
+		m.counters[recipeDriftDetectedCount].Add(ctx, 1, metric.WithAttributes(attrs...))
+	}
+}
+
+// RecordRecipeQueueDepthChange adds the given delta to the number of recipe operations waiting for an execution slot.
+func (m *recipeEngineMetrics) RecordRecipeQueueDepthChange(ctx context.Context, delta int64, attrs []attribute.KeyValue) {
+	if m.upDownCounters[recipeQueueDepth] != nil {
+		m.upDownCounters[recipeQueueDepth].Add(ctx, delta, metric.WithAttributes(attrs...))
+	}
+}
+
+// RecordRecipeQueueWaitDuration records the time a recipe operation waited for an execution slot with the given attributes.
+func (m *recipeEngineMetrics) RecordRecipeQueueWaitDuration(ctx context.Context, startTime time.Time, attrs []attribute.KeyValue) {
+	if m.valueRecorders[recipeQueueWaitDuration] != nil {
+		elapsedTime := float64(time.Since(startTime)) / float64(time.Millisecond)
+		m.valueRecorders[recipeQueueWaitDuration].Record(ctx, elapsedTime, metric.WithAttributes(attrs...))
+	}
+}
+
+// NewRecipeQueueAttributes generates the attributes of the recipe execution queues.
+func NewRecipeQueueAttributes(queue, driver string) []attribute.KeyValue {
+	attrs := []attribute.KeyValue{recipeQueueAttrKey.String(queue)}
+
+	if driver != "" {
+		attrs = append(attrs, recipeDriverAttrKey.String(strings.ToLower(driver)))
+	}
+
+	return attrs
+}
+
 // NewRecipeAttributes generates common attributes for recipe operations.
 func NewRecipeAttributes(operationType, recipeName string, definition *recipes.EnvironmentDefinition, state string) []attribute.KeyValue {
 	attrs := make([]attribute.KeyValue, 0)
@@ -42,6 +42,9 @@ const (
 	// recipeTemplatePathAttrKey is the attribute name for the recipe template path.
 	recipeTemplatePathAttrKey = attribute.Key("recipe_template_path")
 
+	// recipeQueueAttrKey is the attribute name for the recipe execution queue.
+	recipeQueueAttrKey = attribute.Key("recipe_queue")
+
 	// TerraformVersionAttrKey is the attribute key for the Terraform version.
 	TerraformVersionAttrKey = attribute.Key("terraform_version")
 
@@ -50,4 +53,7 @@ const (
 
 	// FailedOperationState is the value for a failed operation state.
 	FailedOperationState = "failed"
+
+	// DriftedOperationState is the value for a drift check operation that detected drift.
+	DriftedOperationState = "drifted"
 )
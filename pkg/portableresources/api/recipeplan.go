@@ -0,0 +1,38 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	pr_dm "github.com/radius-project/radius/pkg/portableresources/datamodel"
+	rp_api "github.com/radius-project/radius/pkg/rp/api/v20231001preview"
+)
+
+// RecipePlanDataModelToVersioned converts a recipe plan data model to a versioned model interface and returns an error
+// if the version is not supported. The recipe plan result is a common type of the API, so the resource providers share
+// its versioned model.
+func RecipePlanDataModelToVersioned(model *pr_dm.RecipePlan, version string) (v1.VersionedModelInterface, error) {
+	switch version {
+	case V20231001preview:
+		versioned := &rp_api.RecipePlanResult{}
+		err := versioned.ConvertFrom(model)
+		return versioned, err
+
+	default:
+		return nil, v1.ErrUnsupportedAPIVersion
+	}
+}
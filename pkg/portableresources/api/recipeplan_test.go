@@ -0,0 +1,70 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	pr_dm "github.com/radius-project/radius/pkg/portableresources/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	rp_api "github.com/radius-project/radius/pkg/rp/api/v20231001preview"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecipePlanDataModelToVersioned(t *testing.T) {
+	testset := []struct {
+		apiVersion   string
+		apiModelType any
+		err          error
+	}{
+		{
+			"2023-10-01-preview",
+			&rp_api.RecipePlanResult{},
+			nil,
+		},
+		{
+			"unsupported",
+			nil,
+			v1.ErrUnsupportedAPIVersion,
+		},
+	}
+
+	for _, tc := range testset {
+		t.Run(tc.apiVersion, func(t *testing.T) {
+			dm := &pr_dm.RecipePlan{
+				RecipePlan: recipes.RecipePlan{
+					Changes: []recipes.ResourceChange{
+						{ID: "azurerm_redis_cache.redis", Action: recipes.ResourceChangeActionCreate},
+					},
+					Status: &rpv1.RecipeStatus{
+						TemplateKind: recipes.TemplateKindTerraform,
+						TemplatePath: "Azure/redis/azurerm",
+					},
+				},
+			}
+			am, err := RecipePlanDataModelToVersioned(dm, tc.apiVersion)
+			if tc.err != nil {
+				require.ErrorAs(t, tc.err, &err)
+			} else {
+				require.NoError(t, err)
+				require.IsType(t, tc.apiModelType, am)
+			}
+		})
+	}
+}
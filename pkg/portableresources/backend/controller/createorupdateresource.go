@@ -18,6 +18,7 @@ package controller
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 
@@ -162,9 +163,16 @@ func (c *CreateOrUpdateResource[P, T]) executeRecipeIfNeeded(ctx context.Context
 	if input == nil {
 		return nil, nil
 	}
+	properties, err := resourceProperties(data)
+	if err != nil {
+		return nil, err
+	}
+
 	request := recipes.ResourceMetadata{
 		Name:          input.Name,
 		Parameters:    input.Parameters,
+		Version:       input.Version,
+		Properties:    properties,
 		EnvironmentID: data.ResourceMetadata().Environment,
 		ApplicationID: data.ResourceMetadata().Application,
 		ResourceID:    data.GetBaseResource().ID,
@@ -178,3 +186,22 @@ func (c *CreateOrUpdateResource[P, T]) executeRecipeIfNeeded(ctx context.Context
 		Simulated:     simulated,
 	})
 }
+
+// resourceProperties returns the properties of the resource as they are serialized in its JSON representation, without
+// the status, which is computed by the resource provider.
+func resourceProperties(data any) (map[string]any, error) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	resource := struct {
+		Properties map[string]any `json:"properties"`
+	}{}
+	if err := json.Unmarshal(b, &resource); err != nil {
+		return nil, err
+	}
+	delete(resource.Properties, "status")
+
+	return resource.Properties, nil
+}
@@ -336,6 +336,17 @@ func TestCreateOrUpdateResource_Run(t *testing.T) {
 				Parameters: map[string]any{
 					"p1": "v1",
 				},
+				Properties: map[string]any{
+					"application": TestApplicationID,
+					"environment": TestEnvironmentID,
+					"isProcessed": false,
+					"recipe": map[string]any{
+						"name": "test-recipe",
+						"parameters": map[string]any{
+							"p1": "v1",
+						},
+					},
+				},
 			}
 			prevState := []string{
 				oldOutputResourceResourceID,
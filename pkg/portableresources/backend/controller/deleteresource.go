@@ -84,9 +84,15 @@ func (c *DeleteResource[P, T]) Run(ctx context.Context, request *ctrl.Request) (
 			EnvironmentID: data.ResourceMetadata().Environment,
 			ApplicationID: data.ResourceMetadata().Application,
 			Parameters:    recipeDataModel.Recipe().Parameters,
+			Version:       recipeDataModel.Recipe().Version,
 			ResourceID:    id.String(),
 		}
 
+		// Delete with the version of the recipe the resource was deployed with, which may no longer be the default.
+		if data.ResourceMetadata().Status.Recipe != nil && data.ResourceMetadata().Status.Recipe.Version != "" {
+			recipeData.Version = data.ResourceMetadata().Status.Recipe.Version
+		}
+
 		err = c.engine.Delete(ctx, engine.DeleteOptions{
 			BaseOptions: engine.BaseOptions{
 				Recipe: recipeData,
@@ -0,0 +1,253 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drift
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	"github.com/radius-project/radius/pkg/metrics"
+	pr_api "github.com/radius-project/radius/pkg/portableresources/api"
+	"github.com/radius-project/radius/pkg/portableresources/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/configloader"
+	"github.com/radius-project/radius/pkg/recipes/controllerconfig"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// CheckerOptions represents the options to create the drift checkers of a resource provider.
+type CheckerOptions struct {
+	// RecipeControllerConfig is the configuration of the recipe engine used to plan and execute recipes.
+	RecipeControllerConfig *controllerconfig.RecipeControllerConfig
+
+	// StatusManager is the manager of the async operations queued to reconcile drifted resources.
+	StatusManager statusmanager.StatusManager
+}
+
+// ResourceChecker checks the resources of a resource type deployed by recipes for drift.
+type ResourceChecker interface {
+	// ResourceType returns the resource type checked by the checker, e.g. "Applications.Datastores/redisCaches".
+	ResourceType() string
+
+	// Check checks the resource stored in obj for drift and records the result in the recipe status of the resource.
+	// If autoReconcile is true, an operation to re-execute the recipe of a drifted resource is queued.
+	Check(ctx context.Context, client store.StorageClient, obj *store.Object, autoReconcile bool) error
+}
+
+// Checker checks the resources of a portable resource type deployed by recipes for drift.
+type Checker[P interface {
+	*T
+	rpv1.RadiusResourceModel
+}, T any] struct {
+	resourceType        string
+	engine              engine.Engine
+	configurationLoader configloader.ConfigurationLoader
+	statusManager       statusmanager.StatusManager
+	asyncTimeout        time.Duration
+}
+
+// NewChecker creates a new drift checker for the given resource type. Drifted resources are reconciled by queuing a PUT
+// operation of the resource with the status manager, which times out after asyncTimeout.
+func NewChecker[P interface {
+	*T
+	rpv1.RadiusResourceModel
+}, T any](resourceType string, eng engine.Engine, configurationLoader configloader.ConfigurationLoader, statusManager statusmanager.StatusManager, asyncTimeout time.Duration) *Checker[P, T] {
+	return &Checker[P, T]{
+		resourceType:        resourceType,
+		engine:              eng,
+		configurationLoader: configurationLoader,
+		statusManager:       statusManager,
+		asyncTimeout:        asyncTimeout,
+	}
+}
+
+// ResourceType returns the resource type checked by the checker.
+func (c *Checker[P, T]) ResourceType() string {
+	return c.resourceType
+}
+
+// Check plans the recipe of the resource against its previous state and records the resources that would be changed
+// as drifted. Resources that are not deployed by a recipe, that are being provisioned or that are deployed in simulated
+// environments are skipped.
+func (c *Checker[P, T]) Check(ctx context.Context, client store.StorageClient, obj *store.Object, autoReconcile bool) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	data := P(new(T))
+	if err := obj.As(data); err != nil {
+		return err
+	}
+
+	// 'any' is required here to convert to an interface type, only then can we use a type assertion.
+	recipeDataModel, supportsRecipes := any(data).(datamodel.RecipeDataModel)
+	if !supportsRecipes || recipeDataModel.Recipe() == nil {
+		return nil
+	}
+
+	if data.ResourceMetadata().Status.Recipe == nil || !data.ProvisioningState().IsTerminal() || data.ProvisioningState() == v1.ProvisioningStateFailed {
+		return nil
+	}
+
+	input := recipeDataModel.Recipe()
+	metadata := recipes.ResourceMetadata{
+		Name:          input.Name,
+		Parameters:    input.Parameters,
+		Version:       input.Version,
+		EnvironmentID: data.ResourceMetadata().Environment,
+		ApplicationID: data.ResourceMetadata().Application,
+		ResourceID:    data.GetBaseResource().ID,
+	}
+
+	config, err := c.configurationLoader.LoadConfiguration(ctx, metadata)
+	if err != nil {
+		return err
+	}
+
+	if config.Simulated {
+		return nil
+	}
+
+	prevState := []string{}
+	for _, outputResource := range data.OutputResources() {
+		prevState = append(prevState, outputResource.ID.String())
+	}
+
+	opts := engine.ExecuteOptions{
+		BaseOptions: engine.BaseOptions{
+			Recipe: metadata,
+		},
+		PreviousState: prevState,
+	}
+
+	start := time.Now()
+	plan, err := c.engine.Plan(ctx, opts)
+	if err != nil {
+		metrics.DefaultRecipeEngineMetrics.RecordRecipeOperationDuration(ctx, start,
+			metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDriftCheck, metadata.Name, nil, metrics.FailedOperationState))
+		return err
+	}
+
+	drift := &rpv1.RecipeDrift{
+		Resources:     driftedResources(plan),
+		LastCheckedAt: start.UTC(),
+	}
+	drift.Drifted = len(drift.Resources) > 0
+	if previous := data.ResourceMetadata().Status.Recipe.Drift; previous != nil {
+		drift.LastReconciledAt = previous.LastReconciledAt
+	}
+
+	state := metrics.SuccessfulOperationState
+	if drift.Drifted {
+		state = metrics.DriftedOperationState
+		metrics.DefaultRecipeEngineMetrics.RecordRecipeDriftDetected(ctx,
+			metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDriftCheck, metadata.Name, nil, state))
+		logger.Info(fmt.Sprintf("Detected drift of %d resources deployed by recipe %q for resource %q", len(drift.Resources), metadata.Name, metadata.ResourceID))
+	}
+	metrics.DefaultRecipeEngineMetrics.RecordRecipeOperationDuration(ctx, start,
+		metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDriftCheck, metadata.Name, nil, state))
+
+	// The recipe of a drifted resource is re-executed by the PUT operation of the resource, which runs in the async
+	// operation worker like any other update of the resource. The resource is saved in the accepted state before the
+	// operation is queued, so that it is not updated concurrently.
+	reconcile := drift.Drifted && autoReconcile
+	if reconcile {
+		reconciledAt := time.Now().UTC()
+		drift.LastReconciledAt = &reconciledAt
+		data.SetProvisioningState(v1.ProvisioningStateAccepted)
+	}
+	data.ResourceMetadata().Status.Recipe.Drift = drift
+
+	update := &store.Object{
+		Metadata: store.Metadata{
+			ID: obj.ID,
+		},
+		Data: data,
+	}
+	err = client.Save(ctx, update, store.WithETag(obj.ETag))
+	if errors.Is(err, &store.ErrConcurrency{}) {
+		// The resource was updated while it was checked, the next check will use the updated resource.
+		logger.Info(fmt.Sprintf("Skipped recording drift for resource %q updated during the check", metadata.ResourceID))
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if reconcile {
+		return c.reconcile(ctx, client, update, data)
+	}
+
+	return nil
+}
+
+// reconcile queues the PUT operation of the resource to re-execute its recipe. If the operation can't be queued, the
+// provisioning state of the resource is set to failed, as the frontend does when it fails to queue an operation.
+func (c *Checker[P, T]) reconcile(ctx context.Context, client store.StorageClient, obj *store.Object, data P) error {
+	id, err := resources.ParseResource(obj.ID)
+	if err != nil {
+		return err
+	}
+
+	sCtx := &v1.ARMRequestContext{
+		ResourceID:  id,
+		OperationID: uuid.New(),
+		OperationType: v1.OperationType{
+			Type:   c.resourceType,
+			Method: v1.OperationPut,
+		},
+		APIVersion: pr_api.V20231001preview,
+	}
+	options := statusmanager.QueueOperationOptions{
+		OperationTimeout: c.asyncTimeout,
+		RetryAfter:       v1.DefaultRetryAfterDuration,
+	}
+
+	if err := c.statusManager.QueueAsyncOperation(ctx, sCtx, options); err != nil {
+		data.SetProvisioningState(v1.ProvisioningStateFailed)
+		if rbErr := client.Save(ctx, obj, store.WithETag(obj.ETag)); rbErr != nil {
+			return rbErr
+		}
+		return err
+	}
+
+	return nil
+}
+
+// driftedResources returns the IDs of the resources that would be changed by applying the plan.
+func driftedResources(plan *recipes.RecipePlan) []string {
+	resources := []string{}
+	if plan == nil {
+		return resources
+	}
+
+	for _, change := range plan.Changes {
+		switch change.Action {
+		case recipes.ResourceChangeActionCreate, recipes.ResourceChangeActionUpdate, recipes.ResourceChangeActionReplace, recipes.ResourceChangeActionDelete:
+			resources = append(resources, change.ID)
+		}
+	}
+
+	return resources
+}
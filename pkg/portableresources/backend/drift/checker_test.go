@@ -0,0 +1,304 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drift
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	"github.com/radius-project/radius/pkg/portableresources"
+	"github.com/radius-project/radius/pkg/portableresources/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/configloader"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+const (
+	testResourceType  = "Applications.Test/testResources"
+	testEnvironmentID = "/planes/radius/local/resourceGroups/radius-test-rg/providers/Applications.Core/environments/test-env"
+	testApplicationID = "/planes/radius/local/resourceGroups/radius-test-rg/providers/Applications.Core/applications/test-app"
+	testResourceID    = "/planes/radius/local/resourceGroups/radius-test-rg/providers/Applications.Test/testResources/tr"
+
+	outputResourceID = "/subscriptions/test-sub/resourceGroups/test-rg/providers/Systems.Test/testResources/test1"
+)
+
+type testResource struct {
+	v1.BaseResource
+	datamodel.PortableResourceMetadata
+
+	Properties testResourceProperties `json:"properties"`
+}
+
+type testResourceProperties struct {
+	rpv1.BasicResourceProperties
+	ResourceProvisioning portableresources.ResourceProvisioning `json:"resourceProvisioning,omitempty"`
+	Recipe               portableresources.ResourceRecipe       `json:"recipe,omitempty"`
+}
+
+func (r *testResource) ApplyDeploymentOutput(do rpv1.DeploymentOutput) error {
+	return nil
+}
+
+func (r *testResource) OutputResources() []rpv1.OutputResource {
+	return r.Properties.Status.OutputResources
+}
+
+func (r *testResource) ResourceMetadata() *rpv1.BasicResourceProperties {
+	return &r.Properties.BasicResourceProperties
+}
+
+func (r *testResource) Recipe() *portableresources.ResourceRecipe {
+	if r.Properties.ResourceProvisioning == portableresources.ResourceProvisioningManual {
+		return nil
+	}
+	return &r.Properties.Recipe
+}
+
+func newTestObject(mutate func(r *testResource)) *store.Object {
+	resource := &testResource{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:   testResourceID,
+				Name: "tr",
+				Type: testResourceType,
+			},
+			InternalMetadata: v1.InternalMetadata{
+				AsyncProvisioningState: v1.ProvisioningStateSucceeded,
+			},
+		},
+		Properties: testResourceProperties{
+			BasicResourceProperties: rpv1.BasicResourceProperties{
+				Environment: testEnvironmentID,
+				Application: testApplicationID,
+				Status: rpv1.ResourceStatus{
+					OutputResources: []rpv1.OutputResource{{ID: resources.MustParse(outputResourceID)}},
+					Recipe:          &rpv1.RecipeStatus{TemplateKind: recipes.TemplateKindBicep, TemplatePath: "test/path:1.0"},
+				},
+			},
+			Recipe: portableresources.ResourceRecipe{Name: "test-recipe"},
+		},
+	}
+	if mutate != nil {
+		mutate(resource)
+	}
+
+	return &store.Object{
+		Metadata: store.Metadata{ID: testResourceID, ETag: "etag"},
+		Data:     resource,
+	}
+}
+
+func Test_Checker_Check(t *testing.T) {
+	errPlan := errors.New("plan error")
+	errQueue := errors.New("queue error")
+
+	driftedPlan := &recipes.RecipePlan{
+		Changes: []recipes.ResourceChange{
+			{ID: outputResourceID, Action: recipes.ResourceChangeActionUpdate},
+			{ID: "/subscriptions/test-sub/resourceGroups/test-rg/providers/Systems.Test/testResources/test2", Action: recipes.ResourceChangeActionNoChange},
+		},
+	}
+	noDriftPlan := &recipes.RecipePlan{
+		Changes: []recipes.ResourceChange{
+			{ID: outputResourceID, Action: recipes.ResourceChangeActionNoChange},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		mutate        func(r *testResource)
+		simulated     bool
+		plan          *recipes.RecipePlan
+		planErr       error
+		autoReconcile bool
+		queueErr      error
+		saveErr       error
+		expectSaves   int
+		expectQueue   bool
+		expectedErr   error
+		verify        func(t *testing.T, saved []*testResource)
+	}{
+		{
+			name:        "no drift",
+			plan:        noDriftPlan,
+			expectSaves: 1,
+			verify: func(t *testing.T, saved []*testResource) {
+				drift := saved[0].Properties.Status.Recipe.Drift
+				require.NotNil(t, drift)
+				require.False(t, drift.Drifted)
+				require.Empty(t, drift.Resources)
+				require.False(t, drift.LastCheckedAt.IsZero())
+				require.Nil(t, drift.LastReconciledAt)
+			},
+		},
+		{
+			name:        "drift",
+			plan:        driftedPlan,
+			expectSaves: 1,
+			verify: func(t *testing.T, saved []*testResource) {
+				drift := saved[0].Properties.Status.Recipe.Drift
+				require.True(t, drift.Drifted)
+				require.Equal(t, []string{outputResourceID}, drift.Resources)
+				require.Nil(t, drift.LastReconciledAt)
+				require.Equal(t, v1.ProvisioningStateSucceeded, saved[0].ProvisioningState())
+			},
+		},
+		{
+			name:          "drift reconciled",
+			plan:          driftedPlan,
+			autoReconcile: true,
+			expectSaves:   1,
+			expectQueue:   true,
+			verify: func(t *testing.T, saved []*testResource) {
+				drift := saved[0].Properties.Status.Recipe.Drift
+				require.True(t, drift.Drifted)
+				require.Equal(t, []string{outputResourceID}, drift.Resources)
+				require.NotNil(t, drift.LastReconciledAt)
+				require.Equal(t, v1.ProvisioningStateAccepted, saved[0].ProvisioningState())
+			},
+		},
+		{
+			name:          "drift reconcile queue failure",
+			plan:          driftedPlan,
+			autoReconcile: true,
+			queueErr:      errQueue,
+			expectSaves:   2,
+			expectQueue:   true,
+			expectedErr:   errQueue,
+			verify: func(t *testing.T, saved []*testResource) {
+				require.Equal(t, v1.ProvisioningStateFailed, saved[1].ProvisioningState())
+			},
+		},
+		{
+			name:        "plan failure",
+			planErr:     errPlan,
+			expectedErr: errPlan,
+		},
+		{
+			name:          "concurrent update",
+			plan:          driftedPlan,
+			autoReconcile: true,
+			saveErr:       &store.ErrConcurrency{},
+			expectSaves:   1,
+		},
+		{
+			name: "manual provisioning",
+			mutate: func(r *testResource) {
+				r.Properties.ResourceProvisioning = portableresources.ResourceProvisioningManual
+			},
+		},
+		{
+			name: "not deployed",
+			mutate: func(r *testResource) {
+				r.Properties.Status.Recipe = nil
+			},
+		},
+		{
+			name: "provisioning",
+			mutate: func(r *testResource) {
+				r.InternalMetadata.AsyncProvisioningState = v1.ProvisioningStateUpdating
+			},
+		},
+		{
+			name:      "simulated",
+			simulated: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mctrl := gomock.NewController(t)
+			client := store.NewMockStorageClient(mctrl)
+			eng := engine.NewMockEngine(mctrl)
+			cfg := configloader.NewMockConfigurationLoader(mctrl)
+			sm := statusmanager.NewMockStatusManager(mctrl)
+
+			obj := newTestObject(tt.mutate)
+			data := &testResource{}
+			require.NoError(t, obj.As(data))
+
+			skipped := data.Recipe() == nil || data.Properties.Status.Recipe == nil || !data.ProvisioningState().IsTerminal()
+			if !skipped {
+				cfg.EXPECT().
+					LoadConfiguration(gomock.Any(), gomock.Any()).
+					Return(&recipes.Configuration{Simulated: tt.simulated}, nil).
+					Times(1)
+			}
+
+			if !skipped && !tt.simulated {
+				eng.EXPECT().
+					Plan(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, opts engine.ExecuteOptions) (*recipes.RecipePlan, error) {
+						require.Equal(t, "test-recipe", opts.Recipe.Name)
+						require.Equal(t, testResourceID, opts.Recipe.ResourceID)
+						require.Equal(t, []string{outputResourceID}, opts.PreviousState)
+						return tt.plan, tt.planErr
+					}).
+					Times(1)
+			}
+
+			// Each saved resource is copied, since the checker saves the resource again if the operation cannot be queued.
+			saved := []*testResource{}
+			client.EXPECT().
+				Save(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, obj *store.Object, options ...store.MutatingOptions) error {
+					r := &testResource{}
+					require.NoError(t, obj.As(r))
+					saved = append(saved, r)
+					return tt.saveErr
+				}).
+				Times(tt.expectSaves)
+
+			if tt.expectQueue {
+				sm.EXPECT().
+					QueueAsyncOperation(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, sCtx *v1.ARMRequestContext, options statusmanager.QueueOperationOptions) error {
+						require.Equal(t, testResourceID, sCtx.ResourceID.String())
+						require.Equal(t, v1.OperationType{Type: testResourceType, Method: v1.OperationPut}, sCtx.OperationType)
+						require.Equal(t, time.Minute, options.OperationTimeout)
+						return tt.queueErr
+					}).
+					Times(1)
+			}
+
+			checker := NewChecker[*testResource, testResource](testResourceType, eng, cfg, sm, time.Minute)
+			require.Equal(t, testResourceType, checker.ResourceType())
+
+			err := checker.Check(context.Background(), client, obj, tt.autoReconcile)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			if tt.verify != nil {
+				require.Len(t, saved, tt.expectSaves)
+				tt.verify(t, saved)
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drift
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// DefaultInterval is the default interval between two drift checks.
+	DefaultInterval = time.Hour
+
+	// rootScope is the scope under which the resources are checked for drift.
+	rootScope = "/planes/radius"
+)
+
+// Options represents the options of the drift detector.
+type Options struct {
+	// StorageProvider is the provider of the storage clients used to list and update resources.
+	StorageProvider dataprovider.DataStorageProvider
+
+	// Checkers are the drift checkers of the resource types to check.
+	Checkers []ResourceChecker
+
+	// Interval is the interval between two drift checks. Defaults to DefaultInterval.
+	Interval time.Duration
+
+	// AutoReconcile queues an update operation of a resource to re-execute its recipe when drift is detected.
+	AutoReconcile bool
+}
+
+// Detector periodically checks the resources deployed by recipes for drift.
+type Detector struct {
+	options Options
+}
+
+// NewDetector creates a new drift detector with the given options.
+func NewDetector(options Options) *Detector {
+	if options.Interval <= 0 {
+		options.Interval = DefaultInterval
+	}
+	return &Detector{options: options}
+}
+
+// Run checks all resources for drift every interval until the context is cancelled.
+func (d *Detector) Run(ctx context.Context) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	logger.Info(fmt.Sprintf("Checking resources deployed by recipes for drift every %s", d.options.Interval))
+
+	ticker := time.NewTicker(d.options.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			d.CheckAll(ctx)
+		}
+	}
+}
+
+// CheckAll checks all resources of the resource types of the checkers for drift. Failures are logged and do not stop
+// the check of the other resources.
+func (d *Detector) CheckAll(ctx context.Context) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	for _, checker := range d.options.Checkers {
+		if ctx.Err() != nil {
+			return
+		}
+
+		if err := d.checkResourceType(ctx, checker); err != nil {
+			logger.Error(err, "failed to check resources for drift", "resourceType", checker.ResourceType())
+		}
+	}
+}
+
+func (d *Detector) checkResourceType(ctx context.Context, checker ResourceChecker) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	client, err := d.options.StorageProvider.GetStorageClient(ctx, checker.ResourceType())
+	if err != nil {
+		return err
+	}
+
+	query := store.Query{
+		RootScope:      rootScope,
+		ScopeRecursive: true,
+		ResourceType:   checker.ResourceType(),
+	}
+
+	token := ""
+	for {
+		result, err := client.Query(ctx, query, store.WithPaginationToken(token))
+		if err != nil {
+			return err
+		}
+
+		for i := range result.Items {
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			obj := &result.Items[i]
+			if err := checker.Check(ctx, client, obj, d.options.AutoReconcile); err != nil {
+				logger.Error(err, "failed to check resource for drift", "resourceID", obj.ID)
+			}
+		}
+
+		if result.PaginationToken == "" {
+			return nil
+		}
+		token = result.PaginationToken
+	}
+}
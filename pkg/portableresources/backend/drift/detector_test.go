@@ -0,0 +1,95 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drift
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+type fakeChecker struct {
+	resourceType  string
+	checked       []string
+	autoReconcile bool
+	err           error
+}
+
+func (c *fakeChecker) ResourceType() string {
+	return c.resourceType
+}
+
+func (c *fakeChecker) Check(ctx context.Context, client store.StorageClient, obj *store.Object, autoReconcile bool) error {
+	c.checked = append(c.checked, obj.ID)
+	c.autoReconcile = autoReconcile
+	return c.err
+}
+
+func Test_NewDetector_DefaultInterval(t *testing.T) {
+	detector := NewDetector(Options{})
+	require.Equal(t, DefaultInterval, detector.options.Interval)
+}
+
+func Test_Detector_CheckAll(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	provider := dataprovider.NewMockDataStorageProvider(mctrl)
+	client := store.NewMockStorageClient(mctrl)
+
+	checker := &fakeChecker{resourceType: testResourceType, err: errors.New("check error")}
+	unavailable := &fakeChecker{resourceType: "Applications.Test/otherResources"}
+
+	provider.EXPECT().
+		GetStorageClient(gomock.Any(), testResourceType).
+		Return(client, nil).
+		Times(1)
+	provider.EXPECT().
+		GetStorageClient(gomock.Any(), unavailable.resourceType).
+		Return(nil, errors.New("storage error")).
+		Times(1)
+
+	expectedQuery := store.Query{RootScope: "/planes/radius", ScopeRecursive: true, ResourceType: testResourceType}
+	client.EXPECT().
+		Query(gomock.Any(), expectedQuery, gomock.Any()).
+		Return(&store.ObjectQueryResult{
+			Items:           []store.Object{{Metadata: store.Metadata{ID: "first"}}},
+			PaginationToken: "next",
+		}, nil).
+		Times(1)
+	client.EXPECT().
+		Query(gomock.Any(), expectedQuery, gomock.Any()).
+		Return(&store.ObjectQueryResult{
+			Items: []store.Object{{Metadata: store.Metadata{ID: "second"}}},
+		}, nil).
+		Times(1)
+
+	detector := NewDetector(Options{
+		StorageProvider: provider,
+		Checkers:        []ResourceChecker{unavailable, checker},
+		AutoReconcile:   true,
+	})
+	detector.CheckAll(context.Background())
+
+	require.Empty(t, unavailable.checked)
+	require.Equal(t, []string{"first", "second"}, checker.checked)
+	require.True(t, checker.autoReconcile)
+}
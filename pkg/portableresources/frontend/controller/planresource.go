@@ -0,0 +1,145 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/portableresources/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+)
+
+const (
+	// defaultPlanTimeout is the maximum time spent planning a recipe. The plan is computed within the request, so it is
+	// bounded well below the timeouts of the clients and the ARM proxy.
+	defaultPlanTimeout = 2 * time.Minute
+)
+
+// PlanResource is the controller implementation to compute the changes that the execution of the recipe of a
+// portable resource would make, without deploying anything.
+type PlanResource[P interface {
+	*T
+	rpv1.RadiusResourceModel
+}, T any] struct {
+	ctrl.Operation[P, T]
+	engine        engine.Engine
+	planConverter v1.ConvertToAPIModel[datamodel.RecipePlan]
+	planTimeout   time.Duration
+}
+
+// NewPlanResource creates a new controller for planning the recipe of a portable resource with the given engine.
+// The planConverter is used to convert the computed plan to the versioned API model.
+func NewPlanResource[P interface {
+	*T
+	rpv1.RadiusResourceModel
+}, T any](opts ctrl.Options, resourceOpts ctrl.ResourceOptions[T], eng engine.Engine, planConverter v1.ConvertToAPIModel[datamodel.RecipePlan]) (ctrl.Controller, error) {
+	return &PlanResource[P, T]{
+		Operation:     ctrl.NewOperation[P](opts, resourceOpts),
+		engine:        eng,
+		planConverter: planConverter,
+		planTimeout:   defaultPlanTimeout,
+	}, nil
+}
+
+// Run reads the resource from the request body, runs the update filters against the existing resource and calls the
+// recipe engine to plan the recipe of the resource. The output resources of the existing resource are used to find
+// the resources that would be deleted. Planning is cancelled if it does not complete within the plan timeout.
+func (c *PlanResource[P, T]) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	sCtx := v1.ARMRequestContextFromContext(ctx)
+
+	// Request route for plan has name of the operation as suffix which should be removed to get the resource id.
+	// route id format: subscriptions/<subscription_id>/resourceGroups/<resource_group>/providers/Applications.Datastores/redisCaches/<resource_name>/plan
+	id := sCtx.ResourceID.Truncate()
+
+	newResource, err := c.GetResourceFromRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	oldResource, _, err := c.GetResource(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	prevState := []string{}
+	if oldResource != nil {
+		for _, outputResource := range P(oldResource).OutputResources() {
+			prevState = append(prevState, outputResource.ID.String())
+		}
+	}
+
+	for _, filter := range c.UpdateFilters() {
+		if resp, err := filter(ctx, newResource, oldResource, c.Options()); resp != nil || err != nil {
+			return resp, err
+		}
+	}
+
+	// 'any' is required here to convert to an interface type, only then can we use a type assertion.
+	recipeDataModel, supportsRecipes := any(newResource).(datamodel.RecipeDataModel)
+	if !supportsRecipes || recipeDataModel.Recipe() == nil {
+		return rest.NewBadRequestResponse(fmt.Sprintf("The resource %q is not provisioned by a recipe.", id.String())), nil
+	}
+
+	planCtx, cancel := context.WithTimeout(ctx, c.planTimeout)
+	defer cancel()
+
+	input := recipeDataModel.Recipe()
+	plan, err := c.engine.Plan(planCtx, engine.ExecuteOptions{
+		BaseOptions: engine.BaseOptions{
+			Recipe: recipes.ResourceMetadata{
+				Name:          input.Name,
+				Parameters:    input.Parameters,
+				Version:       input.Version,
+				EnvironmentID: P(newResource).ResourceMetadata().Environment,
+				ApplicationID: P(newResource).ResourceMetadata().Application,
+				ResourceID:    id.String(),
+			},
+		},
+		PreviousState: prevState,
+	})
+	if err != nil {
+		if errors.Is(planCtx.Err(), context.DeadlineExceeded) {
+			return rest.NewInternalServerErrorARMResponse(v1.ErrorResponse{
+				Error: v1.ErrorDetails{
+					Code:    v1.CodeInternal,
+					Message: fmt.Sprintf("The plan of the recipe of %q did not complete within %s.", id.String(), c.planTimeout),
+					Target:  id.String(),
+				},
+			}), nil
+		}
+		if recipeError, ok := err.(*recipes.RecipeError); ok {
+			return rest.NewBadRequestARMResponse(v1.ErrorResponse{Error: recipeError.ErrorDetails}), nil
+		}
+		return nil, err
+	}
+
+	versioned, err := c.planConverter(&datamodel.RecipePlan{RecipePlan: *plan, ResourceType: c.ResourceType()}, sCtx.APIVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	return rest.NewOKResponse(versioned), nil
+}
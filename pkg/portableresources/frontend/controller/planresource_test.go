@@ -0,0 +1,308 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/portableresources"
+	"github.com/radius-project/radius/pkg/portableresources/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+const (
+	testResourceID    = "/planes/radius/local/resourceGroups/radius-test-rg/providers/Applications.Test/testResources/tr"
+	testEnvironmentID = "/planes/radius/local/resourceGroups/radius-test-rg/providers/Applications.Core/environments/test-env"
+	testOutputID      = "/planes/kubernetes/local/namespaces/default/providers/apps/Deployment/redis"
+)
+
+type TestResource struct {
+	v1.BaseResource
+
+	// ResourceMetadata represents internal DataModel properties common to all portable resource types.
+	datamodel.PortableResourceMetadata
+
+	// Properties is the properties of the resource.
+	Properties TestResourceProperties `json:"properties"`
+}
+
+// ApplyDeploymentOutput updates the status of the TestResource instance with the DeploymentOutput values.
+func (r *TestResource) ApplyDeploymentOutput(do rpv1.DeploymentOutput) error {
+	r.Properties.Status.OutputResources = do.DeployedOutputResources
+	return nil
+}
+
+// OutputResources returns the OutputResources from the Status field of the Properties field of the TestResource instance.
+func (r *TestResource) OutputResources() []rpv1.OutputResource {
+	return r.Properties.Status.OutputResources
+}
+
+// ResourceMetadata returns the BasicResourceProperties of the TestResource instance.
+func (r *TestResource) ResourceMetadata() *rpv1.BasicResourceProperties {
+	return &r.Properties.BasicResourceProperties
+}
+
+// Recipe returns a pointer to the ResourceRecipe stored in the Properties field of the TestResource struct.
+func (r *TestResource) Recipe() *portableresources.ResourceRecipe {
+	if r.Properties.ResourceProvisioning == portableresources.ResourceProvisioningManual {
+		return nil
+	}
+	return &r.Properties.Recipe
+}
+
+type TestResourceProperties struct {
+	rpv1.BasicResourceProperties
+	ResourceProvisioning portableresources.ResourceProvisioning `json:"resourceProvisioning,omitempty"`
+	Recipe               portableresources.ResourceRecipe       `json:"recipe,omitempty"`
+}
+
+// testPlanResult is the versioned model of the recipe plan used by the tests.
+type testPlanResult struct {
+	ResourceType string                   `json:"resourceType"`
+	Changes      []recipes.ResourceChange `json:"changes"`
+}
+
+func (r *testPlanResult) ConvertFrom(src v1.DataModelInterface) error {
+	plan := src.(*datamodel.RecipePlan)
+	r.ResourceType = plan.ResourceType
+	r.Changes = plan.Changes
+	return nil
+}
+
+func (r *testPlanResult) ConvertTo() (v1.DataModelInterface, error) {
+	return nil, errors.New("not implemented")
+}
+
+func newTestPlanController(t *testing.T, mctrl *gomock.Controller) (*store.MockStorageClient, *engine.MockEngine, ctrl.Controller) {
+	mStorageClient := store.NewMockStorageClient(mctrl)
+	eng := engine.NewMockEngine(mctrl)
+
+	resourceOpts := ctrl.ResourceOptions[TestResource]{
+		RequestConverter: func(content []byte, version string) (*TestResource, error) {
+			dm := &TestResource{}
+			err := json.Unmarshal(content, dm)
+			return dm, err
+		},
+	}
+	planConverter := func(model *datamodel.RecipePlan, version string) (v1.VersionedModelInterface, error) {
+		versioned := &testPlanResult{}
+		err := versioned.ConvertFrom(model)
+		return versioned, err
+	}
+
+	c, err := NewPlanResource[*TestResource, TestResource](ctrl.Options{StorageClient: mStorageClient, ResourceType: "Applications.Test/testResources"}, resourceOpts, eng, planConverter)
+	require.NoError(t, err)
+	return mStorageClient, eng, c
+}
+
+func newTestPlanRequest(t *testing.T, resource *TestResource) (context.Context, *http.Request) {
+	body, err := json.Marshal(resource)
+	require.NoError(t, err)
+	req, err := rpctest.NewHTTPRequestWithContent(context.Background(), http.MethodPost, "http://localhost:8080"+testResourceID+"/plan?api-version=2023-10-01-preview", body)
+	require.NoError(t, err)
+	return rpctest.NewARMRequestContext(req), req
+}
+
+func TestPlanResource_Run(t *testing.T) {
+	newResource := &TestResource{
+		Properties: TestResourceProperties{
+			BasicResourceProperties: rpv1.BasicResourceProperties{
+				Environment: testEnvironmentID,
+			},
+			Recipe: portableresources.ResourceRecipe{
+				Name:       "default",
+				Parameters: map[string]any{"port": 6379},
+			},
+		},
+	}
+
+	t.Run("new resource", func(t *testing.T) {
+		mctrl := gomock.NewController(t)
+		mStorageClient, eng, c := newTestPlanController(t, mctrl)
+		ctx, req := newTestPlanRequest(t, newResource)
+
+		mStorageClient.EXPECT().
+			Get(gomock.Any(), testResourceID).
+			Return(nil, &store.ErrNotFound{ID: testResourceID})
+		eng.EXPECT().
+			Plan(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, opts engine.ExecuteOptions) (*recipes.RecipePlan, error) {
+				require.Equal(t, "default", opts.Recipe.Name)
+				require.Equal(t, testResourceID, opts.Recipe.ResourceID)
+				require.Equal(t, testEnvironmentID, opts.Recipe.EnvironmentID)
+				require.Empty(t, opts.PreviousState)
+				return &recipes.RecipePlan{
+					Changes: []recipes.ResourceChange{
+						{ID: testOutputID, Action: recipes.ResourceChangeActionCreate},
+					},
+				}, nil
+			})
+
+		w := httptest.NewRecorder()
+		resp, err := c.Run(ctx, w, req)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, req)
+		require.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+		actual := &testPlanResult{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), actual))
+		require.Equal(t, "Applications.Test/testResources", actual.ResourceType)
+		require.Equal(t, []recipes.ResourceChange{{ID: testOutputID, Action: recipes.ResourceChangeActionCreate}}, actual.Changes)
+	})
+
+	t.Run("existing resource", func(t *testing.T) {
+		mctrl := gomock.NewController(t)
+		mStorageClient, eng, c := newTestPlanController(t, mctrl)
+		ctx, req := newTestPlanRequest(t, newResource)
+
+		oldResource := &TestResource{
+			Properties: TestResourceProperties{
+				BasicResourceProperties: rpv1.BasicResourceProperties{
+					Environment: testEnvironmentID,
+					Status: rpv1.ResourceStatus{
+						OutputResources: []rpv1.OutputResource{
+							{ID: resources.MustParse(testOutputID)},
+						},
+					},
+				},
+			},
+		}
+		mStorageClient.EXPECT().
+			Get(gomock.Any(), testResourceID).
+			Return(&store.Object{Metadata: store.Metadata{ID: testResourceID}, Data: oldResource}, nil)
+		eng.EXPECT().
+			Plan(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, opts engine.ExecuteOptions) (*recipes.RecipePlan, error) {
+				require.Equal(t, []string{testOutputID}, opts.PreviousState)
+				return &recipes.RecipePlan{
+					Changes: []recipes.ResourceChange{
+						{ID: testOutputID, Action: recipes.ResourceChangeActionDelete},
+					},
+				}, nil
+			})
+
+		w := httptest.NewRecorder()
+		resp, err := c.Run(ctx, w, req)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, req)
+		require.Equal(t, http.StatusOK, w.Result().StatusCode)
+	})
+
+	t.Run("manual resource", func(t *testing.T) {
+		mctrl := gomock.NewController(t)
+		mStorageClient, _, c := newTestPlanController(t, mctrl)
+		manual := &TestResource{
+			Properties: TestResourceProperties{
+				ResourceProvisioning: portableresources.ResourceProvisioningManual,
+			},
+		}
+		ctx, req := newTestPlanRequest(t, manual)
+
+		mStorageClient.EXPECT().
+			Get(gomock.Any(), testResourceID).
+			Return(nil, &store.ErrNotFound{ID: testResourceID})
+
+		w := httptest.NewRecorder()
+		resp, err := c.Run(ctx, w, req)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, req)
+		require.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+	})
+
+	t.Run("recipe error", func(t *testing.T) {
+		mctrl := gomock.NewController(t)
+		mStorageClient, eng, c := newTestPlanController(t, mctrl)
+		ctx, req := newTestPlanRequest(t, newResource)
+
+		mStorageClient.EXPECT().
+			Get(gomock.Any(), testResourceID).
+			Return(nil, &store.ErrNotFound{ID: testResourceID})
+		eng.EXPECT().
+			Plan(gomock.Any(), gomock.Any()).
+			Return(nil, recipes.NewRecipeError(recipes.RecipePlanFailed, "plan failed", ""))
+
+		w := httptest.NewRecorder()
+		resp, err := c.Run(ctx, w, req)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, req)
+		require.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+
+		actual := &v1.ErrorResponse{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), actual))
+		require.Equal(t, recipes.RecipePlanFailed, actual.Error.Code)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		mctrl := gomock.NewController(t)
+		mStorageClient, eng, c := newTestPlanController(t, mctrl)
+		c.(*PlanResource[*TestResource, TestResource]).planTimeout = time.Millisecond
+		ctx, req := newTestPlanRequest(t, newResource)
+
+		mStorageClient.EXPECT().
+			Get(gomock.Any(), testResourceID).
+			Return(nil, &store.ErrNotFound{ID: testResourceID})
+		eng.EXPECT().
+			Plan(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, opts engine.ExecuteOptions) (*recipes.RecipePlan, error) {
+				<-ctx.Done()
+				return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, ctx.Err().Error(), "")
+			})
+
+		w := httptest.NewRecorder()
+		resp, err := c.Run(ctx, w, req)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, req)
+		require.Equal(t, http.StatusInternalServerError, w.Result().StatusCode)
+
+		actual := &v1.ErrorResponse{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), actual))
+		require.Contains(t, actual.Error.Message, "did not complete within")
+	})
+
+	t.Run("engine error", func(t *testing.T) {
+		mctrl := gomock.NewController(t)
+		mStorageClient, eng, c := newTestPlanController(t, mctrl)
+		ctx, req := newTestPlanRequest(t, newResource)
+
+		mStorageClient.EXPECT().
+			Get(gomock.Any(), testResourceID).
+			Return(nil, &store.ErrNotFound{ID: testResourceID})
+		eng.EXPECT().
+			Plan(gomock.Any(), gomock.Any()).
+			Return(nil, errors.New("failed to load configuration"))
+
+		w := httptest.NewRecorder()
+		_, err := c.Run(ctx, w, req)
+		require.Error(t, err)
+	})
+}
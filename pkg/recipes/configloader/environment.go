@@ -170,6 +170,38 @@ func getRecipeDefinition(environment *v20231001preview.EnvironmentResource, reci
 		if c.PlainHTTP != nil {
 			definition.PlainHTTP = *c.PlainHTTP
 		}
+	case *v20231001preview.HelmRecipeProperties:
+		if c.TemplateVersion != nil {
+			definition.TemplateVersion = *c.TemplateVersion
+		}
+		if c.PlainHTTP != nil {
+			definition.PlainHTTP = *c.PlainHTTP
+		}
+	case *v20231001preview.KubernetesRecipeProperties:
+		if c.PlainHTTP != nil {
+			definition.PlainHTTP = *c.PlainHTTP
+		}
+	}
+
+	// A version pinned by the resource takes precedence over the default version of the recipe.
+	version := recipe.Version
+	if version == "" && found.GetRecipeProperties().DefaultVersion != nil {
+		version = *found.GetRecipeProperties().DefaultVersion
+	}
+	if version != "" {
+		v, ok := found.GetRecipeProperties().Versions[version]
+		if !ok || v == nil {
+			err := fmt.Errorf("could not find version %q of recipe %q in environment %q", version, recipe.Name, recipe.EnvironmentID)
+			return nil, recipes.NewRecipeError(recipes.RecipeVersionNotFoundFailure, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+		}
+
+		definition.Version = version
+		definition.TemplatePath = *v.TemplatePath
+		definition.Parameters = v.Parameters
+		definition.TemplateVersion = ""
+		if v.TemplateVersion != nil {
+			definition.TemplateVersion = *v.TemplateVersion
+		}
 	}
 
 	return definition, nil
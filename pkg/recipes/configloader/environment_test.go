@@ -307,6 +307,31 @@ func TestGetRecipeDefinition(t *testing.T) {
 						TemplatePath:    to.Ptr("Azure/cosmosdb/azurerm"),
 						TemplateVersion: to.Ptr("1.1.0"),
 					},
+					"helm-mongo": &model.HelmRecipeProperties{
+						TemplateKind:    to.Ptr(recipes.TemplateKindHelm),
+						TemplatePath:    to.Ptr("oci://localhost:8000/charts/mongodb"),
+						TemplateVersion: to.Ptr("1.0.0"),
+						PlainHTTP:       to.Ptr(true),
+					},
+					"versioned": &model.TerraformRecipeProperties{
+						TemplateKind:    to.Ptr(recipes.TemplateKindTerraform),
+						TemplatePath:    to.Ptr("Azure/cosmosdb/azurerm"),
+						TemplateVersion: to.Ptr("1.0.0"),
+						DefaultVersion:  to.Ptr("v2"),
+						Versions: map[string]*model.RecipeVersionProperties{
+							"v1": {
+								TemplatePath:    to.Ptr("Azure/cosmosdb/azurerm"),
+								TemplateVersion: to.Ptr("1.1.0"),
+							},
+							"v2": {
+								TemplatePath:    to.Ptr("Azure/cosmosdb/azurerm"),
+								TemplateVersion: to.Ptr("2.0.0"),
+								Parameters: map[string]any{
+									"foo": "bar",
+								},
+							},
+						},
+					},
 				},
 			},
 		},
@@ -378,6 +403,75 @@ func TestGetRecipeDefinition(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, recipeDef, &expected)
 	})
+	t.Run("success-helm", func(t *testing.T) {
+		metadata := recipes.ResourceMetadata{
+			Name:          "helm-mongo",
+			EnvironmentID: envResourceId,
+			ResourceID:    mongoResourceID,
+		}
+		expected := recipes.EnvironmentDefinition{
+			Name:            "helm-mongo",
+			Driver:          recipes.TemplateKindHelm,
+			ResourceType:    "Applications.Datastores/mongoDatabases",
+			TemplatePath:    "oci://localhost:8000/charts/mongodb",
+			TemplateVersion: "1.0.0",
+			PlainHTTP:       true,
+		}
+		recipeDef, err := getRecipeDefinition(&envResource, &metadata)
+		require.NoError(t, err)
+		require.Equal(t, recipeDef, &expected)
+	})
+	t.Run("success-default-version", func(t *testing.T) {
+		metadata := recipes.ResourceMetadata{
+			Name:          "versioned",
+			EnvironmentID: envResourceId,
+			ResourceID:    mongoResourceID,
+		}
+		expected := recipes.EnvironmentDefinition{
+			Name:            "versioned",
+			Driver:          recipes.TemplateKindTerraform,
+			ResourceType:    "Applications.Datastores/mongoDatabases",
+			TemplatePath:    "Azure/cosmosdb/azurerm",
+			TemplateVersion: "2.0.0",
+			Parameters: map[string]any{
+				"foo": "bar",
+			},
+			Version: "v2",
+		}
+		recipeDef, err := getRecipeDefinition(&envResource, &metadata)
+		require.NoError(t, err)
+		require.Equal(t, &expected, recipeDef)
+	})
+	t.Run("success-pinned-version", func(t *testing.T) {
+		metadata := recipes.ResourceMetadata{
+			Name:          "versioned",
+			EnvironmentID: envResourceId,
+			ResourceID:    mongoResourceID,
+			Version:       "v1",
+		}
+		expected := recipes.EnvironmentDefinition{
+			Name:            "versioned",
+			Driver:          recipes.TemplateKindTerraform,
+			ResourceType:    "Applications.Datastores/mongoDatabases",
+			TemplatePath:    "Azure/cosmosdb/azurerm",
+			TemplateVersion: "1.1.0",
+			Version:         "v1",
+		}
+		recipeDef, err := getRecipeDefinition(&envResource, &metadata)
+		require.NoError(t, err)
+		require.Equal(t, &expected, recipeDef)
+	})
+	t.Run("version not found", func(t *testing.T) {
+		metadata := recipes.ResourceMetadata{
+			Name:          "versioned",
+			EnvironmentID: envResourceId,
+			ResourceID:    mongoResourceID,
+			Version:       "v3",
+		}
+		_, err := getRecipeDefinition(&envResource, &metadata)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "could not find version \"v3\"")
+	})
 	t.Run("no recipes registered to the environment", func(t *testing.T) {
 		envResourceNilRecipe := envResource
 		envResourceNilRecipe.Properties.Recipes = nil
@@ -0,0 +1,157 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recipes
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/recipes/util"
+)
+
+// OutputType is the type of a value or secret output by a recipe.
+type OutputType string
+
+const (
+	// OutputTypeString is the type of string outputs.
+	OutputTypeString OutputType = "string"
+	// OutputTypeInteger is the type of integer outputs.
+	OutputTypeInteger OutputType = "integer"
+	// OutputTypeBoolean is the type of boolean outputs.
+	OutputTypeBoolean OutputType = "boolean"
+)
+
+// OutputField describes a value or secret output by a recipe.
+type OutputField struct {
+	// Name is the name of the value or secret.
+	Name string
+	// Type is the type of the value or secret. Secrets are always strings.
+	Type OutputType
+	// Secret is true if the field is output in the secrets of the recipe instead of its values.
+	Secret bool
+	// Required is true if every recipe of the resource type must output the field, unless the resource sets it in the
+	// property named by Property.
+	Required bool
+	// Property is the name of the resource property that is used instead of the output when it is set. It is empty for
+	// fields that can only be output by the recipe.
+	Property string
+}
+
+// OutputContract describes the values and secrets output by the recipes of a resource type.
+type OutputContract struct {
+	// Fields is the list of values and secrets of the contract. Outputs that are not part of the contract are not validated.
+	Fields []OutputField
+}
+
+// Validate validates the values and secrets of the recipe output against the contract. The properties are the properties
+// of the resource, and the required fields set in them don't need to be output by the recipe. It returns a RecipeError
+// with the RecipeOutputContractViolation code which has the details of every missing or mistyped field.
+func (c OutputContract) Validate(output *RecipeOutput, properties map[string]any) error {
+	if output == nil {
+		output = &RecipeOutput{}
+	}
+
+	details := []*v1.ErrorDetails{}
+	for _, field := range c.Fields {
+		kind, outputs := "value", output.Values
+		if field.Secret {
+			kind, outputs = "secret", output.Secrets
+		}
+
+		target := fmt.Sprintf("%ss.%s", kind, field.Name)
+		value, ok := outputs[field.Name]
+		if !ok || value == nil {
+			if field.Required && !isPropertySet(properties, field.Property) {
+				details = append(details, &v1.ErrorDetails{
+					Code:    RecipeOutputContractViolation,
+					Message: fmt.Sprintf("the recipe must output the %s %q", kind, field.Name),
+					Target:  target,
+				})
+			}
+			continue
+		}
+
+		expected := field.Type
+		if field.Secret {
+			expected = OutputTypeString
+		}
+		if !isOutputType(value, expected) {
+			details = append(details, &v1.ErrorDetails{
+				Code:    RecipeOutputContractViolation,
+				Message: fmt.Sprintf("the %s %q output by the recipe is expected to be a %s, got %T", kind, field.Name, expected, value),
+				Target:  target,
+			})
+		}
+	}
+
+	if len(details) == 0 {
+		return nil
+	}
+
+	fields := []string{}
+	for _, detail := range details {
+		fields = append(fields, detail.Target)
+	}
+	message := fmt.Sprintf("the recipe outputs don't satisfy the output contract of the resource type, invalid fields: %s", strings.Join(fields, ", "))
+
+	return NewRecipeError(RecipeOutputContractViolation, message, util.ExecutionError, details...)
+}
+
+func isPropertySet(properties map[string]any, property string) bool {
+	if property == "" {
+		return false
+	}
+
+	value, ok := properties[property]
+	if !ok || value == nil {
+		return false
+	}
+
+	// The properties are decoded from the JSON of the resource, so unset strings and numbers may be present as zero values.
+	switch v := value.(type) {
+	case string:
+		return v != ""
+	case float64:
+		return v != 0
+	}
+
+	return true
+}
+
+func isOutputType(value any, outputType OutputType) bool {
+	switch outputType {
+	case OutputTypeString:
+		_, ok := value.(string)
+		return ok
+	case OutputTypeBoolean:
+		_, ok := value.(bool)
+		return ok
+	case OutputTypeInteger:
+		switch v := value.(type) {
+		case int, int32, int64:
+			return true
+		case float64:
+			// Numbers are decoded as float64 from the JSON outputs of the drivers.
+			return v == math.Trunc(v)
+		}
+		return false
+	default:
+		return true
+	}
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recipes
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/recipes/util"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_OutputContract_Validate(t *testing.T) {
+	contract := OutputContract{
+		Fields: []OutputField{
+			{Name: "host", Type: OutputTypeString, Required: true, Property: "host"},
+			{Name: "port", Type: OutputTypeInteger, Required: true, Property: "port"},
+			{Name: "tls", Type: OutputTypeBoolean},
+			{Name: "password", Secret: true},
+		},
+	}
+
+	tests := []struct {
+		name            string
+		output          *RecipeOutput
+		properties      map[string]any
+		expectedTargets []string
+	}{
+		{
+			name: "valid",
+			output: &RecipeOutput{
+				Values:  map[string]any{"host": "localhost", "port": float64(6379), "tls": true, "extra": []any{"ignored"}},
+				Secrets: map[string]any{"password": "secret"},
+			},
+		},
+		{
+			name: "optional fields missing",
+			output: &RecipeOutput{
+				Values: map[string]any{"host": "localhost", "port": 6379},
+			},
+		},
+		{
+			name:            "nil output",
+			output:          nil,
+			expectedTargets: []string{"values.host", "values.port"},
+		},
+		{
+			name: "missing required field",
+			output: &RecipeOutput{
+				Values: map[string]any{"port": int32(6379)},
+			},
+			expectedTargets: []string{"values.host"},
+		},
+		{
+			name: "required field set in the resource properties",
+			output: &RecipeOutput{
+				Values: map[string]any{"port": int32(6379)},
+			},
+			properties: map[string]any{"host": "localhost"},
+		},
+		{
+			name:            "required fields empty in the resource properties",
+			output:          nil,
+			properties:      map[string]any{"host": "", "port": float64(0)},
+			expectedTargets: []string{"values.host", "values.port"},
+		},
+		{
+			name: "mistyped fields",
+			output: &RecipeOutput{
+				Values:  map[string]any{"host": "localhost", "port": "6379", "tls": "true"},
+				Secrets: map[string]any{"password": 1234},
+			},
+			expectedTargets: []string{"values.port", "values.tls", "secrets.password"},
+		},
+		{
+			name: "fractional integer",
+			output: &RecipeOutput{
+				Values: map[string]any{"host": "localhost", "port": 6379.5},
+			},
+			expectedTargets: []string{"values.port"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := contract.Validate(tc.output, tc.properties)
+			if len(tc.expectedTargets) == 0 {
+				require.NoError(t, err)
+				return
+			}
+
+			recipeError, ok := err.(*RecipeError)
+			require.True(t, ok)
+			require.Equal(t, RecipeOutputContractViolation, recipeError.ErrorDetails.Code)
+			require.Equal(t, util.ExecutionError, recipeError.DeploymentStatus)
+
+			targets := []string{}
+			for _, detail := range recipeError.ErrorDetails.Details {
+				require.Equal(t, RecipeOutputContractViolation, detail.Code)
+				targets = append(targets, detail.Target)
+				require.Contains(t, recipeError.ErrorDetails.Message, detail.Target)
+			}
+			require.Equal(t, tc.expectedTargets, targets)
+		})
+	}
+}
@@ -17,10 +17,12 @@ limitations under the License.
 package controllerconfig
 
 import (
+	"path/filepath"
 	"strconv"
 
 	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
 	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/corerp/handlers"
 	"github.com/radius-project/radius/pkg/kubeutil"
 	"github.com/radius-project/radius/pkg/portableresources/processors"
 	"github.com/radius-project/radius/pkg/recipes"
@@ -30,6 +32,22 @@ import (
 	"github.com/radius-project/radius/pkg/sdk"
 	"github.com/radius-project/radius/pkg/sdk/clients"
 	"github.com/radius-project/radius/pkg/ucp/secret/provider"
+
+	dapr_ctrl "github.com/radius-project/radius/pkg/daprrp/frontend/controller"
+	pubsub_proc "github.com/radius-project/radius/pkg/daprrp/processors/pubsubbrokers"
+	secretstore_proc "github.com/radius-project/radius/pkg/daprrp/processors/secretstores"
+	statestore_proc "github.com/radius-project/radius/pkg/daprrp/processors/statestores"
+	ds_ctrl "github.com/radius-project/radius/pkg/datastoresrp/frontend/controller"
+	mongo_proc "github.com/radius-project/radius/pkg/datastoresrp/processors/mongodatabases"
+	rds_proc "github.com/radius-project/radius/pkg/datastoresrp/processors/rediscaches"
+	sql_proc "github.com/radius-project/radius/pkg/datastoresrp/processors/sqldatabases"
+	msg_ctrl "github.com/radius-project/radius/pkg/messagingrp/frontend/controller"
+	rmq_proc "github.com/radius-project/radius/pkg/messagingrp/processors/rabbitmqqueues"
+)
+
+const (
+	// terraformCacheDir is the default directory under the Terraform path where Terraform binaries are cached.
+	terraformCacheDir = "cache"
 )
 
 // RecipeControllerConfig is the configuration for the controllers which uses recipe.
@@ -86,6 +104,11 @@ func New(options hostoptions.HostOptions) (*RecipeControllerConfig, error) {
 		return nil, err
 	}
 
+	terraformCachePath := options.Config.Terraform.CachePath
+	if terraformCachePath == "" && options.Config.Terraform.Path != "" {
+		terraformCachePath = filepath.Join(options.Config.Terraform.Path, terraformCacheDir)
+	}
+
 	cfg.ConfigLoader = configloader.NewEnvironmentLoader(clientOptions)
 	cfg.Engine = engine.NewEngine(engine.Options{
 		ConfigurationLoader: cfg.ConfigLoader,
@@ -102,8 +125,29 @@ func New(options hostoptions.HostOptions) (*RecipeControllerConfig, error) {
 			),
 			recipes.TemplateKindTerraform: driver.NewTerraformDriver(options.UCPConnection, provider.NewSecretProvider(options.Config.SecretProvider),
 				driver.TerraformOptions{
-					Path: options.Config.Terraform.Path,
+					Path:      options.Config.Terraform.Path,
+					Version:   options.Config.Terraform.Version,
+					CacheDir:  terraformCachePath,
+					ExecPath:  options.Config.Terraform.ExecPath,
+					MirrorURL: options.Config.Terraform.MirrorURL,
+					Offline:   options.Config.Terraform.Offline,
 				}, cfg.K8sClients.ClientSet),
+			recipes.TemplateKindHelm: driver.NewHelmDriver(options.K8sConfig, cfg.K8sClients.RuntimeClient, driver.HelmOptions{}),
+			recipes.TemplateKindKubernetes: driver.NewKubernetesDriver(cfg.K8sClients.RuntimeClient,
+				handlers.NewKubernetesHandler(cfg.K8sClients.RuntimeClient, cfg.K8sClients.ClientSet, cfg.K8sClients.DiscoveryClient, cfg.K8sClients.DynamicClient)),
+		},
+		OutputContracts: map[string]recipes.OutputContract{
+			dapr_ctrl.DaprPubSubBrokersResourceType: pubsub_proc.OutputContract,
+			dapr_ctrl.DaprSecretStoresResourceType:  secretstore_proc.OutputContract,
+			dapr_ctrl.DaprStateStoresResourceType:   statestore_proc.OutputContract,
+			ds_ctrl.MongoDatabasesResourceType:      mongo_proc.OutputContract,
+			ds_ctrl.RedisCachesResourceType:         rds_proc.OutputContract,
+			ds_ctrl.SqlDatabasesResourceType:        sql_proc.OutputContract,
+			msg_ctrl.RabbitMQQueuesResourceType:     rmq_proc.OutputContract,
+		},
+		Concurrency: engine.ConcurrencyOptions{
+			MaxPerEnvironment: options.Config.RecipeConcurrency.MaxPerEnvironment,
+			MaxPerDriver:      options.Config.RecipeConcurrency.MaxPerDriver,
 		},
 	})
 
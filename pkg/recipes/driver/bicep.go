@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
@@ -86,56 +87,15 @@ func (d *bicepDriver) Execute(ctx context.Context, opts ExecuteOptions) (*recipe
 	logger := logr.FromContextOrDiscard(ctx)
 	logger.Info(fmt.Sprintf("Deploying recipe: %q, template: %q", opts.Definition.Name, opts.Definition.TemplatePath))
 
-	recipeData := make(map[string]any)
-	downloadStartTime := time.Now()
-
-	err := util.ReadFromRegistry(ctx, opts.Definition, &recipeData, d.RegistryClient)
-	if err != nil {
-		metrics.DefaultRecipeEngineMetrics.RecordRecipeDownloadDuration(ctx, downloadStartTime,
-			metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDownloadRecipe, opts.Recipe.Name, &opts.Definition, recipes.RecipeDownloadFailed))
-		return nil, recipes.NewRecipeError(recipes.RecipeDownloadFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
-	}
-	metrics.DefaultRecipeEngineMetrics.RecordRecipeDownloadDuration(ctx, downloadStartTime,
-		metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDownloadRecipe, opts.Recipe.Name, &opts.Definition, metrics.SuccessfulOperationState))
-
-	// create the context object to be passed to the recipe deployment
-	recipeContext, err := recipecontext.New(&opts.Recipe, &opts.Configuration)
+	deploymentID, deployment, err := d.prepareDeployment(ctx, opts.BaseOptions)
 	if err != nil {
-		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
-	}
-
-	// get the parameters after resolving the conflict between developer and operator parameters
-	// if the recipe template also has the context parameter defined then add it to the parameter for deployment
-	isContextParameterDefined := hasContextParameter(recipeData)
-	parameters := createRecipeParameters(opts.Recipe.Parameters, opts.Definition.Parameters, isContextParameterDefined, recipeContext)
-
-	deploymentName := deploymentPrefix + strconv.FormatInt(time.Now().UnixNano(), 10)
-	deploymentID, err := createDeploymentID(recipeContext.Resource.ID, deploymentName)
-	if err != nil {
-		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+		return nil, err
 	}
 
-	// Provider config will specify the Azure and AWS scopes (if provided).
-	providerConfig := newProviderConfig(deploymentID.FindScope(resources_radius.ScopeResourceGroups), opts.Configuration.Providers)
-
 	logger.Info("deploying bicep template for recipe", "deploymentID", deploymentID)
-	if providerConfig.AWS != nil {
-		logger.Info("using AWS provider", "deploymentID", deploymentID, "scope", providerConfig.AWS.Value.Scope)
-	}
-	if providerConfig.Az != nil {
-		logger.Info("using Azure provider", "deploymentID", deploymentID, "scope", providerConfig.Az.Value.Scope)
-	}
-
 	poller, err := d.DeploymentClient.CreateOrUpdate(
 		ctx,
-		clients.Deployment{
-			Properties: &clients.DeploymentProperties{
-				Mode:           armresources.DeploymentModeIncremental,
-				ProviderConfig: &providerConfig,
-				Parameters:     parameters,
-				Template:       recipeData,
-			},
-		},
+		deployment,
 		deploymentID.String(),
 		clients.DeploymentsClientAPIVersion,
 	)
@@ -159,7 +119,7 @@ func (d *bicepDriver) Execute(ctx context.Context, opts ExecuteOptions) (*recipe
 	// as bicep does not take care of automatically deleting the unused resources.
 	// Identify the output resources that are no longer relevant to the recipe.
 	garbageCollectionStartTime := time.Now()
-	diff, err := d.getGCOutputResources(recipeResponse.Resources, opts.PrevState)
+	diff, err := getGCOutputResources(recipeResponse.Resources, opts.PrevState)
 	if err != nil {
 		return nil, err
 	}
@@ -237,6 +197,37 @@ func (d *bicepDriver) Delete(ctx context.Context, opts DeleteOptions) error {
 	return nil
 }
 
+// Plan fetches recipe contents from container registry and runs what-if for the deployment of the bicep template using
+// the UCP deployment client. The resources that were deployed by the previous execution of the recipe and are not part of
+// the template are reported as deleted, since they are garbage collected by Execute.
+func (d *bicepDriver) Plan(ctx context.Context, opts ExecuteOptions) (*recipes.RecipePlan, error) {
+	logger := logr.FromContextOrDiscard(ctx)
+	logger.Info(fmt.Sprintf("Planning recipe: %q, template: %q", opts.Definition.Name, opts.Definition.TemplatePath))
+
+	deploymentID, deployment, err := d.prepareDeployment(ctx, opts.BaseOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("running what-if for bicep template for recipe", "deploymentID", deploymentID)
+	poller, err := d.DeploymentClient.WhatIf(ctx, deployment, deploymentID.String(), clients.DeploymentsClientAPIVersion)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, fmt.Sprintf("failed to plan recipe %s of type %s", opts.Recipe.Name, opts.Definition.ResourceType), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	resp, err := poller.PollUntilDone(ctx, &runtime.PollUntilDoneOptions{Frequency: pollFrequency})
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, fmt.Sprintf("failed to plan recipe %s of type %s", opts.Recipe.Name, opts.Definition.ResourceType), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	changes := []*armresources.WhatIfChange{}
+	if resp.Properties != nil {
+		changes = resp.Properties.Changes
+	}
+
+	return prepareRecipePlan(opts.Definition, changes, opts.PrevState), nil
+}
+
 // GetRecipeMetadata gets the Bicep recipe parameters information from the container registry
 func (d *bicepDriver) GetRecipeMetadata(ctx context.Context, opts BaseOptions) (map[string]any, error) {
 	// Recipe parameters can be found in the recipe data pulled from the registry in the following format:
@@ -265,6 +256,59 @@ func (d *bicepDriver) GetRecipeMetadata(ctx context.Context, opts BaseOptions) (
 	return recipeData, nil
 }
 
+// prepareDeployment fetches recipe contents from container registry and creates the deployment ID and the deployment of
+// the bicep template, with the recipe parameters and the provider config.
+func (d *bicepDriver) prepareDeployment(ctx context.Context, opts BaseOptions) (resources.ID, clients.Deployment, error) {
+	logger := logr.FromContextOrDiscard(ctx)
+
+	recipeData := make(map[string]any)
+	downloadStartTime := time.Now()
+
+	err := util.ReadFromRegistry(ctx, opts.Definition, &recipeData, d.RegistryClient)
+	if err != nil {
+		metrics.DefaultRecipeEngineMetrics.RecordRecipeDownloadDuration(ctx, downloadStartTime,
+			metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDownloadRecipe, opts.Recipe.Name, &opts.Definition, recipes.RecipeDownloadFailed))
+		return resources.ID{}, clients.Deployment{}, recipes.NewRecipeError(recipes.RecipeDownloadFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+	metrics.DefaultRecipeEngineMetrics.RecordRecipeDownloadDuration(ctx, downloadStartTime,
+		metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDownloadRecipe, opts.Recipe.Name, &opts.Definition, metrics.SuccessfulOperationState))
+
+	// create the context object to be passed to the recipe deployment
+	recipeContext, err := recipecontext.New(&opts.Recipe, &opts.Configuration)
+	if err != nil {
+		return resources.ID{}, clients.Deployment{}, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	// get the parameters after resolving the conflict between developer and operator parameters
+	// if the recipe template also has the context parameter defined then add it to the parameter for deployment
+	isContextParameterDefined := hasContextParameter(recipeData)
+	parameters := createRecipeParameters(opts.Recipe.Parameters, opts.Definition.Parameters, isContextParameterDefined, recipeContext)
+
+	deploymentName := deploymentPrefix + strconv.FormatInt(time.Now().UnixNano(), 10)
+	deploymentID, err := createDeploymentID(recipeContext.Resource.ID, deploymentName)
+	if err != nil {
+		return resources.ID{}, clients.Deployment{}, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	// Provider config will specify the Azure and AWS scopes (if provided).
+	providerConfig := newProviderConfig(deploymentID.FindScope(resources_radius.ScopeResourceGroups), opts.Configuration.Providers)
+	if providerConfig.AWS != nil {
+		logger.Info("using AWS provider", "deploymentID", deploymentID, "scope", providerConfig.AWS.Value.Scope)
+	}
+	if providerConfig.Az != nil {
+		logger.Info("using Azure provider", "deploymentID", deploymentID, "scope", providerConfig.Az.Value.Scope)
+	}
+
+	return deploymentID, clients.Deployment{
+		Properties: &clients.DeploymentProperties{
+			Mode:           armresources.DeploymentModeIncremental,
+			ProviderConfig: &providerConfig,
+			Parameters:     parameters,
+			Template:       recipeData,
+		},
+	}, nil
+}
+
 func hasContextParameter(recipeData map[string]any) bool {
 	parametersAny, ok := recipeData[recipeParameters]
 	if !ok {
@@ -374,9 +418,99 @@ func (d *bicepDriver) prepareRecipeResponse(templatePath string, outputs any, re
 	return recipeResponse, nil
 }
 
+// prepareRecipePlan populates the recipe plan from the what-if changes of the deployment. The resources from the previous
+// deployment that are not part of the what-if changes are reported as deleted.
+func prepareRecipePlan(definition recipes.EnvironmentDefinition, whatIfChanges []*armresources.WhatIfChange, prevState []string) *recipes.RecipePlan {
+	plan := &recipes.RecipePlan{
+		Changes: []recipes.ResourceChange{},
+		Status: &rpv1.RecipeStatus{
+			TemplateKind: recipes.TemplateKindBicep,
+			TemplatePath: definition.TemplatePath,
+		},
+	}
+
+	planned := []string{}
+	for _, change := range whatIfChanges {
+		if change == nil || change.ResourceID == nil {
+			continue
+		}
+
+		resourceType := ""
+		if id, err := resources.Parse(*change.ResourceID); err == nil {
+			resourceType = id.Type()
+		}
+
+		plan.Changes = append(plan.Changes, recipes.ResourceChange{
+			ID:     *change.ResourceID,
+			Type:   resourceType,
+			Action: whatIfChangeAction(change.ChangeType),
+		})
+		planned = append(planned, *change.ResourceID)
+	}
+
+	plan.Changes = append(plan.Changes, deletedChanges(planned, prevState)...)
+	return plan
+}
+
+// deletedChanges returns the delete changes for the resources of the previous state that are not planned.
+func deletedChanges(planned []string, prevState []string) []recipes.ResourceChange {
+	changes := []recipes.ResourceChange{}
+	for _, prevResourceID := range prevState {
+		if containsID(planned, prevResourceID) {
+			continue
+		}
+
+		resourceType := ""
+		if id, err := resources.Parse(prevResourceID); err == nil {
+			resourceType = id.Type()
+		}
+
+		changes = append(changes, recipes.ResourceChange{
+			ID:     prevResourceID,
+			Type:   resourceType,
+			Action: recipes.ResourceChangeActionDelete,
+		})
+	}
+
+	return changes
+}
+
+// whatIfChangeAction converts the what-if change type of a resource to the action of the recipe plan. What-if reports
+// existing resources whose properties it can't compare as deployed, which is not a known change, so they are reported
+// as unknown.
+func whatIfChangeAction(changeType *armresources.ChangeType) recipes.ResourceChangeAction {
+	if changeType == nil {
+		return recipes.ResourceChangeActionUnknown
+	}
+
+	switch *changeType {
+	case armresources.ChangeTypeCreate:
+		return recipes.ResourceChangeActionCreate
+	case armresources.ChangeTypeModify:
+		return recipes.ResourceChangeActionUpdate
+	case armresources.ChangeTypeDelete:
+		return recipes.ResourceChangeActionDelete
+	case armresources.ChangeTypeNoChange, armresources.ChangeTypeIgnore:
+		return recipes.ResourceChangeActionNoChange
+	default:
+		return recipes.ResourceChangeActionUnknown
+	}
+}
+
+// containsID returns true if the list of resource IDs contains the resource ID, ignoring case.
+func containsID(ids []string, id string) bool {
+	for _, existing := range ids {
+		if strings.EqualFold(existing, id) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // getGCOutputResources [GC stands for Garbage Collection] compares two slices of resource ids and
 // returns a slice of OutputResources that contains the elements that are in the "previous" slice but not in the "current".
-func (d *bicepDriver) getGCOutputResources(current []string, previous []string) ([]rpv1.OutputResource, error) {
+func getGCOutputResources(current []string, previous []string) ([]rpv1.OutputResource, error) {
 	// We can easily determine which resources have changed via a brute-force search comparing IDs.
 	// The lists of resources we work with are small, so this is fine.
 	diff := []rpv1.OutputResource{}
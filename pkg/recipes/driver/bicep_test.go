@@ -515,7 +515,6 @@ func Test_Bicep_GetRecipeMetadata_Error(t *testing.T) {
 }
 
 func Test_GetGCOutputResources(t *testing.T) {
-	d := &bicepDriver{}
 	before := []string{
 		"/subscriptions/test-sub/resourceGroups/test-rg/providers/System.Test/testResources/resource1",
 		"/subscriptions/test-sub/resourceGroups/test-rg/providers/System.Test/testResources/resource2",
@@ -534,13 +533,12 @@ func Test_GetGCOutputResources(t *testing.T) {
 			RadiusManaged: to.Ptr(true),
 		},
 	}
-	res, err := d.getGCOutputResources(after, before)
+	res, err := getGCOutputResources(after, before)
 	require.NoError(t, err)
 	require.Equal(t, exp, res)
 }
 
 func Test_GetGCOutputResources_NoDiff(t *testing.T) {
-	d := &bicepDriver{}
 	before := []string{
 		"/subscriptions/test-sub/resourceGroups/test-rg/providers/System.Test/testResources/resource1",
 		"/subscriptions/test-sub/resourceGroups/test-rg/providers/System.Test/testResources/resource2",
@@ -550,7 +548,7 @@ func Test_GetGCOutputResources_NoDiff(t *testing.T) {
 		"/subscriptions/test-sub/resourceGroups/test-rg/providers/System.Test/testResources/resource2",
 	}
 	exp := []rpv1.OutputResource{}
-	res, err := d.getGCOutputResources(after, before)
+	res, err := getGCOutputResources(after, before)
 	require.NoError(t, err)
 	require.Equal(t, exp, res)
 }
@@ -586,3 +584,38 @@ func Test_Bicep_Delete_Success_AfterRetry(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+func Test_Bicep_PrepareRecipePlan(t *testing.T) {
+	definition := recipes.EnvironmentDefinition{
+		Name:         "mongoAzure",
+		Driver:       recipes.TemplateKindBicep,
+		TemplatePath: "radiusdev.azurecr.io/recipes/mongodatabases/azure:1.0",
+	}
+	accountID := "/subscriptions/test-sub/resourceGroups/test-rg/providers/Microsoft.DocumentDB/databaseAccounts/acc1"
+	databaseID := "/subscriptions/test-sub/resourceGroups/test-rg/providers/Microsoft.DocumentDB/databaseAccounts/acc1/mongodbDatabases/db1"
+	staleID := "/subscriptions/test-sub/resourceGroups/test-rg/providers/Microsoft.Cache/redis/old"
+
+	changes := []*armresources.WhatIfChange{
+		{ResourceID: to.Ptr(accountID), ChangeType: to.Ptr(armresources.ChangeTypeModify)},
+		{ResourceID: to.Ptr(databaseID), ChangeType: to.Ptr(armresources.ChangeTypeCreate)},
+		{ResourceID: to.Ptr("/subscriptions/test-sub/resourceGroups/test-rg/providers/Microsoft.Network/virtualNetworks/vnet"), ChangeType: to.Ptr(armresources.ChangeTypeUnsupported)},
+		{ResourceID: to.Ptr("/subscriptions/test-sub/resourceGroups/test-rg/providers/Microsoft.Network/privateEndpoints/pe"), ChangeType: to.Ptr(armresources.ChangeTypeDeploy)},
+	}
+
+	plan := prepareRecipePlan(definition, changes, []string{strings.ToLower(accountID), staleID})
+
+	expected := &recipes.RecipePlan{
+		Changes: []recipes.ResourceChange{
+			{ID: accountID, Type: "Microsoft.DocumentDB/databaseAccounts", Action: recipes.ResourceChangeActionUpdate},
+			{ID: databaseID, Type: "Microsoft.DocumentDB/databaseAccounts/mongodbDatabases", Action: recipes.ResourceChangeActionCreate},
+			{ID: "/subscriptions/test-sub/resourceGroups/test-rg/providers/Microsoft.Network/virtualNetworks/vnet", Type: "Microsoft.Network/virtualNetworks", Action: recipes.ResourceChangeActionUnknown},
+			{ID: "/subscriptions/test-sub/resourceGroups/test-rg/providers/Microsoft.Network/privateEndpoints/pe", Type: "Microsoft.Network/privateEndpoints", Action: recipes.ResourceChangeActionUnknown},
+			{ID: staleID, Type: "Microsoft.Cache/redis", Action: recipes.ResourceChangeActionDelete},
+		},
+		Status: &rpv1.RecipeStatus{
+			TemplateKind: recipes.TemplateKindBicep,
+			TemplatePath: "radiusdev.azurecr.io/recipes/mongodatabases/azure:1.0",
+		},
+	}
+	require.Equal(t, expected, plan)
+}
@@ -0,0 +1,441 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/releaseutil"
+	k8s_errors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/radius-project/radius/pkg/metrics"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/helm"
+	"github.com/radius-project/radius/pkg/recipes/recipecontext"
+	recipes_util "github.com/radius-project/radius/pkg/recipes/util"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// helmReleaseNameMaxLength is the maximum length of a Helm release name.
+	helmReleaseNameMaxLength = 53
+
+	// helmReleaseNameHashLength is the length of the resource ID hash appended to the release name.
+	helmReleaseNameHashLength = 8
+)
+
+var invalidReleaseNameChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
+var _ Driver = (*helmDriver)(nil)
+
+// NewHelmDriver creates a new instance of driver to execute a Helm chart recipe.
+func NewHelmDriver(k8sConfig *rest.Config, runtimeClient client.Reader, options HelmOptions) Driver {
+	return &helmDriver{
+		helmClient:    helm.NewClient(k8sConfig, options.Timeout),
+		runtimeClient: runtimeClient,
+	}
+}
+
+// HelmOptions represents the options required for execution of Helm driver.
+type HelmOptions struct {
+	// Timeout is the maximum time to wait for the Kubernetes resources of a release to become ready or to be deleted.
+	// Defaults to 10 minutes.
+	Timeout time.Duration
+}
+
+// helmDriver represents a driver to interact with Helm chart recipes - install or upgrade releases, uninstall releases, etc.
+type helmDriver struct {
+	// helmClient is used to load charts and to install, upgrade and uninstall releases.
+	helmClient helm.Client
+
+	// runtimeClient is used to read the live objects of the release when the recipe is planned.
+	runtimeClient client.Reader
+}
+
+// Execute loads the chart of the recipe and installs (or upgrades) it as a release named after the resource, in the
+// Kubernetes namespace of the resource. The recipe parameters are passed as the chart values along with the recipe
+// context, which is set as the "context" value. It returns the Kubernetes objects of the release as the output resources,
+// along with the values and secrets defined by the chart outputs.
+func (d *helmDriver) Execute(ctx context.Context, opts ExecuteOptions) (*recipes.RecipeOutput, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	logger.Info(fmt.Sprintf("Deploying recipe: %q, template: %q", opts.Definition.Name, opts.Definition.TemplatePath))
+
+	recipeContext, err := recipecontext.New(&opts.Recipe, &opts.Configuration)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	namespace, releaseName, err := helmRelease(recipeContext)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	downloadStartTime := time.Now()
+	helmChart, err := d.helmClient.LoadChart(ctx, &opts.Definition)
+	if err != nil {
+		metrics.DefaultRecipeEngineMetrics.RecordRecipeDownloadDuration(ctx, downloadStartTime,
+			metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDownloadRecipe, opts.Recipe.Name, &opts.Definition, recipes.RecipeDownloadFailed))
+		return nil, recipes.NewRecipeError(recipes.RecipeDownloadFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+	metrics.DefaultRecipeEngineMetrics.RecordRecipeDownloadDuration(ctx, downloadStartTime,
+		metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDownloadRecipe, opts.Recipe.Name, &opts.Definition, metrics.SuccessfulOperationState))
+
+	values, err := createHelmValues(opts.Recipe.Parameters, opts.Definition.Parameters, recipeContext)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	rel, err := d.helmClient.Deploy(ctx, helm.DeployOptions{
+		Namespace:   namespace,
+		ReleaseName: releaseName,
+		Chart:       helmChart,
+		Values:      values,
+	})
+	if err != nil {
+		return nil, deploymentError(opts, err)
+	}
+
+	recipeResponse, err := d.prepareRecipeResponse(opts.Definition, rel)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.InvalidRecipeOutputs, fmt.Sprintf("failed to read the recipe outputs: %s", err.Error()), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	return recipeResponse, nil
+}
+
+// Plan loads the chart of the recipe and renders the release without installing or upgrading it. The Kubernetes objects
+// of the rendered release are compared with the live objects in the cluster.
+func (d *helmDriver) Plan(ctx context.Context, opts ExecuteOptions) (*recipes.RecipePlan, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	logger.Info(fmt.Sprintf("Planning recipe: %q, template: %q", opts.Definition.Name, opts.Definition.TemplatePath))
+
+	recipeContext, err := recipecontext.New(&opts.Recipe, &opts.Configuration)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	namespace, releaseName, err := helmRelease(recipeContext)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	helmChart, err := d.helmClient.LoadChart(ctx, &opts.Definition)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDownloadFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	values, err := createHelmValues(opts.Recipe.Parameters, opts.Definition.Parameters, recipeContext)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	rel, err := d.helmClient.Deploy(ctx, helm.DeployOptions{
+		Namespace:   namespace,
+		ReleaseName: releaseName,
+		Chart:       helmChart,
+		Values:      values,
+		DryRun:      true,
+	})
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, fmt.Sprintf("failed to plan recipe %s of type %s: %s", opts.Recipe.Name, opts.Definition.ResourceType, err.Error()), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+	if rel == nil {
+		err := errors.New("helm release is empty")
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	objects, err := releaseObjects(rel)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	plan, err := prepareObjectsPlan(ctx, d.runtimeClient, &rpv1.RecipeStatus{
+		TemplateKind:    recipes.TemplateKindHelm,
+		TemplatePath:    opts.Definition.TemplatePath,
+		TemplateVersion: opts.Definition.TemplateVersion,
+	}, objects, opts.PrevState)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	return plan, nil
+}
+
+// Delete uninstalls the release of the recipe, which deletes the Kubernetes objects deployed by the chart.
+func (d *helmDriver) Delete(ctx context.Context, opts DeleteOptions) error {
+	recipeContext, err := recipecontext.New(&opts.Recipe, &opts.Configuration)
+	if err != nil {
+		return recipes.NewRecipeError(recipes.RecipeDeletionFailed, err.Error(), "", recipes.GetErrorDetails(err))
+	}
+
+	namespace, releaseName, err := helmRelease(recipeContext)
+	if err != nil {
+		return recipes.NewRecipeError(recipes.RecipeDeletionFailed, err.Error(), "", recipes.GetErrorDetails(err))
+	}
+
+	err = d.helmClient.Uninstall(ctx, namespace, releaseName)
+	if err != nil {
+		return recipes.NewRecipeError(recipes.RecipeDeletionFailed, err.Error(), "", recipes.GetErrorDetails(err))
+	}
+
+	return nil
+}
+
+// GetRecipeMetadata returns the Helm chart parameters, which are the top-level values of the chart. The default value
+// of each parameter is the default value of the chart, and the type and description are read from the values schema
+// of the chart if it has one.
+func (d *helmDriver) GetRecipeMetadata(ctx context.Context, opts BaseOptions) (map[string]any, error) {
+	helmChart, err := d.helmClient.LoadChart(ctx, &opts.Definition)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeGetMetadataFailed, err.Error(), "", recipes.GetErrorDetails(err))
+	}
+
+	parameters, err := chartParameters(helmChart)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeGetMetadataFailed, err.Error(), "", recipes.GetErrorDetails(err))
+	}
+
+	return map[string]any{
+		recipeParameters: parameters,
+	}, nil
+}
+
+// prepareRecipeResponse populates the recipe response from the release. The output resources are the Kubernetes
+// objects in the manifest of the release. The values and secrets are read from:
+//
+//   - the rendered NOTES.txt of the chart, if it is a YAML (or JSON) document with a "result" object, using the same
+//     format as the "result" output of Bicep and Terraform recipes.
+//   - the data of the ConfigMaps (values) and Secrets (secrets) of the release that have the "radapp.io/recipe-output"
+//     annotation set to "true". ConfigMap values that are valid JSON are decoded.
+func (d *helmDriver) prepareRecipeResponse(definition recipes.EnvironmentDefinition, rel *release.Release) (*recipes.RecipeOutput, error) {
+	if rel == nil {
+		return nil, errors.New("helm release is empty")
+	}
+
+	recipeResponse := &recipes.RecipeOutput{
+		Resources: []string{},
+		Values:    map[string]any{},
+		Secrets:   map[string]any{},
+	}
+
+	if rel.Info != nil && strings.TrimSpace(rel.Info.Notes) != "" {
+		notes := map[string]any{}
+		// NOTES.txt is free-form text for most charts, so only a document with a "result" object is used.
+		if err := yaml.Unmarshal([]byte(rel.Info.Notes), &notes); err == nil {
+			if result, ok := notes[recipes.ResultPropertyName].(map[string]any); ok {
+				if err := recipeResponse.PrepareRecipeResponse(result); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	objects, err := releaseObjects(rel)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, obj := range objects {
+		id := resources_kubernetes.IDFromParts(resources_kubernetes.PlaneNameTODO, obj.GroupVersionKind().Group, obj.GetKind(), obj.GetNamespace(), obj.GetName())
+		if !containsResourceID(recipeResponse.Resources, id) {
+			recipeResponse.Resources = append(recipeResponse.Resources, id.String())
+		}
+
+		if err := addObjectOutputs(recipeResponse, obj); err != nil {
+			return nil, err
+		}
+	}
+
+	recipeResponse.Status = &rpv1.RecipeStatus{
+		TemplateKind:    recipes.TemplateKindHelm,
+		TemplatePath:    definition.TemplatePath,
+		TemplateVersion: definition.TemplateVersion,
+	}
+
+	return recipeResponse, nil
+}
+
+// releaseObjects returns the Kubernetes objects in the manifest of the release. The namespace of namespaced objects
+// defaults to the namespace of the release.
+func releaseObjects(rel *release.Release) ([]*unstructured.Unstructured, error) {
+	manifests := releaseutil.SplitManifests(rel.Manifest)
+	keys := make([]string, 0, len(manifests))
+	for k := range manifests {
+		keys = append(keys, k)
+	}
+	sort.Sort(releaseutil.BySplitManifestsOrder(keys))
+
+	objects := []*unstructured.Unstructured{}
+	for _, k := range keys {
+		obj := &unstructured.Unstructured{}
+		if err := yaml.Unmarshal([]byte(manifests[k]), &obj.Object); err != nil {
+			return nil, fmt.Errorf("failed to decode the manifest of release %q: %w", rel.Name, err)
+		}
+		if len(obj.Object) == 0 {
+			continue
+		}
+		if obj.GetKind() == "" || obj.GetName() == "" {
+			return nil, fmt.Errorf("the manifest of release %q contains an object without a kind or name", rel.Name)
+		}
+		if obj.GetNamespace() == "" && !isClusterScoped(obj) {
+			obj.SetNamespace(rel.Namespace)
+		}
+		objects = append(objects, obj)
+	}
+
+	return objects, nil
+}
+
+// isClusterScoped returns true for the common cluster-scoped kinds. Other objects are assumed to be namespaced.
+func isClusterScoped(obj *unstructured.Unstructured) bool {
+	switch obj.GroupVersionKind().GroupKind().String() {
+	case "Namespace", "PersistentVolume", "StorageClass.storage.k8s.io", "ClusterRole.rbac.authorization.k8s.io",
+		"ClusterRoleBinding.rbac.authorization.k8s.io", "CustomResourceDefinition.apiextensions.k8s.io":
+		return true
+	}
+
+	return false
+}
+
+// deploymentError returns the recipe error for a failure to deploy the Kubernetes objects of a Helm or Kubernetes
+// manifest recipe. applications-rp is only allowed to manage the kinds of objects granted to its ClusterRole, so the
+// error of a forbidden request points to the setting of the Radius Helm chart that grants additional kinds.
+func deploymentError(opts ExecuteOptions, err error) error {
+	message := fmt.Sprintf("failed to deploy recipe %s of type %s: %s", opts.Recipe.Name, opts.Definition.ResourceType, err.Error())
+	if k8s_errors.IsForbidden(err) {
+		message += ". The Radius resource provider is not allowed to manage the objects of the recipe: grant the permissions with rp.recipes.extraRBAC in the values of the Radius Helm chart"
+	}
+
+	return recipes.NewRecipeError(recipes.RecipeDeploymentFailed, message, recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+}
+
+func containsResourceID(ids []string, id resources.ID) bool {
+	for _, existing := range ids {
+		if strings.EqualFold(existing, id.String()) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// helmRelease returns the namespace and the name of the release for the resource in the recipe context. The release
+// name is derived from the resource name, and a hash of the resource ID is added so that it is unique.
+func helmRelease(recipeContext *recipecontext.Context) (namespace string, releaseName string, err error) {
+	if recipeContext.Runtime.Kubernetes == nil || recipeContext.Runtime.Kubernetes.Namespace == "" {
+		return "", "", errors.New("a Kubernetes namespace is required to deploy a Helm recipe")
+	}
+
+	hash := sha256.Sum256([]byte(strings.ToLower(recipeContext.Resource.ID)))
+	suffix := hex.EncodeToString(hash[:])[:helmReleaseNameHashLength]
+
+	prefix := invalidReleaseNameChars.ReplaceAllString(strings.ToLower(recipeContext.Resource.Name), "-")
+	maxPrefixLength := helmReleaseNameMaxLength - helmReleaseNameHashLength - 1
+	if len(prefix) > maxPrefixLength {
+		prefix = prefix[:maxPrefixLength]
+	}
+	prefix = strings.Trim(prefix, "-")
+	if prefix == "" {
+		return recipeContext.Runtime.Kubernetes.Namespace, "recipe-" + suffix, nil
+	}
+
+	return recipeContext.Runtime.Kubernetes.Namespace, prefix + "-" + suffix, nil
+}
+
+// createHelmValues creates the values passed to the chart after handling conflicts in parameters set by operator and developer.
+// In case of conflict the developer parameter takes precedence. The recipe context is added as the "context" value.
+func createHelmValues(devParams, operatorParams map[string]any, recipeContext *recipecontext.Context) (map[string]any, error) {
+	values := map[string]any{}
+	for k, v := range operatorParams {
+		values[k] = v
+	}
+	for k, v := range devParams {
+		values[k] = v
+	}
+
+	contextValue, err := recipeContextValue(recipeContext)
+	if err != nil {
+		return nil, err
+	}
+	values[recipecontext.RecipeContextParamKey] = contextValue
+
+	return values, nil
+}
+
+// chartParameters returns the top-level values of the chart in the format of recipe parameters.
+func chartParameters(helmChart *chart.Chart) (map[string]any, error) {
+	schemaProperties := map[string]any{}
+	if len(helmChart.Schema) > 0 {
+		schema := map[string]any{}
+		if err := json.Unmarshal(helmChart.Schema, &schema); err != nil {
+			return nil, fmt.Errorf("failed to decode the values schema of chart %q: %w", helmChart.Name(), err)
+		}
+		if properties, ok := schema["properties"].(map[string]any); ok {
+			schemaProperties = properties
+		}
+	}
+
+	parameters := map[string]any{}
+	for name, defaultValue := range helmChart.Values {
+		parameters[name] = map[string]any{
+			"defaultValue": defaultValue,
+		}
+	}
+
+	for name, property := range schemaProperties {
+		if name == recipecontext.RecipeContextParamKey {
+			continue
+		}
+
+		parameter, ok := parameters[name].(map[string]any)
+		if !ok {
+			parameter = map[string]any{}
+			parameters[name] = parameter
+		}
+
+		if p, ok := property.(map[string]any); ok {
+			for _, key := range []string{"type", "description"} {
+				if v, ok := p[key]; ok {
+					parameter[key] = v
+				}
+			}
+		}
+	}
+
+	// The recipe context is set by Radius, so it is not a parameter of the recipe.
+	delete(parameters, recipecontext.RecipeContextParamKey)
+
+	return parameters, nil
+}
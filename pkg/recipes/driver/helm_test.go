@@ -0,0 +1,521 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	gomock "github.com/golang/mock/gomock"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/helm"
+	"github.com/radius-project/radius/pkg/recipes/recipecontext"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/release"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8s_errors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const (
+	testHelmManifest = `---
+# Source: redis/templates/service.yaml
+apiVersion: v1
+kind: Service
+metadata:
+  name: redis
+spec:
+  ports:
+  - port: 6379
+---
+# Source: redis/templates/deployment.yaml
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: redis
+  namespace: test-namespace
+---
+# Source: redis/templates/outputs.yaml
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: redis-outputs
+  annotations:
+    radapp.io/recipe-output: "true"
+data:
+  host: redis.test-namespace.svc.cluster.local
+  port: "6379"
+---
+# Source: redis/templates/secret.yaml
+apiVersion: v1
+kind: Secret
+metadata:
+  name: redis-secrets
+  annotations:
+    radapp.io/recipe-output: "true"
+data:
+  password: c2VjcmV0
+stringData:
+  connectionString: redis.test-namespace.svc.cluster.local:6379,password=secret
+---
+# Source: redis/templates/other.yaml
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: redis-config
+data:
+  ignored: "true"
+`
+
+	testHelmNotes = `result:
+  values:
+    username: admin
+  resources:
+  - /planes/aws/aws/accounts/000/regions/us-west-2/providers/AWS.ElastiCache/CacheCluster/redis
+`
+)
+
+func setupHelm(t *testing.T, objects ...runtimeclient.Object) (*helm.MockClient, helmDriver) {
+	ctrl := gomock.NewController(t)
+	client := helm.NewMockClient(ctrl)
+	runtimeClient := fake.NewClientBuilder().WithObjects(objects...).Build()
+
+	return client, helmDriver{helmClient: client, runtimeClient: runtimeClient}
+}
+
+func buildHelmTestInputs() (recipes.Configuration, recipes.ResourceMetadata, recipes.EnvironmentDefinition) {
+	envConfig := recipes.Configuration{
+		Runtime: recipes.RuntimeConfiguration{
+			Kubernetes: &recipes.KubernetesRuntime{
+				Namespace:            "test-namespace",
+				EnvironmentNamespace: "test-env-namespace",
+			},
+		},
+	}
+
+	recipeMetadata := recipes.ResourceMetadata{
+		Name:          "redis",
+		ApplicationID: "/planes/radius/local/resourcegroups/test-rg/providers/applications.core/applications/app1",
+		EnvironmentID: "/planes/radius/local/resourcegroups/test-rg/providers/applications.core/environments/env1",
+		ResourceID:    "/planes/radius/local/resourceGroups/test-rg/providers/applications.datastores/rediscaches/test-redis",
+		Parameters: map[string]any{
+			"replicas": 2,
+		},
+	}
+
+	envRecipe := recipes.EnvironmentDefinition{
+		Name:            "redis",
+		Driver:          recipes.TemplateKindHelm,
+		TemplatePath:    "oci://myregistry.azurecr.io/charts/redis",
+		ResourceType:    "Applications.Datastores/redisCaches",
+		TemplateVersion: "1.0.0",
+		Parameters: map[string]any{
+			"replicas": 1,
+			"image":    "redis:7",
+		},
+	}
+
+	return envConfig, recipeMetadata, envRecipe
+}
+
+func Test_Helm_Execute_Success(t *testing.T) {
+	ctx := testcontext.New(t)
+	client, driver := setupHelm(t)
+	envConfig, recipeMetadata, envRecipe := buildHelmTestInputs()
+
+	helmChart := &chart.Chart{Metadata: &chart.Metadata{Name: "redis", Version: "1.0.0"}}
+	client.EXPECT().LoadChart(ctx, &envRecipe).Times(1).Return(helmChart, nil)
+	client.EXPECT().Deploy(ctx, gomock.Any()).Times(1).DoAndReturn(func(_ any, options helm.DeployOptions) (*release.Release, error) {
+		require.Equal(t, "test-namespace", options.Namespace)
+		require.True(t, strings.HasPrefix(options.ReleaseName, "test-redis-"))
+		require.Same(t, helmChart, options.Chart)
+		require.Equal(t, 2, options.Values["replicas"])
+		require.Equal(t, "redis:7", options.Values["image"])
+
+		recipeContext, ok := options.Values[recipecontext.RecipeContextParamKey].(map[string]any)
+		require.True(t, ok)
+		require.Equal(t, "test-redis", recipeContext["resource"].(map[string]any)["name"])
+
+		return &release.Release{
+			Name:      options.ReleaseName,
+			Namespace: options.Namespace,
+			Manifest:  testHelmManifest,
+			Info:      &release.Info{Notes: testHelmNotes},
+		}, nil
+	})
+
+	recipeOutput, err := driver.Execute(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.NoError(t, err)
+
+	expected := &recipes.RecipeOutput{
+		Resources: []string{
+			"/planes/aws/aws/accounts/000/regions/us-west-2/providers/AWS.ElastiCache/CacheCluster/redis",
+			"/planes/kubernetes/local/namespaces/test-namespace/providers/core/Service/redis",
+			"/planes/kubernetes/local/namespaces/test-namespace/providers/apps/Deployment/redis",
+			"/planes/kubernetes/local/namespaces/test-namespace/providers/core/ConfigMap/redis-outputs",
+			"/planes/kubernetes/local/namespaces/test-namespace/providers/core/Secret/redis-secrets",
+			"/planes/kubernetes/local/namespaces/test-namespace/providers/core/ConfigMap/redis-config",
+		},
+		Values: map[string]any{
+			"username": "admin",
+			"host":     "redis.test-namespace.svc.cluster.local",
+			"port":     float64(6379),
+		},
+		Secrets: map[string]any{
+			"password":         "secret",
+			"connectionString": "redis.test-namespace.svc.cluster.local:6379,password=secret",
+		},
+		Status: &rpv1.RecipeStatus{
+			TemplateKind:    recipes.TemplateKindHelm,
+			TemplatePath:    "oci://myregistry.azurecr.io/charts/redis",
+			TemplateVersion: "1.0.0",
+		},
+	}
+	require.Equal(t, expected, recipeOutput)
+}
+
+func Test_Helm_Plan(t *testing.T) {
+	ctx := testcontext.New(t)
+	client, driver := setupHelm(t,
+		&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "redis", Namespace: "test-namespace"}},
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "redis-config", Namespace: "test-namespace"},
+			Data:       map[string]string{"ignored": "false"},
+		},
+	)
+	envConfig, recipeMetadata, envRecipe := buildHelmTestInputs()
+
+	helmChart := &chart.Chart{Metadata: &chart.Metadata{Name: "redis", Version: "1.0.0"}}
+	client.EXPECT().LoadChart(ctx, &envRecipe).Times(1).Return(helmChart, nil)
+	client.EXPECT().Deploy(ctx, gomock.Any()).Times(1).DoAndReturn(func(_ any, options helm.DeployOptions) (*release.Release, error) {
+		require.True(t, options.DryRun)
+		return &release.Release{
+			Name:      options.ReleaseName,
+			Namespace: options.Namespace,
+			Manifest:  testHelmManifest,
+			Info:      &release.Info{Notes: testHelmNotes},
+		}, nil
+	})
+
+	staleID := "/planes/kubernetes/local/namespaces/test-namespace/providers/core/Service/redis-old"
+	plan, err := driver.Plan(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+		PrevState: []string{
+			"/planes/kubernetes/local/namespaces/test-namespace/providers/apps/Deployment/redis",
+			staleID,
+		},
+	})
+	require.NoError(t, err)
+
+	expected := &recipes.RecipePlan{
+		Changes: []recipes.ResourceChange{
+			{ID: "/planes/kubernetes/local/namespaces/test-namespace/providers/core/Service/redis", Type: "core/Service", Action: recipes.ResourceChangeActionCreate},
+			{ID: "/planes/kubernetes/local/namespaces/test-namespace/providers/apps/Deployment/redis", Type: "apps/Deployment", Action: recipes.ResourceChangeActionNoChange},
+			{ID: "/planes/kubernetes/local/namespaces/test-namespace/providers/core/ConfigMap/redis-outputs", Type: "core/ConfigMap", Action: recipes.ResourceChangeActionCreate},
+			{ID: "/planes/kubernetes/local/namespaces/test-namespace/providers/core/Secret/redis-secrets", Type: "core/Secret", Action: recipes.ResourceChangeActionCreate},
+			{ID: "/planes/kubernetes/local/namespaces/test-namespace/providers/core/ConfigMap/redis-config", Type: "core/ConfigMap", Action: recipes.ResourceChangeActionUpdate},
+			{ID: staleID, Type: "core/Service", Action: recipes.ResourceChangeActionDelete},
+		},
+		Status: &rpv1.RecipeStatus{
+			TemplateKind:    recipes.TemplateKindHelm,
+			TemplatePath:    "oci://myregistry.azurecr.io/charts/redis",
+			TemplateVersion: "1.0.0",
+		},
+	}
+	require.Equal(t, expected, plan)
+}
+
+func Test_Helm_Plan_AfterExecute(t *testing.T) {
+	ctx := testcontext.New(t)
+	envConfig, recipeMetadata, envRecipe := buildHelmTestInputs()
+
+	// The objects of the installed release, as read back from the cluster.
+	installed, err := releaseObjects(&release.Release{Name: "redis", Namespace: "test-namespace", Manifest: testHelmManifest})
+	require.NoError(t, err)
+	objects := []runtimeclient.Object{}
+	for _, obj := range installed {
+		if obj.GetKind() == "Secret" {
+			require.NoError(t, encodeStringData(obj))
+		}
+		annotations := obj.GetAnnotations()
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations["meta.helm.sh/release-name"] = "redis"
+		annotations["meta.helm.sh/release-namespace"] = "test-namespace"
+		obj.SetAnnotations(annotations)
+		objects = append(objects, obj)
+	}
+
+	client, driver := setupHelm(t, objects...)
+	helmChart := &chart.Chart{Metadata: &chart.Metadata{Name: "redis", Version: "1.0.0"}}
+	client.EXPECT().LoadChart(ctx, &envRecipe).Times(1).Return(helmChart, nil)
+	client.EXPECT().Deploy(ctx, gomock.Any()).Times(1).DoAndReturn(func(_ any, options helm.DeployOptions) (*release.Release, error) {
+		return &release.Release{
+			Name:      options.ReleaseName,
+			Namespace: options.Namespace,
+			Manifest:  testHelmManifest,
+			Info:      &release.Info{Notes: testHelmNotes},
+		}, nil
+	})
+
+	plan, err := driver.Plan(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, plan.Changes, 5)
+	for _, change := range plan.Changes {
+		require.Equal(t, recipes.ResourceChangeActionNoChange, change.Action, change.ID)
+	}
+}
+
+func Test_Helm_Execute_LoadChartFailure(t *testing.T) {
+	ctx := testcontext.New(t)
+	client, driver := setupHelm(t)
+	envConfig, recipeMetadata, envRecipe := buildHelmTestInputs()
+
+	client.EXPECT().LoadChart(ctx, &envRecipe).Times(1).Return(nil, errors.New("chart not found"))
+
+	_, err := driver.Execute(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.Error(t, err)
+
+	recipeError, ok := err.(*recipes.RecipeError)
+	require.True(t, ok)
+	require.Equal(t, recipes.RecipeDownloadFailed, recipeError.ErrorDetails.Code)
+}
+
+func Test_Helm_Execute_DeployFailure(t *testing.T) {
+	ctx := testcontext.New(t)
+	client, driver := setupHelm(t)
+	envConfig, recipeMetadata, envRecipe := buildHelmTestInputs()
+
+	client.EXPECT().LoadChart(ctx, &envRecipe).Times(1).Return(&chart.Chart{Metadata: &chart.Metadata{Name: "redis"}}, nil)
+	client.EXPECT().Deploy(ctx, gomock.Any()).Times(1).Return(nil, errors.New("timed out waiting for the condition"))
+
+	_, err := driver.Execute(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.Error(t, err)
+
+	recipeError, ok := err.(*recipes.RecipeError)
+	require.True(t, ok)
+	require.Equal(t, recipes.RecipeDeploymentFailed, recipeError.ErrorDetails.Code)
+	require.Contains(t, recipeError.ErrorDetails.Message, "timed out waiting for the condition")
+}
+
+func Test_Helm_Execute_DeployForbidden(t *testing.T) {
+	ctx := testcontext.New(t)
+	client, driver := setupHelm(t)
+	envConfig, recipeMetadata, envRecipe := buildHelmTestInputs()
+
+	forbidden := k8s_errors.NewForbidden(schema.GroupResource{Group: "batch", Resource: "jobs"}, "redis", errors.New("access denied"))
+	client.EXPECT().LoadChart(ctx, &envRecipe).Times(1).Return(&chart.Chart{Metadata: &chart.Metadata{Name: "redis"}}, nil)
+	client.EXPECT().Deploy(ctx, gomock.Any()).Times(1).Return(nil, fmt.Errorf("failed to install release: %w", forbidden))
+
+	_, err := driver.Execute(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.Error(t, err)
+
+	recipeError, ok := err.(*recipes.RecipeError)
+	require.True(t, ok)
+	require.Equal(t, recipes.RecipeDeploymentFailed, recipeError.ErrorDetails.Code)
+	require.Contains(t, recipeError.ErrorDetails.Message, "rp.recipes.extraRBAC")
+}
+
+func Test_Helm_Execute_NoNamespace(t *testing.T) {
+	ctx := testcontext.New(t)
+	_, driver := setupHelm(t)
+	_, recipeMetadata, envRecipe := buildHelmTestInputs()
+
+	_, err := driver.Execute(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: recipes.Configuration{Runtime: recipes.RuntimeConfiguration{Kubernetes: &recipes.KubernetesRuntime{}}},
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "a Kubernetes namespace is required to deploy a Helm recipe")
+}
+
+func Test_Helm_Delete(t *testing.T) {
+	ctx := testcontext.New(t)
+	client, driver := setupHelm(t)
+	envConfig, recipeMetadata, envRecipe := buildHelmTestInputs()
+
+	recipeContext, err := recipecontext.New(&recipeMetadata, &envConfig)
+	require.NoError(t, err)
+	namespace, releaseName, err := helmRelease(recipeContext)
+	require.NoError(t, err)
+
+	t.Run("success", func(t *testing.T) {
+		client.EXPECT().Uninstall(ctx, namespace, releaseName).Times(1).Return(nil)
+
+		err := driver.Delete(ctx, DeleteOptions{
+			BaseOptions: BaseOptions{
+				Configuration: envConfig,
+				Recipe:        recipeMetadata,
+				Definition:    envRecipe,
+			},
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		client.EXPECT().Uninstall(ctx, namespace, releaseName).Times(1).Return(errors.New("uninstall failed"))
+
+		err := driver.Delete(ctx, DeleteOptions{
+			BaseOptions: BaseOptions{
+				Configuration: envConfig,
+				Recipe:        recipeMetadata,
+				Definition:    envRecipe,
+			},
+		})
+		require.Error(t, err)
+
+		recipeError, ok := err.(*recipes.RecipeError)
+		require.True(t, ok)
+		require.Equal(t, recipes.RecipeDeletionFailed, recipeError.ErrorDetails.Code)
+	})
+}
+
+func Test_Helm_GetRecipeMetadata(t *testing.T) {
+	ctx := testcontext.New(t)
+	client, driver := setupHelm(t)
+	_, _, envRecipe := buildHelmTestInputs()
+
+	helmChart := &chart.Chart{
+		Metadata: &chart.Metadata{Name: "redis"},
+		Values: map[string]any{
+			"replicas": float64(1),
+			"image":    "redis:7",
+			"context":  map[string]any{},
+		},
+		Schema: []byte(`{"properties": {"replicas": {"type": "integer", "description": "The number of replicas.", "minimum": 1}, "tls": {"type": "boolean"}}}`),
+	}
+	client.EXPECT().LoadChart(ctx, &envRecipe).Times(1).Return(helmChart, nil)
+
+	metadata, err := driver.GetRecipeMetadata(ctx, BaseOptions{Definition: envRecipe})
+	require.NoError(t, err)
+
+	expected := map[string]any{
+		"parameters": map[string]any{
+			"replicas": map[string]any{
+				"defaultValue": float64(1),
+				"type":         "integer",
+				"description":  "The number of replicas.",
+			},
+			"image": map[string]any{
+				"defaultValue": "redis:7",
+			},
+			"tls": map[string]any{
+				"type": "boolean",
+			},
+		},
+	}
+	require.Equal(t, expected, metadata)
+}
+
+func Test_helmRelease(t *testing.T) {
+	tests := []struct {
+		desc         string
+		resourceName string
+		resourceID   string
+		prefix       string
+	}{
+		{
+			desc:         "simple name",
+			resourceName: "redis",
+			resourceID:   "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/redisCaches/redis",
+			prefix:       "redis-",
+		},
+		{
+			desc:         "invalid characters",
+			resourceName: "My_Redis",
+			resourceID:   "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/redisCaches/My_Redis",
+			prefix:       "my-redis-",
+		},
+		{
+			desc:         "long name",
+			resourceName: strings.Repeat("a", 100),
+			resourceID:   "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/redisCaches/" + strings.Repeat("a", 100),
+			prefix:       strings.Repeat("a", 44) + "-",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			recipeContext := &recipecontext.Context{
+				Resource: recipecontext.Resource{
+					ResourceInfo: recipecontext.ResourceInfo{Name: tc.resourceName, ID: tc.resourceID},
+				},
+				Runtime: recipes.RuntimeConfiguration{Kubernetes: &recipes.KubernetesRuntime{Namespace: "default"}},
+			}
+
+			namespace, releaseName, err := helmRelease(recipeContext)
+			require.NoError(t, err)
+			require.Equal(t, "default", namespace)
+			require.True(t, strings.HasPrefix(releaseName, tc.prefix), releaseName)
+			require.LessOrEqual(t, len(releaseName), helmReleaseNameMaxLength)
+
+			// The release name is stable, and case-insensitive to the resource ID.
+			recipeContext.Resource.ID = strings.ToUpper(tc.resourceID)
+			_, other, err := helmRelease(recipeContext)
+			require.NoError(t, err)
+			require.Equal(t, releaseName, other)
+		})
+	}
+}
@@ -0,0 +1,520 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"helm.sh/helm/v3/pkg/releaseutil"
+	k8s_errors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/radius-project/radius/pkg/corerp/handlers"
+	"github.com/radius-project/radius/pkg/metrics"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/manifest"
+	"github.com/radius-project/radius/pkg/recipes/recipecontext"
+	recipes_util "github.com/radius-project/radius/pkg/recipes/util"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// manifestParametersKey is the key of the recipe parameters in the data passed to the manifest templates.
+	manifestParametersKey = "parameters"
+)
+
+var _ Driver = (*kubernetesDriver)(nil)
+
+// NewKubernetesDriver creates a new instance of driver to execute a Kubernetes manifest or Kustomize recipe.
+func NewKubernetesDriver(runtimeClient client.Client, handler handlers.ResourceHandler) Driver {
+	return &kubernetesDriver{
+		runtimeClient: runtimeClient,
+		handler:       handler,
+	}
+}
+
+// kubernetesDriver represents a driver to interact with Kubernetes manifest recipes - render the manifests, apply the
+// rendered objects, delete the objects, etc.
+type kubernetesDriver struct {
+	// runtimeClient is used to determine whether the rendered objects are namespaced, and to read the live objects
+	// when the recipe is planned.
+	runtimeClient client.Client
+
+	// handler is the Kubernetes resource handler used to apply the rendered objects, wait until they are ready, and
+	// delete them.
+	handler handlers.ResourceHandler
+}
+
+// Execute renders the manifests of the recipe with the recipe context and parameters, and applies the rendered objects
+// server-side in the Kubernetes namespace of the resource. Objects that were deployed by the previous execution of the
+// recipe and are no longer rendered are deleted. It returns the applied objects as the output resources, along with the
+// values and secrets of the annotated ConfigMaps and Secrets.
+func (d *kubernetesDriver) Execute(ctx context.Context, opts ExecuteOptions) (*recipes.RecipeOutput, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	logger.Info(fmt.Sprintf("Deploying recipe: %q, template: %q", opts.Definition.Name, opts.Definition.TemplatePath))
+
+	objects, err := d.render(ctx, opts, recipes.RecipeDeploymentFailed)
+	if err != nil {
+		return nil, err
+	}
+
+	recipeResponse := &recipes.RecipeOutput{
+		Resources: []string{},
+		Values:    map[string]any{},
+		Secrets:   map[string]any{},
+	}
+
+	for _, obj := range objects {
+		outputResource := rpv1.NewKubernetesOutputResource(obj.GetName(), obj, metav1.ObjectMeta{Name: obj.GetName(), Namespace: obj.GetNamespace()})
+		_, err := d.handler.Put(ctx, &handlers.PutOptions{Resource: &outputResource})
+		if err != nil {
+			return nil, deploymentError(opts, err)
+		}
+
+		if !containsResourceID(recipeResponse.Resources, outputResource.ID) {
+			recipeResponse.Resources = append(recipeResponse.Resources, outputResource.ID.String())
+		}
+
+		if err := addObjectOutputs(recipeResponse, obj); err != nil {
+			return nil, recipes.NewRecipeError(recipes.InvalidRecipeOutputs, fmt.Sprintf("failed to read the recipe outputs: %s", err.Error()), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+		}
+	}
+
+	recipeResponse.Status = &rpv1.RecipeStatus{
+		TemplateKind: recipes.TemplateKindKubernetes,
+		TemplatePath: opts.Definition.TemplatePath,
+	}
+
+	// Server-side apply does not delete the objects that are no longer rendered by the recipe, so the objects from the
+	// previous deployment that aren't included in the current deployment need to be garbage collected.
+	garbageCollectionStartTime := time.Now()
+	diff, err := getGCOutputResources(recipeResponse.Resources, opts.PrevState)
+	if err != nil {
+		return nil, err
+	}
+
+	err = d.Delete(ctx, DeleteOptions{
+		OutputResources: diff,
+	})
+	if err != nil {
+		metrics.DefaultRecipeEngineMetrics.RecordRecipeGarbageCollectionDuration(ctx, garbageCollectionStartTime,
+			metrics.NewRecipeAttributes(metrics.RecipeEngineOperationGC, opts.Recipe.Name, &opts.Definition, metrics.FailedOperationState))
+		return nil, recipes.NewRecipeError(recipes.RecipeGarbageCollectionFailed, err.Error(), recipes_util.ExecutionError, nil)
+	}
+	metrics.DefaultRecipeEngineMetrics.RecordRecipeGarbageCollectionDuration(ctx, garbageCollectionStartTime,
+		metrics.NewRecipeAttributes(metrics.RecipeEngineOperationGC, opts.Recipe.Name, &opts.Definition, metrics.SuccessfulOperationState))
+
+	return recipeResponse, nil
+}
+
+// Plan renders the manifests of the recipe and compares the rendered objects with the live objects in the cluster.
+// Rendered objects that differ from the live objects are reported as updated, and objects deployed by the previous
+// execution of the recipe that are no longer rendered are reported as deleted.
+func (d *kubernetesDriver) Plan(ctx context.Context, opts ExecuteOptions) (*recipes.RecipePlan, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	logger.Info(fmt.Sprintf("Planning recipe: %q, template: %q", opts.Definition.Name, opts.Definition.TemplatePath))
+
+	objects, err := d.render(ctx, opts, recipes.RecipePlanFailed)
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := prepareObjectsPlan(ctx, d.runtimeClient, &rpv1.RecipeStatus{
+		TemplateKind: recipes.TemplateKindKubernetes,
+		TemplatePath: opts.Definition.TemplatePath,
+	}, objects, opts.PrevState)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	return plan, nil
+}
+
+// Delete deletes the output resources that are marked as managed by Radius, in the reverse order of their deployment.
+func (d *kubernetesDriver) Delete(ctx context.Context, opts DeleteOptions) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	for i := len(opts.OutputResources) - 1; i >= 0; i-- {
+		outputResource := opts.OutputResources[i]
+
+		// If the resource is not managed by Radius, skip the deletion
+		if outputResource.RadiusManaged == nil || !*outputResource.RadiusManaged {
+			logger.Info(fmt.Sprintf("Skipping deletion of output resource: %q, not managed by Radius", outputResource.ID.String()))
+			continue
+		}
+
+		logger.Info(fmt.Sprintf("Deleting output resource: %q", outputResource.ID.String()))
+		err := d.handler.Delete(ctx, &handlers.DeleteOptions{Resource: &outputResource})
+		if err != nil {
+			return recipes.NewRecipeError(recipes.RecipeDeletionFailed, err.Error(), "", recipes.GetErrorDetails(err))
+		}
+	}
+
+	return nil
+}
+
+// GetRecipeMetadata returns the parameters of the recipe. Kubernetes manifests do not declare their parameters, so
+// the parameters are always empty.
+func (d *kubernetesDriver) GetRecipeMetadata(ctx context.Context, opts BaseOptions) (map[string]any, error) {
+	return map[string]any{
+		recipeParameters: map[string]any{},
+	}, nil
+}
+
+// render renders the manifests of the recipe with the recipe context and parameters. The namespace of the namespaced
+// objects is set to the Kubernetes namespace of the resource, and the objects are sorted in install order. Errors that
+// aren't download errors are reported with the given error code.
+func (d *kubernetesDriver) render(ctx context.Context, opts ExecuteOptions, errorCode string) ([]*unstructured.Unstructured, error) {
+	recipeContext, err := recipecontext.New(&opts.Recipe, &opts.Configuration)
+	if err != nil {
+		return nil, recipes.NewRecipeError(errorCode, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	if recipeContext.Runtime.Kubernetes == nil || recipeContext.Runtime.Kubernetes.Namespace == "" {
+		err := errors.New("a Kubernetes namespace is required to deploy a Kubernetes recipe")
+		return nil, recipes.NewRecipeError(errorCode, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+	namespace := recipeContext.Runtime.Kubernetes.Namespace
+
+	data, err := createManifestData(opts.Recipe.Parameters, opts.Definition.Parameters, recipeContext)
+	if err != nil {
+		return nil, recipes.NewRecipeError(errorCode, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	downloadStartTime := time.Now()
+	objects, err := manifest.Render(ctx, opts.Definition.TemplatePath, data, manifest.Options{PlainHTTP: opts.Definition.PlainHTTP})
+	if err != nil {
+		metrics.DefaultRecipeEngineMetrics.RecordRecipeDownloadDuration(ctx, downloadStartTime,
+			metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDownloadRecipe, opts.Recipe.Name, &opts.Definition, recipes.RecipeDownloadFailed))
+		return nil, recipes.NewRecipeError(recipes.RecipeDownloadFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+	metrics.DefaultRecipeEngineMetrics.RecordRecipeDownloadDuration(ctx, downloadStartTime,
+		metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDownloadRecipe, opts.Recipe.Name, &opts.Definition, metrics.SuccessfulOperationState))
+
+	for _, obj := range objects {
+		if d.isNamespaced(obj) {
+			obj.SetNamespace(namespace)
+		} else {
+			obj.SetNamespace("")
+		}
+	}
+	sortByInstallOrder(objects)
+
+	return objects, nil
+}
+
+// prepareObjectsPlan populates the recipe plan from the Kubernetes objects that the recipe would deploy. Objects that
+// don't exist in the cluster are reported as created. Existing objects are reported as updated if a field set by the
+// recipe differs from the live object, and as unchanged otherwise. The resources of the previous state that the recipe
+// would no longer deploy are reported as deleted.
+func prepareObjectsPlan(ctx context.Context, reader client.Reader, status *rpv1.RecipeStatus, objects []*unstructured.Unstructured, prevState []string) (*recipes.RecipePlan, error) {
+	plan := &recipes.RecipePlan{
+		Changes: []recipes.ResourceChange{},
+		Status:  status,
+	}
+
+	planned := []string{}
+	for _, obj := range objects {
+		id := resources_kubernetes.IDFromParts(resources_kubernetes.PlaneNameTODO, obj.GroupVersionKind().Group, obj.GetKind(), obj.GetNamespace(), obj.GetName())
+		if containsID(planned, id.String()) {
+			continue
+		}
+		planned = append(planned, id.String())
+
+		action, err := objectChangeAction(ctx, reader, obj)
+		if err != nil {
+			return nil, err
+		}
+
+		plan.Changes = append(plan.Changes, recipes.ResourceChange{
+			ID:     id.String(),
+			Type:   id.Type(),
+			Action: action,
+		})
+	}
+
+	plan.Changes = append(plan.Changes, deletedChanges(planned, prevState)...)
+	return plan, nil
+}
+
+// objectChangeAction compares the object that the recipe would deploy with the live object in the cluster. Only the
+// fields set by the recipe are compared, so that the fields defaulted or added by the cluster are not reported as
+// changes.
+func objectChangeAction(ctx context.Context, reader client.Reader, obj *unstructured.Unstructured) (recipes.ResourceChangeAction, error) {
+	live := &unstructured.Unstructured{}
+	live.SetGroupVersionKind(obj.GroupVersionKind())
+	err := reader.Get(ctx, client.ObjectKeyFromObject(obj), live)
+	if k8s_errors.IsNotFound(err) || meta.IsNoMatchError(err) {
+		return recipes.ResourceChangeActionCreate, nil
+	} else if err != nil {
+		return "", fmt.Errorf("failed to get %s %q: %w", obj.GetKind(), obj.GetName(), err)
+	}
+
+	desired := obj.DeepCopy()
+	unstructured.RemoveNestedField(desired.Object, "status")
+	if desired.GroupVersionKind().GroupKind().String() == "Secret" {
+		if err := encodeStringData(desired); err != nil {
+			return "", err
+		}
+	}
+
+	if containsFields(desired.Object, live.Object) {
+		return recipes.ResourceChangeActionNoChange, nil
+	}
+
+	return recipes.ResourceChangeActionUpdate, nil
+}
+
+// encodeStringData merges the stringData of a Secret into its base64-encoded data, as the API server does when the
+// Secret is written.
+func encodeStringData(secret *unstructured.Unstructured) error {
+	stringData, found, err := unstructured.NestedStringMap(secret.Object, "stringData")
+	if err != nil {
+		return fmt.Errorf("failed to read the stringData of Secret %q: %w", secret.GetName(), err)
+	} else if !found {
+		return nil
+	}
+
+	data, _, err := unstructured.NestedStringMap(secret.Object, "data")
+	if err != nil {
+		return fmt.Errorf("failed to read the data of Secret %q: %w", secret.GetName(), err)
+	}
+	if data == nil {
+		data = map[string]string{}
+	}
+
+	for key, value := range stringData {
+		data[key] = base64.StdEncoding.EncodeToString([]byte(value))
+	}
+
+	unstructured.RemoveNestedField(secret.Object, "stringData")
+	return unstructured.SetNestedStringMap(secret.Object, data, "data")
+}
+
+// containsFields returns true if all the fields of the desired value are set to the same values in the live value.
+// Maps are compared by the keys of the desired map, and lists are compared element by element. Fields of the desired
+// value that are set to their zero value match missing live fields, since the API server omits empty fields.
+func containsFields(desired any, live any) bool {
+	switch desiredValue := desired.(type) {
+	case map[string]any:
+		liveMap, ok := live.(map[string]any)
+		if !ok {
+			return isZeroValue(desired) && live == nil
+		}
+
+		for key, value := range desiredValue {
+			liveValue, ok := liveMap[key]
+			if !ok {
+				if isZeroValue(value) {
+					continue
+				}
+				return false
+			}
+			if !containsFields(value, liveValue) {
+				return false
+			}
+		}
+
+		return true
+	case []any:
+		liveList, ok := live.([]any)
+		if !ok {
+			return isZeroValue(desired) && live == nil
+		}
+		if len(desiredValue) != len(liveList) {
+			return false
+		}
+
+		for i := range desiredValue {
+			if !containsFields(desiredValue[i], liveList[i]) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	if desiredNumber, ok := toFloat64(desired); ok {
+		liveNumber, ok := toFloat64(live)
+		return ok && desiredNumber == liveNumber
+	}
+
+	return desired == live
+}
+
+// isZeroValue returns true if the value is nil or the zero value of its type.
+func isZeroValue(value any) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case map[string]any:
+		return len(v) == 0
+	case []any:
+		return len(v) == 0
+	case string:
+		return v == ""
+	case bool:
+		return !v
+	}
+
+	number, ok := toFloat64(value)
+	return ok && number == 0
+}
+
+// toFloat64 converts the numeric values of decoded JSON and YAML objects to float64.
+func toFloat64(value any) (float64, bool) {
+	switch v := value.(type) {
+	case int:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+
+	return 0, false
+}
+
+// isNamespaced returns true if the object is namespaced. Kinds that are not known to the cluster, such as custom
+// resources whose definition is deployed by the same recipe, fall back to the common cluster-scoped kinds.
+func (d *kubernetesDriver) isNamespaced(obj *unstructured.Unstructured) bool {
+	namespaced, err := d.runtimeClient.IsObjectNamespaced(obj)
+	if err != nil {
+		return !isClusterScoped(obj)
+	}
+
+	return namespaced
+}
+
+// sortByInstallOrder sorts the objects in the order Helm installs them, so that for example namespaces and
+// ConfigMaps are created before the deployments that use them. Unknown kinds are installed last.
+func sortByInstallOrder(objects []*unstructured.Unstructured) {
+	order := map[string]int{}
+	for i, kind := range releaseutil.InstallOrder {
+		order[kind] = i
+	}
+
+	rank := func(obj *unstructured.Unstructured) int {
+		if i, ok := order[obj.GetKind()]; ok {
+			return i
+		}
+		return len(releaseutil.InstallOrder)
+	}
+
+	sort.SliceStable(objects, func(i, j int) bool {
+		return rank(objects[i]) < rank(objects[j])
+	})
+}
+
+// addObjectOutputs adds the data of a core ConfigMap or Secret that has the "radapp.io/recipe-output" annotation set
+// to "true" to the recipe output. ConfigMap values that are valid JSON are decoded. Other objects are ignored.
+func addObjectOutputs(recipeResponse *recipes.RecipeOutput, obj *unstructured.Unstructured) error {
+	if !strings.EqualFold(obj.GetAnnotations()[recipes.AnnotationRecipeOutput], "true") || obj.GroupVersionKind().Group != "" {
+		return nil
+	}
+
+	switch obj.GetKind() {
+	case "ConfigMap":
+		data, _, err := unstructured.NestedStringMap(obj.Object, "data")
+		if err != nil {
+			return fmt.Errorf("failed to read the data of ConfigMap %q: %w", obj.GetName(), err)
+		}
+		for k, v := range data {
+			var value any
+			if err := json.Unmarshal([]byte(v), &value); err != nil {
+				value = v
+			}
+			recipeResponse.Values[k] = value
+		}
+	case "Secret":
+		data, _, err := unstructured.NestedStringMap(obj.Object, "data")
+		if err != nil {
+			return fmt.Errorf("failed to read the data of Secret %q: %w", obj.GetName(), err)
+		}
+		for k, v := range data {
+			decoded, err := base64.StdEncoding.DecodeString(v)
+			if err != nil {
+				return fmt.Errorf("failed to decode the value of %q in Secret %q: %w", k, obj.GetName(), err)
+			}
+			recipeResponse.Secrets[k] = string(decoded)
+		}
+
+		stringData, _, err := unstructured.NestedStringMap(obj.Object, "stringData")
+		if err != nil {
+			return fmt.Errorf("failed to read the data of Secret %q: %w", obj.GetName(), err)
+		}
+		for k, v := range stringData {
+			recipeResponse.Secrets[k] = v
+		}
+	}
+
+	return nil
+}
+
+// createManifestData creates the data passed to the manifest templates after handling conflicts in parameters set by
+// operator and developer. In case of conflict the developer parameter takes precedence. The recipe context is set as
+// the "context" key and the parameters are set as the "parameters" key.
+func createManifestData(devParams, operatorParams map[string]any, recipeContext *recipecontext.Context) (map[string]any, error) {
+	parameters := map[string]any{}
+	for k, v := range operatorParams {
+		parameters[k] = v
+	}
+	for k, v := range devParams {
+		parameters[k] = v
+	}
+
+	contextValue, err := recipeContextValue(recipeContext)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		recipecontext.RecipeContextParamKey: contextValue,
+		manifestParametersKey:               parameters,
+	}, nil
+}
+
+// recipeContextValue converts the recipe context to a map, since templates can only access nested values of maps.
+func recipeContextValue(recipeContext *recipecontext.Context) (map[string]any, error) {
+	b, err := json.Marshal(recipeContext)
+	if err != nil {
+		return nil, err
+	}
+
+	contextValue := map[string]any{}
+	if err := json.Unmarshal(b, &contextValue); err != nil {
+		return nil, err
+	}
+
+	return contextValue, nil
+}
@@ -0,0 +1,479 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	gomock "github.com/golang/mock/gomock"
+	"github.com/radius-project/radius/pkg/corerp/handlers"
+	"github.com/radius-project/radius/pkg/recipes"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8s_errors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const (
+	testKubernetesManifest = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: {{ .context.resource.name }}
+spec:
+  replicas: {{ .parameters.replicas }}
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: {{ .context.resource.name }}
+spec:
+  ports:
+  - port: 6379
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: {{ .context.resource.name }}-reader
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: {{ .context.resource.name }}-outputs
+  annotations:
+    radapp.io/recipe-output: "true"
+data:
+  host: {{ .context.resource.name }}.{{ .context.runtime.kubernetes.namespace }}.svc.cluster.local
+  port: "6379"
+---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: {{ .context.resource.name }}-secrets
+  annotations:
+    radapp.io/recipe-output: "true"
+stringData:
+  password: {{ .parameters.password }}
+`
+)
+
+func setupKubernetes(t *testing.T, objects ...client.Object) (*handlers.MockResourceHandler, kubernetesDriver) {
+	ctrl := gomock.NewController(t)
+	handler := handlers.NewMockResourceHandler(ctrl)
+
+	mapper := meta.NewDefaultRESTMapper([]schema.GroupVersion{})
+	for _, gvk := range []schema.GroupVersionKind{
+		{Group: "apps", Version: "v1", Kind: "Deployment"},
+		{Version: "v1", Kind: "Service"},
+		{Version: "v1", Kind: "ConfigMap"},
+		{Version: "v1", Kind: "Secret"},
+	} {
+		mapper.Add(gvk, meta.RESTScopeNamespace)
+	}
+	runtimeClient := fake.NewClientBuilder().WithRESTMapper(mapper).WithObjects(objects...).Build()
+
+	return handler, kubernetesDriver{runtimeClient: runtimeClient, handler: handler}
+}
+
+func buildKubernetesTestInputs(t *testing.T) (recipes.Configuration, recipes.ResourceMetadata, recipes.EnvironmentDefinition) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/redis.yaml" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(testKubernetesManifest))
+	}))
+	t.Cleanup(server.Close)
+	templatePath := server.URL + "/redis.yaml"
+
+	envConfig := recipes.Configuration{
+		Runtime: recipes.RuntimeConfiguration{
+			Kubernetes: &recipes.KubernetesRuntime{
+				Namespace:            "test-namespace",
+				EnvironmentNamespace: "test-env-namespace",
+			},
+		},
+	}
+
+	recipeMetadata := recipes.ResourceMetadata{
+		Name:          "redis",
+		ApplicationID: "/planes/radius/local/resourcegroups/test-rg/providers/applications.core/applications/app1",
+		EnvironmentID: "/planes/radius/local/resourcegroups/test-rg/providers/applications.core/environments/env1",
+		ResourceID:    "/planes/radius/local/resourceGroups/test-rg/providers/applications.datastores/rediscaches/test-redis",
+		Parameters: map[string]any{
+			"replicas": 2,
+		},
+	}
+
+	envRecipe := recipes.EnvironmentDefinition{
+		Name:         "redis",
+		Driver:       recipes.TemplateKindKubernetes,
+		TemplatePath: templatePath,
+		ResourceType: "Applications.Datastores/redisCaches",
+		Parameters: map[string]any{
+			"replicas": 1,
+			"password": "secret",
+		},
+	}
+
+	return envConfig, recipeMetadata, envRecipe
+}
+
+func Test_Kubernetes_Execute_Success(t *testing.T) {
+	ctx := testcontext.New(t)
+	handler, driver := setupKubernetes(t)
+	envConfig, recipeMetadata, envRecipe := buildKubernetesTestInputs(t)
+
+	applied := []*unstructured.Unstructured{}
+	handler.EXPECT().Put(ctx, gomock.Any()).Times(5).DoAndReturn(func(ctx context.Context, options *handlers.PutOptions) (map[string]string, error) {
+		applied = append(applied, options.Resource.CreateResource.Data.(*unstructured.Unstructured))
+		return map[string]string{}, nil
+	})
+
+	staleID := resources_kubernetes.IDFromParts(resources_kubernetes.PlaneNameTODO, "", "Service", "test-namespace", "test-redis-old")
+	handler.EXPECT().Delete(ctx, gomock.Any()).Times(1).DoAndReturn(func(ctx context.Context, options *handlers.DeleteOptions) error {
+		require.Equal(t, staleID, options.Resource.ID)
+		return nil
+	})
+
+	secretID := resources_kubernetes.IDFromParts(resources_kubernetes.PlaneNameTODO, "", "Secret", "test-namespace", "test-redis-secrets")
+	result, err := driver.Execute(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+		PrevState: []string{secretID.String(), staleID.String()},
+	})
+	require.NoError(t, err)
+
+	// The objects are applied in the install order of Helm, and cluster-scoped objects have no namespace.
+	require.Len(t, applied, 5)
+	require.Equal(t, []string{"Secret", "ConfigMap", "ClusterRole", "Service", "Deployment"}, []string{
+		applied[0].GetKind(), applied[1].GetKind(), applied[2].GetKind(), applied[3].GetKind(), applied[4].GetKind(),
+	})
+	require.Equal(t, "", applied[2].GetNamespace())
+	require.Equal(t, "test-namespace", applied[4].GetNamespace())
+	replicas, _, err := unstructured.NestedInt64(applied[4].Object, "spec", "replicas")
+	require.NoError(t, err)
+	require.Equal(t, int64(2), replicas)
+
+	expected := &recipes.RecipeOutput{
+		Resources: []string{
+			secretID.String(),
+			resources_kubernetes.IDFromParts(resources_kubernetes.PlaneNameTODO, "", "ConfigMap", "test-namespace", "test-redis-outputs").String(),
+			resources_kubernetes.IDFromParts(resources_kubernetes.PlaneNameTODO, "rbac.authorization.k8s.io", "ClusterRole", "", "test-redis-reader").String(),
+			resources_kubernetes.IDFromParts(resources_kubernetes.PlaneNameTODO, "", "Service", "test-namespace", "test-redis").String(),
+			resources_kubernetes.IDFromParts(resources_kubernetes.PlaneNameTODO, "apps", "Deployment", "test-namespace", "test-redis").String(),
+		},
+		Values: map[string]any{
+			"host": "test-redis.test-namespace.svc.cluster.local",
+			"port": float64(6379),
+		},
+		Secrets: map[string]any{
+			"password": "secret",
+		},
+		Status: &rpv1.RecipeStatus{
+			TemplateKind: recipes.TemplateKindKubernetes,
+			TemplatePath: envRecipe.TemplatePath,
+		},
+	}
+	require.Equal(t, expected, result)
+}
+
+func Test_Kubernetes_Plan(t *testing.T) {
+	ctx := testcontext.New(t)
+	_, driver := setupKubernetes(t,
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "test-redis-secrets",
+				Namespace:   "test-namespace",
+				Annotations: map[string]string{"radapp.io/recipe-output": "true"},
+			},
+			Data: map[string][]byte{"password": []byte("secret")},
+		},
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-redis", Namespace: "test-namespace"},
+			Spec:       appsv1.DeploymentSpec{Replicas: to.Ptr(int32(1))},
+		},
+	)
+	envConfig, recipeMetadata, envRecipe := buildKubernetesTestInputs(t)
+
+	secretID := resources_kubernetes.IDFromParts(resources_kubernetes.PlaneNameTODO, "", "Secret", "test-namespace", "test-redis-secrets")
+	staleID := resources_kubernetes.IDFromParts(resources_kubernetes.PlaneNameTODO, "", "Service", "test-namespace", "test-redis-old")
+	plan, err := driver.Plan(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+		PrevState: []string{secretID.String(), staleID.String()},
+	})
+	require.NoError(t, err)
+
+	expected := &recipes.RecipePlan{
+		Changes: []recipes.ResourceChange{
+			{ID: secretID.String(), Type: "core/Secret", Action: recipes.ResourceChangeActionNoChange},
+			{ID: resources_kubernetes.IDFromParts(resources_kubernetes.PlaneNameTODO, "", "ConfigMap", "test-namespace", "test-redis-outputs").String(), Type: "core/ConfigMap", Action: recipes.ResourceChangeActionCreate},
+			{ID: resources_kubernetes.IDFromParts(resources_kubernetes.PlaneNameTODO, "rbac.authorization.k8s.io", "ClusterRole", "", "test-redis-reader").String(), Type: "rbac.authorization.k8s.io/ClusterRole", Action: recipes.ResourceChangeActionCreate},
+			{ID: resources_kubernetes.IDFromParts(resources_kubernetes.PlaneNameTODO, "", "Service", "test-namespace", "test-redis").String(), Type: "core/Service", Action: recipes.ResourceChangeActionCreate},
+			{ID: resources_kubernetes.IDFromParts(resources_kubernetes.PlaneNameTODO, "apps", "Deployment", "test-namespace", "test-redis").String(), Type: "apps/Deployment", Action: recipes.ResourceChangeActionUpdate},
+			{ID: staleID.String(), Type: "core/Service", Action: recipes.ResourceChangeActionDelete},
+		},
+		Status: &rpv1.RecipeStatus{
+			TemplateKind: recipes.TemplateKindKubernetes,
+			TemplatePath: envRecipe.TemplatePath,
+		},
+	}
+	require.Equal(t, expected, plan)
+}
+
+func Test_Kubernetes_Plan_AfterExecute(t *testing.T) {
+	ctx := testcontext.New(t)
+	handler, driver := setupKubernetes(t)
+	envConfig, recipeMetadata, envRecipe := buildKubernetesTestInputs(t)
+
+	// Apply the objects to the fake cluster, adding the metadata set by the API server and the labels of other field
+	// managers, and merging the stringData of Secrets into their data.
+	handler.EXPECT().Put(ctx, gomock.Any()).Times(5).DoAndReturn(func(ctx context.Context, options *handlers.PutOptions) (map[string]string, error) {
+		obj := options.Resource.CreateResource.Data.(*unstructured.Unstructured).DeepCopy()
+		if obj.GetKind() == "Secret" {
+			require.NoError(t, encodeStringData(obj))
+		}
+		obj.SetUID("test-uid")
+		obj.SetLabels(map[string]string{"app.kubernetes.io/managed-by": "radius-rp"})
+		return map[string]string{}, driver.runtimeClient.Create(ctx, obj)
+	})
+
+	opts := ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	}
+	result, err := driver.Execute(ctx, opts)
+	require.NoError(t, err)
+
+	opts.PrevState = result.Resources
+	plan, err := driver.Plan(ctx, opts)
+	require.NoError(t, err)
+
+	require.Len(t, plan.Changes, 5)
+	for _, change := range plan.Changes {
+		require.Equal(t, recipes.ResourceChangeActionNoChange, change.Action, change.ID)
+	}
+}
+
+func Test_containsFields(t *testing.T) {
+	live := map[string]any{
+		"metadata": map[string]any{
+			"name":   "redis",
+			"uid":    "test-uid",
+			"labels": map[string]any{"app": "redis", "managed-by": "radius"},
+		},
+		"spec": map[string]any{
+			"replicas": int64(2),
+			"ports": []any{
+				map[string]any{"port": int64(6379), "protocol": "TCP"},
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		desired  map[string]any
+		expected bool
+	}{
+		{
+			name:     "same fields",
+			desired:  map[string]any{"metadata": map[string]any{"name": "redis"}, "spec": map[string]any{"replicas": float64(2)}},
+			expected: true,
+		},
+		{
+			name:     "defaulted list element fields",
+			desired:  map[string]any{"spec": map[string]any{"ports": []any{map[string]any{"port": 6379}}}},
+			expected: true,
+		},
+		{
+			name:     "empty fields",
+			desired:  map[string]any{"metadata": map[string]any{"annotations": map[string]any{}}, "spec": map[string]any{"paused": false}},
+			expected: true,
+		},
+		{
+			name:     "different value",
+			desired:  map[string]any{"spec": map[string]any{"replicas": int64(3)}},
+			expected: false,
+		},
+		{
+			name:     "missing field",
+			desired:  map[string]any{"metadata": map[string]any{"labels": map[string]any{"tier": "cache"}}},
+			expected: false,
+		},
+		{
+			name:     "different list length",
+			desired:  map[string]any{"spec": map[string]any{"ports": []any{map[string]any{"port": 6379}, map[string]any{"port": 6380}}}},
+			expected: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, containsFields(tc.desired, live))
+		})
+	}
+}
+
+func Test_Kubernetes_Execute_RenderFailure(t *testing.T) {
+	ctx := testcontext.New(t)
+	_, driver := setupKubernetes(t)
+	envConfig, recipeMetadata, envRecipe := buildKubernetesTestInputs(t)
+	envRecipe.TemplatePath = strings.TrimSuffix(envRecipe.TemplatePath, "redis.yaml") + "missing.yaml"
+
+	_, err := driver.Execute(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.Error(t, err)
+
+	recipeError, ok := err.(*recipes.RecipeError)
+	require.True(t, ok)
+	require.Equal(t, recipes.RecipeDownloadFailed, recipeError.ErrorDetails.Code)
+}
+
+func Test_Kubernetes_Execute_DeployFailure(t *testing.T) {
+	ctx := testcontext.New(t)
+	handler, driver := setupKubernetes(t)
+	envConfig, recipeMetadata, envRecipe := buildKubernetesTestInputs(t)
+
+	handler.EXPECT().Put(ctx, gomock.Any()).Times(1).Return(nil, errors.New("timed out waiting for the condition"))
+
+	_, err := driver.Execute(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.Error(t, err)
+
+	recipeError, ok := err.(*recipes.RecipeError)
+	require.True(t, ok)
+	require.Equal(t, recipes.RecipeDeploymentFailed, recipeError.ErrorDetails.Code)
+	require.Contains(t, recipeError.ErrorDetails.Message, "timed out waiting for the condition")
+}
+
+func Test_Kubernetes_Execute_DeployForbidden(t *testing.T) {
+	ctx := testcontext.New(t)
+	handler, driver := setupKubernetes(t)
+	envConfig, recipeMetadata, envRecipe := buildKubernetesTestInputs(t)
+
+	forbidden := k8s_errors.NewForbidden(schema.GroupResource{Group: "apps", Resource: "deployments"}, "redis", errors.New("access denied"))
+	handler.EXPECT().Put(ctx, gomock.Any()).Times(1).Return(nil, forbidden)
+
+	_, err := driver.Execute(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.Error(t, err)
+
+	recipeError, ok := err.(*recipes.RecipeError)
+	require.True(t, ok)
+	require.Equal(t, recipes.RecipeDeploymentFailed, recipeError.ErrorDetails.Code)
+	require.Contains(t, recipeError.ErrorDetails.Message, "rp.recipes.extraRBAC")
+}
+
+func Test_Kubernetes_Execute_NoNamespace(t *testing.T) {
+	ctx := testcontext.New(t)
+	_, driver := setupKubernetes(t)
+	_, recipeMetadata, envRecipe := buildKubernetesTestInputs(t)
+
+	_, err := driver.Execute(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: recipes.Configuration{Runtime: recipes.RuntimeConfiguration{Kubernetes: &recipes.KubernetesRuntime{}}},
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "a Kubernetes namespace is required to deploy a Kubernetes recipe")
+}
+
+func Test_Kubernetes_Delete(t *testing.T) {
+	ctx := testcontext.New(t)
+	handler, driver := setupKubernetes(t)
+
+	configMapID := resources_kubernetes.IDFromParts(resources_kubernetes.PlaneNameTODO, "", "ConfigMap", "test-namespace", "redis")
+	serviceID := resources_kubernetes.IDFromParts(resources_kubernetes.PlaneNameTODO, "", "Service", "test-namespace", "redis")
+	deploymentID := resources_kubernetes.IDFromParts(resources_kubernetes.PlaneNameTODO, "apps", "Deployment", "test-namespace", "redis")
+	outputResources := []rpv1.OutputResource{
+		{ID: configMapID, RadiusManaged: to.Ptr(true)},
+		{ID: serviceID, RadiusManaged: to.Ptr(false)},
+		{ID: deploymentID, RadiusManaged: to.Ptr(true)},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		// Resources are deleted in the reverse order of their deployment, and unmanaged resources are skipped.
+		gomock.InOrder(
+			handler.EXPECT().Delete(ctx, &handlers.DeleteOptions{Resource: &rpv1.OutputResource{ID: deploymentID, RadiusManaged: to.Ptr(true)}}).Times(1).Return(nil),
+			handler.EXPECT().Delete(ctx, &handlers.DeleteOptions{Resource: &rpv1.OutputResource{ID: configMapID, RadiusManaged: to.Ptr(true)}}).Times(1).Return(nil),
+		)
+
+		err := driver.Delete(ctx, DeleteOptions{OutputResources: outputResources})
+		require.NoError(t, err)
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		handler.EXPECT().Delete(ctx, gomock.Any()).Times(1).Return(errors.New("delete failed"))
+
+		err := driver.Delete(ctx, DeleteOptions{OutputResources: outputResources})
+		require.Error(t, err)
+
+		recipeError, ok := err.(*recipes.RecipeError)
+		require.True(t, ok)
+		require.Equal(t, recipes.RecipeDeletionFailed, recipeError.ErrorDetails.Code)
+	})
+}
+
+func Test_Kubernetes_GetRecipeMetadata(t *testing.T) {
+	ctx := testcontext.New(t)
+	_, driver := setupKubernetes(t)
+
+	metadata, err := driver.GetRecipeMetadata(ctx, BaseOptions{})
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{recipeParameters: map[string]any{}}, metadata)
+}
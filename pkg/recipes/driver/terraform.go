@@ -52,8 +52,14 @@ var _ Driver = (*terraformDriver)(nil)
 // NewTerraformDriver creates a new instance of driver to execute a Terraform recipe.
 func NewTerraformDriver(ucpConn sdk.Connection, secretProvider *ucp_provider.SecretProvider, options TerraformOptions, k8sClientSet kubernetes.Interface) Driver {
 	return &terraformDriver{
-		terraformExecutor: terraform.NewExecutor(ucpConn, secretProvider, k8sClientSet),
-		options:           options,
+		terraformExecutor: terraform.NewExecutor(ucpConn, secretProvider, k8sClientSet, terraform.InstallOptions{
+			Version:   options.Version,
+			CacheDir:  options.CacheDir,
+			ExecPath:  options.ExecPath,
+			MirrorURL: options.MirrorURL,
+			Offline:   options.Offline,
+		}),
+		options: options,
 	}
 }
 
@@ -61,6 +67,22 @@ func NewTerraformDriver(ucpConn sdk.Connection, secretProvider *ucp_provider.Sec
 type TerraformOptions struct {
 	// Path is the path to the directory mounted to the container where terraform can be installed and executed.
 	Path string
+
+	// Version is the default version of Terraform, used when the environment doesn't pin a version. The latest version
+	// is used if it is empty.
+	Version string
+
+	// CacheDir is the directory of the shared cache of Terraform binaries.
+	CacheDir string
+
+	// ExecPath is the path to a pre-provisioned Terraform binary.
+	ExecPath string
+
+	// MirrorURL is the base URL of a mirror of https://releases.hashicorp.com used to download Terraform.
+	MirrorURL string
+
+	// Offline disables downloading Terraform from https://releases.hashicorp.com.
+	Offline bool
 }
 
 // terraformDriver represents a driver to interact with Terraform Recipe - deploy recipe, delete resources, etc.
@@ -122,6 +144,51 @@ func (d *terraformDriver) Execute(ctx context.Context, opts ExecuteOptions) (*re
 	return recipeOutputs, nil
 }
 
+// Plan creates a unique directory for each execution of terraform and runs terraform plan for the recipe using
+// the Terraform CLI through terraform-exec. It returns the changes that the deployment of the recipe would make.
+func (d *terraformDriver) Plan(ctx context.Context, opts ExecuteOptions) (*recipes.RecipePlan, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	requestDirPath, err := d.createExecutionDirectory(ctx, opts.Recipe, opts.Definition)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+	defer func() {
+		if err := os.RemoveAll(requestDirPath); err != nil {
+			logger.Info(fmt.Sprintf("Failed to cleanup Terraform execution directory %q. Err: %s", requestDirPath, err.Error()))
+		}
+	}()
+
+	// Add credential information to .gitconfig if module source is of type git.
+	if strings.HasPrefix(opts.Definition.TemplatePath, "git::") && !reflect.DeepEqual(opts.BaseOptions.Secrets, v20231001preview.SecretStoresClientListSecretsResponse{}) {
+		err := addSecretsToGitConfig(opts.BaseOptions.Secrets, &opts.Recipe, opts.Definition.TemplatePath)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	tfPlan, err := d.terraformExecutor.Plan(ctx, terraform.Options{
+		RootDir:        requestDirPath,
+		EnvConfig:      &opts.Configuration,
+		ResourceRecipe: &opts.Recipe,
+		EnvRecipe:      &opts.Definition,
+	})
+
+	// Unset credential information from .gitconfig if module source is of type git.
+	if strings.HasPrefix(opts.Definition.TemplatePath, "git::") && !reflect.DeepEqual(opts.BaseOptions.Secrets, v20231001preview.SecretStoresClientListSecretsResponse{}) {
+		unsetError := unsetSecretsFromGitConfig(opts.BaseOptions.Secrets, opts.Definition.TemplatePath)
+		if unsetError != nil {
+			return nil, unsetError
+		}
+	}
+
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	return prepareTerraformPlan(opts.Definition, tfPlan), nil
+}
+
 // Delete returns an error if called as it is not yet implemented.
 func (d *terraformDriver) Delete(ctx context.Context, opts DeleteOptions) error {
 	logger := ucplog.FromContextOrDiscard(ctx)
@@ -193,6 +260,60 @@ func (d *terraformDriver) prepareRecipeResponse(ctx context.Context, definition
 	return recipeResponse, nil
 }
 
+// prepareTerraformPlan populates the recipe plan from the resource changes of the Terraform plan. Terraform resources
+// are identified by their address in the module since their IDs are only known once they are created.
+func prepareTerraformPlan(definition recipes.EnvironmentDefinition, tfPlan *tfjson.Plan) *recipes.RecipePlan {
+	plan := &recipes.RecipePlan{
+		Changes: []recipes.ResourceChange{},
+		Status: &rpv1.RecipeStatus{
+			TemplateKind:    recipes.TemplateKindTerraform,
+			TemplatePath:    definition.TemplatePath,
+			TemplateVersion: definition.TemplateVersion,
+		},
+	}
+	if tfPlan == nil {
+		return plan
+	}
+
+	for _, change := range tfPlan.ResourceChanges {
+		// Data sources are read during the plan and are not changed by the deployment.
+		if change == nil || change.Mode == tfjson.DataResourceMode {
+			continue
+		}
+
+		action := recipes.ResourceChangeActionUnknown
+		if change.Change != nil {
+			action = terraformChangeAction(change.Change.Actions)
+		}
+
+		plan.Changes = append(plan.Changes, recipes.ResourceChange{
+			ID:     change.Address,
+			Type:   change.Type,
+			Action: action,
+		})
+	}
+
+	return plan
+}
+
+// terraformChangeAction converts the actions planned by Terraform for a resource to the action of the recipe plan.
+func terraformChangeAction(actions tfjson.Actions) recipes.ResourceChangeAction {
+	switch {
+	case actions.NoOp(), actions.Read():
+		return recipes.ResourceChangeActionNoChange
+	case actions.Create():
+		return recipes.ResourceChangeActionCreate
+	case actions.Update():
+		return recipes.ResourceChangeActionUpdate
+	case actions.Replace():
+		return recipes.ResourceChangeActionReplace
+	case actions.Delete():
+		return recipes.ResourceChangeActionDelete
+	default:
+		return recipes.ResourceChangeActionUnknown
+	}
+}
+
 // createExecutionDirectory creates a unique directory for each execution of terraform.
 func (d *terraformDriver) createExecutionDirectory(ctx context.Context, recipe recipes.ResourceMetadata, definition recipes.EnvironmentDefinition) (string, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
@@ -139,6 +139,79 @@ func Test_Terraform_Execute_Success(t *testing.T) {
 	verifyDirectoryCleanup(t, driver.options.Path, armCtx.OperationID.String())
 }
 
+func Test_Terraform_Plan_Success(t *testing.T) {
+	ctx := testcontext.New(t)
+	armCtx := &v1.ARMRequestContext{
+		OperationID: uuid.New(),
+	}
+	ctx = v1.WithARMRequestContext(ctx, armCtx)
+
+	tfExecutor, driver := setup(t)
+	envConfig, recipeMetadata, envRecipe := buildTestInputs()
+
+	tfPlan := &tfjson.Plan{
+		ResourceChanges: []*tfjson.ResourceChange{
+			{Address: "azurerm_redis_cache.redis", Type: "azurerm_redis_cache", Mode: tfjson.ManagedResourceMode, Change: &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionCreate}}},
+			{Address: "azurerm_resource_group.rg", Type: "azurerm_resource_group", Mode: tfjson.ManagedResourceMode, Change: &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionNoop}}},
+			{Address: "azurerm_storage_account.sa", Type: "azurerm_storage_account", Mode: tfjson.ManagedResourceMode, Change: &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionDelete, tfjson.ActionCreate}}},
+			{Address: "azurerm_key_vault.kv", Type: "azurerm_key_vault", Mode: tfjson.ManagedResourceMode, Change: &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionUpdate}}},
+			{Address: "azurerm_key_vault_secret.old", Type: "azurerm_key_vault_secret", Mode: tfjson.ManagedResourceMode, Change: &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionDelete}}},
+			{Address: "data.azurerm_client_config.current", Type: "azurerm_client_config", Mode: tfjson.DataResourceMode, Change: &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionRead}}},
+		},
+	}
+	tfExecutor.EXPECT().Plan(ctx, gomock.Any()).Times(1).Return(tfPlan, nil)
+
+	plan, err := driver.Plan(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.NoError(t, err)
+
+	expected := &recipes.RecipePlan{
+		Changes: []recipes.ResourceChange{
+			{ID: "azurerm_redis_cache.redis", Type: "azurerm_redis_cache", Action: recipes.ResourceChangeActionCreate},
+			{ID: "azurerm_resource_group.rg", Type: "azurerm_resource_group", Action: recipes.ResourceChangeActionNoChange},
+			{ID: "azurerm_storage_account.sa", Type: "azurerm_storage_account", Action: recipes.ResourceChangeActionReplace},
+			{ID: "azurerm_key_vault.kv", Type: "azurerm_key_vault", Action: recipes.ResourceChangeActionUpdate},
+			{ID: "azurerm_key_vault_secret.old", Type: "azurerm_key_vault_secret", Action: recipes.ResourceChangeActionDelete},
+		},
+		Status: &rpv1.RecipeStatus{
+			TemplateKind:    recipes.TemplateKindTerraform,
+			TemplatePath:    "Azure/redis/azurerm",
+			TemplateVersion: "1.0",
+		},
+	}
+	require.Equal(t, expected, plan)
+	verifyDirectoryCleanup(t, driver.options.Path, armCtx.OperationID.String())
+}
+
+func Test_Terraform_Plan_Failure(t *testing.T) {
+	ctx := testcontext.New(t)
+	armCtx := &v1.ARMRequestContext{
+		OperationID: uuid.New(),
+	}
+	ctx = v1.WithARMRequestContext(ctx, armCtx)
+
+	tfExecutor, driver := setup(t)
+	envConfig, recipeMetadata, envRecipe := buildTestInputs()
+
+	tfExecutor.EXPECT().Plan(ctx, gomock.Any()).Times(1).Return(nil, errors.New("terraform plan failure"))
+
+	_, err := driver.Plan(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.Error(t, err)
+	require.Equal(t, recipes.RecipePlanFailed, recipes.GetErrorDetails(err).Code)
+	verifyDirectoryCleanup(t, driver.options.Path, armCtx.OperationID.String())
+}
+
 func Test_Terraform_Execute_DeploymentFailure(t *testing.T) {
 	ctx := testcontext.New(t)
 	armCtx := &v1.ARMRequestContext{
@@ -38,6 +38,10 @@ type Driver interface {
 	// Delete handles deletion of output resources for the recipe deployment.
 	Delete(ctx context.Context, opts DeleteOptions) error
 
+	// Plan fetches the recipe contents and returns the changes that the deployment of the recipe would make to its
+	// resources, without deploying it.
+	Plan(ctx context.Context, opts ExecuteOptions) (*recipes.RecipePlan, error)
+
 	// Gets the Recipe metadata and parameters from Recipe's template path
 	GetRecipeMetadata(ctx context.Context, opts BaseOptions) (map[string]any, error)
 }
@@ -19,6 +19,7 @@ package engine
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
@@ -33,7 +34,7 @@ import (
 
 // NewEngine creates a new Engine to deploy recipe.
 func NewEngine(options Options) *engine {
-	return &engine{options: options}
+	return &engine{options: options, limiter: newLimiter(options.Concurrency)}
 }
 
 var _ Engine = (*engine)(nil)
@@ -43,10 +44,19 @@ type Options struct {
 	ConfigurationLoader configloader.ConfigurationLoader
 	SecretsLoader       configloader.SecretsLoader
 	Drivers             map[string]recipedriver.Driver
+
+	// OutputContracts are the output contracts of the recipes of each resource type, keyed by resource type.
+	// The outputs of the recipes of resource types without a contract are not validated.
+	OutputContracts map[string]recipes.OutputContract
+
+	// Concurrency limits the number of recipe operations running concurrently per environment and per driver.
+	// Operations are not limited by default.
+	Concurrency ConcurrencyOptions
 }
 
 type engine struct {
 	options Options
+	limiter *limiter
 }
 
 // Execute loads the recipe definition from the environment, finds the driver associated with the recipe, loads the
@@ -76,14 +86,121 @@ func (e *engine) Execute(ctx context.Context, opts ExecuteOptions) (*recipes.Rec
 func (e *engine) executeCore(ctx context.Context, recipe recipes.ResourceMetadata, prevState []string) (*recipes.RecipeOutput, *recipes.EnvironmentDefinition, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
+	driverOptions, driver, err := e.prepareExecution(ctx, recipe)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// No need to try executing the recipe if it's a simulated environment.
+	if driverOptions == nil {
+		logger.Info("simulated environment enabled, skipping deployment")
+		return nil, nil, nil
+	}
+
+	release, err := e.limiter.acquire(ctx, recipe.EnvironmentID, driverOptions.Definition.Driver)
+	if err != nil {
+		return nil, &driverOptions.Definition, fmt.Errorf("failed to wait for a slot to run the recipe: %w", err)
+	}
+	defer release()
+
+	res, err := driver.Execute(ctx, recipedriver.ExecuteOptions{
+		BaseOptions: *driverOptions,
+		PrevState:   prevState,
+	})
+	if err != nil {
+		return nil, &driverOptions.Definition, err
+	}
+
+	if err := e.validateOutputs(driverOptions.Definition.ResourceType, res, recipe.Properties); err != nil {
+		return nil, &driverOptions.Definition, err
+	}
+
+	if res != nil && res.Status != nil {
+		res.Status.Version = driverOptions.Definition.Version
+	}
+
+	return res, &driverOptions.Definition, nil
+}
+
+// validateOutputs validates the recipe output against the output contract of the resource type, if any.
+func (e *engine) validateOutputs(resourceType string, output *recipes.RecipeOutput, properties map[string]any) error {
+	for contractType, contract := range e.options.OutputContracts {
+		if strings.EqualFold(contractType, resourceType) {
+			return contract.Validate(output, properties)
+		}
+	}
+
+	return nil
+}
+
+// Plan loads the recipe definition from the environment, finds the driver associated with the recipe, loads the
+// configuration associated with the recipe, and then calls the driver to compute the changes that the execution of
+// the recipe would make, without making them.
+func (e *engine) Plan(ctx context.Context, opts ExecuteOptions) (*recipes.RecipePlan, error) {
+	planStart := time.Now()
+	result := metrics.SuccessfulOperationState
+
+	plan, definition, err := e.planCore(ctx, opts.Recipe, opts.PreviousState)
+	if err != nil {
+		result = metrics.FailedOperationState
+		if recipes.GetErrorDetails(err) != nil {
+			result = recipes.GetErrorDetails(err).Code
+		}
+	}
+
+	metrics.DefaultRecipeEngineMetrics.RecordRecipeOperationDuration(ctx, planStart,
+		metrics.NewRecipeAttributes(metrics.RecipeEngineOperationPlan, opts.Recipe.Name,
+			definition, result))
+
+	return plan, err
+}
+
+// planCore function is the core logic of the Plan function.
+// Any changes to the core logic of the Plan function should be made here.
+func (e *engine) planCore(ctx context.Context, recipe recipes.ResourceMetadata, prevState []string) (*recipes.RecipePlan, *recipes.EnvironmentDefinition, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	driverOptions, driver, err := e.prepareExecution(ctx, recipe)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Nothing is deployed in a simulated environment, so the plan has no changes.
+	if driverOptions == nil {
+		logger.Info("simulated environment enabled, skipping plan")
+		return &recipes.RecipePlan{Changes: []recipes.ResourceChange{}}, nil, nil
+	}
+
+	release, err := e.limiter.acquire(ctx, recipe.EnvironmentID, driverOptions.Definition.Driver)
+	if err != nil {
+		return nil, &driverOptions.Definition, fmt.Errorf("failed to wait for a slot to run the recipe: %w", err)
+	}
+	defer release()
+
+	plan, err := driver.Plan(ctx, recipedriver.ExecuteOptions{
+		BaseOptions: *driverOptions,
+		PrevState:   prevState,
+	})
+	if err != nil {
+		return nil, &driverOptions.Definition, err
+	}
+
+	if plan != nil && plan.Status != nil {
+		plan.Status.Version = driverOptions.Definition.Version
+	}
+
+	return plan, &driverOptions.Definition, nil
+}
+
+// prepareExecution loads the configuration and the recipe definition from the environment, finds the driver associated
+// with the recipe and loads the secrets required by the recipe. It returns nil options if the environment is simulated.
+func (e *engine) prepareExecution(ctx context.Context, recipe recipes.ResourceMetadata) (*recipedriver.BaseOptions, recipedriver.Driver, error) {
 	configuration, err := e.options.ConfigurationLoader.LoadConfiguration(ctx, recipe)
 	if err != nil {
 		return nil, nil, recipes.NewRecipeError(recipes.RecipeConfigurationFailure, err.Error(), util.RecipeSetupError, recipes.GetErrorDetails(err))
 	}
 
-	// No need to try executing the recipe if it's a simulated environment.
 	if configuration.Simulated {
-		logger.Info("simulated environment enabled, skipping deployment")
 		return nil, nil, nil
 	}
 
@@ -108,20 +225,12 @@ func (e *engine) executeCore(ctx context.Context, recipe recipes.ResourceMetadat
 		}
 	}
 
-	res, err := driver.Execute(ctx, recipedriver.ExecuteOptions{
-		BaseOptions: recipedriver.BaseOptions{
-			Configuration: *configuration,
-			Recipe:        recipe,
-			Definition:    *definition,
-			Secrets:       secrets,
-		},
-		PrevState: prevState,
-	})
-	if err != nil {
-		return nil, definition, err
-	}
-
-	return res, definition, nil
+	return &recipedriver.BaseOptions{
+		Configuration: *configuration,
+		Recipe:        recipe,
+		Definition:    *definition,
+		Secrets:       secrets,
+	}, driver, nil
 }
 
 // Delete calls the Delete method of the driver specified in the recipe definition to delete the output resources.
@@ -163,6 +272,12 @@ func (e *engine) deleteCore(ctx context.Context, recipe recipes.ResourceMetadata
 		return nil, err
 	}
 
+	release, err := e.limiter.acquire(ctx, recipe.EnvironmentID, definition.Driver)
+	if err != nil {
+		return definition, fmt.Errorf("failed to wait for a slot to run the recipe: %w", err)
+	}
+	defer release()
+
 	err = driver.Delete(ctx, recipedriver.DeleteOptions{
 		BaseOptions: recipedriver.BaseOptions{
 			Configuration: *configuration,
@@ -197,6 +312,12 @@ func (e *engine) getRecipeMetadataCore(ctx context.Context, recipeDefinition rec
 		return nil, fmt.Errorf("could not find driver %s", recipeDefinition.Driver)
 	}
 
+	release, err := e.limiter.acquire(ctx, "", recipeDefinition.Driver)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wait for a slot to run the recipe: %w", err)
+	}
+	defer release()
+
 	return driver.GetRecipeMetadata(ctx, recipedriver.BaseOptions{
 		Recipe:     recipes.ResourceMetadata{},
 		Definition: recipeDefinition,
@@ -17,9 +17,11 @@ limitations under the License.
 package engine
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
@@ -122,6 +124,187 @@ func Test_Engine_Execute_Success(t *testing.T) {
 	require.Equal(t, result, recipeResult)
 }
 
+func Test_Engine_Execute_RecordsVersion(t *testing.T) {
+	recipeMetadata := recipes.ResourceMetadata{
+		Name:          "mongo-azure",
+		ApplicationID: "/planes/radius/local/resourcegroups/test-rg/providers/applications.core/applications/app1",
+		EnvironmentID: "/planes/radius/local/resourcegroups/test-rg/providers/applications.core/environments/env1",
+		ResourceID:    "/planes/radius/local/resourceGroups/test-rg/providers/Microsoft.Resources/deployments/recipe",
+		Version:       "v2",
+	}
+	envConfig := &recipes.Configuration{
+		Runtime: recipes.RuntimeConfiguration{
+			Kubernetes: &recipes.KubernetesRuntime{
+				Namespace: "default",
+			},
+		},
+	}
+	recipeDefinition := &recipes.EnvironmentDefinition{
+		Driver:       recipes.TemplateKindBicep,
+		TemplatePath: "ghcr.io/radius-project/dev/recipes/functionaltest/basic/mongodatabases/azure:2.0",
+		ResourceType: "Applications.Datastores/mongoDatabases",
+		Version:      "v2",
+	}
+	ctx := testcontext.New(t)
+	engine, configLoader, driver := setup(t)
+
+	configLoader.EXPECT().
+		LoadConfiguration(ctx, recipeMetadata).
+		Times(1).
+		Return(envConfig, nil)
+	configLoader.EXPECT().
+		LoadRecipe(ctx, &recipeMetadata).
+		Times(1).
+		Return(recipeDefinition, nil)
+	driver.EXPECT().
+		Execute(ctx, gomock.Any()).
+		Times(1).
+		Return(&recipes.RecipeOutput{
+			Status: &rpv1.RecipeStatus{
+				TemplateKind: recipes.TemplateKindBicep,
+				TemplatePath: recipeDefinition.TemplatePath,
+			},
+		}, nil)
+
+	result, err := engine.Execute(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Recipe: recipeMetadata,
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "v2", result.Status.Version)
+}
+
+func Test_Engine_Execute_ConcurrencyLimit(t *testing.T) {
+	recipeMetadata := recipes.ResourceMetadata{
+		Name:          "mongo-azure",
+		EnvironmentID: "/planes/radius/local/resourcegroups/test-rg/providers/applications.core/environments/env1",
+		ResourceID:    "/planes/radius/local/resourceGroups/test-rg/providers/Microsoft.Resources/deployments/recipe",
+	}
+	envConfig := &recipes.Configuration{
+		Runtime: recipes.RuntimeConfiguration{
+			Kubernetes: &recipes.KubernetesRuntime{
+				Namespace: "default",
+			},
+		},
+	}
+	recipeDefinition := &recipes.EnvironmentDefinition{
+		Driver:       recipes.TemplateKindTerraform,
+		TemplatePath: "Azure/cosmosdb/azurerm",
+		ResourceType: "Applications.Datastores/mongoDatabases",
+	}
+	engine, configLoader, _ := setup(t)
+	engine.limiter = newLimiter(ConcurrencyOptions{MaxPerDriver: map[string]int{recipes.TemplateKindTerraform: 1}})
+
+	// Another execution holds the only Terraform slot.
+	release, err := engine.limiter.acquire(testcontext.New(t), recipeMetadata.EnvironmentID, recipes.TemplateKindTerraform)
+	require.NoError(t, err)
+	defer release()
+
+	ctx, cancel := context.WithTimeout(testcontext.New(t), 50*time.Millisecond)
+	defer cancel()
+
+	configLoader.EXPECT().
+		LoadConfiguration(ctx, recipeMetadata).
+		Times(1).
+		Return(envConfig, nil)
+	configLoader.EXPECT().
+		LoadRecipe(ctx, &recipeMetadata).
+		Times(1).
+		Return(recipeDefinition, nil)
+
+	// The driver is not called since the execution never gets a slot.
+	_, err = engine.Execute(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Recipe: recipeMetadata,
+		},
+	})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func Test_Engine_Plan_Success(t *testing.T) {
+	recipeMetadata := recipes.ResourceMetadata{
+		Name:          "mongo-azure",
+		ApplicationID: "/planes/radius/local/resourcegroups/test-rg/providers/applications.core/applications/app1",
+		EnvironmentID: "/planes/radius/local/resourcegroups/test-rg/providers/applications.core/environments/env1",
+		ResourceID:    "/planes/radius/local/resourceGroups/test-rg/providers/Microsoft.Resources/deployments/recipe",
+	}
+	prevState := []string{
+		"/subscriptions/test-sub/resourceGroups/test-rg/providers/System.Test/testResources/test1",
+	}
+	envConfig := &recipes.Configuration{
+		Runtime: recipes.RuntimeConfiguration{
+			Kubernetes: &recipes.KubernetesRuntime{
+				Namespace: "default",
+			},
+		},
+	}
+	recipeDefinition := &recipes.EnvironmentDefinition{
+		Driver:       recipes.TemplateKindBicep,
+		TemplatePath: "ghcr.io/radius-project/dev/recipes/functionaltest/basic/mongodatabases/azure:1.0",
+		ResourceType: "Applications.Datastores/mongoDatabases",
+	}
+	recipePlan := &recipes.RecipePlan{
+		Changes: []recipes.ResourceChange{
+			{ID: prevState[0], Type: "System.Test/testResources", Action: recipes.ResourceChangeActionDelete},
+		},
+	}
+	ctx := testcontext.New(t)
+	engine, configLoader, driver := setup(t)
+
+	configLoader.EXPECT().
+		LoadConfiguration(ctx, recipeMetadata).
+		Times(1).
+		Return(envConfig, nil)
+	configLoader.EXPECT().
+		LoadRecipe(ctx, &recipeMetadata).
+		Times(1).
+		Return(recipeDefinition, nil)
+	driver.EXPECT().
+		Plan(ctx, recipedriver.ExecuteOptions{
+			BaseOptions: recipedriver.BaseOptions{
+				Configuration: *envConfig,
+				Recipe:        recipeMetadata,
+				Definition:    *recipeDefinition,
+			},
+			PrevState: prevState,
+		}).
+		Times(1).
+		Return(recipePlan, nil)
+
+	result, err := engine.Plan(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Recipe: recipeMetadata,
+		},
+		PreviousState: prevState,
+	})
+	require.NoError(t, err)
+	require.Equal(t, recipePlan, result)
+}
+
+func Test_Engine_Plan_SimulatedEnv_Success(t *testing.T) {
+	recipeMetadata := recipes.ResourceMetadata{
+		Name:          "mongo-azure",
+		EnvironmentID: "/planes/radius/local/resourcegroups/test-rg/providers/applications.core/environments/env1",
+		ResourceID:    "/planes/radius/local/resourceGroups/test-rg/providers/Microsoft.Resources/deployments/recipe",
+	}
+	ctx := testcontext.New(t)
+	engine, configLoader, _ := setup(t)
+
+	configLoader.EXPECT().
+		LoadConfiguration(ctx, recipeMetadata).
+		Times(1).
+		Return(&recipes.Configuration{Simulated: true}, nil)
+
+	result, err := engine.Plan(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Recipe: recipeMetadata,
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, &recipes.RecipePlan{Changes: []recipes.ResourceChange{}}, result)
+}
+
 func Test_Engine_Execute_SimulatedEnv_Success(t *testing.T) {
 	recipeMetadata := recipes.ResourceMetadata{
 		Name:          "mongo-azure",
@@ -235,6 +418,103 @@ func Test_Engine_Execute_Failure(t *testing.T) {
 	require.Equal(t, err.Error(), "failed to execute recipe")
 }
 
+func Test_Engine_Execute_OutputContract(t *testing.T) {
+	recipeMetadata := recipes.ResourceMetadata{
+		Name:          "redis",
+		ApplicationID: "/planes/radius/local/resourcegroups/test-rg/providers/applications.core/applications/app1",
+		EnvironmentID: "/planes/radius/local/resourcegroups/test-rg/providers/applications.core/environments/env1",
+		ResourceID:    "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/redisCaches/redis",
+	}
+	envConfig := &recipes.Configuration{
+		Runtime: recipes.RuntimeConfiguration{
+			Kubernetes: &recipes.KubernetesRuntime{
+				Namespace: "default",
+			},
+		},
+	}
+	recipeDefinition := &recipes.EnvironmentDefinition{
+		Driver:       recipes.TemplateKindBicep,
+		TemplatePath: "ghcr.io/radius-project/dev/recipes/rediscaches:1.0",
+		ResourceType: "Applications.Datastores/redisCaches",
+	}
+	contract := recipes.OutputContract{
+		Fields: []recipes.OutputField{
+			{Name: "host", Type: recipes.OutputTypeString, Required: true, Property: "host"},
+			{Name: "port", Type: recipes.OutputTypeInteger, Required: true, Property: "port"},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		contracts    map[string]recipes.OutputContract
+		properties   map[string]any
+		recipeResult *recipes.RecipeOutput
+		expectedErr  bool
+	}{
+		{
+			name:         "valid outputs",
+			contracts:    map[string]recipes.OutputContract{"applications.datastores/rediscaches": contract},
+			recipeResult: &recipes.RecipeOutput{Values: map[string]any{"host": "redis", "port": float64(6379)}},
+		},
+		{
+			name:         "missing output",
+			contracts:    map[string]recipes.OutputContract{"Applications.Datastores/redisCaches": contract},
+			recipeResult: &recipes.RecipeOutput{Values: map[string]any{"host": "redis"}},
+			expectedErr:  true,
+		},
+		{
+			name:         "missing output set in the resource properties",
+			contracts:    map[string]recipes.OutputContract{"Applications.Datastores/redisCaches": contract},
+			properties:   map[string]any{"port": float64(6379)},
+			recipeResult: &recipes.RecipeOutput{Values: map[string]any{"host": "redis"}},
+		},
+		{
+			name:         "no contract",
+			contracts:    map[string]recipes.OutputContract{"Applications.Datastores/mongoDatabases": contract},
+			recipeResult: &recipes.RecipeOutput{Values: map[string]any{}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := testcontext.New(t)
+			engine, configLoader, driver := setup(t)
+			engine.options.OutputContracts = tc.contracts
+			metadata := recipeMetadata
+			metadata.Properties = tc.properties
+
+			configLoader.EXPECT().
+				LoadConfiguration(ctx, metadata).
+				Times(1).
+				Return(envConfig, nil)
+			configLoader.EXPECT().
+				LoadRecipe(ctx, &metadata).
+				Times(1).
+				Return(recipeDefinition, nil)
+			driver.EXPECT().
+				Execute(ctx, gomock.Any()).
+				Times(1).
+				Return(tc.recipeResult, nil)
+
+			result, err := engine.Execute(ctx, ExecuteOptions{
+				BaseOptions: BaseOptions{
+					Recipe: metadata,
+				},
+			})
+			if tc.expectedErr {
+				require.Nil(t, result)
+				recipeError, ok := err.(*recipes.RecipeError)
+				require.True(t, ok)
+				require.Equal(t, recipes.RecipeOutputContractViolation, recipeError.ErrorDetails.Code)
+				require.Equal(t, "values.port", recipeError.ErrorDetails.Details[0].Target)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.recipeResult, result)
+			}
+		})
+	}
+}
+
 func Test_Engine_Terraform_Success(t *testing.T) {
 	recipeMetadata := recipes.ResourceMetadata{
 		Name:          "mongo-azure",
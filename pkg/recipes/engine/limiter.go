@@ -0,0 +1,142 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/radius-project/radius/pkg/metrics"
+	"go.opentelemetry.io/otel/attribute"
+	"golang.org/x/sync/semaphore"
+)
+
+// ConcurrencyOptions represents the limits on the number of recipe operations the engine runs concurrently.
+type ConcurrencyOptions struct {
+	// MaxPerEnvironment is the maximum number of recipe operations running concurrently for an environment.
+	// Operations are not limited per environment if it is zero.
+	MaxPerEnvironment int
+
+	// MaxPerDriver is the maximum number of recipe operations running concurrently for each driver across all
+	// environments, keyed by driver name. Drivers without a positive limit are not limited.
+	MaxPerDriver map[string]int
+}
+
+// limiter limits the number of recipe operations running concurrently. Waiting operations are admitted in the order
+// they arrived. An operation takes a slot of its environment before queueing for a slot of its driver, so a single
+// environment never holds more than MaxPerEnvironment places in a driver queue and deployments of other environments
+// are not starved by a large deployment.
+type limiter struct {
+	options ConcurrencyOptions
+
+	mu           sync.Mutex
+	environments map[string]*queue
+	drivers      map[string]*queue
+}
+
+// queue is a FIFO of the operations waiting for one of a fixed number of slots.
+type queue struct {
+	sem *semaphore.Weighted
+
+	// users is the number of operations waiting for or holding a slot. Environment queues are removed when unused.
+	users int
+}
+
+func newLimiter(options ConcurrencyOptions) *limiter {
+	return &limiter{
+		options:      options,
+		environments: map[string]*queue{},
+		drivers:      map[string]*queue{},
+	}
+}
+
+// acquire waits for a slot of the environment and of the driver. Operations without an environment only wait for a
+// slot of the driver. It returns a function that releases the slots, or an error if the context is done before they
+// are available.
+func (l *limiter) acquire(ctx context.Context, environmentID string, driver string) (func(), error) {
+	if l == nil {
+		return func() {}, nil
+	}
+
+	environmentLimit := l.options.MaxPerEnvironment
+	if environmentID == "" {
+		environmentLimit = 0
+	}
+
+	releaseEnvironment, err := l.wait(ctx, l.environments, strings.ToLower(environmentID), environmentLimit,
+		metrics.NewRecipeQueueAttributes(metrics.RecipeQueueEnvironment, driver))
+	if err != nil {
+		return nil, err
+	}
+
+	releaseDriver, err := l.wait(ctx, l.drivers, driver, l.options.MaxPerDriver[driver],
+		metrics.NewRecipeQueueAttributes(metrics.RecipeQueueDriver, driver))
+	if err != nil {
+		releaseEnvironment()
+		return nil, err
+	}
+
+	return func() {
+		releaseDriver()
+		releaseEnvironment()
+	}, nil
+}
+
+// wait waits for a slot of the queue with the given key, creating the queue if needed. Keys without a positive limit
+// are not limited.
+func (l *limiter) wait(ctx context.Context, queues map[string]*queue, key string, limit int, attrs []attribute.KeyValue) (func(), error) {
+	if limit <= 0 {
+		return func() {}, nil
+	}
+
+	l.mu.Lock()
+	q, ok := queues[key]
+	if !ok {
+		q = &queue{sem: semaphore.NewWeighted(int64(limit))}
+		queues[key] = q
+	}
+	q.users++
+	l.mu.Unlock()
+
+	start := time.Now()
+	metrics.DefaultRecipeEngineMetrics.RecordRecipeQueueDepthChange(ctx, 1, attrs)
+	err := q.sem.Acquire(ctx, 1)
+	metrics.DefaultRecipeEngineMetrics.RecordRecipeQueueDepthChange(ctx, -1, attrs)
+	if err != nil {
+		l.done(queues, key, q)
+		return nil, err
+	}
+	metrics.DefaultRecipeEngineMetrics.RecordRecipeQueueWaitDuration(ctx, start, attrs)
+
+	return func() {
+		q.sem.Release(1)
+		l.done(queues, key, q)
+	}, nil
+}
+
+// done removes the queue once no operation waits for or holds one of its slots.
+func (l *limiter) done(queues map[string]*queue, key string, q *queue) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	q.users--
+	if q.users == 0 {
+		delete(queues, key)
+	}
+}
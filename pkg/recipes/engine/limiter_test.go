@@ -0,0 +1,168 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	limiterEnv1 = "/planes/radius/local/resourcegroups/test-rg/providers/applications.core/environments/env1"
+	limiterEnv2 = "/planes/radius/local/resourcegroups/test-rg/providers/applications.core/environments/env2"
+)
+
+// acquireAsync acquires a slot in a goroutine and returns a channel receiving its release function once acquired.
+func acquireAsync(ctx context.Context, l *limiter, environmentID string, driver string) <-chan func() {
+	acquired := make(chan func(), 1)
+	go func() {
+		release, err := l.acquire(ctx, environmentID, driver)
+		if err == nil {
+			acquired <- release
+		}
+	}()
+	return acquired
+}
+
+func requireWaiting(t *testing.T, acquired <-chan func()) {
+	select {
+	case <-acquired:
+		require.Fail(t, "expected the operation to wait for a slot")
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func requireAcquired(t *testing.T, acquired <-chan func()) func() {
+	select {
+	case release := <-acquired:
+		return release
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "expected the operation to acquire a slot")
+		return nil
+	}
+}
+
+func Test_Limiter_Nil(t *testing.T) {
+	var l *limiter
+	release, err := l.acquire(testcontext.New(t), limiterEnv1, recipes.TemplateKindTerraform)
+	require.NoError(t, err)
+	release()
+}
+
+func Test_Limiter_Unlimited(t *testing.T) {
+	ctx := testcontext.New(t)
+	l := newLimiter(ConcurrencyOptions{})
+
+	for i := 0; i < 10; i++ {
+		_, err := l.acquire(ctx, limiterEnv1, recipes.TemplateKindTerraform)
+		require.NoError(t, err)
+	}
+	require.Empty(t, l.environments)
+	require.Empty(t, l.drivers)
+}
+
+func Test_Limiter_MaxPerEnvironment(t *testing.T) {
+	ctx := testcontext.New(t)
+	l := newLimiter(ConcurrencyOptions{MaxPerEnvironment: 1})
+
+	release, err := l.acquire(ctx, limiterEnv1, recipes.TemplateKindBicep)
+	require.NoError(t, err)
+
+	// Another environment is not limited by env1.
+	other, err := l.acquire(ctx, limiterEnv2, recipes.TemplateKindBicep)
+	require.NoError(t, err)
+	other()
+
+	// Operations without an environment are not limited per environment.
+	metadata, err := l.acquire(ctx, "", recipes.TemplateKindBicep)
+	require.NoError(t, err)
+	metadata()
+
+	waiting := acquireAsync(ctx, l, limiterEnv1, recipes.TemplateKindTerraform)
+	requireWaiting(t, waiting)
+
+	release()
+	requireAcquired(t, waiting)()
+
+	require.Empty(t, l.environments)
+}
+
+func Test_Limiter_MaxPerDriver(t *testing.T) {
+	ctx := testcontext.New(t)
+	l := newLimiter(ConcurrencyOptions{MaxPerDriver: map[string]int{recipes.TemplateKindTerraform: 1}})
+
+	release, err := l.acquire(ctx, limiterEnv1, recipes.TemplateKindTerraform)
+	require.NoError(t, err)
+
+	// Other drivers are not limited.
+	bicep, err := l.acquire(ctx, limiterEnv1, recipes.TemplateKindBicep)
+	require.NoError(t, err)
+	bicep()
+
+	waiting := acquireAsync(ctx, l, limiterEnv2, recipes.TemplateKindTerraform)
+	requireWaiting(t, waiting)
+
+	release()
+	requireAcquired(t, waiting)()
+
+	require.Empty(t, l.drivers)
+}
+
+func Test_Limiter_FairAcrossEnvironments(t *testing.T) {
+	ctx := testcontext.New(t)
+	l := newLimiter(ConcurrencyOptions{
+		MaxPerEnvironment: 1,
+		MaxPerDriver:      map[string]int{recipes.TemplateKindTerraform: 1},
+	})
+
+	release, err := l.acquire(ctx, limiterEnv1, recipes.TemplateKindTerraform)
+	require.NoError(t, err)
+
+	// The second operation of env1 waits for the slot of env1, so it doesn't queue for the driver ahead of env2.
+	env1 := acquireAsync(ctx, l, limiterEnv1, recipes.TemplateKindTerraform)
+	requireWaiting(t, env1)
+	env2 := acquireAsync(ctx, l, limiterEnv2, recipes.TemplateKindTerraform)
+	requireWaiting(t, env2)
+
+	release()
+	releaseEnv2 := requireAcquired(t, env2)
+	requireWaiting(t, env1)
+
+	releaseEnv2()
+	requireAcquired(t, env1)()
+}
+
+func Test_Limiter_ContextCanceled(t *testing.T) {
+	ctx := testcontext.New(t)
+	l := newLimiter(ConcurrencyOptions{MaxPerEnvironment: 1})
+
+	release, err := l.acquire(ctx, limiterEnv1, recipes.TemplateKindTerraform)
+	require.NoError(t, err)
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = l.acquire(canceled, limiterEnv1, recipes.TemplateKindTerraform)
+	require.ErrorIs(t, err, context.Canceled)
+
+	release()
+	require.Empty(t, l.environments)
+}
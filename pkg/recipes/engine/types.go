@@ -30,6 +30,10 @@ type Engine interface {
 	// prevState is added to the driver execute options, which is used to get the obsolete resources for cleanup. It consists list of recipe output resource IDs that were created in the previous deployment.
 	Execute(ctx context.Context, opts ExecuteOptions) (*recipes.RecipeOutput, error)
 
+	// Plan gathers environment configuration, recipe definition and calls the driver to compute the changes that the
+	// execution of the recipe would make, without deploying anything. prevState is used to find the resources that would be deleted.
+	Plan(ctx context.Context, opts ExecuteOptions) (*recipes.RecipePlan, error)
+
 	// Delete handles deletion of output resources for the recipe deployment.
 	Delete(ctx context.Context, opts DeleteOptions) error
 
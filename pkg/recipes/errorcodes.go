@@ -29,6 +29,9 @@ const (
 	// Used for errors encountered during processing recipe outputs.
 	InvalidRecipeOutputs = "InvalidRecipeOutputs"
 
+	// Used for recipe outputs that don't satisfy the output contract of the resource type.
+	RecipeOutputContractViolation = "RecipeOutputContractViolation"
+
 	// Used for errors encountered while reading a recipe from registry.
 	RecipeLanguageFailure = "RecipeLanguageFailure"
 
@@ -38,9 +41,15 @@ const (
 	// Used for errors encountered when getting recipe parameters.
 	RecipeGetMetadataFailed = "RecipeGetMetadataFailed"
 
+	// Used for errors encountered when planning the changes of a recipe deployment.
+	RecipePlanFailed = "RecipePlanFailed"
+
 	// Used for errors when checking the existence of a recipe.
 	RecipeNotFoundFailure = "RecipeNotFoundFailure"
 
+	// Used for errors when checking the existence of a version of a recipe.
+	RecipeVersionNotFoundFailure = "RecipeVersionNotFoundFailure"
+
 	// Used for errors with recipe drivers
 	RecipeDriverNotFoundFailure = "DriverNotFoundFailure"
 
@@ -0,0 +1,243 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/hashicorp/go-version"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/registry"
+	"helm.sh/helm/v3/pkg/repo"
+	"oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content"
+	"oras.land/oras-go/v2/registry/remote"
+	"sigs.k8s.io/yaml"
+
+	"github.com/radius-project/radius/pkg/recipes"
+)
+
+const (
+	ociScheme = "oci://"
+
+	// maxChartSize is the maximum size of a chart archive downloaded over HTTP.
+	maxChartSize = 20 * 1024 * 1024
+)
+
+// chartLoader loads Helm charts from OCI registries, chart repositories and chart archive URLs.
+type chartLoader struct {
+	// httpClient is the client used to download charts from chart repositories.
+	httpClient *http.Client
+
+	// registryClient is the optional client used to interact with OCI registries.
+	registryClient remote.Client
+}
+
+// load loads the chart referenced by the recipe definition. The template path of the recipe can be:
+//
+//   - an OCI reference, for example oci://myregistry.azurecr.io/charts/redis.
+//   - a chart in a chart repository, for example https://charts.example.com/redis.
+//   - a URL of a chart archive, for example https://charts.example.com/redis-1.0.0.tgz.
+//
+// Paths in the file system of the resource provider are rejected, since users cannot place content there.
+//
+// The template version of the recipe selects the version of the chart. The latest version is used when it is empty.
+func (l *chartLoader) load(ctx context.Context, definition *recipes.EnvironmentDefinition) (*chart.Chart, error) {
+	templatePath := strings.TrimSpace(definition.TemplatePath)
+	switch {
+	case strings.HasPrefix(templatePath, ociScheme):
+		return l.loadFromRegistry(ctx, strings.TrimPrefix(templatePath, ociScheme), definition.TemplateVersion, definition.PlainHTTP)
+	case strings.HasPrefix(templatePath, "https://") || strings.HasPrefix(templatePath, "http://"):
+		if isChartArchive(templatePath) {
+			return l.loadFromURL(ctx, templatePath)
+		}
+		return l.loadFromRepository(ctx, templatePath, definition.TemplateVersion)
+	default:
+		return nil, fmt.Errorf("template path %q is not supported: publish the chart to an OCI registry (oci://) or a chart repository (http(s)://)", templatePath)
+	}
+}
+
+// loadFromRegistry pulls the chart from an OCI registry. When version is empty the highest semantic version tag
+// of the repository is used.
+func (l *chartLoader) loadFromRegistry(ctx context.Context, reference, chartVersion string, plainHTTP bool) (*chart.Chart, error) {
+	repository, err := remote.NewRepository(reference)
+	if err != nil {
+		return nil, fmt.Errorf("invalid chart reference %q: %w", reference, err)
+	}
+	repository.Client = l.registryClient
+	repository.PlainHTTP = plainHTTP
+
+	tag := chartVersion
+	if tag == "" {
+		tag, err = latestTag(ctx, repository)
+		if err != nil {
+			return nil, err
+		}
+	}
+	// OCI tags do not allow "+", so Helm replaces it with "_" when pushing a chart.
+	tag = strings.ReplaceAll(tag, "+", "_")
+
+	manifestDescriptor, manifestBytes, err := oras.FetchBytes(ctx, repository, tag, oras.DefaultFetchBytesOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch chart %s:%s: %w", reference, tag, err)
+	}
+
+	var manifest ocispec.Manifest
+	if err := json.Unmarshal(manifestBytes, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to decode the manifest %s of chart %s: %w", manifestDescriptor.Digest, reference, err)
+	}
+
+	for _, layer := range manifest.Layers {
+		if layer.MediaType != registry.ChartLayerMediaType && layer.MediaType != registry.LegacyChartLayerMediaType {
+			continue
+		}
+
+		data, err := content.FetchAll(ctx, repository, layer)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch chart %s:%s: %w", reference, tag, err)
+		}
+		return loader.LoadArchive(bytes.NewReader(data))
+	}
+
+	return nil, fmt.Errorf("%s:%s is not a Helm chart", reference, tag)
+}
+
+// loadFromRepository finds the chart in the index of a chart repository. The chart path is the URL of the
+// repository followed by the name of the chart.
+func (l *chartLoader) loadFromRepository(ctx context.Context, chartPath, chartVersion string) (*chart.Chart, error) {
+	u, err := url.Parse(chartPath)
+	if err != nil {
+		return nil, fmt.Errorf("invalid chart path %q: %w", chartPath, err)
+	}
+
+	chartName := path.Base(u.Path)
+	u.Path = path.Dir(u.Path)
+	repoURL := strings.TrimSuffix(u.String(), "/")
+	if chartName == "" || chartName == "." || chartName == "/" {
+		return nil, fmt.Errorf("invalid chart path %q: the chart name is missing", chartPath)
+	}
+
+	data, err := l.get(ctx, repoURL+"/index.yaml")
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch the index of chart repository %q: %w", repoURL, err)
+	}
+
+	index := &repo.IndexFile{}
+	if err := yaml.Unmarshal(data, index); err != nil {
+		return nil, fmt.Errorf("failed to decode the index of chart repository %q: %w", repoURL, err)
+	}
+	index.SortEntries()
+
+	chartEntry, err := index.Get(chartName, chartVersion)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find chart %q with version %q in chart repository %q: %w", chartName, chartVersion, repoURL, err)
+	}
+	if len(chartEntry.URLs) == 0 {
+		return nil, fmt.Errorf("chart %q with version %q in chart repository %q has no downloadable URL", chartName, chartEntry.Version, repoURL)
+	}
+
+	chartURL, err := repo.ResolveReferenceURL(repoURL, chartEntry.URLs[0])
+	if err != nil {
+		return nil, err
+	}
+
+	return l.loadFromURL(ctx, chartURL)
+}
+
+// loadFromURL downloads a chart archive.
+func (l *chartLoader) loadFromURL(ctx context.Context, chartURL string) (*chart.Chart, error) {
+	data, err := l.get(ctx, chartURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download chart %q: %w", chartURL, err)
+	}
+
+	return loader.LoadArchive(bytes.NewReader(data))
+}
+
+func (l *chartLoader) get(ctx context.Context, u string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	httpClient := l.httpClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxChartSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxChartSize {
+		return nil, fmt.Errorf("the response exceeds the maximum size of %d bytes", maxChartSize)
+	}
+
+	return data, nil
+}
+
+// latestTag returns the tag of the repository with the highest semantic version.
+func latestTag(ctx context.Context, repository *remote.Repository) (string, error) {
+	var latest *version.Version
+	latestTag := ""
+	err := repository.Tags(ctx, "", func(tags []string) error {
+		for _, tag := range tags {
+			v, err := version.NewSemver(strings.ReplaceAll(tag, "_", "+"))
+			if err != nil {
+				continue
+			}
+			if latest == nil || v.GreaterThan(latest) {
+				latest = v
+				latestTag = tag
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to list the versions of chart %s: %w", repository.Reference.String(), err)
+	}
+
+	if latestTag == "" {
+		return "", fmt.Errorf("no version of chart %s was found", repository.Reference.String())
+	}
+
+	return latestTag, nil
+}
+
+func isChartArchive(chartPath string) bool {
+	return strings.HasSuffix(chartPath, ".tgz") || strings.HasSuffix(chartPath, ".tar.gz")
+}
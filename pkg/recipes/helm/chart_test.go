@@ -0,0 +1,276 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chartutil"
+	"helm.sh/helm/v3/pkg/registry"
+	"helm.sh/helm/v3/pkg/repo"
+	"sigs.k8s.io/yaml"
+)
+
+var testChartVersions = []string{"1.0.0", "1.2.0", "1.10.0+build.1"}
+
+func newTestChart(version string) *chart.Chart {
+	return &chart.Chart{
+		Metadata: &chart.Metadata{
+			APIVersion: chart.APIVersionV2,
+			Name:       "redis",
+			Version:    version,
+		},
+		Values: map[string]any{
+			"replicas": 1,
+		},
+		Templates: []*chart.File{
+			{Name: "templates/configmap.yaml", Data: []byte("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: redis\n")},
+		},
+	}
+}
+
+// saveTestCharts saves the test chart archives to dir and returns the archive contents by version.
+func saveTestCharts(t *testing.T, dir string) map[string][]byte {
+	archives := map[string][]byte{}
+	for _, version := range testChartVersions {
+		archivePath, err := chartutil.Save(newTestChart(version), dir)
+		require.NoError(t, err)
+
+		data, err := os.ReadFile(archivePath)
+		require.NoError(t, err)
+		archives[version] = data
+	}
+
+	return archives
+}
+
+func digestOf(data []byte) string {
+	hash := sha256.Sum256(data)
+	return "sha256:" + hex.EncodeToString(hash[:])
+}
+
+// newFakeRegistry creates a fake OCI registry serving the test charts from the "charts/redis" repository.
+func newFakeRegistry(t *testing.T, archives map[string][]byte) *httptest.Server {
+	manifests := map[string][]byte{}
+	blobs := map[string][]byte{}
+	for version, data := range archives {
+		config := []byte("{}")
+		manifest, err := json.Marshal(ocispec.Manifest{
+			MediaType: ocispec.MediaTypeImageManifest,
+			Config: ocispec.Descriptor{
+				MediaType: registry.ConfigMediaType,
+				Digest:    digest.Digest("sha256:" + strings.Repeat("0", 64)),
+				Size:      int64(len(config)),
+			},
+			Layers: []ocispec.Descriptor{
+				{
+					MediaType: registry.ChartLayerMediaType,
+					Digest:    digest.Digest(digestOf(data)),
+					Size:      int64(len(data)),
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		tag := strings.ReplaceAll(version, "+", "_")
+		manifests[tag] = manifest
+		manifests[digestOf(manifest)] = manifest
+		blobs[digestOf(data)] = data
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		const prefix = "/v2/charts/redis/"
+		switch {
+		case r.URL.Path == prefix+"tags/list":
+			tags := []string{"latest-is-not-semver"}
+			for tag := range manifests {
+				if !strings.HasPrefix(tag, "sha256:") {
+					tags = append(tags, tag)
+				}
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]any{"name": "charts/redis", "tags": tags})
+		case strings.HasPrefix(r.URL.Path, prefix+"manifests/"):
+			manifest, ok := manifests[strings.TrimPrefix(r.URL.Path, prefix+"manifests/")]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Header().Set("Content-Type", ocispec.MediaTypeImageManifest)
+			w.Header().Set("Docker-Content-Digest", digestOf(manifest))
+			w.Header().Set("Content-Length", fmt.Sprint(len(manifest)))
+			if r.Method != http.MethodHead {
+				_, _ = w.Write(manifest)
+			}
+		case strings.HasPrefix(r.URL.Path, prefix+"blobs/"):
+			blob, ok := blobs[strings.TrimPrefix(r.URL.Path, prefix+"blobs/")]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Header().Set("Content-Type", "application/octet-stream")
+			w.Header().Set("Content-Length", fmt.Sprint(len(blob)))
+			_, _ = w.Write(blob)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+// newFakeChartRepository creates a fake chart repository serving the test charts.
+func newFakeChartRepository(t *testing.T, archives map[string][]byte) *httptest.Server {
+	index := repo.NewIndexFile()
+	for version, data := range archives {
+		err := index.MustAdd(newTestChart(version).Metadata, fmt.Sprintf("redis-%s.tgz", version), "", digestOf(data))
+		require.NoError(t, err)
+	}
+	indexData, err := yaml.Marshal(index)
+	require.NoError(t, err)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/charts/index.yaml" {
+			_, _ = w.Write(indexData)
+			return
+		}
+		for version, data := range archives {
+			if r.URL.Path == fmt.Sprintf("/charts/redis-%s.tgz", version) {
+				_, _ = w.Write(data)
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestChartLoader_Load(t *testing.T) {
+	ctx := testcontext.New(t)
+	dir := t.TempDir()
+	archives := saveTestCharts(t, dir)
+
+	registryServer := newFakeRegistry(t, archives)
+	registryHost := strings.TrimPrefix(registryServer.URL, "http://")
+	repositoryServer := newFakeChartRepository(t, archives)
+
+	tests := []struct {
+		desc            string
+		templatePath    string
+		templateVersion string
+		plainHTTP       bool
+		expectedVersion string
+		expectedErr     string
+	}{
+		{
+			desc:            "oci",
+			templatePath:    "oci://" + registryHost + "/charts/redis",
+			templateVersion: "1.2.0",
+			plainHTTP:       true,
+			expectedVersion: "1.2.0",
+		},
+		{
+			desc:            "oci latest",
+			templatePath:    "oci://" + registryHost + "/charts/redis",
+			plainHTTP:       true,
+			expectedVersion: "1.10.0+build.1",
+		},
+		{
+			desc:            "oci build metadata",
+			templatePath:    "oci://" + registryHost + "/charts/redis",
+			templateVersion: "1.10.0+build.1",
+			plainHTTP:       true,
+			expectedVersion: "1.10.0+build.1",
+		},
+		{
+			desc:            "oci version not found",
+			templatePath:    "oci://" + registryHost + "/charts/redis",
+			templateVersion: "3.0.0",
+			plainHTTP:       true,
+			expectedErr:     "failed to fetch chart",
+		},
+		{
+			desc:            "chart repository",
+			templatePath:    repositoryServer.URL + "/charts/redis",
+			templateVersion: "1.0.0",
+			expectedVersion: "1.0.0",
+		},
+		{
+			desc:            "chart repository latest",
+			templatePath:    repositoryServer.URL + "/charts/redis",
+			expectedVersion: "1.10.0+build.1",
+		},
+		{
+			desc:            "chart repository version not found",
+			templatePath:    repositoryServer.URL + "/charts/redis",
+			templateVersion: "3.0.0",
+			expectedErr:     "failed to find chart \"redis\" with version \"3.0.0\"",
+		},
+		{
+			desc:            "chart archive url",
+			templatePath:    repositoryServer.URL + "/charts/redis-1.2.0.tgz",
+			expectedVersion: "1.2.0",
+		},
+		{
+			desc:         "local path",
+			templatePath: filepath.Join(dir, "redis-1.0.0.tgz"),
+			expectedErr:  "is not supported",
+		},
+		{
+			desc:         "local file url",
+			templatePath: "file://" + filepath.Join(dir, "redis-1.0.0.tgz"),
+			expectedErr:  "is not supported",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			l := &chartLoader{httpClient: http.DefaultClient}
+			c, err := l.load(ctx, &recipes.EnvironmentDefinition{
+				TemplatePath:    tc.templatePath,
+				TemplateVersion: tc.templateVersion,
+				PlainHTTP:       tc.plainHTTP,
+			})
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, "redis", c.Name())
+			require.Equal(t, tc.expectedVersion, c.Metadata.Version)
+		})
+	}
+}
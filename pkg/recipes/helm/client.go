@@ -0,0 +1,164 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-logr/logr"
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/storage/driver"
+	"k8s.io/client-go/rest"
+
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// helmStorageDriver is the storage driver for the release information. "secret" stores it in Kubernetes secrets
+	// in the namespace of the release.
+	helmStorageDriver = "secret"
+)
+
+var _ Client = (*client)(nil)
+
+// NewClient creates a new Helm client which deploys releases to the cluster described by the given configuration.
+// The default timeout is used if timeout is zero.
+func NewClient(k8sConfig *rest.Config, timeout time.Duration) Client {
+	if timeout == 0 {
+		timeout = DefaultTimeout
+	}
+
+	return &client{
+		k8sConfig: k8sConfig,
+		timeout:   timeout,
+		loader:    &chartLoader{httpClient: http.DefaultClient},
+	}
+}
+
+type client struct {
+	k8sConfig *rest.Config
+	timeout   time.Duration
+	loader    *chartLoader
+}
+
+// LoadChart downloads (if needed) and loads the Helm chart referenced by the recipe definition.
+func (c *client) LoadChart(ctx context.Context, definition *recipes.EnvironmentDefinition) (*chart.Chart, error) {
+	return c.loader.load(ctx, definition)
+}
+
+// Deploy installs the chart as a new release, or upgrades the release if it already exists. Both operations are
+// atomic: a failed install is uninstalled and a failed upgrade is rolled back.
+func (c *client) Deploy(ctx context.Context, options DeployOptions) (*release.Release, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	cfg, err := c.newConfiguration(ctx, options.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := releaseExists(cfg, options.ReleaseName)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		logger.Info(fmt.Sprintf("Installing Helm release %q in namespace %q", options.ReleaseName, options.Namespace), "dryRun", options.DryRun)
+		install := action.NewInstall(cfg)
+		install.Namespace = options.Namespace
+		install.ReleaseName = options.ReleaseName
+		install.CreateNamespace = true
+		install.DryRun = options.DryRun
+		install.Atomic = !options.DryRun
+		install.Wait = !options.DryRun
+		install.Timeout = c.timeout
+		return install.RunWithContext(ctx, options.Chart, options.Values)
+	}
+
+	logger.Info(fmt.Sprintf("Upgrading Helm release %q in namespace %q", options.ReleaseName, options.Namespace), "dryRun", options.DryRun)
+	upgrade := action.NewUpgrade(cfg)
+	upgrade.Namespace = options.Namespace
+	upgrade.DryRun = options.DryRun
+	upgrade.Atomic = !options.DryRun
+	upgrade.Wait = !options.DryRun
+	upgrade.Timeout = c.timeout
+	return upgrade.RunWithContext(ctx, options.ReleaseName, options.Chart, options.Values)
+}
+
+// Uninstall uninstalls the release and waits for its resources to be deleted.
+func (c *client) Uninstall(ctx context.Context, namespace, releaseName string) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	cfg, err := c.newConfiguration(ctx, namespace)
+	if err != nil {
+		return err
+	}
+
+	exists, err := releaseExists(cfg, releaseName)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		logger.Info(fmt.Sprintf("Helm release %q in namespace %q does not exist", releaseName, namespace))
+		return nil
+	}
+
+	logger.Info(fmt.Sprintf("Uninstalling Helm release %q in namespace %q", releaseName, namespace))
+	uninstall := action.NewUninstall(cfg)
+	uninstall.Wait = true
+	uninstall.Timeout = c.timeout
+	_, err = uninstall.Run(releaseName)
+	return err
+}
+
+func (c *client) newConfiguration(ctx context.Context, namespace string) (*action.Configuration, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	cfg := &action.Configuration{}
+	err := cfg.Init(newRESTClientGetter(c.k8sConfig, namespace), namespace, helmStorageDriver, helmLogger(logger))
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize Helm: %w", err)
+	}
+
+	return cfg, nil
+}
+
+// releaseExists returns true if the release has any revision in the history.
+func releaseExists(cfg *action.Configuration, releaseName string) (bool, error) {
+	history := action.NewHistory(cfg)
+	history.Max = 1
+	_, err := history.Run(releaseName)
+	if errors.Is(err, driver.ErrReleaseNotFound) {
+		return false, nil
+	} else if err != nil {
+		return false, fmt.Errorf("failed to get the history of Helm release %q: %w", releaseName, err)
+	}
+
+	return true, nil
+}
+
+func helmLogger(logger logr.Logger) action.DebugLog {
+	return func(format string, v ...any) {
+		logger.V(ucplog.LevelDebug).Info(fmt.Sprintf(format, v...))
+	}
+}
@@ -0,0 +1,82 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/radius-project/radius/pkg/recipes/helm (interfaces: Client)
+
+// Package helm is a generated GoMock package.
+package helm
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	recipes "github.com/radius-project/radius/pkg/recipes"
+	chart "helm.sh/helm/v3/pkg/chart"
+	release "helm.sh/helm/v3/pkg/release"
+)
+
+// MockClient is a mock of Client interface.
+type MockClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockClientMockRecorder
+}
+
+// MockClientMockRecorder is the mock recorder for MockClient.
+type MockClientMockRecorder struct {
+	mock *MockClient
+}
+
+// NewMockClient creates a new mock instance.
+func NewMockClient(ctrl *gomock.Controller) *MockClient {
+	mock := &MockClient{ctrl: ctrl}
+	mock.recorder = &MockClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClient) EXPECT() *MockClientMockRecorder {
+	return m.recorder
+}
+
+// Deploy mocks base method.
+func (m *MockClient) Deploy(arg0 context.Context, arg1 DeployOptions) (*release.Release, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Deploy", arg0, arg1)
+	ret0, _ := ret[0].(*release.Release)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Deploy indicates an expected call of Deploy.
+func (mr *MockClientMockRecorder) Deploy(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deploy", reflect.TypeOf((*MockClient)(nil).Deploy), arg0, arg1)
+}
+
+// LoadChart mocks base method.
+func (m *MockClient) LoadChart(arg0 context.Context, arg1 *recipes.EnvironmentDefinition) (*chart.Chart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoadChart", arg0, arg1)
+	ret0, _ := ret[0].(*chart.Chart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LoadChart indicates an expected call of LoadChart.
+func (mr *MockClientMockRecorder) LoadChart(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadChart", reflect.TypeOf((*MockClient)(nil).LoadChart), arg0, arg1)
+}
+
+// Uninstall mocks base method.
+func (m *MockClient) Uninstall(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Uninstall", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Uninstall indicates an expected call of Uninstall.
+func (mr *MockClientMockRecorder) Uninstall(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Uninstall", reflect.TypeOf((*MockClient)(nil).Uninstall), arg0, arg1, arg2)
+}
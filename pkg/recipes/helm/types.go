@@ -0,0 +1,65 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"context"
+	"time"
+
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/release"
+
+	"github.com/radius-project/radius/pkg/recipes"
+)
+
+const (
+	// DefaultTimeout is the default time to wait for the Kubernetes resources of a release to become ready.
+	DefaultTimeout = 10 * time.Minute
+)
+
+//go:generate mockgen -destination=./mock_client.go -package=helm -self_package github.com/radius-project/radius/pkg/recipes/helm github.com/radius-project/radius/pkg/recipes/helm Client
+type Client interface {
+	// LoadChart downloads (if needed) and loads the Helm chart referenced by the recipe definition.
+	LoadChart(ctx context.Context, definition *recipes.EnvironmentDefinition) (*chart.Chart, error)
+
+	// Deploy installs the chart as a new release, or upgrades the release if it already exists, and waits for the
+	// release resources to become ready. If the dry run option is set, the release is only rendered.
+	Deploy(ctx context.Context, options DeployOptions) (*release.Release, error)
+
+	// Uninstall uninstalls the release and waits for its resources to be deleted. It is not an error if the release
+	// does not exist.
+	Uninstall(ctx context.Context, namespace, releaseName string) error
+}
+
+// DeployOptions represents the options required to install or upgrade a Helm release.
+type DeployOptions struct {
+	// Namespace is the Kubernetes namespace of the release.
+	Namespace string
+
+	// ReleaseName is the name of the release.
+	ReleaseName string
+
+	// Chart is the chart to deploy.
+	Chart *chart.Chart
+
+	// Values are the values passed to the chart. They are merged with the default values of the chart.
+	Values map[string]any
+
+	// DryRun renders the release without installing or upgrading it. The manifest of the returned release contains
+	// the Kubernetes objects that would be deployed.
+	DryRun bool
+}
@@ -0,0 +1,370 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/Masterminds/sprig/v3"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8sjson "k8s.io/apimachinery/pkg/util/json"
+	k8syaml "k8s.io/apimachinery/pkg/util/yaml"
+	"oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content/file"
+	"oras.land/oras-go/v2/registry/remote"
+	"sigs.k8s.io/kustomize/api/konfig"
+	"sigs.k8s.io/kustomize/api/krusty"
+	"sigs.k8s.io/kustomize/kyaml/filesys"
+)
+
+const (
+	ociScheme = "oci://"
+
+	// maxManifestSize is the maximum size of a manifest file downloaded over HTTP.
+	maxManifestSize = 10 * 1024 * 1024
+
+	// maxArtifactSize is the maximum size of a blob pulled from an OCI registry.
+	maxArtifactSize = 20 * 1024 * 1024
+
+	// noValue is printed by Go templates for missing map keys. It is removed from the rendered manifests so that
+	// optional parameters render as empty values, the same way Helm does.
+	noValue = "<no value>"
+)
+
+// Options configures how Render fetches the manifests of a recipe.
+type Options struct {
+	// PlainHTTP connects to the OCI registry using HTTP rather than HTTPS.
+	PlainHTTP bool
+
+	// RegistryClient is the optional client used to interact with OCI registries.
+	RegistryClient remote.Client
+}
+
+// Render fetches the Kubernetes manifests referenced by templatePath, executes them as Go templates with the given
+// data, and returns the Kubernetes objects they define. The template path can be:
+//
+//   - the OCI reference of an artifact, for example oci://myregistry.azurecr.io/recipes/redis:1.0.0. The artifact
+//     contains either the manifest files or a single directory, as pushed by "oras push". If the content contains a
+//     kustomization file it is built as a Kustomize overlay, otherwise every YAML and JSON file is rendered in
+//     lexical order.
+//   - the URL of a manifest file, for example https://example.com/recipes/redis.yaml.
+//
+// Paths in the file system of the resource provider are rejected, since users cannot place content there.
+//
+// The sprig template functions, except those reading the environment, are available to the templates. Files with the
+// .yaml, .yml or .json extension are executed as templates, including the kustomization files and the bases of an
+// overlay.
+func Render(ctx context.Context, templatePath string, data map[string]any, options Options) ([]*unstructured.Unstructured, error) {
+	templatePath = strings.TrimSpace(templatePath)
+	switch {
+	case templatePath == "":
+		return nil, errors.New("template path is empty")
+	case strings.HasPrefix(templatePath, ociScheme):
+		return renderFromRegistry(ctx, strings.TrimPrefix(templatePath, ociScheme), data, options)
+	case strings.HasPrefix(templatePath, "https://") || strings.HasPrefix(templatePath, "http://"):
+		content, err := download(ctx, templatePath)
+		if err != nil {
+			return nil, err
+		}
+		return renderManifest(templatePath, content, data)
+	default:
+		return nil, fmt.Errorf("template path %q is not supported: publish the manifests to an OCI registry (oci://) or serve the manifest file over HTTP(S)", templatePath)
+	}
+}
+
+// renderFromRegistry pulls the artifact from an OCI registry and renders its content. The reference must include
+// a tag or a digest.
+func renderFromRegistry(ctx context.Context, reference string, data map[string]any, options Options) ([]*unstructured.Unstructured, error) {
+	repository, err := remote.NewRepository(reference)
+	if err != nil {
+		return nil, fmt.Errorf("invalid manifest reference %q: %w", reference, err)
+	}
+	if repository.Reference.Reference == "" {
+		return nil, fmt.Errorf("invalid manifest reference %q: a tag or digest is required", reference)
+	}
+	repository.Client = options.RegistryClient
+	repository.PlainHTTP = options.PlainHTTP
+
+	return renderArtifact(ctx, repository, repository.Reference.Reference, data)
+}
+
+// renderArtifact copies the artifact tagged ref from the source to a temporary directory and renders its content.
+func renderArtifact(ctx context.Context, src oras.ReadOnlyTarget, ref string, data map[string]any) ([]*unstructured.Unstructured, error) {
+	dir, err := os.MkdirTemp("", "manifest-recipe-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	store, err := file.New(dir)
+	if err != nil {
+		return nil, err
+	}
+	defer store.Close()
+
+	copyOptions := oras.DefaultCopyOptions
+	copyOptions.PreCopy = func(_ context.Context, desc ocispec.Descriptor) error {
+		if desc.Size > maxArtifactSize {
+			return fmt.Errorf("the blob %s exceeds the maximum size of %d bytes", desc.Digest, maxArtifactSize)
+		}
+		return nil
+	}
+	if _, err := oras.Copy(ctx, src, ref, store, ref, copyOptions); err != nil {
+		return nil, fmt.Errorf("failed to pull manifests %q: %w", ref, err)
+	}
+
+	root, err := artifactRoot(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	return renderPath(root, data)
+}
+
+// artifactRoot returns the directory to render from the content of a pulled artifact. An artifact pushed from a
+// single directory is unpacked into a subdirectory named after it, which becomes the root.
+func artifactRoot(dir string) (string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return "", err
+	}
+	if len(entries) == 0 {
+		return "", errors.New("the artifact does not contain any manifest")
+	}
+	if len(entries) == 1 && entries[0].IsDir() {
+		return filepath.Join(dir, entries[0].Name()), nil
+	}
+
+	return dir, nil
+}
+
+// renderPath renders a manifest file, a Kustomize overlay or a directory of manifests.
+func renderPath(localPath string, data map[string]any) ([]*unstructured.Unstructured, error) {
+	info, err := os.Stat(localPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find manifests %q: %w", localPath, err)
+	}
+
+	if !info.IsDir() {
+		content, err := os.ReadFile(localPath)
+		if err != nil {
+			return nil, err
+		}
+		return renderManifest(localPath, content, data)
+	}
+
+	if isKustomization(localPath) {
+		return renderKustomization(localPath, data)
+	}
+
+	return renderDirectory(localPath, data)
+}
+
+// download reads the manifest file at the URL.
+func download(ctx context.Context, manifestURL string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, manifestURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("invalid manifest URL %q: %w", manifestURL, err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download manifest %q: %w", manifestURL, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to download manifest %q: unexpected status code %d", manifestURL, resp.StatusCode)
+	}
+
+	content, err := io.ReadAll(io.LimitReader(resp.Body, maxManifestSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to download manifest %q: %w", manifestURL, err)
+	}
+	if len(content) > maxManifestSize {
+		return nil, fmt.Errorf("failed to download manifest %q: the response exceeds the maximum size of %d bytes", manifestURL, maxManifestSize)
+	}
+
+	return content, nil
+}
+
+// renderDirectory renders every manifest file of the directory in lexical order. Subdirectories are ignored.
+func renderDirectory(dir string, data map[string]any) ([]*unstructured.Unstructured, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	names := []string{}
+	for _, entry := range entries {
+		if !entry.IsDir() && isManifestFile(entry.Name()) {
+			names = append(names, entry.Name())
+		}
+	}
+	sort.Strings(names)
+
+	objects := []*unstructured.Unstructured{}
+	for _, name := range names {
+		filePath := filepath.Join(dir, name)
+		content, err := os.ReadFile(filePath)
+		if err != nil {
+			return nil, err
+		}
+
+		fileObjects, err := renderManifest(filePath, content, data)
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, fileObjects...)
+	}
+
+	return objects, nil
+}
+
+// renderKustomization builds the Kustomize overlay in the directory. The files read by Kustomize are executed as
+// templates before they are parsed.
+func renderKustomization(dir string, data map[string]any) ([]*unstructured.Unstructured, error) {
+	fs := &templateFileSystem{FileSystem: filesys.MakeFsOnDisk(), data: data}
+
+	resources, err := krusty.MakeKustomizer(krusty.MakeDefaultOptions()).Run(fs, dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build kustomization %q: %w", dir, err)
+	}
+
+	content, err := resources.AsYaml()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build kustomization %q: %w", dir, err)
+	}
+
+	return decode(dir, content)
+}
+
+// renderManifest executes the manifest as a template and decodes the Kubernetes objects of the result.
+func renderManifest(name string, content []byte, data map[string]any) ([]*unstructured.Unstructured, error) {
+	rendered, err := execute(name, content, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return decode(name, rendered)
+}
+
+// funcMap returns the sprig functions available to the templates. As in Helm, the functions reading the environment
+// are removed, since the environment of the resource provider holds its credentials.
+func funcMap() template.FuncMap {
+	funcs := sprig.TxtFuncMap()
+	delete(funcs, "env")
+	delete(funcs, "expandenv")
+	return funcs
+}
+
+// execute executes the content as a Go template with the sprig functions.
+func execute(name string, content []byte, data map[string]any) ([]byte, error) {
+	tmpl, err := template.New(name).Option("missingkey=zero").Funcs(funcMap()).Parse(string(content))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse template %q: %w", name, err)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return nil, fmt.Errorf("failed to render template %q: %w", name, err)
+	}
+
+	return []byte(strings.ReplaceAll(buf.String(), noValue, "")), nil
+}
+
+// decode decodes the YAML (or JSON) documents of the content. Empty documents are skipped.
+func decode(name string, content []byte) ([]*unstructured.Unstructured, error) {
+	decoder := k8syaml.NewYAMLOrJSONDecoder(bytes.NewReader(content), 4096)
+
+	objects := []*unstructured.Unstructured{}
+	for {
+		raw := json.RawMessage{}
+		err := decoder.Decode(&raw)
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to decode manifest %q: %w", name, err)
+		}
+
+		// The Kubernetes JSON package decodes integers as int64 rather than float64, as the Kubernetes clients expect.
+		obj := &unstructured.Unstructured{}
+		if err := k8sjson.Unmarshal(raw, &obj.Object); err != nil {
+			return nil, fmt.Errorf("failed to decode manifest %q: %w", name, err)
+		}
+		if len(obj.Object) == 0 {
+			continue
+		}
+		if obj.GetAPIVersion() == "" || obj.GetKind() == "" || obj.GetName() == "" {
+			return nil, fmt.Errorf("manifest %q contains an object without an apiVersion, kind or name", name)
+		}
+		objects = append(objects, obj)
+	}
+
+	return objects, nil
+}
+
+// isKustomization returns true if the directory contains a kustomization file.
+func isKustomization(dir string) bool {
+	for _, name := range konfig.RecognizedKustomizationFileNames() {
+		if _, err := os.Stat(filepath.Join(dir, name)); err == nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isManifestFile returns true if the file is a YAML or JSON file.
+func isManifestFile(name string) bool {
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".yaml", ".yml", ".json":
+		return true
+	}
+
+	return false
+}
+
+// templateFileSystem is a Kustomize file system that executes the manifest files it reads as templates.
+type templateFileSystem struct {
+	filesys.FileSystem
+
+	// data is the data passed to the templates.
+	data map[string]any
+}
+
+// ReadFile reads the file and executes it as a template if it is a manifest file.
+func (fs *templateFileSystem) ReadFile(path string) ([]byte, error) {
+	content, err := fs.FileSystem.ReadFile(path)
+	if err != nil || !isManifestFile(path) {
+		return content, err
+	}
+
+	return execute(path, content, fs.data)
+}
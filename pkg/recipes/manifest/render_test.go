@@ -0,0 +1,326 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content/file"
+	"oras.land/oras-go/v2/content/memory"
+)
+
+func testData() map[string]any {
+	return map[string]any{
+		"context": map[string]any{
+			"resource": map[string]any{
+				"name": "redis",
+			},
+			"runtime": map[string]any{
+				"kubernetes": map[string]any{
+					"namespace": "default-app",
+				},
+			},
+		},
+		"parameters": map[string]any{
+			"replicas": 3,
+			"password": "p@ssw0rd",
+		},
+	}
+}
+
+func TestRender(t *testing.T) {
+	manifest, err := os.ReadFile(filepath.Join("testdata", "redis.yaml"))
+	require.NoError(t, err)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/recipes/redis.yaml" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write(manifest)
+	}))
+	defer server.Close()
+
+	redisValidator := func(t *testing.T, objects []*unstructured.Unstructured) {
+		require.Len(t, objects, 2)
+		require.Equal(t, "Deployment", objects[0].GetKind())
+		require.Equal(t, "redis", objects[0].GetName())
+		replicas, _, err := unstructured.NestedInt64(objects[0].Object, "spec", "replicas")
+		require.NoError(t, err)
+		require.Equal(t, int64(3), replicas)
+		containers, _, err := unstructured.NestedSlice(objects[0].Object, "spec", "template", "spec", "containers")
+		require.NoError(t, err)
+		// The missing "tag" parameter renders as an empty value.
+		require.Equal(t, "redis:", containers[0].(map[string]any)["image"])
+		require.Equal(t, "Service", objects[1].GetKind())
+		require.Equal(t, "redis", objects[1].GetName())
+	}
+
+	tests := []struct {
+		desc         string
+		templatePath string
+		validate     func(t *testing.T, objects []*unstructured.Unstructured)
+		err          string
+	}{
+		{
+			desc:         "manifest file URL",
+			templatePath: server.URL + "/recipes/redis.yaml",
+			validate:     redisValidator,
+		},
+		{
+			desc:         "empty template path",
+			templatePath: "",
+			err:          "template path is empty",
+		},
+		{
+			desc:         "manifest file URL not found",
+			templatePath: server.URL + "/recipes/missing.yaml",
+			err:          "unexpected status code 404",
+		},
+		{
+			desc:         "OCI reference without a tag",
+			templatePath: "oci://myregistry.azurecr.io/recipes/redis",
+			err:          "a tag or digest is required",
+		},
+		{
+			desc:         "local manifest file",
+			templatePath: filepath.Join("testdata", "redis.yaml"),
+			err:          "is not supported",
+		},
+		{
+			desc:         "local directory",
+			templatePath: "file://" + filepath.Join("testdata", "overlay"),
+			err:          "is not supported",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			objects, err := Render(testcontext.New(t), tc.templatePath, testData(), Options{})
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+
+			require.NoError(t, err)
+			tc.validate(t, objects)
+		})
+	}
+}
+
+// pushArtifact packs the files and directories of testdata into an artifact tagged 1.0.0 in an in-memory registry.
+func pushArtifact(t *testing.T, names ...string) oras.ReadOnlyTarget {
+	ctx := testcontext.New(t)
+
+	source, err := file.New("testdata")
+	require.NoError(t, err)
+	defer source.Close()
+
+	layers := []ocispec.Descriptor{}
+	for _, name := range names {
+		layer, err := source.Add(ctx, name, "", "")
+		require.NoError(t, err)
+		layers = append(layers, layer)
+	}
+
+	root, err := oras.Pack(ctx, source, "application/vnd.radius.manifest.v1", layers, oras.PackOptions{PackImageManifest: true})
+	require.NoError(t, err)
+	require.NoError(t, source.Tag(ctx, root, "1.0.0"))
+
+	target := memory.New()
+	_, err = oras.Copy(ctx, source, "1.0.0", target, "1.0.0", oras.DefaultCopyOptions)
+	require.NoError(t, err)
+
+	return target
+}
+
+func TestRenderArtifact(t *testing.T) {
+	directoryValidator := func(t *testing.T, objects []*unstructured.Unstructured) {
+		require.Len(t, objects, 2)
+		require.Equal(t, "ConfigMap", objects[0].GetKind())
+		require.Equal(t, "redis-config", objects[0].GetName())
+		host, _, err := unstructured.NestedString(objects[0].Object, "data", "host")
+		require.NoError(t, err)
+		require.Equal(t, "redis.default-app.svc.cluster.local", host)
+		require.Equal(t, "Secret", objects[1].GetKind())
+		require.Equal(t, "redis-secret", objects[1].GetName())
+		password, _, err := unstructured.NestedString(objects[1].Object, "stringData", "password")
+		require.NoError(t, err)
+		require.Equal(t, "p@ssw0rd", password)
+	}
+
+	tests := []struct {
+		desc     string
+		names    []string
+		ref      string
+		validate func(t *testing.T, objects []*unstructured.Unstructured)
+		err      string
+	}{
+		{
+			desc:     "directory",
+			names:    []string{"directory"},
+			ref:      "1.0.0",
+			validate: directoryValidator,
+		},
+		{
+			desc:  "manifest files",
+			names: []string{"redis.yaml"},
+			ref:   "1.0.0",
+			validate: func(t *testing.T, objects []*unstructured.Unstructured) {
+				require.Len(t, objects, 2)
+				require.Equal(t, "Deployment", objects[0].GetKind())
+				require.Equal(t, "Service", objects[1].GetKind())
+			},
+		},
+		{
+			desc:  "kustomization",
+			names: []string{"base"},
+			ref:   "1.0.0",
+			validate: func(t *testing.T, objects []*unstructured.Unstructured) {
+				require.Len(t, objects, 1)
+				require.Equal(t, "Service", objects[0].GetKind())
+				require.Equal(t, "redis", objects[0].GetName())
+			},
+		},
+		{
+			desc:  "tag not found",
+			names: []string{"directory"},
+			ref:   "2.0.0",
+			err:   "failed to pull manifests",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			objects, err := renderArtifact(testcontext.New(t), pushArtifact(t, tc.names...), tc.ref, testData())
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+
+			require.NoError(t, err)
+			tc.validate(t, objects)
+		})
+	}
+}
+
+func TestRenderPath(t *testing.T) {
+	tests := []struct {
+		desc      string
+		localPath string
+		validate  func(t *testing.T, objects []*unstructured.Unstructured)
+		err       string
+	}{
+		{
+			desc:      "manifest file",
+			localPath: filepath.Join("testdata", "redis.yaml"),
+			validate: func(t *testing.T, objects []*unstructured.Unstructured) {
+				require.Len(t, objects, 2)
+				require.Equal(t, "Deployment", objects[0].GetKind())
+				require.Equal(t, "Service", objects[1].GetKind())
+			},
+		},
+		{
+			desc:      "directory",
+			localPath: filepath.Join("testdata", "directory"),
+			validate: func(t *testing.T, objects []*unstructured.Unstructured) {
+				require.Len(t, objects, 2)
+				require.Equal(t, "ConfigMap", objects[0].GetKind())
+				require.Equal(t, "Secret", objects[1].GetKind())
+			},
+		},
+		{
+			desc:      "kustomize overlay",
+			localPath: filepath.Join("testdata", "overlay"),
+			validate: func(t *testing.T, objects []*unstructured.Unstructured) {
+				require.Len(t, objects, 1)
+				require.Equal(t, "Service", objects[0].GetKind())
+				require.Equal(t, "redis", objects[0].GetName())
+				require.Equal(t, "default-app", objects[0].GetNamespace())
+				require.Equal(t, map[string]string{"app": "redis"}, objects[0].GetLabels())
+			},
+		},
+		{
+			desc:      "manifest file not found",
+			localPath: filepath.Join("testdata", "missing.yaml"),
+			err:       "failed to find manifests",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			objects, err := renderPath(tc.localPath, testData())
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+
+			require.NoError(t, err)
+			tc.validate(t, objects)
+		})
+	}
+}
+
+func TestRenderManifest_Invalid(t *testing.T) {
+	tests := []struct {
+		desc    string
+		content string
+		err     string
+	}{
+		{
+			desc:    "invalid template",
+			content: "kind: {{ .context.resource.name ",
+			err:     "failed to parse template",
+		},
+		{
+			desc:    "env function",
+			content: "kind: {{ env \"HOME\" }}",
+			err:     "function \"env\" not defined",
+		},
+		{
+			desc:    "expandenv function",
+			content: "kind: {{ expandenv \"$HOME\" }}",
+			err:     "function \"expandenv\" not defined",
+		},
+		{
+			desc:    "invalid yaml",
+			content: "kind: [",
+			err:     "failed to decode manifest",
+		},
+		{
+			desc:    "object without a kind",
+			content: "apiVersion: v1\nmetadata:\n  name: redis\n",
+			err:     "contains an object without an apiVersion, kind or name",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := renderManifest("test.yaml", []byte(tc.content), testData())
+			require.ErrorContains(t, err, tc.err)
+		})
+	}
+}
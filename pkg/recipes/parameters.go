@@ -0,0 +1,291 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recipes
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+const (
+	// ParameterTypeString is the type of string parameters.
+	ParameterTypeString = "string"
+	// ParameterTypeInteger is the type of integer parameters.
+	ParameterTypeInteger = "integer"
+	// ParameterTypeNumber is the type of number parameters.
+	ParameterTypeNumber = "number"
+	// ParameterTypeBoolean is the type of boolean parameters.
+	ParameterTypeBoolean = "boolean"
+	// ParameterTypeObject is the type of object and map parameters.
+	ParameterTypeObject = "object"
+	// ParameterTypeArray is the type of array, list, set and tuple parameters.
+	ParameterTypeArray = "array"
+	// ParameterTypeAny is the type of parameters that accept any value.
+	ParameterTypeAny = "any"
+)
+
+// ParameterDefinition is the definition of a parameter declared by the template of a recipe, as returned by the
+// GetRecipeMetadata function of the recipe engine.
+type ParameterDefinition struct {
+	// Name is the name of the parameter.
+	Name string
+	// Type is the type of the parameter, one of the ParameterType constants.
+	Type string
+	// DeclaredType is the type of the parameter as declared by the template, e.g. "securestring" or "list(string)".
+	DeclaredType string
+	// Required is true if the parameter has no default value.
+	Required bool
+	// DefaultValue is the default value of the parameter.
+	DefaultValue any
+	// AllowedValues is the list of values allowed for the parameter, if restricted.
+	AllowedValues []any
+	// MinValue is the minimum value of an integer parameter.
+	MinValue *float64
+	// MaxValue is the maximum value of an integer parameter.
+	MaxValue *float64
+	// MinLength is the minimum length of a string or array parameter.
+	MinLength *float64
+	// MaxLength is the maximum length of a string or array parameter.
+	MaxLength *float64
+	// Description is the description of the parameter.
+	Description string
+	// Sensitive is true if the value of the parameter is a secret.
+	Sensitive bool
+}
+
+// ParameterSchema is the set of parameters declared by the template of a recipe.
+type ParameterSchema struct {
+	// Parameters is the list of parameters sorted by name.
+	Parameters []ParameterDefinition
+	// Strict is true if the template only accepts the declared parameters. Helm charts and Kubernetes manifests
+	// accept any parameter.
+	Strict bool
+}
+
+// NewParameterSchema creates the parameter schema of a recipe from the parameters returned by the GetRecipeMetadata
+// function of the recipe engine for a template of the given kind.
+func NewParameterSchema(templateKind string, parameters map[string]any) (*ParameterSchema, error) {
+	schema := &ParameterSchema{
+		Parameters: []ParameterDefinition{},
+		Strict:     templateKind == TemplateKindBicep || templateKind == TemplateKindTerraform,
+	}
+
+	for name, value := range parameters {
+		details, ok := value.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("the details of parameter %q are not in the expected format", name)
+		}
+
+		definition := ParameterDefinition{Name: name}
+		definition.DeclaredType, _ = details["type"].(string)
+		definition.Type = normalizeParameterType(templateKind, definition.DeclaredType)
+		definition.DefaultValue = details["defaultValue"]
+		definition.AllowedValues, _ = details["allowedValues"].([]any)
+		definition.MinValue = toFloat(details["minValue"])
+		definition.MaxValue = toFloat(details["maxValue"])
+		definition.MinLength = toFloat(details["minLength"])
+		definition.MaxLength = toFloat(details["maxLength"])
+		definition.Description, _ = details["description"].(string)
+		if metadata, ok := details["metadata"].(map[string]any); ok && definition.Description == "" {
+			definition.Description, _ = metadata["description"].(string)
+		}
+
+		switch templateKind {
+		case TemplateKindTerraform:
+			definition.Required, _ = details["required"].(bool)
+			definition.Sensitive, _ = details["sensitive"].(bool)
+		case TemplateKindBicep:
+			_, hasDefault := details["defaultValue"]
+			definition.Required = !hasDefault
+			definition.Sensitive = strings.HasPrefix(strings.ToLower(definition.DeclaredType), "secure")
+		}
+
+		schema.Parameters = append(schema.Parameters, definition)
+	}
+
+	sort.Slice(schema.Parameters, func(i, j int) bool {
+		return schema.Parameters[i].Name < schema.Parameters[j].Name
+	})
+
+	return schema, nil
+}
+
+// Validate validates the given parameter values against the schema. Parameters that aren't declared by strict
+// schemas, values of the wrong type and values that don't satisfy the constraints of their parameter are reported.
+// Required parameters are not validated, as they can be set by the resources using the recipe.
+func (s *ParameterSchema) Validate(values map[string]any) error {
+	definitions := map[string]ParameterDefinition{}
+	for _, definition := range s.Parameters {
+		definitions[definition.Name] = definition
+	}
+
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	msgs := []string{}
+	for _, name := range names {
+		definition, ok := definitions[name]
+		if !ok {
+			if s.Strict {
+				msgs = append(msgs, fmt.Sprintf("parameter %q is not declared by the recipe", name))
+			}
+			continue
+		}
+
+		if msg := definition.validate(values[name]); msg != "" {
+			msgs = append(msgs, fmt.Sprintf("parameter %q %s", name, msg))
+		}
+	}
+
+	if len(msgs) == 1 {
+		return fmt.Errorf("%s", msgs[0])
+	} else if len(msgs) > 1 {
+		return fmt.Errorf("multiple errors were found:\n\t%v", strings.Join(msgs, "\n\t"))
+	}
+
+	return nil
+}
+
+func (d ParameterDefinition) validate(value any) string {
+	if value == nil {
+		return ""
+	}
+
+	if !isParameterType(value, d.Type) {
+		return fmt.Sprintf("must be of type %s, got %T", d.Type, value)
+	}
+
+	if len(d.AllowedValues) > 0 {
+		allowed := false
+		for _, allowedValue := range d.AllowedValues {
+			if parameterValuesEqual(value, allowedValue) {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return fmt.Sprintf("must be one of %v, got %v", d.AllowedValues, value)
+		}
+	}
+
+	if number := toFloat(value); number != nil {
+		if d.MinValue != nil && *number < *d.MinValue {
+			return fmt.Sprintf("must be greater than or equal to %v, got %v", *d.MinValue, value)
+		}
+		if d.MaxValue != nil && *number > *d.MaxValue {
+			return fmt.Sprintf("must be less than or equal to %v, got %v", *d.MaxValue, value)
+		}
+	}
+
+	length := -1
+	switch v := value.(type) {
+	case string:
+		length = len(v)
+	case []any:
+		length = len(v)
+	}
+	if length >= 0 {
+		if d.MinLength != nil && float64(length) < *d.MinLength {
+			return fmt.Sprintf("must have a length greater than or equal to %v, got %d", *d.MinLength, length)
+		}
+		if d.MaxLength != nil && float64(length) > *d.MaxLength {
+			return fmt.Sprintf("must have a length less than or equal to %v, got %d", *d.MaxLength, length)
+		}
+	}
+
+	return ""
+}
+
+// normalizeParameterType maps the type of a parameter declared by a Bicep template, a Terraform module or the
+// values schema of a Helm chart to one of the ParameterType constants.
+func normalizeParameterType(templateKind string, declaredType string) string {
+	t := strings.ToLower(strings.TrimSpace(declaredType))
+	switch {
+	case t == "":
+		return ParameterTypeAny
+	case t == "string" || t == "securestring":
+		return ParameterTypeString
+	case t == "int" || t == "integer":
+		return ParameterTypeInteger
+	case t == "number":
+		return ParameterTypeNumber
+	case t == "bool" || t == "boolean":
+		return ParameterTypeBoolean
+	case t == "object" || t == "secureobject" || strings.HasPrefix(t, "map(") || strings.HasPrefix(t, "object("):
+		return ParameterTypeObject
+	case t == "array" || strings.HasPrefix(t, "list(") || strings.HasPrefix(t, "set(") || strings.HasPrefix(t, "tuple("):
+		return ParameterTypeArray
+	default:
+		return ParameterTypeAny
+	}
+}
+
+func isParameterType(value any, parameterType string) bool {
+	switch parameterType {
+	case ParameterTypeString:
+		_, ok := value.(string)
+		return ok
+	case ParameterTypeInteger:
+		number := toFloat(value)
+		return number != nil && *number == float64(int64(*number))
+	case ParameterTypeNumber:
+		return toFloat(value) != nil
+	case ParameterTypeBoolean:
+		_, ok := value.(bool)
+		return ok
+	case ParameterTypeObject:
+		_, ok := value.(map[string]any)
+		return ok
+	case ParameterTypeArray:
+		_, ok := value.([]any)
+		return ok
+	default:
+		return true
+	}
+}
+
+func parameterValuesEqual(value any, other any) bool {
+	a, b := toFloat(value), toFloat(other)
+	if a != nil && b != nil {
+		return *a == *b
+	}
+	return reflect.DeepEqual(value, other)
+}
+
+func toFloat(value any) *float64 {
+	var f float64
+	switch v := value.(type) {
+	case float64:
+		f = v
+	case float32:
+		f = float64(v)
+	case int:
+		f = float64(v)
+	case int32:
+		f = float64(v)
+	case int64:
+		f = float64(v)
+	default:
+		return nil
+	}
+	return &f
+}
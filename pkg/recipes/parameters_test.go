@@ -0,0 +1,164 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recipes
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewParameterSchema(t *testing.T) {
+	t.Run("bicep", func(t *testing.T) {
+		schema, err := NewParameterSchema(TemplateKindBicep, map[string]any{
+			"size": map[string]any{
+				"type":          "string",
+				"defaultValue":  "small",
+				"allowedValues": []any{"small", "large"},
+				"description":   "The size of the cache.",
+			},
+			"password": map[string]any{
+				"type": "securestring",
+			},
+			"replicas": map[string]any{
+				"type":     "int",
+				"minValue": float64(1),
+				"maxValue": float64(3),
+			},
+		})
+		require.NoError(t, err)
+		require.True(t, schema.Strict)
+		require.Equal(t, []ParameterDefinition{
+			{Name: "password", Type: ParameterTypeString, DeclaredType: "securestring", Required: true, Sensitive: true},
+			{Name: "replicas", Type: ParameterTypeInteger, DeclaredType: "int", Required: true, MinValue: to.Ptr(float64(1)), MaxValue: to.Ptr(float64(3))},
+			{Name: "size", Type: ParameterTypeString, DeclaredType: "string", DefaultValue: "small", AllowedValues: []any{"small", "large"}, Description: "The size of the cache."},
+		}, schema.Parameters)
+	})
+
+	t.Run("terraform", func(t *testing.T) {
+		schema, err := NewParameterSchema(TemplateKindTerraform, map[string]any{
+			"tags":  map[string]any{"type": "map(string)", "required": false, "defaultValue": map[string]any{}},
+			"zones": map[string]any{"type": "list(string)", "required": true, "sensitive": false},
+			"name":  map[string]any{"type": "", "required": true},
+		})
+		require.NoError(t, err)
+		require.True(t, schema.Strict)
+		require.Equal(t, []ParameterDefinition{
+			{Name: "name", Type: ParameterTypeAny, Required: true},
+			{Name: "tags", Type: ParameterTypeObject, DeclaredType: "map(string)", DefaultValue: map[string]any{}},
+			{Name: "zones", Type: ParameterTypeArray, DeclaredType: "list(string)", Required: true},
+		}, schema.Parameters)
+	})
+
+	t.Run("helm", func(t *testing.T) {
+		schema, err := NewParameterSchema(TemplateKindHelm, map[string]any{
+			"replicaCount": map[string]any{"type": "integer", "defaultValue": float64(1)},
+		})
+		require.NoError(t, err)
+		require.False(t, schema.Strict)
+		require.Equal(t, []ParameterDefinition{
+			{Name: "replicaCount", Type: ParameterTypeInteger, DeclaredType: "integer", DefaultValue: float64(1)},
+		}, schema.Parameters)
+	})
+
+	t.Run("malformed parameter", func(t *testing.T) {
+		_, err := NewParameterSchema(TemplateKindBicep, map[string]any{"size": "string"})
+		require.EqualError(t, err, `the details of parameter "size" are not in the expected format`)
+	})
+}
+
+func Test_ParameterSchema_Validate(t *testing.T) {
+	parameters := map[string]any{
+		"size":     map[string]any{"type": "string", "allowedValues": []any{"small", "large"}},
+		"name":     map[string]any{"type": "string", "minLength": float64(3), "maxLength": float64(5)},
+		"replicas": map[string]any{"type": "int", "minValue": float64(1), "maxValue": float64(3)},
+		"ratio":    map[string]any{"type": "number"},
+		"enabled":  map[string]any{"type": "bool"},
+		"tags":     map[string]any{"type": "object"},
+		"zones":    map[string]any{"type": "array"},
+		"extra":    map[string]any{},
+	}
+
+	tests := []struct {
+		name         string
+		templateKind string
+		values       map[string]any
+		expectedErr  string
+	}{
+		{
+			name:         "valid",
+			templateKind: TemplateKindBicep,
+			values: map[string]any{
+				"size":     "large",
+				"name":     "abc",
+				"replicas": float64(2),
+				"ratio":    0.5,
+				"enabled":  true,
+				"tags":     map[string]any{"env": "dev"},
+				"zones":    []any{"1"},
+				"extra":    []any{1, "2"},
+			},
+		},
+		{
+			name:         "undeclared parameter",
+			templateKind: TemplateKindTerraform,
+			values:       map[string]any{"unknown": "value"},
+			expectedErr:  `parameter "unknown" is not declared by the recipe`,
+		},
+		{
+			name:         "undeclared parameter of non strict schema",
+			templateKind: TemplateKindHelm,
+			values:       map[string]any{"unknown": "value"},
+		},
+		{
+			name:         "mistyped parameter",
+			templateKind: TemplateKindBicep,
+			values:       map[string]any{"replicas": 1.5},
+			expectedErr:  `parameter "replicas" must be of type integer, got float64`,
+		},
+		{
+			name:         "multiple errors",
+			templateKind: TemplateKindBicep,
+			values: map[string]any{
+				"size":     "medium",
+				"name":     "ab",
+				"replicas": 4,
+				"enabled":  "true",
+			},
+			expectedErr: "multiple errors were found:\n" +
+				"\tparameter \"enabled\" must be of type boolean, got string\n" +
+				"\tparameter \"name\" must have a length greater than or equal to 3, got 2\n" +
+				"\tparameter \"replicas\" must be less than or equal to 3, got 4\n" +
+				"\tparameter \"size\" must be one of [small large], got medium",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			schema, err := NewParameterSchema(tc.templateKind, parameters)
+			require.NoError(t, err)
+
+			err = schema.Validate(tc.values)
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
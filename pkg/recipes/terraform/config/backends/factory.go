@@ -0,0 +1,48 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"k8s.io/client-go/kubernetes"
+)
+
+// NewBackend creates the Terraform state backend configured for the environment. Kubernetes secret backend is used
+// if no backend is configured.
+func NewBackend(config datamodel.TerraformBackendConfig, k8sClientSet kubernetes.Interface) (Backend, error) {
+	switch config.Kind {
+	case "", BackendKubernetes:
+		return NewKubernetesBackend(k8sClientSet), nil
+	case BackendPostgres:
+		return NewPostgresBackend(os.Getenv(PostgresConnStrEnvVar)), nil
+	case BackendS3:
+		if config.S3 == nil || config.S3.Bucket == "" {
+			return nil, fmt.Errorf("bucket is required for the %q terraform backend", BackendS3)
+		}
+		return NewS3Backend(*config.S3, nil), nil
+	case BackendLocal:
+		if config.Local == nil || config.Local.Path == "" {
+			return nil, fmt.Errorf("path is required for the %q terraform backend", BackendLocal)
+		}
+		return NewLocalBackend(config.Local.Path), nil
+	default:
+		return nil, fmt.Errorf("unsupported terraform backend kind %q", config.Kind)
+	}
+}
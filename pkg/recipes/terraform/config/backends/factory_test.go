@@ -0,0 +1,94 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func Test_NewBackend(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   datamodel.TerraformBackendConfig
+		expected Backend
+		err      string
+	}{
+		{
+			name:     "default",
+			config:   datamodel.TerraformBackendConfig{},
+			expected: &kubernetesBackend{},
+		},
+		{
+			name:     "kubernetes",
+			config:   datamodel.TerraformBackendConfig{Kind: BackendKubernetes},
+			expected: &kubernetesBackend{},
+		},
+		{
+			name:     "postgres",
+			config:   datamodel.TerraformBackendConfig{Kind: BackendPostgres},
+			expected: &postgresBackend{},
+		},
+		{
+			name: "s3",
+			config: datamodel.TerraformBackendConfig{
+				Kind: BackendS3,
+				S3:   &datamodel.S3BackendConfig{Bucket: "tfstate"},
+			},
+			expected: &s3Backend{},
+		},
+		{
+			name:   "s3 without bucket",
+			config: datamodel.TerraformBackendConfig{Kind: BackendS3},
+			err:    "bucket is required for the \"s3\" terraform backend",
+		},
+		{
+			name: "local",
+			config: datamodel.TerraformBackendConfig{
+				Kind:  BackendLocal,
+				Local: &datamodel.LocalBackendConfig{Path: "/var/radius/tfstate"},
+			},
+			expected: &localBackend{},
+		},
+		{
+			name:   "local without path",
+			config: datamodel.TerraformBackendConfig{Kind: BackendLocal},
+			err:    "path is required for the \"local\" terraform backend",
+		},
+		{
+			name:   "unsupported",
+			config: datamodel.TerraformBackendConfig{Kind: "consul"},
+			err:    "unsupported terraform backend kind \"consul\"",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			backend, err := NewBackend(tt.config, fake.NewSimpleClientset())
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.IsType(t, tt.expected, backend)
+		})
+	}
+}
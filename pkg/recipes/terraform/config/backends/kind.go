@@ -0,0 +1,102 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"context"
+
+	"github.com/radius-project/radius/pkg/recipes"
+	corev1 "k8s.io/api/core/v1"
+	k8s_errors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// BackendKindNamePrefix is the prefix of the name of the ConfigMap that records the kind of the backend storing
+	// the Terraform state of a resource recipe.
+	BackendKindNamePrefix = "tfbackend-"
+
+	// backendKindKey is the key of the backend kind in the ConfigMap data.
+	backendKindKey = "kind"
+)
+
+// RecordedKind returns the kind of the backend storing the Terraform state of the resource recipe, or an empty string
+// if the recipe has no state. The state of recipes deployed before the kind was recorded is stored by the Kubernetes
+// backend, which is detected from its state secret.
+func RecordedKind(ctx context.Context, k8sClientSet kubernetes.Interface, resourceRecipe *recipes.ResourceMetadata) (string, error) {
+	suffix, err := generateSecretSuffix(resourceRecipe)
+	if err != nil {
+		return "", err
+	}
+
+	configMap, err := k8sClientSet.CoreV1().ConfigMaps(RadiusNamespace).Get(ctx, BackendKindNamePrefix+suffix, metav1.GetOptions{})
+	if err == nil {
+		return configMap.Data[backendKindKey], nil
+	} else if !k8s_errors.IsNotFound(err) {
+		return "", err
+	}
+
+	exists, err := NewKubernetesBackend(k8sClientSet).ValidateBackendExists(ctx, KubernetesBackendNamePrefix+suffix)
+	if err != nil {
+		return "", err
+	} else if exists {
+		return BackendKubernetes, nil
+	}
+
+	return "", nil
+}
+
+// RecordKind records the kind of the backend storing the Terraform state of the resource recipe.
+func RecordKind(ctx context.Context, k8sClientSet kubernetes.Interface, resourceRecipe *recipes.ResourceMetadata, kind string) error {
+	suffix, err := generateSecretSuffix(resourceRecipe)
+	if err != nil {
+		return err
+	}
+
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      BackendKindNamePrefix + suffix,
+			Namespace: RadiusNamespace,
+		},
+		Data: map[string]string{backendKindKey: kind},
+	}
+
+	configMaps := k8sClientSet.CoreV1().ConfigMaps(RadiusNamespace)
+	_, err = configMaps.Update(ctx, configMap, metav1.UpdateOptions{})
+	if k8s_errors.IsNotFound(err) {
+		_, err = configMaps.Create(ctx, configMap, metav1.CreateOptions{})
+	}
+
+	return err
+}
+
+// DeleteRecordedKind deletes the record of the backend kind of the resource recipe. It returns nil if no kind is
+// recorded.
+func DeleteRecordedKind(ctx context.Context, k8sClientSet kubernetes.Interface, resourceRecipe *recipes.ResourceMetadata) error {
+	suffix, err := generateSecretSuffix(resourceRecipe)
+	if err != nil {
+		return err
+	}
+
+	err = k8sClientSet.CoreV1().ConfigMaps(RadiusNamespace).Delete(ctx, BackendKindNamePrefix+suffix, metav1.DeleteOptions{})
+	if err != nil && !k8s_errors.IsNotFound(err) {
+		return err
+	}
+
+	return nil
+}
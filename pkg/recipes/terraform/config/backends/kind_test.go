@@ -0,0 +1,66 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func Test_RecordedKind(t *testing.T) {
+	ctx := testcontext.New(t)
+	_, resourceRecipe := getTestInputs()
+	suffix, err := generateSecretSuffix(&resourceRecipe)
+	require.NoError(t, err)
+
+	t.Run("no state", func(t *testing.T) {
+		kind, err := RecordedKind(ctx, fake.NewSimpleClientset(), &resourceRecipe)
+		require.NoError(t, err)
+		require.Equal(t, "", kind)
+	})
+
+	t.Run("state deployed before the kind was recorded", func(t *testing.T) {
+		secret := &v1.Secret{ObjectMeta: metav1.ObjectMeta{Name: KubernetesBackendNamePrefix + suffix, Namespace: RadiusNamespace}}
+		kind, err := RecordedKind(ctx, fake.NewSimpleClientset(secret), &resourceRecipe)
+		require.NoError(t, err)
+		require.Equal(t, BackendKubernetes, kind)
+	})
+
+	t.Run("record, update and delete", func(t *testing.T) {
+		k8sClientSet := fake.NewSimpleClientset()
+		require.NoError(t, RecordKind(ctx, k8sClientSet, &resourceRecipe, BackendKubernetes))
+		kind, err := RecordedKind(ctx, k8sClientSet, &resourceRecipe)
+		require.NoError(t, err)
+		require.Equal(t, BackendKubernetes, kind)
+
+		require.NoError(t, RecordKind(ctx, k8sClientSet, &resourceRecipe, BackendS3))
+		kind, err = RecordedKind(ctx, k8sClientSet, &resourceRecipe)
+		require.NoError(t, err)
+		require.Equal(t, BackendS3, kind)
+
+		require.NoError(t, DeleteRecordedKind(ctx, k8sClientSet, &resourceRecipe))
+		require.NoError(t, DeleteRecordedKind(ctx, k8sClientSet, &resourceRecipe))
+		kind, err = RecordedKind(ctx, k8sClientSet, &resourceRecipe)
+		require.NoError(t, err)
+		require.Equal(t, "", kind)
+	})
+}
@@ -70,6 +70,16 @@ func (p *kubernetesBackend) BuildBackend(resourceRecipe *recipes.ResourceMetadat
 	return generateKubernetesBackendConfig(secretSuffix)
 }
 
+// StateName returns the name of the Kubernetes secret that Terraform creates to store the state file of the resource recipe.
+func (p *kubernetesBackend) StateName(resourceRecipe *recipes.ResourceMetadata) (string, error) {
+	secretSuffix, err := generateSecretSuffix(resourceRecipe)
+	if err != nil {
+		return "", err
+	}
+
+	return KubernetesBackendNamePrefix + secretSuffix, nil
+}
+
 // ValidateBackendExists checks if the Kubernetes secret for Terraform state file exists.
 // name is the name of the backend Kubernetes secret resource that is created as a part of terraform apply
 // during recipe deployment.
@@ -89,6 +99,16 @@ func (p *kubernetesBackend) ValidateBackendExists(ctx context.Context, name stri
 	return true, nil
 }
 
+// DeleteBackend deletes the Kubernetes secret for Terraform state file. It returns nil if the secret does not exist.
+func (p *kubernetesBackend) DeleteBackend(ctx context.Context, name string) error {
+	err := p.k8sClientSet.CoreV1().Secrets(RadiusNamespace).Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil && !k8s_errors.IsNotFound(err) {
+		return err
+	}
+
+	return nil
+}
+
 // generateSecretSuffix returns a unique string from the resourceID, environmentID, and applicationID
 // which is used as key for kubernetes secret in defining terraform backend.
 func generateSecretSuffix(resourceRecipe *recipes.ResourceMetadata) (string, error) {
@@ -118,7 +138,7 @@ func generateSecretSuffix(resourceRecipe *recipes.ResourceMetadata) (string, err
 }
 
 // generateKubernetesBackendConfig returns Terraform backend configuration to store Terraform state file for the deployment.
-// Currently, the supported backend for Terraform Recipes is Kubernetes secret. https://developer.hashicorp.com/terraform/language/settings/backends/kubernetes
+// https://developer.hashicorp.com/terraform/language/settings/backends/kubernetes
 func generateKubernetesBackendConfig(secretSuffix string) (map[string]interface{}, error) {
 	backend := map[string]interface{}{
 		BackendKubernetes: map[string]interface{}{
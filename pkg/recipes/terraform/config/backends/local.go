@@ -0,0 +1,94 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/radius-project/radius/pkg/recipes"
+)
+
+const (
+	BackendLocal = "local"
+
+	// localStateFileExtension is the extension of the Terraform state files stored by the local backend.
+	localStateFileExtension = ".tfstate"
+)
+
+var _ Backend = (*localBackend)(nil)
+
+type localBackend struct {
+	dir string
+}
+
+// NewLocalBackend creates a Terraform backend that stores the state file in the given directory. The directory should be
+// on a persistent volume, such as a Kubernetes PersistentVolumeClaim, that is shared by all the replicas running recipes.
+func NewLocalBackend(dir string) Backend {
+	return &localBackend{dir: dir}
+}
+
+// BuildBackend generates the Terraform backend configuration for local backend.
+// https://developer.hashicorp.com/terraform/language/settings/backends/local
+func (p *localBackend) BuildBackend(resourceRecipe *recipes.ResourceMetadata) (map[string]any, error) {
+	path, err := p.StateName(resourceRecipe)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		BackendLocal: map[string]any{
+			"path": path,
+		},
+	}, nil
+}
+
+// StateName returns the path of the state file of the resource recipe.
+func (p *localBackend) StateName(resourceRecipe *recipes.ResourceMetadata) (string, error) {
+	suffix, err := generateSecretSuffix(resourceRecipe)
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(p.dir, suffix+localStateFileExtension), nil
+}
+
+// ValidateBackendExists checks if the state file exists at the given path.
+func (p *localBackend) ValidateBackendExists(ctx context.Context, name string) (bool, error) {
+	_, err := os.Stat(name)
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// DeleteBackend deletes the state file at the given path and the backup file created by Terraform.
+func (p *localBackend) DeleteBackend(ctx context.Context, name string) error {
+	for _, path := range []string{name, name + ".backup"} {
+		if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+	}
+
+	return nil
+}
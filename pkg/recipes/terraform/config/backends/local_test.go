@@ -0,0 +1,72 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_LocalBackend_BuildBackend(t *testing.T) {
+	_, resourceRecipe := getTestInputs()
+	suffix, err := generateSecretSuffix(&resourceRecipe)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	config, err := NewLocalBackend(dir).BuildBackend(&resourceRecipe)
+	require.NoError(t, err)
+
+	expected := map[string]any{
+		BackendLocal: map[string]any{
+			"path": filepath.Join(dir, suffix+".tfstate"),
+		},
+	}
+	require.Equal(t, expected, config)
+}
+
+func Test_LocalBackend_ValidateAndDeleteBackend(t *testing.T) {
+	ctx := context.Background()
+	_, resourceRecipe := getTestInputs()
+
+	b := NewLocalBackend(t.TempDir())
+	name, err := b.StateName(&resourceRecipe)
+	require.NoError(t, err)
+
+	exists, err := b.ValidateBackendExists(ctx, name)
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	require.NoError(t, os.WriteFile(name, []byte("{}"), 0600))
+	require.NoError(t, os.WriteFile(name+".backup", []byte("{}"), 0600))
+
+	exists, err = b.ValidateBackendExists(ctx, name)
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	err = b.DeleteBackend(ctx, name)
+	require.NoError(t, err)
+	require.NoFileExists(t, name)
+	require.NoFileExists(t, name+".backup")
+
+	// Deleting a state file that does not exist is not an error.
+	err = b.DeleteBackend(ctx, name)
+	require.NoError(t, err)
+}
@@ -50,6 +50,35 @@ func (mr *MockBackendMockRecorder) BuildBackend(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildBackend", reflect.TypeOf((*MockBackend)(nil).BuildBackend), arg0)
 }
 
+// DeleteBackend mocks base method.
+func (m *MockBackend) DeleteBackend(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBackend", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBackend indicates an expected call of DeleteBackend.
+func (mr *MockBackendMockRecorder) DeleteBackend(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBackend", reflect.TypeOf((*MockBackend)(nil).DeleteBackend), arg0, arg1)
+}
+
+// StateName mocks base method.
+func (m *MockBackend) StateName(arg0 *recipes.ResourceMetadata) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StateName", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StateName indicates an expected call of StateName.
+func (mr *MockBackendMockRecorder) StateName(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StateName", reflect.TypeOf((*MockBackend)(nil).StateName), arg0)
+}
+
 // ValidateBackendExists mocks base method.
 func (m *MockBackend) ValidateBackendExists(arg0 context.Context, arg1 string) (bool, error) {
 	m.ctrl.T.Helper()
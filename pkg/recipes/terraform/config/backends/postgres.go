@@ -0,0 +1,130 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/lib/pq"
+	"github.com/radius-project/radius/pkg/recipes"
+)
+
+const (
+	BackendPostgres = "pg"
+
+	// PostgresConnStrEnvVar is the environment variable that holds the Postgres connection string. Terraform reads the
+	// connection string of the pg backend from the same environment variable, so it's never written to the Terraform config.
+	// https://developer.hashicorp.com/terraform/language/settings/backends/pg
+	PostgresConnStrEnvVar = "PG_CONN_STR"
+
+	// PostgresSchemaPrefix is the prefix of the Postgres schema that Terraform creates to store the state of a recipe.
+	// Each recipe gets its own schema since the default Terraform workspace is used for recipes deployment.
+	PostgresSchemaPrefix = "tfstate_"
+
+	// defaultWorkspace is the name of the Terraform workspace used for recipes deployment. The pg backend stores the state
+	// of each workspace as a row in the "states" table of the schema.
+	defaultWorkspace = "default"
+)
+
+var _ Backend = (*postgresBackend)(nil)
+
+type postgresBackend struct {
+	connStr string
+}
+
+// NewPostgresBackend creates a Terraform backend that stores the state file in the Postgres database of the given
+// connection string.
+func NewPostgresBackend(connStr string) Backend {
+	return &postgresBackend{connStr: connStr}
+}
+
+// BuildBackend generates the Terraform backend configuration for Postgres backend.
+// https://developer.hashicorp.com/terraform/language/settings/backends/pg
+func (p *postgresBackend) BuildBackend(resourceRecipe *recipes.ResourceMetadata) (map[string]any, error) {
+	if p.connStr == "" {
+		return nil, fmt.Errorf("environment variable %s must be set to use the %q terraform backend", PostgresConnStrEnvVar, BackendPostgres)
+	}
+
+	schemaName, err := p.StateName(resourceRecipe)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		BackendPostgres: map[string]any{
+			"schema_name": schemaName,
+		},
+	}, nil
+}
+
+// StateName returns the name of the Postgres schema that stores the state file of the resource recipe.
+func (p *postgresBackend) StateName(resourceRecipe *recipes.ResourceMetadata) (string, error) {
+	suffix, err := generateSecretSuffix(resourceRecipe)
+	if err != nil {
+		return "", err
+	}
+
+	return PostgresSchemaPrefix + suffix, nil
+}
+
+// ValidateBackendExists checks if the state of the default workspace exists in the given Postgres schema.
+func (p *postgresBackend) ValidateBackendExists(ctx context.Context, name string) (bool, error) {
+	db, err := p.open()
+	if err != nil {
+		return false, err
+	}
+	defer db.Close()
+
+	var exists bool
+	err = db.QueryRowContext(ctx, "SELECT EXISTS (SELECT 1 FROM information_schema.tables WHERE table_schema = $1 AND table_name = 'states')", name).Scan(&exists)
+	if err != nil {
+		return false, err
+	} else if !exists {
+		return false, nil
+	}
+
+	query := fmt.Sprintf("SELECT EXISTS (SELECT 1 FROM %s.states WHERE name = $1)", pq.QuoteIdentifier(name))
+	err = db.QueryRowContext(ctx, query, defaultWorkspace).Scan(&exists)
+	if err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+// DeleteBackend drops the Postgres schema that stores the state file.
+func (p *postgresBackend) DeleteBackend(ctx context.Context, name string) error {
+	db, err := p.open()
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	_, err = db.ExecContext(ctx, fmt.Sprintf("DROP SCHEMA IF EXISTS %s CASCADE", pq.QuoteIdentifier(name)))
+	return err
+}
+
+func (p *postgresBackend) open() (*sql.DB, error) {
+	if p.connStr == "" {
+		return nil, errors.New("postgres connection string is empty")
+	}
+
+	return sql.Open("postgres", p.connStr)
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_PostgresBackend_BuildBackend(t *testing.T) {
+	_, resourceRecipe := getTestInputs()
+	suffix, err := generateSecretSuffix(&resourceRecipe)
+	require.NoError(t, err)
+
+	config, err := NewPostgresBackend("postgres://localhost/radius").BuildBackend(&resourceRecipe)
+	require.NoError(t, err)
+
+	// The connection string must not be written to the Terraform configuration.
+	expected := map[string]any{
+		BackendPostgres: map[string]any{
+			"schema_name": PostgresSchemaPrefix + suffix,
+		},
+	}
+	require.Equal(t, expected, config)
+}
+
+func Test_PostgresBackend_BuildBackend_MissingConnStr(t *testing.T) {
+	_, resourceRecipe := getTestInputs()
+
+	_, err := NewPostgresBackend("").BuildBackend(&resourceRecipe)
+	require.EqualError(t, err, "environment variable PG_CONN_STR must be set to use the \"pg\" terraform backend")
+}
+
+// Test_PostgresBackend_ValidateAndDeleteBackend runs against the Postgres database configured with PG_CONN_STR.
+func Test_PostgresBackend_ValidateAndDeleteBackend(t *testing.T) {
+	connStr := os.Getenv(PostgresConnStrEnvVar)
+	if connStr == "" {
+		t.Skipf("%s is not set", PostgresConnStrEnvVar)
+	}
+
+	ctx := context.Background()
+	_, resourceRecipe := getTestInputs()
+
+	b := NewPostgresBackend(connStr).(*postgresBackend)
+	name, err := b.StateName(&resourceRecipe)
+	require.NoError(t, err)
+
+	exists, err := b.ValidateBackendExists(ctx, name)
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	// Create the schema the same way as the Terraform pg backend does.
+	db, err := b.open()
+	require.NoError(t, err)
+	defer db.Close()
+	_, err = db.ExecContext(ctx, "CREATE SCHEMA "+name)
+	require.NoError(t, err)
+	_, err = db.ExecContext(ctx, "CREATE TABLE "+name+".states (id bigserial primary key, name text unique, data text)")
+	require.NoError(t, err)
+	_, err = db.ExecContext(ctx, "INSERT INTO "+name+".states (name, data) VALUES ('default', '{}')")
+	require.NoError(t, err)
+
+	exists, err = b.ValidateBackendExists(ctx, name)
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	err = b.DeleteBackend(ctx, name)
+	require.NoError(t, err)
+
+	exists, err = b.ValidateBackendExists(ctx, name)
+	require.NoError(t, err)
+	require.False(t, exists)
+}
@@ -0,0 +1,206 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+)
+
+const (
+	BackendS3 = "s3"
+
+	// defaultS3Region is the region used when the backend configuration does not specify one.
+	defaultS3Region = "us-east-1"
+
+	// s3StateFileExtension is the extension of the Terraform state objects stored by the S3 backend.
+	s3StateFileExtension = ".tfstate"
+)
+
+// emptyPayloadHash is the SHA-256 hash of an empty request body, used to sign the S3 requests.
+var emptyPayloadHash = func() string {
+	hash := sha256.Sum256(nil)
+	return hex.EncodeToString(hash[:])
+}()
+
+var _ Backend = (*s3Backend)(nil)
+
+type s3Backend struct {
+	config      datamodel.S3BackendConfig
+	credentials aws.CredentialsProvider
+	httpClient  *http.Client
+}
+
+// NewS3Backend creates a Terraform backend that stores the state file in an S3-compatible bucket, such as AWS S3 or MinIO.
+// If credentials is nil, the credentials are loaded from the default AWS credential chain, for example the AWS_ACCESS_KEY_ID
+// and AWS_SECRET_ACCESS_KEY environment variables. Terraform reads the credentials from the same environment variables.
+func NewS3Backend(config datamodel.S3BackendConfig, credentials aws.CredentialsProvider) Backend {
+	if config.Region == "" {
+		config.Region = defaultS3Region
+	}
+
+	return &s3Backend{config: config, credentials: credentials, httpClient: http.DefaultClient}
+}
+
+// BuildBackend generates the Terraform backend configuration for S3 backend.
+// https://developer.hashicorp.com/terraform/language/settings/backends/s3
+func (p *s3Backend) BuildBackend(resourceRecipe *recipes.ResourceMetadata) (map[string]any, error) {
+	key, err := p.StateName(resourceRecipe)
+	if err != nil {
+		return nil, err
+	}
+
+	backend := map[string]any{
+		"bucket": p.config.Bucket,
+		"key":    key,
+		"region": p.config.Region,
+	}
+
+	// S3-compatible services don't support the AWS specific validations done by Terraform.
+	if p.config.Endpoint != "" {
+		backend["endpoints"] = map[string]any{
+			"s3": p.config.Endpoint,
+		}
+		backend["skip_credentials_validation"] = true
+		backend["skip_region_validation"] = true
+		backend["skip_requesting_account_id"] = true
+	}
+
+	if p.config.UsePathStyle {
+		backend["use_path_style"] = true
+	}
+
+	return map[string]any{
+		BackendS3: backend,
+	}, nil
+}
+
+// StateName returns the key of the object that stores the state file of the resource recipe.
+func (p *s3Backend) StateName(resourceRecipe *recipes.ResourceMetadata) (string, error) {
+	suffix, err := generateSecretSuffix(resourceRecipe)
+	if err != nil {
+		return "", err
+	}
+
+	return path.Join(p.config.KeyPrefix, suffix+s3StateFileExtension), nil
+}
+
+// ValidateBackendExists checks if the object with the given key exists in the bucket.
+func (p *s3Backend) ValidateBackendExists(ctx context.Context, name string) (bool, error) {
+	resp, err := p.do(ctx, http.MethodHead, name)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return false, nil
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return true, nil
+	default:
+		return false, fmt.Errorf("failed to get terraform state object %q from bucket %q: %s", name, p.config.Bucket, resp.Status)
+	}
+}
+
+// DeleteBackend deletes the object with the given key from the bucket.
+func (p *s3Backend) DeleteBackend(ctx context.Context, name string) error {
+	resp, err := p.do(ctx, http.MethodDelete, name)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNotFound && (resp.StatusCode < 200 || resp.StatusCode >= 300) {
+		return fmt.Errorf("failed to delete terraform state object %q from bucket %q: %s", name, p.config.Bucket, resp.Status)
+	}
+
+	return nil
+}
+
+// do sends a signed request without a body for the object with the given key.
+func (p *s3Backend) do(ctx context.Context, method string, key string) (*http.Response, error) {
+	objectURL, err := p.objectURL(key)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, objectURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.credentials == nil {
+		cfg, err := awsconfig.LoadDefaultConfig(ctx, awsconfig.WithRegion(p.config.Region))
+		if err != nil {
+			return nil, err
+		}
+		p.credentials = cfg.Credentials
+	}
+
+	creds, err := p.credentials.Retrieve(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve credentials for the %q terraform backend: %w", BackendS3, err)
+	}
+
+	req.Header.Set("X-Amz-Content-Sha256", emptyPayloadHash)
+	signer := v4.NewSigner(func(o *v4.SignerOptions) {
+		// S3 expects the object key to be escaped only once.
+		o.DisableURIPathEscaping = true
+	})
+	if err := signer.SignHTTP(ctx, creds, req, emptyPayloadHash, "s3", p.config.Region, time.Now()); err != nil {
+		return nil, err
+	}
+
+	return p.httpClient.Do(req)
+}
+
+// objectURL returns the URL of the object with the given key, using path-style or virtual-hosted-style addressing.
+func (p *s3Backend) objectURL(key string) (*url.URL, error) {
+	endpoint := p.config.Endpoint
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://s3.%s.amazonaws.com", p.config.Region)
+	}
+
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid endpoint %q for the %q terraform backend: %w", endpoint, BackendS3, err)
+	}
+
+	key = strings.TrimPrefix(key, "/")
+	if p.config.UsePathStyle {
+		u.Path = path.Join("/", u.Path, p.config.Bucket, key)
+	} else {
+		u.Host = p.config.Bucket + "." + u.Host
+		u.Path = path.Join("/", u.Path, key)
+	}
+
+	return u, nil
+}
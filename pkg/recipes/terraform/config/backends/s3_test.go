@@ -0,0 +1,135 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_S3Backend_BuildBackend(t *testing.T) {
+	_, resourceRecipe := getTestInputs()
+	suffix, err := generateSecretSuffix(&resourceRecipe)
+	require.NoError(t, err)
+
+	t.Run("aws", func(t *testing.T) {
+		config, err := NewS3Backend(datamodel.S3BackendConfig{Bucket: "tfstate"}, nil).BuildBackend(&resourceRecipe)
+		require.NoError(t, err)
+
+		expected := map[string]any{
+			BackendS3: map[string]any{
+				"bucket": "tfstate",
+				"key":    suffix + ".tfstate",
+				"region": defaultS3Region,
+			},
+		}
+		require.Equal(t, expected, config)
+	})
+
+	t.Run("minio", func(t *testing.T) {
+		s3Config := datamodel.S3BackendConfig{
+			Bucket:       "tfstate",
+			Region:       "local",
+			Endpoint:     "http://minio:9000",
+			KeyPrefix:    "radius",
+			UsePathStyle: true,
+		}
+		config, err := NewS3Backend(s3Config, nil).BuildBackend(&resourceRecipe)
+		require.NoError(t, err)
+
+		expected := map[string]any{
+			BackendS3: map[string]any{
+				"bucket": "tfstate",
+				"key":    "radius/" + suffix + ".tfstate",
+				"region": "local",
+				"endpoints": map[string]any{
+					"s3": "http://minio:9000",
+				},
+				"skip_credentials_validation": true,
+				"skip_region_validation":      true,
+				"skip_requesting_account_id":  true,
+				"use_path_style":              true,
+			},
+		}
+		require.Equal(t, expected, config)
+	})
+}
+
+func Test_S3Backend_ObjectURL(t *testing.T) {
+	pathStyle := &s3Backend{config: datamodel.S3BackendConfig{Bucket: "tfstate", Endpoint: "http://minio:9000", UsePathStyle: true}}
+	u, err := pathStyle.objectURL("radius/state.tfstate")
+	require.NoError(t, err)
+	require.Equal(t, "http://minio:9000/tfstate/radius/state.tfstate", u.String())
+
+	virtualHosted := &s3Backend{config: datamodel.S3BackendConfig{Bucket: "tfstate", Region: "us-west-2"}}
+	u, err = virtualHosted.objectURL("state.tfstate")
+	require.NoError(t, err)
+	require.Equal(t, "https://tfstate.s3.us-west-2.amazonaws.com/state.tfstate", u.String())
+}
+
+func Test_S3Backend_ValidateAndDeleteBackend(t *testing.T) {
+	objects := map[string]bool{"/tfstate/radius/state.tfstate": true}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=access-key/") {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		switch {
+		case r.URL.Path == "/tfstate/radius/error.tfstate":
+			w.WriteHeader(http.StatusInternalServerError)
+		case r.Method == http.MethodHead && objects[r.URL.Path]:
+			w.WriteHeader(http.StatusOK)
+		case r.Method == http.MethodHead:
+			w.WriteHeader(http.StatusNotFound)
+		case r.Method == http.MethodDelete:
+			delete(objects, r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	s3Config := datamodel.S3BackendConfig{Bucket: "tfstate", Endpoint: server.URL, UsePathStyle: true}
+	b := NewS3Backend(s3Config, credentials.NewStaticCredentialsProvider("access-key", "secret-key", ""))
+
+	exists, err := b.ValidateBackendExists(ctx, "radius/state.tfstate")
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	err = b.DeleteBackend(ctx, "radius/state.tfstate")
+	require.NoError(t, err)
+
+	exists, err = b.ValidateBackendExists(ctx, "radius/state.tfstate")
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	_, err = b.ValidateBackendExists(ctx, "radius/error.tfstate")
+	require.ErrorContains(t, err, "failed to get terraform state object \"radius/error.tfstate\" from bucket \"tfstate\"")
+
+	err = b.DeleteBackend(ctx, "radius/error.tfstate")
+	require.ErrorContains(t, err, "failed to delete terraform state object \"radius/error.tfstate\" from bucket \"tfstate\"")
+}
@@ -31,8 +31,16 @@ type Backend interface {
 	// Returns an error if the backend configuration cannot be generated.
 	BuildBackend(resourceRecipe *recipes.ResourceMetadata) (map[string]any, error)
 
+	// StateName returns the name of the Terraform state file backend source for the given resource recipe.
+	// For example, for Kubernetes backend, it returns the name of the Kubernetes secret that stores the Terraform state file.
+	StateName(resourceRecipe *recipes.ResourceMetadata) (string, error)
+
 	// ValidateBackendExists checks if the Terraform state file backend source exists.
 	// For example, for Kubernetes backend, it checks if the Kubernetes secret for Terraform state file exists.
 	// returns true if backend is found, false otherwise.
 	ValidateBackendExists(ctx context.Context, name string) (bool, error)
+
+	// DeleteBackend deletes the Terraform state file backend source with the given name.
+	// Returns nil if the backend source does not exist.
+	DeleteBackend(ctx context.Context, name string) error
 }
@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -39,7 +40,6 @@ import (
 	ucp_provider "github.com/radius-project/radius/pkg/ucp/secret/provider"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 	"go.opentelemetry.io/otel/attribute"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 )
 
@@ -50,9 +50,10 @@ var (
 
 var _ TerraformExecutor = (*executor)(nil)
 
-// NewExecutor creates a new Executor with the given UCP connection and secret provider, to execute a Terraform recipe.
-func NewExecutor(ucpConn sdk.Connection, secretProvider *ucp_provider.SecretProvider, k8sClientSet kubernetes.Interface) *executor {
-	return &executor{ucpConn: ucpConn, secretProvider: secretProvider, k8sClientSet: k8sClientSet}
+// NewExecutor creates a new Executor with the given UCP connection, secret provider and Terraform installation options,
+// to execute a Terraform recipe.
+func NewExecutor(ucpConn sdk.Connection, secretProvider *ucp_provider.SecretProvider, k8sClientSet kubernetes.Interface, installOptions InstallOptions) *executor {
+	return &executor{ucpConn: ucpConn, secretProvider: secretProvider, k8sClientSet: k8sClientSet, installOptions: installOptions}
 }
 
 type executor struct {
@@ -64,6 +65,9 @@ type executor struct {
 
 	// k8sClientSet is the Kubernetes client.
 	k8sClientSet kubernetes.Interface
+
+	// installOptions are the default options to install Terraform.
+	installOptions InstallOptions
 }
 
 // Deploy installs Terraform, creates a working directory, generates a config, and runs Terraform init and
@@ -73,7 +77,7 @@ func (e *executor) Deploy(ctx context.Context, options Options) (*tfjson.State,
 
 	// Install Terraform
 	i := install.NewInstaller()
-	tf, err := Install(ctx, i, options.RootDir)
+	tf, err := Install(ctx, i, options.RootDir, e.getInstallOptions(options))
 	// The terraform zip for installation is downloaded in a location outside of the install directory and is only accessible through the installer.Remove function -
 	// stored in latestVersion.pathsToRemove. So this needs to be called for complete cleanup even if the root terraform directory is deleted.
 	defer func() {
@@ -85,8 +89,17 @@ func (e *executor) Deploy(ctx context.Context, options Options) (*tfjson.State,
 		return nil, err
 	}
 
+	backend, err := e.getBackend(options)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := e.validateBackendKind(ctx, options); err != nil {
+		return nil, err
+	}
+
 	// Create Terraform config in the working directory
-	kubernetesBackendSuffix, err := e.generateConfig(ctx, tf, options)
+	stateName, err := e.generateConfig(ctx, tf, options, backend)
 	if err != nil {
 		return nil, err
 	}
@@ -106,17 +119,68 @@ func (e *executor) Deploy(ctx context.Context, options Options) (*tfjson.State,
 	}
 
 	// Validate that the terraform state file backend source exists.
-	// Currently only Kubernetes secret backend is supported, which is created by Terraform as a part of Terraform apply.
-	backendExists, err := backends.NewKubernetesBackend(e.k8sClientSet).ValidateBackendExists(ctx, backends.KubernetesBackendNamePrefix+kubernetesBackendSuffix)
+	// The backend source, for example the Kubernetes secret, is created by Terraform as a part of Terraform apply.
+	backendExists, err := backend.ValidateBackendExists(ctx, stateName)
 	if err != nil {
-		return nil, fmt.Errorf("error retrieving kubernetes secret for terraform state: %w", err)
+		return nil, fmt.Errorf("error retrieving terraform state from %q backend: %w", e.backendKind(options), err)
 	} else if !backendExists {
-		return nil, errors.New("expected kubernetes secret for terraform state is not found")
+		return nil, fmt.Errorf("expected terraform state is not found in %q backend", e.backendKind(options))
+	}
+
+	err = backends.RecordKind(ctx, e.k8sClientSet, options.ResourceRecipe, e.backendKind(options))
+	if err != nil {
+		return nil, fmt.Errorf("error recording the terraform backend of the recipe: %w", err)
 	}
 
 	return state, nil
 }
 
+// Plan installs Terraform, creates a working directory, generates a config, and runs Terraform init and
+// plan in the working directory, returning the planned changes or an error if any of these steps fail.
+func (e *executor) Plan(ctx context.Context, options Options) (*tfjson.Plan, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	// Install Terraform
+	i := install.NewInstaller()
+	tf, err := Install(ctx, i, options.RootDir, e.getInstallOptions(options))
+	// The terraform zip for installation is downloaded in a location outside of the install directory and is only accessible through the installer.Remove function -
+	// stored in latestVersion.pathsToRemove. So this needs to be called for complete cleanup even if the root terraform directory is deleted.
+	defer func() {
+		if err := i.Remove(ctx); err != nil {
+			logger.Info(fmt.Sprintf("Failed to cleanup Terraform installation: %s", err.Error()))
+		}
+	}()
+	if err != nil {
+		return nil, err
+	}
+
+	backend, err := e.getBackend(options)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := e.validateBackendKind(ctx, options); err != nil {
+		return nil, err
+	}
+
+	// Create Terraform config in the working directory
+	_, err = e.generateConfig(ctx, tf, options, backend)
+	if err != nil {
+		return nil, err
+	}
+
+	if options.EnvConfig != nil {
+		// Set environment variables for the Terraform process.
+		err = e.setEnvironmentVariables(ctx, tf, &options.EnvConfig.RecipeConfig)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Run TF Init and Plan in the working directory
+	return initAndPlan(ctx, tf)
+}
+
 // Delete installs Terraform, creates a working directory, generates a config, and runs Terraform destroy
 // in the working directory, returning an error if any of these steps fail.
 func (e *executor) Delete(ctx context.Context, options Options) error {
@@ -124,7 +188,7 @@ func (e *executor) Delete(ctx context.Context, options Options) error {
 
 	// Install Terraform
 	i := install.NewInstaller()
-	tf, err := Install(ctx, i, options.RootDir)
+	tf, err := Install(ctx, i, options.RootDir, e.getInstallOptions(options))
 	// The terraform zip for installation is downloaded in a location outside of the install directory and is only accessible through the installer.Remove function -
 	// stored in latestVersion.pathsToRemove. So this needs to be called for complete cleanup even if the root terraform directory is deleted.
 	defer func() {
@@ -136,8 +200,17 @@ func (e *executor) Delete(ctx context.Context, options Options) error {
 		return err
 	}
 
+	backend, err := e.getBackend(options)
+	if err != nil {
+		return err
+	}
+
+	if err := e.validateBackendKind(ctx, options); err != nil {
+		return err
+	}
+
 	// Create Terraform config in the working directory
-	kubernetesBackendSuffix, err := e.generateConfig(ctx, tf, options)
+	stateName, err := e.generateConfig(ctx, tf, options, backend)
 	if err != nil {
 		return err
 	}
@@ -145,7 +218,7 @@ func (e *executor) Delete(ctx context.Context, options Options) error {
 	// Before running terraform init and destroy, ensure that the Terraform state file storage source exists.
 	// If the state file source has been deleted or wasn't created due to a failure during apply then
 	// terraform initialization will fail due to missing backend source.
-	backendExists, err := backends.NewKubernetesBackend(e.k8sClientSet).ValidateBackendExists(ctx, backends.KubernetesBackendNamePrefix+kubernetesBackendSuffix)
+	backendExists, err := backend.ValidateBackendExists(ctx, stateName)
 	if err != nil {
 		// Continue with the delete flow for all errors other than backend not found.
 		// If it is an intermittent error then the delete flow will fail and should be retried from the client.
@@ -153,7 +226,7 @@ func (e *executor) Delete(ctx context.Context, options Options) error {
 	} else if !backendExists {
 		// Skip deletion if the backend does not exist. Delete can't be performed without Terraform state file.
 		logger.Info("Skipping deletion of recipe resources: Terraform state file backend does not exist.")
-		return nil
+		return backends.DeleteRecordedKind(ctx, e.k8sClientSet, options.ResourceRecipe)
 	}
 
 	// Run TF Destroy in the working directory to delete the resources deployed by the recipe
@@ -162,15 +235,13 @@ func (e *executor) Delete(ctx context.Context, options Options) error {
 		return err
 	}
 
-	// Delete the kubernetes secret created for terraform state file.
-	err = e.k8sClientSet.CoreV1().
-		Secrets(backends.RadiusNamespace).
-		Delete(ctx, backends.KubernetesBackendNamePrefix+kubernetesBackendSuffix, metav1.DeleteOptions{})
+	// Delete the terraform state file from the backend, for example the kubernetes secret.
+	err = backend.DeleteBackend(ctx, stateName)
 	if err != nil {
-		return fmt.Errorf("error deleting kubernetes secret for terraform state: %w", err)
+		return fmt.Errorf("error deleting terraform state from %q backend: %w", e.backendKind(options), err)
 	}
 
-	return nil
+	return backends.DeleteRecordedKind(ctx, e.k8sClientSet, options.ResourceRecipe)
 }
 
 func (e *executor) GetRecipeMetadata(ctx context.Context, options Options) (map[string]any, error) {
@@ -178,7 +249,7 @@ func (e *executor) GetRecipeMetadata(ctx context.Context, options Options) (map[
 
 	// Install Terraform
 	i := install.NewInstaller()
-	tf, err := Install(ctx, i, options.RootDir)
+	tf, err := Install(ctx, i, options.RootDir, e.getInstallOptions(options))
 	// The terraform zip for installation is downloaded in a location outside of the install directory and is only accessible through the installer.Remove function -
 	// stored in latestVersion.pathsToRemove. So this needs to be called for complete cleanup even if the root terraform directory is deleted.
 	defer func() {
@@ -205,6 +276,53 @@ func (e *executor) GetRecipeMetadata(ctx context.Context, options Options) (map[
 	}, nil
 }
 
+// getInstallOptions returns the options to install Terraform for the recipe. The Terraform version pinned by the
+// environment overrides the default version.
+func (e *executor) getInstallOptions(options Options) InstallOptions {
+	installOptions := e.installOptions
+	if options.EnvConfig != nil && options.EnvConfig.RecipeConfig.Terraform.Version != "" {
+		installOptions.Version = options.EnvConfig.RecipeConfig.Terraform.Version
+	}
+
+	return installOptions
+}
+
+// getBackend returns the Terraform state backend configured for the environment of the recipe.
+func (e *executor) getBackend(options Options) (backends.Backend, error) {
+	config := datamodel.TerraformBackendConfig{}
+	if options.EnvConfig != nil {
+		config = options.EnvConfig.RecipeConfig.Terraform.Backend
+	}
+
+	return backends.NewBackend(config, e.k8sClientSet)
+}
+
+// backendKind returns the kind of the Terraform state backend configured for the environment of the recipe.
+func (e *executor) backendKind(options Options) string {
+	if options.EnvConfig != nil && options.EnvConfig.RecipeConfig.Terraform.Backend.Kind != "" {
+		return options.EnvConfig.RecipeConfig.Terraform.Backend.Kind
+	}
+
+	return backends.BackendKubernetes
+}
+
+// validateBackendKind returns an error if the Terraform state of the recipe is stored by a backend of another kind
+// than the backend configured for the environment. Terraform would not find the existing state in the new backend:
+// apply would deploy the resources of the recipe again, and destroy would leave them behind.
+func (e *executor) validateBackendKind(ctx context.Context, options Options) error {
+	recorded, err := backends.RecordedKind(ctx, e.k8sClientSet, options.ResourceRecipe)
+	if err != nil {
+		return fmt.Errorf("error retrieving the terraform backend of the recipe: %w", err)
+	}
+
+	kind := e.backendKind(options)
+	if recorded != "" && recorded != kind {
+		return fmt.Errorf("the terraform state of the recipe is stored in the %q backend, but the environment uses the %q backend: migrate the state or delete the resource before changing the terraform backend of the environment", recorded, kind)
+	}
+
+	return nil
+}
+
 // setEnvironmentVariables sets environment variables for the Terraform process by reading values from the recipe configuration.
 // Terraform process will use environment variables as input for the recipe deployment.
 func (e executor) setEnvironmentVariables(ctx context.Context, tf *tfexec.Terraform, recipeConfig *datamodel.RecipeConfigProperties) error {
@@ -238,7 +356,8 @@ func splitEnvVar(envVars []string) map[string]string {
 }
 
 // generateConfig generates Terraform configuration with required inputs for the module, providers and backend to be initialized and applied.
-func (e *executor) generateConfig(ctx context.Context, tf *tfexec.Terraform, options Options) (string, error) {
+// It returns the name of the Terraform state file backend source, for example the name of the Kubernetes secret.
+func (e *executor) generateConfig(ctx context.Context, tf *tfexec.Terraform, options Options, backend backends.Backend) (string, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 	workingDir := tf.WorkingDir()
 
@@ -259,18 +378,14 @@ func (e *executor) generateConfig(ctx context.Context, tf *tfexec.Terraform, opt
 		return "", err
 	}
 
-	backendConfig, err := tfConfig.AddTerraformBackend(options.ResourceRecipe, backends.NewKubernetesBackend(e.k8sClientSet))
+	_, err = tfConfig.AddTerraformBackend(options.ResourceRecipe, backend)
 	if err != nil {
 		return "", err
 	}
-	// Retrieving the secret_suffix property from backend config to use it to verify secret creation during terraform init.
-	// This is only used for the backend of type kubernetes and should be moved inside an if block when we add more backends.
-	var secretSuffix string
-	if backendDetails, ok := backendConfig[backends.BackendKubernetes]; ok {
-		backendMap := backendDetails.(map[string]any)
-		if secret, ok := backendMap["secret_suffix"]; ok {
-			secretSuffix = secret.(string)
-		}
+	// Retrieving the name of the backend source to verify that the state file is created during terraform apply.
+	stateName, err := backend.StateName(options.ResourceRecipe)
+	if err != nil {
+		return "", err
 	}
 
 	// Add recipe context parameter to the generated Terraform config's module parameters.
@@ -301,7 +416,7 @@ func (e *executor) generateConfig(ctx context.Context, tf *tfexec.Terraform, opt
 		return "", err
 	}
 
-	return secretSuffix, nil
+	return stateName, nil
 }
 
 // downloadAndInspect handles downloading the TF module and retrieving the necessary information
@@ -387,6 +502,34 @@ func initAndApply(ctx context.Context, tf *tfexec.Terraform) (*tfjson.State, err
 	return tf.Show(ctx)
 }
 
+// initAndPlan runs Terraform init and plan in the provided working directory.
+func initAndPlan(ctx context.Context, tf *tfexec.Terraform) (*tfjson.Plan, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	// Initialize Terraform
+	logger.Info("Initializing Terraform")
+	terraformInitStartTime := time.Now()
+	if err := tf.Init(ctx); err != nil {
+		metrics.DefaultRecipeEngineMetrics.RecordTerraformInitializationDuration(ctx, terraformInitStartTime,
+			[]attribute.KeyValue{metrics.OperationStateAttrKey.String(metrics.FailedOperationState)})
+
+		return nil, fmt.Errorf("terraform init failure: %w", err)
+	}
+	metrics.DefaultRecipeEngineMetrics.RecordTerraformInitializationDuration(ctx, terraformInitStartTime,
+		[]attribute.KeyValue{metrics.OperationStateAttrKey.String(metrics.SuccessfulOperationState)})
+
+	// Plan Terraform configuration and save the plan to a file in the working directory
+	logger.Info("Running Terraform plan")
+	planFile := filepath.Join(tf.WorkingDir(), planFileName)
+	if _, err := tf.Plan(ctx, tfexec.Out(planFile)); err != nil {
+		return nil, fmt.Errorf("terraform plan failure: %w", err)
+	}
+
+	// Load the saved plan to retrieve the planned resource changes
+	logger.Info("Fetching Terraform plan")
+	return tf.ShowPlanFile(ctx, planFile)
+}
+
 // initAndDestroy runs Terraform init and destroy in the provided working directory.
 func initAndDestroy(ctx context.Context, tf *tfexec.Terraform) error {
 	logger := ucplog.FromContextOrDiscard(ctx)
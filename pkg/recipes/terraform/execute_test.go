@@ -25,8 +25,10 @@ import (
 	dm "github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/radius-project/radius/pkg/recipes/terraform/config"
+	"github.com/radius-project/radius/pkg/recipes/terraform/config/backends"
 	"github.com/radius-project/radius/test/testcontext"
 	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/kubernetes/fake"
 )
 
 func TestGenerateConfig(t *testing.T) {
@@ -57,7 +59,7 @@ func TestGenerateConfig(t *testing.T) {
 			require.NoError(t, err)
 
 			e := executor{}
-			_, err = e.generateConfig(ctx, tf, tc.opts)
+			_, err = e.generateConfig(ctx, tf, tc.opts, nil)
 			require.Error(t, err)
 			require.ErrorContains(t, err, tc.err)
 		})
@@ -222,3 +224,55 @@ func TestSplitEnvVar(t *testing.T) {
 		})
 	}
 }
+
+func TestGetInstallOptions(t *testing.T) {
+	e := executor{installOptions: InstallOptions{Version: "1.5.0", CacheDir: "/terraform/cache", Offline: true}}
+
+	// The default version is used when the environment doesn't pin one.
+	result := e.getInstallOptions(Options{})
+	require.Equal(t, e.installOptions, result)
+
+	result = e.getInstallOptions(Options{EnvConfig: &recipes.Configuration{}})
+	require.Equal(t, e.installOptions, result)
+
+	result = e.getInstallOptions(Options{
+		EnvConfig: &recipes.Configuration{
+			RecipeConfig: dm.RecipeConfigProperties{
+				Terraform: dm.TerraformConfigProperties{
+					Version: "1.6.4",
+				},
+			},
+		},
+	})
+	require.Equal(t, InstallOptions{Version: "1.6.4", CacheDir: "/terraform/cache", Offline: true}, result)
+}
+
+func TestValidateBackendKind(t *testing.T) {
+	ctx := testcontext.New(t)
+	resourceRecipe := &recipes.ResourceMetadata{
+		EnvironmentID: "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/env",
+		ApplicationID: "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/applications/app",
+		ResourceID:    "/planes/radius/local/resourceGroups/test-group/providers/Applications.Datastores/redisCaches/redis",
+	}
+	s3Options := Options{
+		ResourceRecipe: resourceRecipe,
+		EnvConfig: &recipes.Configuration{
+			RecipeConfig: dm.RecipeConfigProperties{
+				Terraform: dm.TerraformConfigProperties{
+					Backend: dm.TerraformBackendConfig{Kind: backends.BackendS3},
+				},
+			},
+		},
+	}
+
+	e := executor{k8sClientSet: fake.NewSimpleClientset()}
+
+	// A recipe without state can use any backend.
+	require.NoError(t, e.validateBackendKind(ctx, s3Options))
+
+	require.NoError(t, backends.RecordKind(ctx, e.k8sClientSet, resourceRecipe, backends.BackendKubernetes))
+	require.NoError(t, e.validateBackendKind(ctx, Options{ResourceRecipe: resourceRecipe}))
+
+	err := e.validateBackendKind(ctx, s3Options)
+	require.ErrorContains(t, err, `the terraform state of the recipe is stored in the "kubernetes" backend, but the environment uses the "s3" backend`)
+}
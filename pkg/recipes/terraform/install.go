@@ -18,11 +18,17 @@ package terraform
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/go-version"
 	install "github.com/hashicorp/hc-install"
 	"github.com/hashicorp/hc-install/product"
 	"github.com/hashicorp/hc-install/releases"
@@ -37,35 +43,58 @@ const (
 	installSubDir                     = "install"
 	installVerificationRetryCount     = 5
 	installVerificationRetryDelaySecs = 3
+
+	// latestVersion is the version used in logs and metrics when the Terraform version is not pinned.
+	latestVersion = "latest"
+
+	// checksumFileName is the name of the file storing the SHA256 checksum of a cached Terraform binary.
+	checksumFileName = "terraform.sha256"
 )
 
-// Install installs Terraform under /install in the provided Terraform root directory for the resource. It installs
-// the latest version of Terraform and returns the path to the installed Terraform executable. It returns an error
-// if the directory creation or Terraform installation fails.
-func Install(ctx context.Context, installer *install.Installer, tfDir string) (*tfexec.Terraform, error) {
+// InstallOptions represents the options to install Terraform.
+type InstallOptions struct {
+	// Version is the version of Terraform to install, for example 1.6.4. The latest version is installed if it is empty.
+	Version string
+
+	// CacheDir is the directory of the shared cache of Terraform binaries. Each pinned version is downloaded and verified
+	// once, then reused across executions. Terraform is installed for every execution if it is empty.
+	CacheDir string
+
+	// ExecPath is the path to a pre-provisioned Terraform binary. Terraform is never downloaded if it is set.
+	ExecPath string
+
+	// MirrorURL is the base URL of a mirror of https://releases.hashicorp.com used to download Terraform.
+	MirrorURL string
+
+	// Offline disables downloads from https://releases.hashicorp.com. Terraform must then be available from ExecPath,
+	// the cache or MirrorURL.
+	Offline bool
+}
+
+// Install installs Terraform and returns a Terraform executor for the provided Terraform root directory for the resource.
+// A pre-provisioned binary is used if one is configured. Otherwise a pinned version is taken from the cache, or
+// downloaded and added to the cache, and an unpinned version is installed under /install in the root directory. It
+// returns an error if the installation fails or if the installed version doesn't match the pinned version.
+func Install(ctx context.Context, installer *install.Installer, tfDir string, opts InstallOptions) (*tfexec.Terraform, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
-	// Create Terraform installation directory
-	installDir := filepath.Join(tfDir, installSubDir)
-	if err := os.MkdirAll(installDir, 0755); err != nil {
-		return nil, fmt.Errorf("failed to create directory for terraform installation for resource: %w", err)
+	var pinned *version.Version
+	versionAttr := latestVersion
+	if opts.Version != "" {
+		var err error
+		pinned, err = version.NewVersion(opts.Version)
+		if err != nil {
+			return nil, fmt.Errorf("invalid Terraform version %q: %w", opts.Version, err)
+		}
+		versionAttr = pinned.String()
 	}
 
-	logger.Info(fmt.Sprintf("Installing Terraform in the directory: %q", installDir))
-
 	installStartTime := time.Now()
-	// Re-visit this: consider checking if an existing installation of same version of Terraform is available.
-	// For initial iteration we will always install Terraform for every execution of the recipe driver.
-	execPath, err := installer.Ensure(ctx, []src.Source{
-		&releases.LatestVersion{
-			Product:    product.Terraform,
-			InstallDir: installDir,
-		},
-	})
+	execPath, err := ensureExecPath(ctx, installer, tfDir, pinned, opts)
 	if err != nil {
 		metrics.DefaultRecipeEngineMetrics.RecordTerraformInstallationDuration(ctx, installStartTime,
 			[]attribute.KeyValue{
-				metrics.TerraformVersionAttrKey.String("latest"),
+				metrics.TerraformVersionAttrKey.String(versionAttr),
 				metrics.OperationStateAttrKey.String(metrics.FailedOperationState),
 			},
 		)
@@ -74,12 +103,12 @@ func Install(ctx context.Context, installer *install.Installer, tfDir string) (*
 
 	metrics.DefaultRecipeEngineMetrics.RecordTerraformInstallationDuration(ctx, installStartTime,
 		[]attribute.KeyValue{
-			metrics.TerraformVersionAttrKey.String("latest"),
+			metrics.TerraformVersionAttrKey.String(versionAttr),
 			metrics.OperationStateAttrKey.String(metrics.SuccessfulOperationState),
 		},
 	)
 
-	logger.Info(fmt.Sprintf("Terraform latest version installed to: %q", execPath))
+	logger.Info(fmt.Sprintf("Terraform %s version is available at: %q", versionAttr, execPath))
 
 	// Create a new instance of tfexec.Terraform with current Terraform installation path
 	tf, err := NewTerraform(ctx, tfDir, execPath)
@@ -89,21 +118,26 @@ func Install(ctx context.Context, installer *install.Installer, tfDir string) (*
 
 	// Verify Terraform installation is complete before proceeding
 	for attempt := 0; attempt <= installVerificationRetryCount; attempt++ {
-		_, _, err = tf.Version(ctx, false)
+		var installed *version.Version
+		installed, _, err = tf.Version(ctx, false)
 		if err == nil {
 			metrics.DefaultRecipeEngineMetrics.RecordTerraformInstallVerificationDuration(ctx, installStartTime,
 				[]attribute.KeyValue{
-					metrics.TerraformVersionAttrKey.String("latest"),
+					metrics.TerraformVersionAttrKey.String(versionAttr),
 					metrics.OperationStateAttrKey.String(metrics.SuccessfulOperationState),
 				},
 			)
+
+			if pinned != nil && !installed.Equal(pinned) {
+				return nil, fmt.Errorf("terraform at %q is version %s, but version %s is required", execPath, installed, pinned)
+			}
 			break
 		}
 		if attempt < installVerificationRetryCount {
 			logger.Info(fmt.Sprintf("Failed to verify Terraform installation completion: %s. Retrying after %d seconds", err.Error(), installVerificationRetryDelaySecs))
 			metrics.DefaultRecipeEngineMetrics.RecordTerraformInstallVerificationDuration(ctx, installStartTime,
 				[]attribute.KeyValue{
-					metrics.TerraformVersionAttrKey.String("latest"),
+					metrics.TerraformVersionAttrKey.String(versionAttr),
 					metrics.OperationStateAttrKey.String(metrics.FailedOperationState),
 				},
 			)
@@ -118,3 +152,175 @@ func Install(ctx context.Context, installer *install.Installer, tfDir string) (*
 
 	return tf, nil
 }
+
+// ensureExecPath returns the path to the Terraform binary to use, installing Terraform if needed.
+func ensureExecPath(ctx context.Context, installer *install.Installer, tfDir string, pinned *version.Version, opts InstallOptions) (string, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	if opts.ExecPath != "" {
+		if _, err := os.Stat(opts.ExecPath); err != nil {
+			return "", fmt.Errorf("failed to find the pre-provisioned Terraform binary: %w", err)
+		}
+		logger.Info(fmt.Sprintf("Using pre-provisioned Terraform: %q", opts.ExecPath))
+		return opts.ExecPath, nil
+	}
+
+	if pinned == nil {
+		if opts.Offline || opts.MirrorURL != "" {
+			return "", errors.New("a Terraform version must be configured to install Terraform from a mirror or in offline mode")
+		}
+
+		installDir, err := createInstallDir(ctx, tfDir)
+		if err != nil {
+			return "", err
+		}
+
+		// Unpinned versions are not cached because the latest version changes over time.
+		return installer.Ensure(ctx, []src.Source{
+			&releases.LatestVersion{
+				Product:    product.Terraform,
+				InstallDir: installDir,
+			},
+		})
+	}
+
+	if opts.CacheDir == "" {
+		installDir, err := createInstallDir(ctx, tfDir)
+		if err != nil {
+			return "", err
+		}
+
+		return installVersion(ctx, installer, pinned, installDir, opts)
+	}
+
+	return ensureCachedVersion(ctx, installer, pinned, opts)
+}
+
+func createInstallDir(ctx context.Context, tfDir string) (string, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	// Create Terraform installation directory
+	installDir := filepath.Join(tfDir, installSubDir)
+	if err := os.MkdirAll(installDir, 0755); err != nil {
+		return "", fmt.Errorf("failed to create directory for terraform installation for resource: %w", err)
+	}
+
+	logger.Info(fmt.Sprintf("Installing Terraform in the directory: %q", installDir))
+	return installDir, nil
+}
+
+// installVersion downloads the given version of Terraform to installDir, either from the mirror or from
+// https://releases.hashicorp.com. Both checksums and signatures are verified for downloads from https://releases.hashicorp.com.
+func installVersion(ctx context.Context, installer *install.Installer, v *version.Version, installDir string, opts InstallOptions) (string, error) {
+	if opts.MirrorURL != "" {
+		return downloadFromMirror(ctx, opts.MirrorURL, v, installDir)
+	}
+
+	if opts.Offline {
+		return "", fmt.Errorf("terraform %s is not available in offline mode. Configure a pre-provisioned Terraform binary or a mirror", v)
+	}
+
+	return installer.Ensure(ctx, []src.Source{
+		&releases.ExactVersion{
+			Product:    product.Terraform,
+			Version:    v,
+			InstallDir: installDir,
+		},
+	})
+}
+
+// ensureCachedVersion returns the path to the given version of Terraform in the cache, installing it if it is not cached
+// or if the cached binary doesn't match the checksum recorded when it was installed.
+func ensureCachedVersion(ctx context.Context, installer *install.Installer, v *version.Version, opts InstallOptions) (string, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	versionDir := filepath.Join(opts.CacheDir, v.String())
+	execPath := filepath.Join(versionDir, product.Terraform.BinaryName())
+
+	if ok, err := verifyCachedBinary(versionDir, execPath); err != nil {
+		logger.Info(fmt.Sprintf("Removing invalid cached Terraform %s: %s", v, err.Error()))
+		if err := os.RemoveAll(versionDir); err != nil {
+			return "", fmt.Errorf("failed to remove invalid cached Terraform: %w", err)
+		}
+	} else if ok {
+		logger.Info(fmt.Sprintf("Using cached Terraform %s", v))
+		return execPath, nil
+	}
+
+	if err := os.MkdirAll(opts.CacheDir, 0755); err != nil {
+		return "", fmt.Errorf("failed to create Terraform cache directory: %w", err)
+	}
+
+	// Install into a temporary directory and then move it into place, so that concurrent executions never use a partially
+	// installed binary.
+	tmpDir, err := os.MkdirTemp(opts.CacheDir, ".install-"+v.String()+"-*")
+	if err != nil {
+		return "", fmt.Errorf("failed to create Terraform cache directory: %w", err)
+	}
+	defer func() {
+		_ = os.RemoveAll(tmpDir)
+	}()
+
+	logger.Info(fmt.Sprintf("Installing Terraform %s to the cache: %q", v, versionDir))
+	tmpExecPath, err := installVersion(ctx, installer, v, tmpDir, opts)
+	if err != nil {
+		return "", err
+	}
+
+	checksum, err := fileChecksum(tmpExecPath)
+	if err != nil {
+		return "", err
+	}
+	if err := os.WriteFile(filepath.Join(tmpDir, checksumFileName), []byte(checksum), 0644); err != nil {
+		return "", err
+	}
+
+	if err := os.Rename(tmpDir, versionDir); err != nil {
+		// Another execution may have added the same version to the cache concurrently.
+		if ok, verifyErr := verifyCachedBinary(versionDir, execPath); verifyErr == nil && ok {
+			return execPath, nil
+		}
+		return "", fmt.Errorf("failed to add Terraform %s to the cache: %w", v, err)
+	}
+
+	return execPath, nil
+}
+
+// verifyCachedBinary returns true if the cached binary exists and matches its recorded checksum. It returns false if the
+// version is not cached, and an error if the cache entry is incomplete or the checksum doesn't match.
+func verifyCachedBinary(versionDir string, execPath string) (bool, error) {
+	if _, err := os.Stat(versionDir); errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+
+	expected, err := os.ReadFile(filepath.Join(versionDir, checksumFileName))
+	if err != nil {
+		return false, fmt.Errorf("failed to read checksum: %w", err)
+	}
+
+	actual, err := fileChecksum(execPath)
+	if err != nil {
+		return false, err
+	}
+
+	if strings.TrimSpace(string(expected)) != actual {
+		return false, errors.New("checksum mismatch")
+	}
+
+	return true, nil
+}
+
+func fileChecksum(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
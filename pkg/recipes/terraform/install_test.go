@@ -0,0 +1,177 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	install "github.com/hashicorp/hc-install"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeTerraform returns a script which mimics `terraform version -json` for the given version.
+func fakeTerraform(v string) []byte {
+	return []byte(fmt.Sprintf("#!/bin/sh\necho '{\"terraform_version\":\"%s\",\"platform\":\"linux_amd64\",\"provider_selections\":{},\"terraform_outdated\":false}'\n", v))
+}
+
+// newTestMirror returns a mirror of https://releases.hashicorp.com serving a fake Terraform for the given version, and
+// a counter of the requests it received.
+func newTestMirror(t *testing.T, v string) (*httptest.Server, *atomic.Int32) {
+	buf := &bytes.Buffer{}
+	w := zip.NewWriter(buf)
+	f, err := w.Create("terraform")
+	require.NoError(t, err)
+	_, err = f.Write(fakeTerraform(v))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	archive := buf.Bytes()
+	checksum := sha256.Sum256(archive)
+	archiveName := mirrorArchiveName(version.Must(version.NewVersion(v)))
+
+	requests := &atomic.Int32{}
+	mux := http.NewServeMux()
+	mux.HandleFunc(fmt.Sprintf("/terraform/%s/terraform_%s_SHA256SUMS", v, v), func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		_, _ = fmt.Fprintf(w, "%s  %s\n", hex.EncodeToString(checksum[:]), archiveName)
+	})
+	mux.HandleFunc(fmt.Sprintf("/terraform/%s/%s", v, archiveName), func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		_, _ = w.Write(archive)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return server, requests
+}
+
+func TestInstall_InvalidVersion(t *testing.T) {
+	_, err := Install(testcontext.New(t), install.NewInstaller(), t.TempDir(), InstallOptions{Version: "invalid"})
+	require.ErrorContains(t, err, "invalid Terraform version \"invalid\"")
+}
+
+func TestInstall_ExecPath(t *testing.T) {
+	execPath := filepath.Join(t.TempDir(), "terraform")
+	require.NoError(t, os.WriteFile(execPath, fakeTerraform("1.6.4"), 0755))
+
+	t.Run("unpinned", func(t *testing.T) {
+		tf, err := Install(testcontext.New(t), install.NewInstaller(), t.TempDir(), InstallOptions{ExecPath: execPath, Offline: true})
+		require.NoError(t, err)
+		require.Equal(t, execPath, tf.ExecPath())
+	})
+
+	t.Run("pinned", func(t *testing.T) {
+		_, err := Install(testcontext.New(t), install.NewInstaller(), t.TempDir(), InstallOptions{ExecPath: execPath, Version: "1.6.4"})
+		require.NoError(t, err)
+	})
+
+	t.Run("version mismatch", func(t *testing.T) {
+		_, err := Install(testcontext.New(t), install.NewInstaller(), t.TempDir(), InstallOptions{ExecPath: execPath, Version: "1.5.0"})
+		require.ErrorContains(t, err, "is version 1.6.4, but version 1.5.0 is required")
+	})
+
+	t.Run("missing binary", func(t *testing.T) {
+		_, err := Install(testcontext.New(t), install.NewInstaller(), t.TempDir(), InstallOptions{ExecPath: filepath.Join(t.TempDir(), "missing")})
+		require.ErrorContains(t, err, "failed to find the pre-provisioned Terraform binary")
+	})
+}
+
+func TestInstall_Offline(t *testing.T) {
+	_, err := Install(testcontext.New(t), install.NewInstaller(), t.TempDir(), InstallOptions{Offline: true})
+	require.EqualError(t, err, "a Terraform version must be configured to install Terraform from a mirror or in offline mode")
+
+	_, err = Install(testcontext.New(t), install.NewInstaller(), t.TempDir(), InstallOptions{Offline: true, Version: "1.6.4", CacheDir: t.TempDir()})
+	require.EqualError(t, err, "terraform 1.6.4 is not available in offline mode. Configure a pre-provisioned Terraform binary or a mirror")
+}
+
+func TestInstall_MirrorWithoutCache(t *testing.T) {
+	server, requests := newTestMirror(t, "1.6.4")
+	tfDir := t.TempDir()
+
+	tf, err := Install(testcontext.New(t), install.NewInstaller(), tfDir, InstallOptions{Version: "1.6.4", MirrorURL: server.URL, Offline: true})
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(tfDir, installSubDir, "terraform"), tf.ExecPath())
+	require.Equal(t, int32(2), requests.Load())
+}
+
+func TestInstall_Cache(t *testing.T) {
+	server, requests := newTestMirror(t, "1.6.4")
+	cacheDir := t.TempDir()
+	opts := InstallOptions{Version: "1.6.4", MirrorURL: server.URL, CacheDir: cacheDir}
+	cachedExecPath := filepath.Join(cacheDir, "1.6.4", "terraform")
+
+	tf, err := Install(testcontext.New(t), install.NewInstaller(), t.TempDir(), opts)
+	require.NoError(t, err)
+	require.Equal(t, cachedExecPath, tf.ExecPath())
+	require.Equal(t, int32(2), requests.Load())
+
+	// The cached version is reused, even in offline mode without a mirror.
+	tf, err = Install(testcontext.New(t), install.NewInstaller(), t.TempDir(), InstallOptions{Version: "1.6.4", CacheDir: cacheDir, Offline: true})
+	require.NoError(t, err)
+	require.Equal(t, cachedExecPath, tf.ExecPath())
+	require.Equal(t, int32(2), requests.Load())
+
+	// Only the installed version is left in the cache.
+	entries, err := os.ReadDir(cacheDir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+
+	// A cached binary which doesn't match its checksum is installed again.
+	require.NoError(t, os.WriteFile(cachedExecPath, fakeTerraform("1.0.0"), 0755))
+	tf, err = Install(testcontext.New(t), install.NewInstaller(), t.TempDir(), opts)
+	require.NoError(t, err)
+	require.Equal(t, cachedExecPath, tf.ExecPath())
+	require.Equal(t, int32(4), requests.Load())
+}
+
+func TestDownloadFromMirror_Invalid(t *testing.T) {
+	v := version.Must(version.NewVersion("1.6.4"))
+	server, _ := newTestMirror(t, "1.6.4")
+
+	t.Run("version not found", func(t *testing.T) {
+		_, err := downloadFromMirror(testcontext.New(t), server.URL, version.Must(version.NewVersion("1.5.0")), t.TempDir())
+		require.ErrorContains(t, err, "failed to download Terraform checksums from mirror")
+	})
+
+	t.Run("checksum mismatch", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc("/terraform/1.6.4/terraform_1.6.4_SHA256SUMS", func(w http.ResponseWriter, r *http.Request) {
+			_, _ = fmt.Fprintf(w, "%s  %s\n", hex.EncodeToString(make([]byte, sha256.Size)), mirrorArchiveName(v))
+		})
+		mux.HandleFunc("/terraform/1.6.4/"+mirrorArchiveName(v), func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte("not the archive"))
+		})
+		tampered := httptest.NewServer(mux)
+		defer tampered.Close()
+
+		_, err := downloadFromMirror(testcontext.New(t), tampered.URL, v, t.TempDir())
+		require.ErrorContains(t, err, "checksum mismatch")
+	})
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/hc-install/product"
+)
+
+const (
+	// maxMirrorDownloadSize is the maximum size of a Terraform archive downloaded from a mirror.
+	maxMirrorDownloadSize = 512 * 1024 * 1024
+)
+
+// mirrorArchiveName returns the name of the Terraform archive for the current platform.
+func mirrorArchiveName(v *version.Version) string {
+	return fmt.Sprintf("terraform_%s_%s_%s.zip", v, runtime.GOOS, runtime.GOARCH)
+}
+
+// downloadFromMirror downloads the given version of Terraform from a mirror of https://releases.hashicorp.com into
+// installDir and returns the path to the binary. The mirror must use the same layout as https://releases.hashicorp.com:
+//
+//	<mirrorURL>/terraform/<version>/terraform_<version>_SHA256SUMS
+//	<mirrorURL>/terraform/<version>/terraform_<version>_<os>_<arch>.zip
+//
+// The archive is verified against the checksums published with it.
+func downloadFromMirror(ctx context.Context, mirrorURL string, v *version.Version, installDir string) (string, error) {
+	baseURL := fmt.Sprintf("%s/terraform/%s", strings.TrimSuffix(mirrorURL, "/"), v)
+	archiveName := mirrorArchiveName(v)
+
+	sums, err := httpGet(ctx, fmt.Sprintf("%s/terraform_%s_SHA256SUMS", baseURL, v))
+	if err != nil {
+		return "", fmt.Errorf("failed to download Terraform checksums from mirror: %w", err)
+	}
+
+	expected, err := findChecksum(sums, archiveName)
+	if err != nil {
+		return "", err
+	}
+
+	archive, err := httpGet(ctx, fmt.Sprintf("%s/%s", baseURL, archiveName))
+	if err != nil {
+		return "", fmt.Errorf("failed to download Terraform from mirror: %w", err)
+	}
+
+	actual := sha256.Sum256(archive)
+	if hex.EncodeToString(actual[:]) != expected {
+		return "", fmt.Errorf("checksum mismatch for %q downloaded from mirror", archiveName)
+	}
+
+	return unzipBinary(archive, product.Terraform.BinaryName(), installDir)
+}
+
+func httpGet(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %q returned status %d", url, resp.StatusCode)
+	}
+
+	b, err := io.ReadAll(io.LimitReader(resp.Body, maxMirrorDownloadSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(b) > maxMirrorDownloadSize {
+		return nil, fmt.Errorf("GET %q returned more than %d bytes", url, maxMirrorDownloadSize)
+	}
+
+	return b, nil
+}
+
+// findChecksum finds the checksum of the file in the content of a SHA256SUMS file.
+func findChecksum(sums []byte, fileName string) (string, error) {
+	scanner := bufio.NewScanner(bytes.NewReader(sums))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 2 && fields[1] == fileName {
+			return fields[0], nil
+		}
+	}
+
+	return "", fmt.Errorf("checksum for %q not found", fileName)
+}
+
+// unzipBinary extracts the binary with the given name from the zip archive into dir and returns its path.
+func unzipBinary(archive []byte, binaryName string, dir string) (string, error) {
+	r, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		return "", fmt.Errorf("failed to read Terraform archive: %w", err)
+	}
+
+	for _, f := range r.File {
+		if f.Name != binaryName {
+			continue
+		}
+
+		rc, err := f.Open()
+		if err != nil {
+			return "", err
+		}
+		defer rc.Close()
+
+		execPath := filepath.Join(dir, binaryName)
+		out, err := os.OpenFile(execPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0755)
+		if err != nil {
+			return "", err
+		}
+
+		_, err = io.Copy(out, io.LimitReader(rc, maxMirrorDownloadSize))
+		if closeErr := out.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return "", err
+		}
+
+		return execPath, nil
+	}
+
+	return "", fmt.Errorf("%q not found in Terraform archive", binaryName)
+}
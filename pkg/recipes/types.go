@@ -69,6 +69,8 @@ type EnvironmentDefinition struct {
 	TemplateVersion string
 	// Allows insecure connections to registry without SSL check.
 	PlainHTTP bool
+	// Version represents the name of the recipe version the definition was selected from. Empty if the recipe has no named versions.
+	Version string
 }
 
 // ResourceMetadata represents recipe details provided while creating a portable resource.
@@ -83,18 +85,30 @@ type ResourceMetadata struct {
 	ResourceID string
 	// Parameters represents key/value pairs to pass into the recipe template. Overrides any parameters set by the environment.
 	Parameters map[string]any
+	// Version represents the name of the recipe version to use. The default version of the recipe is used when empty.
+	Version string
+	// Properties represents the properties of the resource. The required outputs of the output contract of the resource
+	// type that are set in the properties don't need to be output by the recipe.
+	Properties map[string]any
 }
 
 const (
-	TemplateKindBicep     = "bicep"
-	TemplateKindTerraform = "terraform"
+	TemplateKindBicep      = "bicep"
+	TemplateKindTerraform  = "terraform"
+	TemplateKindHelm       = "helm"
+	TemplateKindKubernetes = "kubernetes"
 
 	// Recipe outputs are expected to be wrapped under an object named "result"
 	ResultPropertyName = "result"
+
+	// AnnotationRecipeOutput is the annotation that marks a ConfigMap or Secret deployed by a Helm or Kubernetes
+	// recipe as a recipe output. The data of an annotated ConfigMap is returned as the recipe values, and the data
+	// of an annotated Secret is returned as the recipe secrets.
+	AnnotationRecipeOutput = "radapp.io/recipe-output"
 )
 
 var (
-	SupportedTemplateKind = []string{TemplateKindBicep, TemplateKindTerraform}
+	SupportedTemplateKind = []string{TemplateKindBicep, TemplateKindTerraform, TemplateKindHelm, TemplateKindKubernetes}
 )
 
 // RecipeOutput represents recipe deployment output.
@@ -112,6 +126,46 @@ type RecipeOutput struct {
 	Status *rpv1.RecipeStatus
 }
 
+// ResourceChangeAction is the action that the execution of a recipe would take on a resource.
+type ResourceChangeAction string
+
+const (
+	// ResourceChangeActionCreate means that the resource would be created.
+	ResourceChangeActionCreate ResourceChangeAction = "create"
+	// ResourceChangeActionUpdate means that the resource would be updated in place.
+	ResourceChangeActionUpdate ResourceChangeAction = "update"
+	// ResourceChangeActionReplace means that the resource would be deleted and created again.
+	ResourceChangeActionReplace ResourceChangeAction = "replace"
+	// ResourceChangeActionDelete means that the resource would be deleted.
+	ResourceChangeActionDelete ResourceChangeAction = "delete"
+	// ResourceChangeActionNoChange means that the resource would not be changed.
+	ResourceChangeActionNoChange ResourceChangeAction = "noChange"
+	// ResourceChangeActionUnknown means that the change to the resource can't be predicted.
+	ResourceChangeActionUnknown ResourceChangeAction = "unknown"
+)
+
+// ResourceChange represents a change that the execution of a recipe would make to a resource.
+type ResourceChange struct {
+	// ID is the identifier of the resource. It is the resource ID for the resources of Bicep, Helm and Kubernetes
+	// recipes, and the resource address for the resources of Terraform recipes.
+	ID string
+
+	// Type is the type of the resource.
+	Type string
+
+	// Action is the action that the execution of the recipe would take on the resource.
+	Action ResourceChangeAction
+}
+
+// RecipePlan represents the changes that the execution of a recipe would make, without making them.
+type RecipePlan struct {
+	// Changes represents the list of changes to the resources of the recipe.
+	Changes []ResourceChange
+
+	// Status represents the recipe that was planned.
+	Status *rpv1.RecipeStatus
+}
+
 // PrepareRecipeOutput populates the recipe output from the recipe deployment output stored in the "result" object.
 // outputs map is the value of "result" output from the recipe deployment response.
 func (ro *RecipeOutput) PrepareRecipeResponse(resultValue map[string]any) error {
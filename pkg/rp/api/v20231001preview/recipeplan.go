@@ -0,0 +1,66 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package v20231001preview contains the versioned models of the 2023-10-01-preview API that are shared by the resource
+// providers of Applications.Core and of the portable resources.
+package v20231001preview
+
+// RecipePlanResult - The changes that the execution of the recipe of a portable resource would make. It is the result of
+// the plan action of every portable resource type, and has the same schema as the RecipePlanResult model generated for
+// each resource provider.
+type RecipePlanResult struct {
+	// REQUIRED; The resources that the execution of the recipe would create, update or delete.
+	Changes []*RecipeResourceChange `json:"changes"`
+
+	// REQUIRED; TemplateKind is the kind of the recipe template.
+	TemplateKind *string `json:"templateKind,omitempty"`
+
+	// REQUIRED; TemplatePath is the path of the recipe template.
+	TemplatePath *string `json:"templatePath,omitempty"`
+
+	// TemplateVersion is the version number of the template.
+	TemplateVersion *string `json:"templateVersion,omitempty"`
+}
+
+// RecipeResourceChange - A change that the execution of a recipe would make to a resource.
+type RecipeResourceChange struct {
+	// REQUIRED; The action that would be taken on the resource.
+	Action *RecipeResourceChangeAction `json:"action,omitempty"`
+
+	// REQUIRED; The ID of the resource. For Terraform recipes this is the address of the resource in the module.
+	ID *string `json:"id,omitempty"`
+
+	// The type of the resource.
+	Type *string `json:"type,omitempty"`
+}
+
+// RecipeResourceChangeAction - The action that the execution of a recipe would take on a resource.
+type RecipeResourceChangeAction string
+
+const (
+	// RecipeResourceChangeActionCreate - The resource would be created.
+	RecipeResourceChangeActionCreate RecipeResourceChangeAction = "create"
+	// RecipeResourceChangeActionDelete - The resource would be deleted.
+	RecipeResourceChangeActionDelete RecipeResourceChangeAction = "delete"
+	// RecipeResourceChangeActionNoChange - The resource would not be changed.
+	RecipeResourceChangeActionNoChange RecipeResourceChangeAction = "noChange"
+	// RecipeResourceChangeActionReplace - The resource would be deleted and created again.
+	RecipeResourceChangeActionReplace RecipeResourceChangeAction = "replace"
+	// RecipeResourceChangeActionUnknown - The change to the resource can't be predicted.
+	RecipeResourceChangeActionUnknown RecipeResourceChangeAction = "unknown"
+	// RecipeResourceChangeActionUpdate - The resource would be updated in place.
+	RecipeResourceChangeActionUpdate RecipeResourceChangeAction = "update"
+)
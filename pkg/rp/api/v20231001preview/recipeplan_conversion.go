@@ -0,0 +1,86 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	pr_dm "github.com/radius-project/radius/pkg/portableresources/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+)
+
+// ConvertFrom converts from version-agnostic datamodel to the versioned RecipePlanResult instance.
+func (dst *RecipePlanResult) ConvertFrom(src v1.DataModelInterface) error {
+	plan, ok := src.(*pr_dm.RecipePlan)
+	if !ok {
+		return v1.ErrInvalidModelConversion
+	}
+
+	if plan.Status != nil {
+		dst.TemplateKind = to.Ptr(plan.Status.TemplateKind)
+		dst.TemplatePath = to.Ptr(plan.Status.TemplatePath)
+		if plan.Status.TemplateVersion != "" {
+			dst.TemplateVersion = to.Ptr(plan.Status.TemplateVersion)
+		}
+	}
+
+	dst.Changes = []*RecipeResourceChange{}
+	for _, change := range plan.Changes {
+		resourceChange := &RecipeResourceChange{
+			ID:     to.Ptr(change.ID),
+			Action: to.Ptr(RecipeResourceChangeAction(change.Action)),
+		}
+		if change.Type != "" {
+			resourceChange.Type = to.Ptr(change.Type)
+		}
+		dst.Changes = append(dst.Changes, resourceChange)
+	}
+
+	return nil
+}
+
+// ConvertTo converts from the versioned RecipePlanResult instance to version-agnostic datamodel.
+func (src *RecipePlanResult) ConvertTo() (v1.DataModelInterface, error) {
+	converted := &pr_dm.RecipePlan{
+		RecipePlan: recipes.RecipePlan{
+			Changes: []recipes.ResourceChange{},
+			Status: &rpv1.RecipeStatus{
+				TemplateKind:    to.String(src.TemplateKind),
+				TemplatePath:    to.String(src.TemplatePath),
+				TemplateVersion: to.String(src.TemplateVersion),
+			},
+		},
+	}
+
+	for _, change := range src.Changes {
+		if change == nil {
+			continue
+		}
+
+		resourceChange := recipes.ResourceChange{
+			ID:   to.String(change.ID),
+			Type: to.String(change.Type),
+		}
+		if change.Action != nil {
+			resourceChange.Action = recipes.ResourceChangeAction(*change.Action)
+		}
+		converted.Changes = append(converted.Changes, resourceChange)
+	}
+
+	return converted, nil
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	"encoding/json"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	pr_dm "github.com/radius-project/radius/pkg/portableresources/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/testutil/resourcetypeutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecipePlan_ConvertDataModelToVersioned(t *testing.T) {
+	plan := &pr_dm.RecipePlan{
+		RecipePlan: recipes.RecipePlan{
+			Changes: []recipes.ResourceChange{
+				{
+					ID:     "/planes/kubernetes/local/namespaces/default/providers/apps/Deployment/redis",
+					Type:   "apps/Deployment",
+					Action: recipes.ResourceChangeActionUpdate,
+				},
+				{
+					ID:     "azurerm_redis_cache.redis",
+					Action: recipes.ResourceChangeActionCreate,
+				},
+			},
+			Status: &rpv1.RecipeStatus{
+				TemplateKind: recipes.TemplateKindBicep,
+				TemplatePath: "ghcr.io/radius-project/recipes/redis:1.0",
+			},
+		},
+	}
+
+	versioned := &RecipePlanResult{}
+	err := versioned.ConvertFrom(plan)
+	require.NoError(t, err)
+
+	expected := &RecipePlanResult{
+		TemplateKind: to.Ptr(recipes.TemplateKindBicep),
+		TemplatePath: to.Ptr("ghcr.io/radius-project/recipes/redis:1.0"),
+		Changes: []*RecipeResourceChange{
+			{
+				ID:     to.Ptr("/planes/kubernetes/local/namespaces/default/providers/apps/Deployment/redis"),
+				Type:   to.Ptr("apps/Deployment"),
+				Action: to.Ptr(RecipeResourceChangeActionUpdate),
+			},
+			{
+				ID:     to.Ptr("azurerm_redis_cache.redis"),
+				Action: to.Ptr(RecipeResourceChangeActionCreate),
+			},
+		},
+	}
+	require.Equal(t, expected, versioned)
+}
+
+func TestRecipePlan_ConvertVersionedToDataModel(t *testing.T) {
+	versioned := &RecipePlanResult{
+		TemplateKind:    to.Ptr(recipes.TemplateKindTerraform),
+		TemplatePath:    to.Ptr("Azure/redis/azurerm"),
+		TemplateVersion: to.Ptr("1.1.0"),
+		Changes: []*RecipeResourceChange{
+			{
+				ID:     to.Ptr("azurerm_redis_cache.redis"),
+				Type:   to.Ptr("azurerm_redis_cache"),
+				Action: to.Ptr(RecipeResourceChangeActionReplace),
+			},
+		},
+	}
+
+	dm, err := versioned.ConvertTo()
+	require.NoError(t, err)
+
+	expected := &pr_dm.RecipePlan{
+		RecipePlan: recipes.RecipePlan{
+			Changes: []recipes.ResourceChange{
+				{
+					ID:     "azurerm_redis_cache.redis",
+					Type:   "azurerm_redis_cache",
+					Action: recipes.ResourceChangeActionReplace,
+				},
+			},
+			Status: &rpv1.RecipeStatus{
+				TemplateKind:    recipes.TemplateKindTerraform,
+				TemplatePath:    "Azure/redis/azurerm",
+				TemplateVersion: "1.1.0",
+			},
+		},
+	}
+	require.Equal(t, expected, dm)
+}
+
+func TestRecipePlan_ConvertFromValidation(t *testing.T) {
+	validationTests := []struct {
+		src v1.DataModelInterface
+		err error
+	}{
+		{&resourcetypeutil.FakeResource{}, v1.ErrInvalidModelConversion},
+		{nil, v1.ErrInvalidModelConversion},
+	}
+
+	for _, tc := range validationTests {
+		versioned := &RecipePlanResult{}
+		err := versioned.ConvertFrom(tc.src)
+		require.ErrorAs(t, tc.err, &err)
+	}
+}
+
+func TestRecipePlan_MarshalJSON(t *testing.T) {
+	versioned := &RecipePlanResult{
+		TemplateKind: to.Ptr(recipes.TemplateKindTerraform),
+		TemplatePath: to.Ptr("Azure/redis/azurerm"),
+		Changes: []*RecipeResourceChange{
+			{
+				ID:     to.Ptr("azurerm_redis_cache.redis"),
+				Action: to.Ptr(RecipeResourceChangeActionCreate),
+			},
+		},
+	}
+
+	b, err := json.Marshal(versioned)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"changes":[{"action":"create","id":"azurerm_redis_cache.redis"}],"templateKind":"terraform","templatePath":"Azure/redis/azurerm"}`, string(b))
+}
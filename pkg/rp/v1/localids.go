@@ -52,8 +52,11 @@ const (
 	LocalIDDaprSecretStoreAzureKeyVault = "DaprSecretStoreAzureKeyVault"
 	LocalIDDaprPubSubBrokerKafka        = "DaprPubSubBrokerKafka"
 	LocalIDDeployment                   = "Deployment"
+	LocalIDHorizontalPodAutoscaler      = "HorizontalPodAutoscaler"
 	LocalIDGateway                      = "Gateway"
 	LocalIDHttpRoute                    = "HttpRoute"
+	LocalIDTLSRoute                     = "TLSRoute"
+	LocalIDReferenceGrant               = "ReferenceGrant"
 	LocalIDKeyVault                     = "KeyVault"
 	LocalIDSecret                       = "Secret"
 	LocalIDConfigMap                    = "ConfigMap"
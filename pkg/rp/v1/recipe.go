@@ -16,6 +16,8 @@ limitations under the License.
 
 package v1
 
+import "time"
+
 // RecipeStatus defines the status of the recipe
 type RecipeStatus struct {
 	// TemplateKind specifies the kind of template used for the recipe.
@@ -26,4 +28,25 @@ type RecipeStatus struct {
 
 	// TemplateVersion specifies the version of the template used for the recipe.
 	TemplateVersion string `json:"templateVersion,omitempty"`
+
+	// Version specifies the name of the recipe version used for the recipe. Empty if the recipe has no named versions.
+	Version string `json:"version,omitempty"`
+
+	// Drift specifies the drift of the deployed infrastructure from the recipe, detected by the last drift check.
+	Drift *RecipeDrift `json:"drift,omitempty"`
+}
+
+// RecipeDrift defines the drift of the infrastructure deployed by a recipe.
+type RecipeDrift struct {
+	// Drifted is true if the deployed infrastructure no longer matches the recipe.
+	Drifted bool `json:"drifted"`
+
+	// Resources specifies the identifiers of the resources whose deployed state no longer matches the recipe.
+	Resources []string `json:"resources,omitempty"`
+
+	// LastCheckedAt specifies the time of the last drift check.
+	LastCheckedAt time.Time `json:"lastCheckedAt,omitempty"`
+
+	// LastReconciledAt specifies the time of the last reconciliation of the drift by running the recipe again.
+	LastReconciledAt *time.Time `json:"lastReconciledAt,omitempty"`
 }
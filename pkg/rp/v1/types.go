@@ -79,6 +79,7 @@ func (in *ResourceStatus) DeepCopy(out *ResourceStatus) {
 			TemplateKind:    out.Recipe.TemplateKind,
 			TemplatePath:    out.Recipe.TemplatePath,
 			TemplateVersion: out.Recipe.TemplateVersion,
+			Drift:           out.Recipe.Drift,
 		}
 	}
 }
@@ -0,0 +1,167 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+
+	manager "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
+	"github.com/radius-project/radius/pkg/kubeutil"
+	"github.com/radius-project/radius/pkg/portableresources/backend/drift"
+	"github.com/radius-project/radius/pkg/recipes/controllerconfig"
+	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	qprovider "github.com/radius-project/radius/pkg/ucp/queue/provider"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// driftDetectorLeaseName is the name of the lease held by the replica that runs the drift detector.
+	driftDetectorLeaseName = "radius-drift-detector"
+
+	// driftDetectorLeaseNamespace is the namespace of the lease held by the replica that runs the drift detector.
+	driftDetectorLeaseNamespace = "radius-system"
+
+	// driftDetectorLeaseDuration is the duration that the other replicas wait before taking over the lease of a
+	// replica that stopped renewing it.
+	driftDetectorLeaseDuration = 60 * time.Second
+
+	// driftDetectorRenewDeadline is the duration that the replica holding the lease retries renewing it before it
+	// stops the drift detector.
+	driftDetectorRenewDeadline = 15 * time.Second
+
+	// driftDetectorRetryPeriod is the duration between two attempts to acquire or renew the lease.
+	driftDetectorRetryPeriod = 5 * time.Second
+)
+
+// DriftCheckerFactory creates the drift checkers of a resource provider.
+type DriftCheckerFactory func(options drift.CheckerOptions) []drift.ResourceChecker
+
+// DriftDetector is a service to periodically check the resources deployed by recipes for drift.
+type DriftDetector struct {
+	options   hostoptions.HostOptions
+	config    *controllerconfig.RecipeControllerConfig
+	factories []DriftCheckerFactory
+}
+
+// NewDriftDetector creates new service instance to run the drift detector.
+func NewDriftDetector(options hostoptions.HostOptions, config *controllerconfig.RecipeControllerConfig, factories []DriftCheckerFactory) *DriftDetector {
+	return &DriftDetector{
+		options:   options,
+		config:    config,
+		factories: factories,
+	}
+}
+
+// Name represents the service name.
+func (d *DriftDetector) Name() string {
+	return "radiusdriftdetector"
+}
+
+// Run starts the drift detector. The replicas of the resource provider elect a leader using a Kubernetes lease, and
+// only the leader checks the resources for drift, so that a drifted resource is reconciled once.
+func (d *DriftDetector) Run(ctx context.Context) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	driftOptions := d.options.Config.DriftDetection
+
+	interval := drift.DefaultInterval
+	if driftOptions.Interval != "" {
+		var err error
+		interval, err = time.ParseDuration(driftOptions.Interval)
+		if err != nil {
+			return fmt.Errorf("failed to parse drift detection interval %q: %w", driftOptions.Interval, err)
+		}
+	}
+
+	k8s, err := kubeutil.NewClients(d.options.K8sConfig)
+	if err != nil {
+		return fmt.Errorf("failed to initialize kubernetes clients: %w", err)
+	}
+
+	storageProvider := dataprovider.NewStorageProvider(d.options.Config.StorageProvider)
+	queueClient, err := qprovider.New(d.options.Config.QueueProvider).GetClient(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to initialize queue client: %w", err)
+	}
+	statusManager := manager.New(storageProvider, queueClient, d.options.Config.Env.RoleLocation)
+
+	checkers := []drift.ResourceChecker{}
+	for _, factory := range d.factories {
+		checkers = append(checkers, factory(drift.CheckerOptions{
+			RecipeControllerConfig: d.config,
+			StatusManager:          statusManager,
+		})...)
+	}
+
+	detector := drift.NewDetector(drift.Options{
+		StorageProvider: storageProvider,
+		Checkers:        checkers,
+		Interval:        interval,
+		AutoReconcile:   driftOptions.AutoReconcile,
+	})
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		return fmt.Errorf("failed to get hostname: %w", err)
+	}
+
+	elector, err := leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
+		Lock: &resourcelock.LeaseLock{
+			LeaseMeta: metav1.ObjectMeta{
+				Name:      driftDetectorLeaseName,
+				Namespace: driftDetectorLeaseNamespace,
+			},
+			Client: k8s.ClientSet.CoordinationV1(),
+			LockConfig: resourcelock.ResourceLockConfig{
+				Identity: hostname + "_" + uuid.NewString(),
+			},
+		},
+		LeaseDuration:   driftDetectorLeaseDuration,
+		RenewDeadline:   driftDetectorRenewDeadline,
+		RetryPeriod:     driftDetectorRetryPeriod,
+		ReleaseOnCancel: true,
+		Name:            driftDetectorLeaseName,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(ctx context.Context) {
+				if err := detector.Run(ctx); err != nil {
+					logger.Error(err, "drift detector stopped")
+				}
+			},
+			OnStoppedLeading: func() {
+				logger.Info("Stopped checking resources for drift")
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to initialize the leader election of the drift detector: %w", err)
+	}
+
+	// Run returns when the lease is lost, in which case the replica competes for the lease again.
+	for ctx.Err() == nil {
+		elector.Run(ctx)
+	}
+
+	return nil
+}
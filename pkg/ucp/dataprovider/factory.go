@@ -20,6 +20,7 @@ import (
 	context "context"
 	"errors"
 	"fmt"
+	"sync"
 
 	"github.com/radius-project/radius/pkg/kubeutil"
 	store "github.com/radius-project/radius/pkg/ucp/store"
@@ -27,6 +28,8 @@ import (
 	ucpv1alpha1 "github.com/radius-project/radius/pkg/ucp/store/apiserverstore/api/ucp.dev/v1alpha1"
 	"github.com/radius-project/radius/pkg/ucp/store/cosmosdb"
 	"github.com/radius-project/radius/pkg/ucp/store/etcdstore"
+	"github.com/radius-project/radius/pkg/ucp/store/inmemory"
+	"github.com/radius-project/radius/pkg/ucp/store/sqlstore"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -38,6 +41,8 @@ var storageClientFactory = map[StorageProviderType]storageFactoryFunc{
 	TypeAPIServer: initAPIServerClient,
 	TypeCosmosDB:  initCosmosDBClient,
 	TypeETCD:      InitETCDClient,
+	TypeInMemory:  initInMemoryClient,
+	TypeSQL:       initSQLClient,
 }
 
 func initAPIServerClient(ctx context.Context, opt StorageProviderOptions, _ string) (store.StorageClient, error) {
@@ -69,7 +74,8 @@ func initAPIServerClient(ctx context.Context, opt StorageProviderOptions, _ stri
 		},
 	}
 
-	rc, err := runtimeclient.New(cfg, options)
+	// The watch client is required to support store.WatchableStorageClient.
+	rc, err := runtimeclient.NewWithWatch(cfg, options)
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize APIServer client: %w", err)
 	}
@@ -117,3 +123,43 @@ func InitETCDClient(ctx context.Context, opt StorageProviderOptions, _ string) (
 	etcdClient := etcdstore.NewETCDClient(client)
 	return etcdClient, nil
 }
+
+func initInMemoryClient(ctx context.Context, opt StorageProviderOptions, _ string) (store.StorageClient, error) {
+	if opt.InMemory.Client != nil {
+		return opt.InMemory.Client, nil
+	}
+
+	return inmemory.Default(), nil
+}
+
+var (
+	// sqlClients caches SQL clients by connection. All resource types share a single table, and sharing the client
+	// avoids opening multiple connection pools to the same database.
+	sqlClients   = map[string]*sqlstore.SQLClient{}
+	sqlClientsMu sync.Mutex
+)
+
+func initSQLClient(ctx context.Context, opt StorageProviderOptions, _ string) (store.StorageClient, error) {
+	sqlClientsMu.Lock()
+	defer sqlClientsMu.Unlock()
+
+	key := opt.SQL.Dialect + "|" + opt.SQL.ConnectionString
+	if client, ok := sqlClients[key]; ok {
+		return client, nil
+	}
+
+	dialect := sqlstore.Dialect(opt.SQL.Dialect)
+	db, err := sqlstore.Open(dialect, opt.SQL.ConnectionString)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize sql client: %w", err)
+	}
+
+	client := sqlstore.NewSQLClient(db, dialect)
+	if err = client.Init(ctx); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("failed to initialize sql client - configuration may be invalid: %w", err)
+	}
+
+	sqlClients[key] = client
+	return client, nil
+}
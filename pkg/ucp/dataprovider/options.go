@@ -18,6 +18,7 @@ package dataprovider
 
 import (
 	"github.com/radius-project/radius/pkg/ucp/hosting"
+	"github.com/radius-project/radius/pkg/ucp/store/inmemory"
 	etcdclient "go.etcd.io/etcd/client/v3"
 )
 
@@ -34,6 +35,12 @@ type StorageProviderOptions struct {
 
 	// ETCD configures options for the etcd store. Will be ignored if another store is configured.
 	ETCD ETCDOptions `yaml:"etcd,omitempty"`
+
+	// InMemory configures options for the in-memory store. Will be ignored if another store is configured.
+	InMemory InMemoryOptions `yaml:"inmemory,omitempty"`
+
+	// SQL configures options for the SQL database store. Will be ignored if another store is configured.
+	SQL SQLOptions `yaml:"sql,omitempty"`
 }
 
 // APIServerOptions represents options for the configuring the Kubernetes APIServer store.
@@ -65,3 +72,18 @@ type ETCDOptions struct {
 	// We need a way to share state between the etcd service and the things that want to consume it. This is that.
 	Client *hosting.AsyncValue[etcdclient.Client] `yaml:"-"`
 }
+
+type InMemoryOptions struct {
+	// Client is the in-memory store to use. The process-wide store is used when this is nil, which allows
+	// multiple services in the same process to share data. Tests should set this to get an isolated store.
+	Client *inmemory.Client `yaml:"-"`
+}
+
+type SQLOptions struct {
+	// Dialect configures the SQL database used by the store. Supported values are 'postgres' and 'sqlite'.
+	Dialect string `yaml:"dialect"`
+
+	// ConnectionString configures the connection string passed to the database driver. For SQLite this is the path to
+	// the database file.
+	ConnectionString string `yaml:"connectionString"`
+}
@@ -49,12 +49,21 @@ func NewStorageProvider(opts StorageProviderOptions) DataStorageProvider {
 
 // GetStorageClient checks if a StorageClient for the given resourceType already exists in the map, and
 // if so, returns it. If not, it creates a new StorageClient using the storageClientFactory and adds it to the map,
-// returning it. If an error occurs, it returns an error.
+// returning it. If an error occurs, it returns an error. Resource types share a StorageClient unless the provider
+// stores them in separate collections.
 func (p *storageProvider) GetStorageClient(ctx context.Context, resourceType string) (store.StorageClient, error) {
 	cn := util.NormalizeStringToLower(resourceType)
 
+	// Only CosmosDB stores each resource type in its own collection. The other providers store every resource type
+	// in the same place, so they share a single client. This allows callers to tell that two resource types are
+	// stored together, for example to write both in a single transaction.
+	key := cn
+	if p.options.Provider != TypeCosmosDB {
+		key = ""
+	}
+
 	p.clientsMu.RLock()
-	c, ok := p.clients[cn]
+	c, ok := p.clients[key]
 	p.clientsMu.RUnlock()
 	if ok {
 		return c, nil
@@ -73,12 +82,12 @@ func (p *storageProvider) GetStorageClient(ctx context.Context, resourceType str
 		p.clientsMu.Lock()
 		defer p.clientsMu.Unlock()
 
-		if c, ok := p.clients[cn]; ok {
+		if c, ok := p.clients[key]; ok {
 			return c, nil
 		}
 
 		if c, err = fn(ctx, p.options, cn); err == nil {
-			p.clients[cn] = c
+			p.clients[key] = c
 		}
 	} else {
 		err = ErrUnsupportedStorageProvider
@@ -34,6 +34,12 @@ const (
 
 	// TypeETCD represents the etcd provider.
 	TypeETCD StorageProviderType = "etcd"
+
+	// TypeInMemory represents the in-memory provider. This is not suitable for production use.
+	TypeInMemory StorageProviderType = "inmemory"
+
+	// TypeSQL represents the SQL database provider.
+	TypeSQL StorageProviderType = "sql"
 )
 
 //go:generate mockgen -destination=./mock_datastorage_provider.go -package=dataprovider -self_package github.com/radius-project/radius/pkg/ucp/dataprovider github.com/radius-project/radius/pkg/ucp/dataprovider DataStorageProvider
@@ -18,6 +18,7 @@ package api
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 
@@ -29,9 +30,11 @@ import (
 	"github.com/radius-project/radius/pkg/armrpc/frontend/server"
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
 	"github.com/radius-project/radius/pkg/ucp/datamodel/converter"
+	deadletter_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/deadletter"
 	kubernetes_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/kubernetes"
 	planes_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/planes"
 	"github.com/radius-project/radius/pkg/ucp/frontend/modules"
+	queue "github.com/radius-project/radius/pkg/ucp/queue/client"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 	"github.com/radius-project/radius/pkg/validator"
 )
@@ -39,6 +42,7 @@ import (
 const (
 	planeCollectionPath       = "/planes"
 	planeCollectionByTypePath = "/planes/{planeType}"
+	deadLetterPath            = "/admin/queues/{" + deadletter_ctrl.QueueNameParam + "}/deadletter"
 
 	// OperationTypeKubernetesOpenAPIV2Doc is the operation type for the required OpenAPI v2 discovery document.
 	//
@@ -58,6 +62,9 @@ const (
 
 	// OperationTypePlanes is the operation type for the planes (specific type) endpoints
 	OperationTypePlanesByType = "PLANESBYTYPE"
+
+	// OperationTypeDeadLetter is the operation type for the dead-letter queue admin endpoints.
+	OperationTypeDeadLetter = "DEADLETTER"
 )
 
 func initModules(ctx context.Context, modules []modules.Initializer) (map[string]http.Handler, []string, error) {
@@ -191,6 +198,54 @@ func Register(ctx context.Context, router chi.Router, planeModules []modules.Ini
 		},
 	}...)
 
+	// Configures the admin routes used to manage the dead-letter queues of async operations. These are not ARM
+	// resources, so the requests are not validated against the OpenAPI spec.
+	deadLetterRouter := server.NewSubrouter(router, options.PathBase+deadLetterPath)
+	getQueue := func(ctx context.Context, name string) (queue.Client, error) {
+		if options.QueueProvider == nil {
+			return nil, errors.New("queue provider is not configured")
+		}
+		return options.QueueProvider.GetNamedClient(ctx, name)
+	}
+
+	handlerOptions = append(handlerOptions, []server.HandlerOptions{
+		{
+			ParentRouter:  deadLetterRouter,
+			Method:        v1.OperationList,
+			OperationType: &v1.OperationType{Type: OperationTypeDeadLetter, Method: v1.OperationList},
+			ControllerFactory: func(opt controller.Options) (controller.Controller, error) {
+				return deadletter_ctrl.NewListMessages(opt, getQueue)
+			},
+		},
+		{
+			ParentRouter:  deadLetterRouter,
+			Path:          "/{" + deadletter_ctrl.MessageIDParam + "}",
+			Method:        v1.OperationGet,
+			OperationType: &v1.OperationType{Type: OperationTypeDeadLetter, Method: v1.OperationGet},
+			ControllerFactory: func(opt controller.Options) (controller.Controller, error) {
+				return deadletter_ctrl.NewGetMessage(opt, getQueue)
+			},
+		},
+		{
+			ParentRouter:  deadLetterRouter,
+			Path:          "/{" + deadletter_ctrl.MessageIDParam + "}",
+			Method:        v1.OperationDelete,
+			OperationType: &v1.OperationType{Type: OperationTypeDeadLetter, Method: v1.OperationDelete},
+			ControllerFactory: func(opt controller.Options) (controller.Controller, error) {
+				return deadletter_ctrl.NewPurgeMessage(opt, getQueue)
+			},
+		},
+		{
+			ParentRouter:  deadLetterRouter,
+			Path:          "/{" + deadletter_ctrl.MessageIDParam + "}/requeue",
+			Method:        v1.OperationPost,
+			OperationType: &v1.OperationType{Type: OperationTypeDeadLetter, Method: v1.OperationPost},
+			ControllerFactory: func(opt controller.Options) (controller.Controller, error) {
+				return deadletter_ctrl.NewRequeueMessage(opt, getQueue)
+			},
+		},
+	}...)
+
 	ctrlOptions := controller.Options{
 		Address:      options.Address,
 		PathBase:     options.PathBase,
@@ -0,0 +1,58 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deadletter
+
+import (
+	"context"
+	http "net/http"
+
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+)
+
+var _ armrpc_controller.Controller = (*ListMessages)(nil)
+
+// ListMessages is the controller implementation to list the messages in a dead-letter queue.
+type ListMessages struct {
+	armrpc_controller.BaseController
+	getQueue QueueGetter
+}
+
+// NewListMessages creates a new ListMessages controller.
+func NewListMessages(opts armrpc_controller.Options, getQueue QueueGetter) (armrpc_controller.Controller, error) {
+	return &ListMessages{armrpc_controller.NewBaseController(opts), getQueue}, nil
+}
+
+// Run returns the messages in the dead-letter queue named in the request URL.
+func (e *ListMessages) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
+	cli, resp, err := getDeadLetterClient(ctx, req, e.getQueue)
+	if resp != nil || err != nil {
+		return resp, err
+	}
+
+	messages, err := cli.ListDeadLetterMessages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := MessageList{Value: []Message{}}
+	for _, msg := range messages {
+		result.Value = append(result.Value, newMessage(msg))
+	}
+
+	return armrpc_rest.NewOKResponse(result), nil
+}
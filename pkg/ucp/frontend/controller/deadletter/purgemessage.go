@@ -0,0 +1,60 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deadletter
+
+import (
+	"context"
+	http "net/http"
+
+	"github.com/go-chi/chi/v5"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+var _ armrpc_controller.Controller = (*PurgeMessage)(nil)
+
+// PurgeMessage is the controller implementation to delete a message from a dead-letter queue.
+type PurgeMessage struct {
+	armrpc_controller.BaseController
+	getQueue QueueGetter
+}
+
+// NewPurgeMessage creates a new PurgeMessage controller.
+func NewPurgeMessage(opts armrpc_controller.Options, getQueue QueueGetter) (armrpc_controller.Controller, error) {
+	return &PurgeMessage{armrpc_controller.NewBaseController(opts), getQueue}, nil
+}
+
+// Run deletes the message with the id in the request URL from the dead-letter queue, or returns a NotFound response if
+// the message is not in the dead-letter queue.
+func (e *PurgeMessage) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
+	cli, resp, err := getDeadLetterClient(ctx, req, e.getQueue)
+	if resp != nil || err != nil {
+		return resp, err
+	}
+
+	id := chi.URLParam(req, MessageIDParam)
+	err = cli.PurgeDeadLetterMessage(ctx, id)
+	if resp := messageNotFoundResponse(req, err); resp != nil {
+		return resp, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	ucplog.FromContextOrDiscard(ctx).Info("Purged dead-lettered message.", "queueName", chi.URLParam(req, QueueNameParam), "messageID", id)
+	return armrpc_rest.NewNoContentResponse(), nil
+}
@@ -0,0 +1,47 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deadletter
+
+import (
+	"context"
+	http "net/http"
+	"testing"
+
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_PurgeMessage(t *testing.T) {
+	cli, msg, getQueue := setupDeadLetter(t)
+
+	ctrl, err := NewPurgeMessage(armrpc_controller.Options{}, getQueue)
+	require.NoError(t, err)
+
+	ctx, req := newTestRequest(t, http.MethodDelete, testQueueName, msg.ID)
+	resp, err := ctrl.Run(ctx, nil, req)
+	require.NoError(t, err)
+	require.Equal(t, armrpc_rest.NewNoContentResponse(), resp)
+
+	messages, err := cli.ListDeadLetterMessages(context.Background())
+	require.NoError(t, err)
+	require.Empty(t, messages)
+
+	resp, err = ctrl.Run(ctx, nil, req)
+	require.NoError(t, err)
+	require.IsType(t, &armrpc_rest.NotFoundResponse{}, resp)
+}
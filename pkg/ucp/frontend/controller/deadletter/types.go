@@ -0,0 +1,120 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package deadletter contains the UCP admin controllers used to manage the dead-letter queues of async operations.
+// Async operations that could not be processed, for example because they were delivered more than the maximum
+// number of times, are moved to the dead-letter queue of the service that owns them. These controllers let operators
+// list, inspect, requeue or purge those operations.
+package deadletter
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	queue "github.com/radius-project/radius/pkg/ucp/queue/client"
+)
+
+const (
+	// QueueNameParam is the name of the URL parameter containing the name of the queue.
+	QueueNameParam = "queueName"
+
+	// MessageIDParam is the name of the URL parameter containing the id of the message.
+	MessageIDParam = "messageID"
+)
+
+// QueueGetter gets the client for the queue with the given name.
+type QueueGetter func(ctx context.Context, name string) (queue.Client, error)
+
+// Message is the representation of a dead-lettered message returned by the admin API.
+type Message struct {
+	// ID is the id of the message.
+	ID string `json:"id"`
+
+	// DequeueCount is the number of times the message was delivered before it was dead-lettered.
+	DequeueCount int `json:"dequeueCount"`
+
+	// EnqueueAt is the time when the message was enqueued.
+	EnqueueAt time.Time `json:"enqueueAt"`
+
+	// DeadLetteredAt is the time when the message was moved to the dead-letter queue.
+	DeadLetteredAt time.Time `json:"deadLetteredAt"`
+
+	// Reason describes why the message was moved to the dead-letter queue.
+	Reason string `json:"reason"`
+
+	// Data is the body of the message. For async operations this is the operation request, which includes the
+	// operation id, operation type and resource id.
+	Data json.RawMessage `json:"data,omitempty"`
+}
+
+// MessageList is the list of dead-lettered messages returned by the admin API.
+type MessageList struct {
+	// Value is the list of messages.
+	Value []Message `json:"value"`
+}
+
+func newMessage(msg *queue.Message) Message {
+	result := Message{
+		ID:             msg.ID,
+		DequeueCount:   msg.DequeueCount,
+		EnqueueAt:      msg.EnqueueAt,
+		DeadLetteredAt: msg.DeadLetteredAt,
+		Reason:         msg.DeadLetterReason,
+	}
+
+	if json.Valid(msg.Data) {
+		result.Data = json.RawMessage(msg.Data)
+	} else if data, err := json.Marshal(string(msg.Data)); err == nil {
+		result.Data = data
+	}
+
+	return result
+}
+
+// getDeadLetterClient gets the dead-letter client for the queue named in the request URL. A response is returned
+// instead if the queue does not support dead-lettering.
+func getDeadLetterClient(ctx context.Context, req *http.Request, getQueue QueueGetter) (queue.DeadLetterClient, armrpc_rest.Response, error) {
+	name := chi.URLParam(req, QueueNameParam)
+	if name == "" {
+		return nil, armrpc_rest.NewBadRequestResponse("queue name is required"), nil
+	}
+
+	cli, err := getQueue(ctx, name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dl, ok := cli.(queue.DeadLetterClient)
+	if !ok {
+		return nil, armrpc_rest.NewBadRequestResponse(queue.ErrDeadLetterNotSupported.Error()), nil
+	}
+
+	return dl, nil, nil
+}
+
+// messageNotFoundResponse returns a NotFound response if err is ErrDeadLetterMessageNotFound.
+func messageNotFoundResponse(req *http.Request, err error) armrpc_rest.Response {
+	if errors.Is(err, queue.ErrDeadLetterMessageNotFound) {
+		return armrpc_rest.NewNotFoundMessageResponse("the message '" + chi.URLParam(req, MessageIDParam) + "' was not found in the dead-letter queue")
+	}
+
+	return nil
+}
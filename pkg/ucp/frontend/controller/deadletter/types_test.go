@@ -0,0 +1,100 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deadletter
+
+import (
+	"context"
+	"errors"
+	http "net/http"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/golang/mock/gomock"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	queue "github.com/radius-project/radius/pkg/ucp/queue/client"
+	"github.com/radius-project/radius/pkg/ucp/queue/inmemory"
+	"github.com/stretchr/testify/require"
+)
+
+const testQueueName = "radius"
+
+// setupDeadLetter returns a queue containing one dead-lettered message and a QueueGetter for it.
+func setupDeadLetter(t *testing.T) (*inmemory.Client, *queue.Message, QueueGetter) {
+	ctx := context.Background()
+	cli := inmemory.New(inmemory.NewInMemQueue(time.Minute))
+
+	require.NoError(t, cli.Enqueue(ctx, queue.NewMessage(map[string]any{"operationType": "APPLICATIONS.CORE/CONTAINERS|PUT"})))
+	msg, err := cli.Dequeue(ctx, queue.QueueClientConfig{})
+	require.NoError(t, err)
+	require.NoError(t, cli.DeadLetterMessage(ctx, msg, "poison message"))
+
+	getQueue := func(ctx context.Context, name string) (queue.Client, error) {
+		require.Equal(t, testQueueName, name)
+		return cli, nil
+	}
+
+	return cli, msg, getQueue
+}
+
+// newTestRequest creates a request with the chi URL parameters used by the dead-letter controllers.
+func newTestRequest(t *testing.T, method string, queueName string, messageID string) (context.Context, *http.Request) {
+	req, err := http.NewRequest(method, "/admin/queues/"+queueName+"/deadletter/"+messageID, nil)
+	require.NoError(t, err)
+
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add(QueueNameParam, queueName)
+	rctx.URLParams.Add(MessageIDParam, messageID)
+	req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+	return rpctest.NewARMRequestContext(req), req
+}
+
+func Test_GetDeadLetterClient(t *testing.T) {
+	t.Run("queue name is required", func(t *testing.T) {
+		ctx, req := newTestRequest(t, http.MethodGet, "", "")
+		_, resp, err := getDeadLetterClient(ctx, req, nil)
+		require.NoError(t, err)
+		require.IsType(t, &armrpc_rest.BadRequestResponse{}, resp)
+	})
+
+	t.Run("queue error", func(t *testing.T) {
+		ctx, req := newTestRequest(t, http.MethodGet, testQueueName, "")
+		_, _, err := getDeadLetterClient(ctx, req, func(ctx context.Context, name string) (queue.Client, error) {
+			return nil, errors.New("failed")
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("dead-lettering not supported", func(t *testing.T) {
+		mctrl := gomock.NewController(t)
+		ctx, req := newTestRequest(t, http.MethodGet, testQueueName, "")
+		_, resp, err := getDeadLetterClient(ctx, req, func(ctx context.Context, name string) (queue.Client, error) {
+			return queue.NewMockClient(mctrl), nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, armrpc_rest.NewBadRequestResponse(queue.ErrDeadLetterNotSupported.Error()), resp)
+	})
+}
+
+func Test_NewMessage(t *testing.T) {
+	msg := newMessage(&queue.Message{Metadata: queue.Metadata{ID: "id", DeadLetterReason: "reason"}, Data: []byte("not json")})
+	require.Equal(t, "id", msg.ID)
+	require.Equal(t, "reason", msg.Reason)
+	require.JSONEq(t, `"not json"`, string(msg.Data))
+}
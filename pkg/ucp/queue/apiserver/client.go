@@ -49,6 +49,13 @@ limitations under the License.
 // and checks if its dequeue count matches the dequeue count of Message Client A currently have. We are using DequeueCount as a
 // revision number of message here. If it is mismatched, it means that Client B already leased the message. In this case,
 // ExtendMessage returns ErrDequeuedMessage to prevent Client A from extending lock.
+//
+// Dequeuers are notified of new messages by watching QueueMessage CRs when the Kubernetes client supports watches. A
+// notification is sent when a message is created or becomes visible again, for example when it is requeued from the
+// dead-letter queue.
+//
+// Dead-lettered messages keep their QueueMessage CR and are marked with the `ucp.dev/deadletter` label, which excludes
+// them from Dequeue. The reason and time are stored in annotations so that the message can be inspected later.
 
 package apiserver
 
@@ -63,10 +70,12 @@ import (
 	"github.com/radius-project/radius/pkg/ucp/queue/client"
 
 	v1alpha1 "github.com/radius-project/radius/pkg/ucp/store/apiserverstore/api/ucp.dev/v1alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/util/retry"
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -76,12 +85,20 @@ const (
 	LabelQueueName = "ucp.dev/queuename"
 	// LabelNextVisibleAt is the label representing the time when message is visible in the queue or requeued.
 	LabelNextVisibleAt = "ucp.dev/nextvisibleat"
+	// LabelDeadLetter is the label marking messages that were moved to the dead-letter queue.
+	LabelDeadLetter = "ucp.dev/deadletter"
+
+	// AnnotationDeadLetterReason is the annotation representing why the message was moved to the dead-letter queue.
+	AnnotationDeadLetterReason = "ucp.dev/deadletterreason"
+	// AnnotationDeadLetteredAt is the annotation representing when the message was moved to the dead-letter queue.
+	AnnotationDeadLetteredAt = "ucp.dev/deadletteredat"
 
 	defaultMessageLockDuration = time.Duration(5) * time.Minute
 	defaultExpiryDuration      = time.Duration(10) * time.Hour
 )
 
-var _ client.Client = (*Client)(nil)
+var _ client.DeadLetterClient = (*Client)(nil)
+var _ client.Notifier = (*Client)(nil)
 
 // Client is the queue client used for dev and test purpose.
 type Client struct {
@@ -125,6 +142,10 @@ func copyMessage(msg *client.Message, queueMessage *v1alpha1.QueueMessage) {
 		ExpireAt:      queueMessage.Spec.ExpireAt.Time,
 		NextVisibleAt: getTimeFromString(queueMessage.Labels[LabelNextVisibleAt]),
 	}
+	if _, ok := queueMessage.Labels[LabelDeadLetter]; ok {
+		msg.DeadLetterReason = queueMessage.Annotations[AnnotationDeadLetterReason]
+		msg.DeadLetteredAt = getTimeFromString(queueMessage.Annotations[AnnotationDeadLetteredAt]).UTC()
+	}
 	msg.ContentType = client.JSONContentType
 	msg.Data = make([]byte, len(queueMessage.Spec.Data.Raw))
 	copy(msg.Data, queueMessage.Spec.Data.Raw)
@@ -208,8 +229,103 @@ func newMessageLabelSelector(now time.Time, name string) (labels.Selector, error
 	if err != nil {
 		return nil, err
 	}
+	selector = selector.Add(*nameLabel)
 
-	return selector.Add(*nameLabel), nil
+	// Dead-lettered messages are never dequeued.
+	deadLetterLabel, err := labels.NewRequirement(LabelDeadLetter, selection.DoesNotExist, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return selector.Add(*deadLetterLabel), nil
+}
+
+func newDeadLetterLabelSelector(name string) (labels.Selector, error) {
+	nameLabel, err := labels.NewRequirement(LabelQueueName, selection.Equals, []string{name})
+	if err != nil {
+		return nil, err
+	}
+
+	deadLetterLabel, err := labels.NewRequirement(LabelDeadLetter, selection.Exists, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return labels.NewSelector().Add(*nameLabel, *deadLetterLabel), nil
+}
+
+func newNotifyLabelSelector(name string) (labels.Selector, error) {
+	nameLabel, err := labels.NewRequirement(LabelQueueName, selection.Equals, []string{name})
+	if err != nil {
+		return nil, err
+	}
+
+	deadLetterLabel, err := labels.NewRequirement(LabelDeadLetter, selection.DoesNotExist, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return labels.NewSelector().Add(*nameLabel, *deadLetterLabel), nil
+}
+
+// Notify watches the QueueMessage CRs of the queue and returns a channel which receives a value when a message is
+// created or becomes visible. The underlying Kubernetes client must implement runtimeclient.WithWatch. The channel is
+// closed when ctx is done or when the watch is terminated by the API server.
+func (c *Client) Notify(ctx context.Context) (<-chan struct{}, error) {
+	wc, ok := c.client.(runtimeclient.WithWatch)
+	if !ok {
+		return nil, errors.New("the Kubernetes client does not support watches")
+	}
+
+	selector, err := newNotifyLabelSelector(c.opts.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	watcher, err := wc.Watch(ctx, &v1alpha1.QueueMessageList{}, runtimeclient.InNamespace(c.opts.Namespace), runtimeclient.MatchingLabelsSelector{Selector: selector})
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan struct{}, 1)
+	go func() {
+		defer close(out)
+		defer watcher.Stop()
+
+		for {
+			var event watch.Event
+			select {
+			case <-ctx.Done():
+				return
+			case e, ok := <-watcher.ResultChan():
+				if !ok {
+					return
+				}
+				event = e
+			}
+
+			switch event.Type {
+			case watch.Added, watch.Modified:
+				// Dequeue and ExtendMessage also modify the message, but they move NextVisibleAt into the future.
+				qm, ok := event.Object.(*v1alpha1.QueueMessage)
+				if !ok || getTimeFromString(qm.Labels[LabelNextVisibleAt]).After(time.Now()) {
+					continue
+				}
+			case watch.Error:
+				return
+			default:
+				continue
+			}
+
+			select {
+			case out <- struct{}{}:
+			default:
+				// The dequeuer already has a pending notification.
+			}
+		}
+	}()
+
+	return out, nil
 }
 
 // getQueueMessage fetches the first item which is the message in the current queue. We can
@@ -260,6 +376,11 @@ func (c *Client) extendItem(ctx context.Context, id string, expectedDequeueCount
 			return client.ErrDequeuedMessage
 		}
 
+		// A dead-lettered message can't be leased or extended.
+		if _, ok := result.Labels[LabelDeadLetter]; ok {
+			return client.ErrInvalidMessage
+		}
+
 		nsec := mustParseInt64(result.Labels[LabelNextVisibleAt])
 
 		// Check if the message is already requeued. This condition is required for ExtendMessage because we cannot extend the message which was requeued.
@@ -330,6 +451,11 @@ func (c *Client) FinishMessage(ctx context.Context, msg *client.Message) error {
 			return getErr
 		}
 
+		// Ensure that it doesn't delete the message that another client leased after the lease of this client expired.
+		if result.Spec.DequeueCount != msg.DequeueCount {
+			return client.ErrDequeuedMessage
+		}
+
 		options := &runtimeclient.DeleteOptions{
 			Preconditions: &metav1.Preconditions{
 				UID:             &result.UID,
@@ -356,3 +482,130 @@ func (c *Client) ExtendMessage(ctx context.Context, msg *client.Message) error {
 	copyMessage(msg, result)
 	return nil
 }
+
+// DeadLetterMessage moves the message to the dead-letter queue.
+func (c *Client) DeadLetterMessage(ctx context.Context, msg *client.Message, reason string) error {
+	if msg == nil {
+		return client.ErrEmptyMessage
+	}
+
+	now := time.Now()
+	result := &v1alpha1.QueueMessage{}
+	retryErr := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		getErr := c.client.Get(ctx, runtimeclient.ObjectKey{Namespace: c.opts.Namespace, Name: msg.ID}, result)
+		if apierrors.IsNotFound(getErr) {
+			return client.ErrInvalidMessage
+		} else if getErr != nil {
+			return getErr
+		}
+
+		if _, ok := result.Labels[LabelDeadLetter]; ok {
+			return client.ErrInvalidMessage
+		}
+
+		result.Labels[LabelDeadLetter] = "true"
+		if result.Annotations == nil {
+			result.Annotations = map[string]string{}
+		}
+		result.Annotations[AnnotationDeadLetterReason] = reason
+		result.Annotations[AnnotationDeadLetteredAt] = int64toa(now.UnixNano())
+
+		return c.client.Update(ctx, result)
+	})
+	if retryErr != nil {
+		return retryErr
+	}
+
+	copyMessage(msg, result)
+	return nil
+}
+
+// ListDeadLetterMessages lists the messages in the dead-letter queue.
+func (c *Client) ListDeadLetterMessages(ctx context.Context) ([]*client.Message, error) {
+	selector, err := newDeadLetterLabelSelector(c.opts.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	ql := &v1alpha1.QueueMessageList{}
+	err = c.client.List(ctx, ql, runtimeclient.InNamespace(c.opts.Namespace), runtimeclient.MatchingLabelsSelector{Selector: selector})
+	if err != nil {
+		return nil, err
+	}
+
+	messages := []*client.Message{}
+	for i := range ql.Items {
+		msg := &client.Message{}
+		copyMessage(msg, &ql.Items[i])
+		messages = append(messages, msg)
+	}
+
+	return messages, nil
+}
+
+// getDeadLetterItem gets the dead-lettered QueueMessage with the given id.
+func (c *Client) getDeadLetterItem(ctx context.Context, id string) (*v1alpha1.QueueMessage, error) {
+	result := &v1alpha1.QueueMessage{}
+	err := c.client.Get(ctx, runtimeclient.ObjectKey{Namespace: c.opts.Namespace, Name: id}, result)
+	if apierrors.IsNotFound(err) {
+		return nil, client.ErrDeadLetterMessageNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	if _, ok := result.Labels[LabelDeadLetter]; !ok || result.Labels[LabelQueueName] != c.opts.Name {
+		return nil, client.ErrDeadLetterMessageNotFound
+	}
+
+	return result, nil
+}
+
+// GetDeadLetterMessage gets the message with the given id from the dead-letter queue.
+func (c *Client) GetDeadLetterMessage(ctx context.Context, id string) (*client.Message, error) {
+	result, err := c.getDeadLetterItem(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &client.Message{}
+	copyMessage(msg, result)
+	return msg, nil
+}
+
+// RequeueDeadLetterMessage moves the message from the dead-letter queue back to the queue and resets its dequeue count.
+func (c *Client) RequeueDeadLetterMessage(ctx context.Context, id string) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		result, err := c.getDeadLetterItem(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		delete(result.Labels, LabelDeadLetter)
+		delete(result.Annotations, AnnotationDeadLetterReason)
+		delete(result.Annotations, AnnotationDeadLetteredAt)
+		result.Labels[LabelNextVisibleAt] = int64toa(now.UnixNano())
+		result.Spec.DequeueCount = 0
+		result.Spec.ExpireAt = metav1.Time{Time: now.Add(c.opts.ExpiryDuration).UTC()}
+
+		return c.client.Update(ctx, result)
+	})
+}
+
+// PurgeDeadLetterMessage deletes the message from the dead-letter queue.
+func (c *Client) PurgeDeadLetterMessage(ctx context.Context, id string) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		result, err := c.getDeadLetterItem(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		options := &runtimeclient.DeleteOptions{
+			Preconditions: &metav1.Preconditions{
+				UID:             &result.UID,
+				ResourceVersion: &result.ResourceVersion,
+			},
+		}
+		return c.client.Delete(ctx, result, options)
+	})
+}
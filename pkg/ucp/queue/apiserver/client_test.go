@@ -31,6 +31,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 func TestMustParseInt64(t *testing.T) {
@@ -87,6 +88,34 @@ func TestCopyMessage(t *testing.T) {
 	require.Equal(t, getTimeFromString(queueM.ObjectMeta.Labels[LabelNextVisibleAt]), msg.NextVisibleAt)
 }
 
+func TestCopyMessage_DeadLetter(t *testing.T) {
+	now := time.Now()
+	queueM := &v1alpha1.QueueMessage{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "applications.core.10101010",
+			Labels: map[string]string{
+				LabelNextVisibleAt: int64toa(now.UnixNano()),
+				LabelQueueName:     "applications.core",
+				LabelDeadLetter:    "true",
+			},
+			Annotations: map[string]string{
+				AnnotationDeadLetterReason: "poison message",
+				AnnotationDeadLetteredAt:   int64toa(now.UnixNano()),
+			},
+		},
+		Spec: v1alpha1.QueueMessageSpec{
+			DequeueCount: 4,
+			Data:         &runtime.RawExtension{Raw: []byte("hello world")},
+		},
+	}
+
+	msg := &client.Message{}
+	copyMessage(msg, queueM)
+
+	require.Equal(t, "poison message", msg.DeadLetterReason)
+	require.Equal(t, now.UnixNano(), msg.DeadLetteredAt.UnixNano())
+}
+
 func TestGenerateID(t *testing.T) {
 	cli, err := New(nil, Options{Name: "applications.core", Namespace: "test"})
 	require.NoError(t, err)
@@ -96,6 +125,69 @@ func TestGenerateID(t *testing.T) {
 	require.Equal(t, 61, len(id))
 }
 
+func TestNotify(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+	rc := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	ctx, cancel := testcontext.NewWithCancel(t)
+	defer cancel()
+
+	cli, err := New(rc, Options{Name: "applications.core", Namespace: "radius-test"})
+	require.NoError(t, err)
+
+	notifyCh, err := cli.Notify(ctx)
+	require.NoError(t, err)
+
+	// Leasing a message must not notify the dequeuers.
+	leased := &v1alpha1.QueueMessage{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "applications.core.leased",
+			Namespace: "radius-test",
+			Labels: map[string]string{
+				LabelNextVisibleAt: int64toa(time.Now().Add(time.Hour).UnixNano()),
+				LabelQueueName:     "applications.core",
+			},
+		},
+	}
+	require.NoError(t, rc.Create(ctx, leased))
+
+	select {
+	case <-notifyCh:
+		require.Fail(t, "unexpected notification for a leased message")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	require.NoError(t, cli.Enqueue(ctx, client.NewMessage("{}")))
+
+	select {
+	case _, ok := <-notifyCh:
+		require.True(t, ok)
+	case <-time.After(10 * time.Second):
+		require.Fail(t, "timed out waiting for the notification")
+	}
+
+	cancel()
+	require.Eventually(t, func() bool {
+		_, ok := <-notifyCh
+		return !ok
+	}, 10*time.Second, 10*time.Millisecond)
+}
+
+func TestNotify_Unsupported(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+
+	// Hide the Watch method of the fake client.
+	rc := struct{ runtimeclient.Client }{fake.NewClientBuilder().WithScheme(scheme).Build()}
+
+	cli, err := New(rc, Options{Name: "applications.core", Namespace: "radius-test"})
+	require.NoError(t, err)
+
+	_, err = cli.Notify(testcontext.New(t))
+	require.Error(t, err)
+}
+
 func TestClient(t *testing.T) {
 	rc, env, err := kubeenv.StartEnvironment([]string{filepath.Join("..", "..", "..", "..", "deploy", "Chart", "crds", "ucpd")})
 
@@ -120,6 +212,8 @@ func TestClient(t *testing.T) {
 	}
 
 	sharedtest.RunTest(t, cli, clear)
+	sharedtest.RunDeadLetterTest(t, cli, clear)
+	sharedtest.RunNotifyTest(t, cli, clear)
 
 	t.Run("ExtendMessage is failed when machine's clock is skewed", func(t *testing.T) {
 		clear(t)
@@ -58,11 +58,34 @@ type Client interface {
 	ExtendMessage(ctx context.Context, msg *Message) error
 }
 
+// Notifier is an optional interface which can be implemented by queue clients to notify dequeuers as soon as a
+// message may be available, instead of waiting for the next polling interval.
+type Notifier interface {
+	// Notify returns a channel which receives a value when a message may be available to dequeue. Notifications can be
+	// coalesced or spurious, so the receiver must dequeue until the queue is empty. The channel is closed when ctx is
+	// done or when the client can no longer deliver notifications.
+	Notify(ctx context.Context) (<-chan struct{}, error)
+}
+
 // StartDequeuer starts a dequeuer to consume the message from the queue and return the output channel.
+//
+// If the client implements Notifier, the dequeuer wakes up as soon as it is notified and keeps dequeuing until the queue
+// is empty. Polling every DequeueIntervalDuration is kept as a fallback, for example to pick up messages whose lock has
+// expired or when the notification channel is closed.
 func StartDequeuer(ctx context.Context, cli Client, opts ...DequeueOptions) (<-chan *Message, error) {
 	log := ucplog.FromContextOrDiscard(ctx)
 	out := make(chan *Message, 1)
 
+	var notifyCh <-chan struct{}
+	if notifier, ok := cli.(Notifier); ok {
+		ch, err := notifier.Notify(ctx)
+		if err != nil {
+			log.Error(err, "fails to subscribe to queue notifications, falling back to polling")
+		} else {
+			notifyCh = ch
+		}
+	}
+
 	go func() {
 		for {
 			var queueconfig QueueClientConfig
@@ -72,6 +95,11 @@ func StartDequeuer(ctx context.Context, cli Client, opts ...DequeueOptions) (<-c
 			msg, err := cli.Dequeue(ctx, queueconfig)
 			if err == nil {
 				out <- msg
+
+				// Notifications are coalesced, so drain the queue before waiting for the next one.
+				if notifyCh != nil && ctx.Err() == nil {
+					continue
+				}
 			} else if !errors.Is(err, ErrMessageNotFound) {
 				log.Error(err, "fails to dequeue the message")
 			}
@@ -80,9 +108,13 @@ func StartDequeuer(ctx context.Context, cli Client, opts ...DequeueOptions) (<-c
 			case <-ctx.Done():
 				close(out)
 				return
+			case _, ok := <-notifyCh:
+				if !ok {
+					log.Info("queue notification channel is closed, falling back to polling")
+					notifyCh = nil
+				}
 			case <-time.After(queueconfig.DequeueIntervalDuration):
 			}
-
 		}
 	}()
 
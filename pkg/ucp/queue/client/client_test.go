@@ -79,3 +79,79 @@ func TestStartDequeuer(t *testing.T) {
 
 	require.Equal(t, 1, recvCnt)
 }
+
+type notifyingClient struct {
+	*MockClient
+	notifyCh chan struct{}
+}
+
+func (c *notifyingClient) Notify(ctx context.Context) (<-chan struct{}, error) {
+	return c.notifyCh, nil
+}
+
+func TestStartDequeuer_Notify(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	defer mctrl.Finish()
+
+	cli := &notifyingClient{MockClient: NewMockClient(mctrl), notifyCh: make(chan struct{}, 1)}
+
+	// The first attempt finds the queue empty, the next one is triggered by the notification.
+	firstCall := cli.EXPECT().Dequeue(gomock.Any(), gomock.Any()).Return(nil, ErrMessageNotFound)
+	secondCall := cli.EXPECT().Dequeue(gomock.Any(), gomock.Any()).Return(&Message{Metadata: Metadata{ID: "testID"}}, nil).After(firstCall)
+	cli.EXPECT().Dequeue(gomock.Any(), gomock.Any()).Return(nil, ErrMessageNotFound).AnyTimes().After(secondCall)
+
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+
+	// The polling interval is long enough that only the notification can deliver the message.
+	msgCh, err := StartDequeuer(ctx, cli, WithDequeueInterval(time.Hour))
+	require.NoError(t, err)
+
+	cli.notifyCh <- struct{}{}
+
+	select {
+	case msg := <-msgCh:
+		require.Equal(t, "testID", msg.ID)
+	case <-time.After(10 * time.Second):
+		require.Fail(t, "timed out waiting for the notified message")
+	}
+}
+
+func TestStartDequeuer_NotifyClosed(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	defer mctrl.Finish()
+
+	cli := &notifyingClient{MockClient: NewMockClient(mctrl), notifyCh: make(chan struct{})}
+	close(cli.notifyCh)
+
+	// The dequeuer falls back to polling once the notification channel is closed.
+	firstCall := cli.EXPECT().Dequeue(gomock.Any(), gomock.Any()).Return(nil, ErrMessageNotFound).Times(2)
+	secondCall := cli.EXPECT().Dequeue(gomock.Any(), gomock.Any()).Return(&Message{Metadata: Metadata{ID: "testID"}}, nil).After(firstCall)
+	cli.EXPECT().Dequeue(gomock.Any(), gomock.Any()).Return(nil, ErrMessageNotFound).AnyTimes().After(secondCall)
+
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+
+	msgCh, err := StartDequeuer(ctx, cli, WithDequeueInterval(defaultTestDequeueInterval))
+	require.NoError(t, err)
+
+	select {
+	case msg := <-msgCh:
+		require.Equal(t, "testID", msg.ID)
+	case <-time.After(10 * time.Second):
+		require.Fail(t, "timed out waiting for the polled message")
+	}
+}
+
+func TestDeadLetterMessage_NotSupported(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	defer mctrl.Finish()
+
+	// Queues that don't support dead-lettering finish the message instead.
+	msg := &Message{Metadata: Metadata{ID: "test"}}
+	mockCli := NewMockClient(mctrl)
+	mockCli.EXPECT().FinishMessage(gomock.Any(), msg).Return(nil).Times(1)
+
+	err := DeadLetterMessage(context.Background(), mockCli, msg, "reason")
+	require.NoError(t, err)
+}
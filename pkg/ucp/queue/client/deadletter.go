@@ -0,0 +1,63 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	// ErrDeadLetterMessageNotFound represents the error when the message is not in the dead-letter queue.
+	ErrDeadLetterMessageNotFound = errors.New("message is not in the dead-letter queue")
+
+	// ErrDeadLetterNotSupported represents the error when the queue client does not support dead-lettering.
+	ErrDeadLetterNotSupported = errors.New("queue does not support dead-lettering")
+)
+
+// DeadLetterClient is implemented by queue clients that can move messages that cannot be processed to a dead-letter
+// queue. Dead-lettered messages are never dequeued, and are kept until they are requeued or purged.
+type DeadLetterClient interface {
+	Client
+
+	// DeadLetterMessage moves a dequeued message to the dead-letter queue. reason describes why the message could not
+	// be processed.
+	DeadLetterMessage(ctx context.Context, msg *Message, reason string) error
+
+	// ListDeadLetterMessages lists the messages in the dead-letter queue.
+	ListDeadLetterMessages(ctx context.Context) ([]*Message, error)
+
+	// GetDeadLetterMessage gets the message with the given id from the dead-letter queue.
+	GetDeadLetterMessage(ctx context.Context, id string) (*Message, error)
+
+	// RequeueDeadLetterMessage moves the message with the given id from the dead-letter queue back to the queue. The
+	// dequeue count of the message is reset.
+	RequeueDeadLetterMessage(ctx context.Context, id string) error
+
+	// PurgeDeadLetterMessage deletes the message with the given id from the dead-letter queue.
+	PurgeDeadLetterMessage(ctx context.Context, id string) error
+}
+
+// DeadLetterMessage moves the message to the dead-letter queue if the client supports dead-lettering. Otherwise the
+// message is finished so that it is not processed again.
+func DeadLetterMessage(ctx context.Context, cli Client, msg *Message, reason string) error {
+	if dl, ok := cli.(DeadLetterClient); ok {
+		return dl.DeadLetterMessage(ctx, msg, reason)
+	}
+
+	return cli.FinishMessage(ctx, msg)
+}
@@ -46,6 +46,10 @@ type Metadata struct {
 	ExpireAt time.Time
 	// NextVisibleAt represents the next visible time after dequeuing the message.
 	NextVisibleAt time.Time
+	// DeadLetterReason represents the reason why the message was moved to the dead-letter queue.
+	DeadLetterReason string
+	// DeadLetteredAt represents the time when the message was moved to the dead-letter queue.
+	DeadLetteredAt time.Time
 }
 
 // NewMessage creates Message.
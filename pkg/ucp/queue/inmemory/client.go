@@ -24,7 +24,8 @@ import (
 )
 
 var namedQueue = &sync.Map{}
-var _ client.Client = (*Client)(nil)
+var _ client.DeadLetterClient = (*Client)(nil)
+var _ client.Notifier = (*Client)(nil)
 
 // Client is the queue client used for dev and test purpose.
 type Client struct {
@@ -68,6 +69,18 @@ func (c *Client) Dequeue(ctx context.Context, opts client.QueueClientConfig) (*c
 	return msg, nil
 }
 
+// Notify returns a channel which receives a value when a message is enqueued to the in-memory queue. The channel is
+// closed when ctx is done.
+func (c *Client) Notify(ctx context.Context) (<-chan struct{}, error) {
+	ch, unsubscribe := c.queue.Subscribe()
+	go func() {
+		<-ctx.Done()
+		unsubscribe()
+	}()
+
+	return ch, nil
+}
+
 // FinishMessage finishes or deletes the message in the queue.
 func (c *Client) FinishMessage(ctx context.Context, msg *client.Message) error {
 	if msg == nil {
@@ -89,3 +102,46 @@ func (c *Client) ExtendMessage(ctx context.Context, msg *client.Message) error {
 	}
 	return err
 }
+
+// DeadLetterMessage moves the message to the dead-letter queue.
+func (c *Client) DeadLetterMessage(ctx context.Context, msg *client.Message, reason string) error {
+	if msg == nil {
+		return client.ErrEmptyMessage
+	}
+
+	return c.queue.DeadLetter(msg, reason)
+}
+
+// ListDeadLetterMessages lists the messages in the dead-letter queue.
+func (c *Client) ListDeadLetterMessages(ctx context.Context) ([]*client.Message, error) {
+	return c.queue.DeadLetters(), nil
+}
+
+// GetDeadLetterMessage gets the message with the given id from the dead-letter queue.
+func (c *Client) GetDeadLetterMessage(ctx context.Context, id string) (*client.Message, error) {
+	for _, msg := range c.queue.DeadLetters() {
+		if msg.ID == id {
+			return msg, nil
+		}
+	}
+
+	return nil, client.ErrDeadLetterMessageNotFound
+}
+
+// RequeueDeadLetterMessage moves the message from the dead-letter queue back to the queue.
+func (c *Client) RequeueDeadLetterMessage(ctx context.Context, id string) error {
+	if c.queue.RemoveDeadLetter(id, true) == nil {
+		return client.ErrDeadLetterMessageNotFound
+	}
+
+	return nil
+}
+
+// PurgeDeadLetterMessage deletes the message from the dead-letter queue.
+func (c *Client) PurgeDeadLetterMessage(ctx context.Context, id string) error {
+	if c.queue.RemoveDeadLetter(id, false) == nil {
+		return client.ErrDeadLetterMessageNotFound
+	}
+
+	return nil
+}
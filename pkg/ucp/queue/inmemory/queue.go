@@ -43,12 +43,20 @@ type InmemQueue struct {
 	v   *list.List
 	vMu sync.Mutex
 
+	// deadLetters holds the dead-lettered messages. It is protected by vMu.
+	deadLetters []*client.Message
+
+	// subscribers holds the channels to notify when a message is enqueued.
+	subscribers   map[chan struct{}]struct{}
+	subscribersMu sync.Mutex
+
 	lockDuration time.Duration
 }
 
 func NewInMemQueue(lockDuration time.Duration) *InmemQueue {
 	return &InmemQueue{
 		v:            &list.List{},
+		subscribers:  map[chan struct{}]struct{}{},
 		lockDuration: lockDuration,
 	}
 }
@@ -63,6 +71,7 @@ func (q *InmemQueue) DeleteAll() {
 	q.vMu.Lock()
 	defer q.vMu.Unlock()
 	_ = q.v.Init()
+	q.deadLetters = nil
 }
 
 func (q *InmemQueue) Enqueue(msg *client.Message) {
@@ -77,6 +86,41 @@ func (q *InmemQueue) Enqueue(msg *client.Message) {
 	msg.Metadata.ExpireAt = time.Now().UTC().Add(messageExpireDuration)
 
 	q.v.PushBack(&element{val: msg, visible: true})
+	q.notify()
+}
+
+// Subscribe returns a channel which receives a value when a message is enqueued or requeued, and a function to
+// unsubscribe. Notifications are coalesced while the subscriber is not receiving.
+func (q *InmemQueue) Subscribe() (<-chan struct{}, func()) {
+	ch := make(chan struct{}, 1)
+
+	q.subscribersMu.Lock()
+	q.subscribers[ch] = struct{}{}
+	q.subscribersMu.Unlock()
+
+	unsubscribe := func() {
+		q.subscribersMu.Lock()
+		defer q.subscribersMu.Unlock()
+		if _, ok := q.subscribers[ch]; ok {
+			delete(q.subscribers, ch)
+			close(ch)
+		}
+	}
+
+	return ch, unsubscribe
+}
+
+func (q *InmemQueue) notify() {
+	q.subscribersMu.Lock()
+	defer q.subscribersMu.Unlock()
+
+	for ch := range q.subscribers {
+		select {
+		case ch <- struct{}{}:
+		default:
+			// The subscriber already has a pending notification.
+		}
+	}
 }
 
 func (q *InmemQueue) Dequeue() *client.Message {
@@ -89,7 +133,9 @@ func (q *InmemQueue) Dequeue() *client.Message {
 			elem.val.DequeueCount++
 			elem.val.NextVisibleAt = time.Now().Add(q.lockDuration)
 			elem.visible = false
-			found = elem.val
+			// Return a copy so that the lease of the caller isn't changed when the message is dequeued again.
+			copied := *elem.val
+			found = &copied
 			return true
 		}
 		return false
@@ -99,21 +145,21 @@ func (q *InmemQueue) Dequeue() *client.Message {
 }
 
 func (q *InmemQueue) Complete(msg *client.Message) error {
-	found := false
+	var err error = client.ErrInvalidMessage
 	q.elementRange(func(e *list.Element, elem *element) bool {
 		if elem.val.ID == msg.ID {
-			found = true
+			if elem.val.DequeueCount != msg.DequeueCount {
+				err = client.ErrDequeuedMessage
+				return true
+			}
+			err = nil
 			q.v.Remove(e)
 			return true
 		}
 		return false
 	})
 
-	if !found {
-		return client.ErrInvalidMessage
-	}
-
-	return nil
+	return err
 }
 
 func (q *InmemQueue) Extend(msg *client.Message) error {
@@ -141,6 +187,67 @@ func (q *InmemQueue) Extend(msg *client.Message) error {
 	return nil
 }
 
+// DeadLetter moves the message to the dead-letter queue.
+func (q *InmemQueue) DeadLetter(msg *client.Message, reason string) error {
+	found := false
+	q.elementRange(func(e *list.Element, elem *element) bool {
+		if elem.val.ID == msg.ID {
+			found = true
+			q.v.Remove(e)
+
+			elem.val.DeadLetterReason = reason
+			elem.val.DeadLetteredAt = time.Now().UTC()
+			q.deadLetters = append(q.deadLetters, elem.val)
+			return true
+		}
+		return false
+	})
+
+	if !found {
+		return client.ErrInvalidMessage
+	}
+
+	return nil
+}
+
+// DeadLetters returns copies of the messages in the dead-letter queue.
+func (q *InmemQueue) DeadLetters() []*client.Message {
+	q.vMu.Lock()
+	defer q.vMu.Unlock()
+
+	result := []*client.Message{}
+	for _, msg := range q.deadLetters {
+		copied := *msg
+		result = append(result, &copied)
+	}
+	return result
+}
+
+// RemoveDeadLetter removes the message with the given id from the dead-letter queue and returns it, or returns nil if
+// the message is not in the dead-letter queue. If requeue is true, the message is added back to the queue.
+func (q *InmemQueue) RemoveDeadLetter(id string, requeue bool) *client.Message {
+	q.vMu.Lock()
+	defer q.vMu.Unlock()
+
+	for i, msg := range q.deadLetters {
+		if msg.ID == id {
+			q.deadLetters = append(q.deadLetters[:i], q.deadLetters[i+1:]...)
+			if requeue {
+				msg.DequeueCount = 0
+				msg.NextVisibleAt = time.Time{}
+				msg.ExpireAt = time.Now().UTC().Add(messageExpireDuration)
+				msg.DeadLetterReason = ""
+				msg.DeadLetteredAt = time.Time{}
+				q.v.PushBack(&element{val: msg, visible: true})
+				q.notify()
+			}
+			return msg
+		}
+	}
+
+	return nil
+}
+
 func (q *InmemQueue) updateQueue() {
 	q.elementRange(func(e *list.Element, elem *element) bool {
 		now := time.Now().UTC()
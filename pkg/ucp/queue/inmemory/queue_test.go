@@ -101,3 +101,28 @@ func TestComplete(t *testing.T) {
 	msg2 := q.Dequeue()
 	require.Nil(t, msg2)
 }
+
+func TestSubscribe(t *testing.T) {
+	q := NewInMemQueue(messageLockDuration)
+
+	ch, unsubscribe := q.Subscribe()
+	q.Enqueue(&client.Message{Data: []byte("test1")})
+	q.Enqueue(&client.Message{Data: []byte("test2")})
+
+	// Notifications are coalesced.
+	_, ok := <-ch
+	require.True(t, ok)
+	select {
+	case <-ch:
+		require.Fail(t, "expected a single pending notification")
+	default:
+	}
+
+	unsubscribe()
+	_, ok = <-ch
+	require.False(t, ok)
+
+	// Unsubscribing twice is safe and further messages don't panic.
+	unsubscribe()
+	q.Enqueue(&client.Message{Data: []byte("test3")})
+}
@@ -25,7 +25,9 @@ import (
 	"github.com/radius-project/radius/pkg/ucp/queue/apiserver"
 	queue "github.com/radius-project/radius/pkg/ucp/queue/client"
 	qinmem "github.com/radius-project/radius/pkg/ucp/queue/inmemory"
+	"github.com/radius-project/radius/pkg/ucp/queue/sqlqueue"
 	ucpv1alpha1 "github.com/radius-project/radius/pkg/ucp/store/apiserverstore/api/ucp.dev/v1alpha1"
+	"github.com/radius-project/radius/pkg/ucp/store/sqlstore"
 	"k8s.io/apimachinery/pkg/runtime"
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -35,6 +37,7 @@ type factoryFunc func(context.Context, QueueProviderOptions) (queue.Client, erro
 var clientFactory = map[QueueProviderType]factoryFunc{
 	TypeInmemory:  initInMemory,
 	TypeAPIServer: initAPIServer,
+	TypeSQL:       initSQL,
 }
 
 func initInMemory(ctx context.Context, opt QueueProviderOptions) (queue.Client, error) {
@@ -70,7 +73,8 @@ func initAPIServer(ctx context.Context, opt QueueProviderOptions) (queue.Client,
 		},
 	}
 
-	rc, err := runtimeclient.New(cfg, options)
+	// Use a client that supports watches so that dequeuers are notified of new messages.
+	rc, err := runtimeclient.NewWithWatch(cfg, options)
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize APIServer client: %w", err)
 	}
@@ -80,3 +84,24 @@ func initAPIServer(ctx context.Context, opt QueueProviderOptions) (queue.Client,
 		Namespace: opt.APIServer.Namespace,
 	})
 }
+
+func initSQL(ctx context.Context, opt QueueProviderOptions) (queue.Client, error) {
+	dialect := sqlstore.Dialect(opt.SQL.Dialect)
+	db, err := sqlstore.Open(dialect, opt.SQL.ConnectionString)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize sql queue client: %w", err)
+	}
+
+	client, err := sqlqueue.New(db, dialect, sqlqueue.Options{Name: opt.Name})
+	if err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("failed to initialize sql queue client: %w", err)
+	}
+
+	if err = client.Init(ctx); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("failed to initialize sql queue client - configuration may be invalid: %w", err)
+	}
+
+	return client, nil
+}
@@ -29,6 +29,9 @@ type QueueProviderOptions struct {
 
 	// APIServer configures options for the Kubernetes APIServer store. (Optional)
 	APIServer APIServerOptions `yaml:"apiserver,omitempty"`
+
+	// SQL configures options for the SQL database queue. (Optional)
+	SQL SQLOptions `yaml:"sql,omitempty"`
 }
 
 // InMemoryQueueOptions represents the inmemory queue options.
@@ -44,3 +47,13 @@ type APIServerOptions struct {
 	// Namespace configures the Kubernetes namespace used for data-storage. The namespace must already exist.
 	Namespace string `yaml:"namespace"`
 }
+
+// SQLOptions represents options for the configuring the SQL database queue.
+type SQLOptions struct {
+	// Dialect configures the SQL database used by the queue. Supported values are 'postgres' and 'sqlite'.
+	Dialect string `yaml:"dialect"`
+
+	// ConnectionString configures the connection string passed to the database driver. For SQLite this is the path to
+	// the database file.
+	ConnectionString string `yaml:"connectionString"`
+}
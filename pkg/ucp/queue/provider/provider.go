@@ -34,13 +34,17 @@ type QueueProvider struct {
 
 	queueClient queue.Client
 	once        sync.Once
+
+	namedClients   map[string]queue.Client
+	namedClientsMu sync.Mutex
 }
 
 // New creates new QueueProvider instance.
 func New(opts QueueProviderOptions) *QueueProvider {
 	return &QueueProvider{
-		queueClient: nil,
-		options:     opts,
+		queueClient:  nil,
+		options:      opts,
+		namedClients: map[string]queue.Client{},
 	}
 }
 
@@ -60,6 +64,36 @@ func (p *QueueProvider) GetClient(ctx context.Context) (queue.Client, error) {
 	return p.queueClient, err
 }
 
+// GetNamedClient creates or gets a client for the queue with the given name using the same provider configuration.
+// This is used to manage the queues of other services that share the same queue backend.
+func (p *QueueProvider) GetNamedClient(ctx context.Context, name string) (queue.Client, error) {
+	if name == p.options.Name {
+		return p.GetClient(ctx)
+	}
+
+	p.namedClientsMu.Lock()
+	defer p.namedClientsMu.Unlock()
+
+	if client, ok := p.namedClients[name]; ok {
+		return client, nil
+	}
+
+	fn, ok := clientFactory[p.options.Provider]
+	if !ok {
+		return nil, ErrUnsupportedStorageProvider
+	}
+
+	options := p.options
+	options.Name = name
+	client, err := fn(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+
+	p.namedClients[name] = client
+	return client, nil
+}
+
 // SetClient sets the queue client for the QueueProvider. This should be used by tests that need to mock the queue client.
 func (p *QueueProvider) SetClient(client queue.Client) {
 	p.queueClient = client
@@ -18,6 +18,7 @@ package provider
 
 import (
 	"context"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -47,3 +48,52 @@ func TestGetClient_InvalidQueue(t *testing.T) {
 	_, err := p.GetClient(context.TODO())
 	require.ErrorIs(t, ErrUnsupportedStorageProvider, err)
 }
+
+func TestGetClient_SQLQueue(t *testing.T) {
+	p := New(QueueProviderOptions{
+		Name:     "Applications.Core",
+		Provider: TypeSQL,
+		SQL: SQLOptions{
+			Dialect:          "sqlite",
+			ConnectionString: filepath.Join(t.TempDir(), "queue.db"),
+		},
+	})
+
+	cli, err := p.GetClient(context.TODO())
+	require.NoError(t, err)
+	require.NotNil(t, cli)
+}
+
+func TestGetClient_SQLQueue_Invalid(t *testing.T) {
+	p := New(QueueProviderOptions{
+		Name:     "Applications.Core",
+		Provider: TypeSQL,
+		SQL:      SQLOptions{Dialect: "mysql", ConnectionString: "test"},
+	})
+
+	_, err := p.GetClient(context.TODO())
+	require.Error(t, err)
+}
+
+func TestGetNamedClient(t *testing.T) {
+	p := New(QueueProviderOptions{
+		Name:     "Applications.Core",
+		Provider: TypeInmemory,
+		InMemory: &InMemoryQueueOptions{},
+	})
+
+	cli, err := p.GetClient(context.TODO())
+	require.NoError(t, err)
+
+	same, err := p.GetNamedClient(context.TODO(), "Applications.Core")
+	require.NoError(t, err)
+	require.Same(t, cli, same)
+
+	other, err := p.GetNamedClient(context.TODO(), "ucp")
+	require.NoError(t, err)
+	require.NotSame(t, cli, other)
+
+	cached, err := p.GetNamedClient(context.TODO(), "ucp")
+	require.NoError(t, err)
+	require.Same(t, other, cached)
+}
@@ -25,4 +25,7 @@ const (
 
 	// TypeAPIServer represents the Kubernetes APIServer provider.
 	TypeAPIServer QueueProviderType = "apiserver"
+
+	// TypeSQL represents the SQL database queue provider.
+	TypeSQL QueueProviderType = "sql"
 )
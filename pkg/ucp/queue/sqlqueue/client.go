@@ -0,0 +1,446 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package sqlqueue is a durable queue implementation backed by a single table of a SQL database. PostgreSQL and SQLite
+// are supported using the same dialects as the SQL store.
+//
+// Each row stores one message. Multiple queues can share the table and are identified by the queue_name column.
+//
+// We need four operations for the queue:
+//
+//  1. Enqueue: Inserts a row that is visible immediately.
+//  2. Dequeue: Leases the first visible message by incrementing its dequeue count and moving next_visible_at forward
+//     by the message lock duration, in a single UPDATE statement. On PostgreSQL the candidate row is selected with
+//     `FOR UPDATE SKIP LOCKED` so that concurrent consumers skip rows locked by each other instead of blocking or
+//     leasing the same message. SQLite only allows a single writer, so the UPDATE statement is atomic without it.
+//  3. FinishMessage: Deletes the row.
+//  4. ExtendMessage: Moves next_visible_at forward if the message is still leased by the caller.
+//
+// Dead-lettered messages stay in the table with dead_lettered_at set, and are ignored by all of the operations above.
+//
+// As with the apiserver queue, the dequeue count is used as the revision number of a message. ExtendMessage only
+// succeeds when the dequeue count of the stored message matches the message held by the caller and the lease has
+// not expired, which prevents a consumer from extending a message that was requeued and leased by another consumer.
+//
+// Unlike the apiserver queue, leasing a message writes a single row rather than updating a Kubernetes resource, which
+// avoids the write amplification of storing every queue operation in etcd.
+package sqlqueue
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/radius-project/radius/pkg/ucp/queue/client"
+	"github.com/radius-project/radius/pkg/ucp/store/sqlstore"
+)
+
+const (
+	// TableName is the name of the table used to store queue messages.
+	TableName = "ucp_queue_messages"
+
+	// columns are the columns read by scanMessage.
+	columns = "id, dequeue_count, enqueue_at, expire_at, next_visible_at, content_type, data, dead_letter_reason, dead_lettered_at"
+
+	defaultMessageLockDuration = time.Duration(5) * time.Minute
+	defaultExpiryDuration      = time.Duration(10) * time.Hour
+)
+
+var _ client.DeadLetterClient = (*Client)(nil)
+
+// Client implements client.Client using a SQL database.
+type Client struct {
+	db      *sql.DB
+	dialect sqlstore.Dialect
+
+	opts Options
+}
+
+// Options is the options to create the SQL queue client.
+type Options struct {
+	// Name represents the name of queue.
+	Name string
+
+	// MessageLockDuration represents the duration of message lock.
+	MessageLockDuration time.Duration
+	// ExpiryDuration represents the duration of the expiry.
+	ExpiryDuration time.Duration
+}
+
+// New creates the queue client backed by the given SQL database. Init must be called before the client is used.
+func New(db *sql.DB, dialect sqlstore.Dialect, options Options) (*Client, error) {
+	if db == nil {
+		return nil, errors.New("db is required")
+	}
+	if err := dialect.Validate(); err != nil {
+		return nil, err
+	}
+	if options.Name == "" {
+		return nil, errors.New("Name is required")
+	}
+
+	if options.MessageLockDuration == time.Duration(0) {
+		options.MessageLockDuration = defaultMessageLockDuration
+	}
+
+	if options.ExpiryDuration == time.Duration(0) {
+		options.ExpiryDuration = defaultExpiryDuration
+	}
+
+	return &Client{db: db, dialect: dialect, opts: options}, nil
+}
+
+// Init creates the table and indexes used by the queue if they do not already exist.
+func (c *Client) Init(ctx context.Context) error {
+	statements := []string{
+		"CREATE TABLE IF NOT EXISTS " + TableName + ` (
+			id TEXT NOT NULL PRIMARY KEY,
+			queue_name TEXT NOT NULL,
+			dequeue_count INTEGER NOT NULL,
+			enqueue_at BIGINT NOT NULL,
+			expire_at BIGINT NOT NULL,
+			next_visible_at BIGINT NOT NULL,
+			content_type TEXT NOT NULL,
+			data TEXT NOT NULL,
+			dead_letter_reason TEXT NOT NULL DEFAULT '',
+			dead_lettered_at BIGINT NOT NULL DEFAULT 0
+		)`,
+		"CREATE INDEX IF NOT EXISTS " + TableName + "_visible ON " + TableName + " (queue_name, next_visible_at)",
+	}
+
+	if c.dialect == sqlstore.DialectSQLite {
+		// Multiple processes (eg: UCP and applications-rp) may share the same database file. Wait for locks held
+		// by other processes instead of failing immediately.
+		statements = append([]string{"PRAGMA busy_timeout = 5000"}, statements...)
+	}
+
+	for _, statement := range statements {
+		if _, err := c.db.ExecContext(ctx, statement); err != nil {
+			return fmt.Errorf("failed to initialize sql queue: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// DB returns the database handle used by the client.
+func (c *Client) DB() *sql.DB {
+	return c.db
+}
+
+// Enqueue enqueues message to the queue.
+func (c *Client) Enqueue(ctx context.Context, msg *client.Message, options ...client.EnqueueOptions) error {
+	if msg == nil || msg.Data == nil || len(msg.Data) == 0 {
+		return client.ErrEmptyMessage
+	}
+
+	if msg.ContentType != client.JSONContentType {
+		return client.ErrUnsupportedContentType
+	}
+
+	now := time.Now()
+
+	// Remove the expired messages of this queue so that the table does not grow without bound.
+	statement := fmt.Sprintf("DELETE FROM %s WHERE queue_name = %s AND expire_at < %s AND dead_lettered_at = 0", TableName, c.dialect.Placeholder(1), c.dialect.Placeholder(2))
+	if _, err := c.db.ExecContext(ctx, statement, c.opts.Name, now.UnixNano()); err != nil {
+		return err
+	}
+
+	statement = fmt.Sprintf(
+		"INSERT INTO %s (id, queue_name, dequeue_count, enqueue_at, expire_at, next_visible_at, content_type, data) VALUES (%s, %s, 0, %s, %s, %s, %s, %s)",
+		TableName,
+		c.dialect.Placeholder(1),
+		c.dialect.Placeholder(2),
+		c.dialect.Placeholder(3),
+		c.dialect.Placeholder(4),
+		c.dialect.Placeholder(5),
+		c.dialect.Placeholder(6),
+		c.dialect.Placeholder(7))
+	_, err := c.db.ExecContext(ctx, statement,
+		uuid.NewString(),
+		c.opts.Name,
+		now.UnixNano(),
+		now.Add(c.opts.ExpiryDuration).UnixNano(),
+		now.UnixNano(),
+		msg.ContentType,
+		string(msg.Data))
+	return err
+}
+
+// Dequeue leases the first visible message in the queue. ErrMessageNotFound is returned if the queue is empty or all
+// messages are leased by other clients.
+func (c *Client) Dequeue(ctx context.Context, cfg client.QueueClientConfig) (*client.Message, error) {
+	now := time.Now()
+
+	lock := ""
+	if c.dialect == sqlstore.DialectPostgres {
+		lock = " FOR UPDATE SKIP LOCKED"
+	}
+
+	statement := fmt.Sprintf(
+		`UPDATE %[1]s SET dequeue_count = dequeue_count + 1, next_visible_at = %[2]s
+		WHERE id = (SELECT id FROM %[1]s WHERE queue_name = %[3]s AND next_visible_at < %[4]s AND expire_at >= %[5]s AND dead_lettered_at = 0 ORDER BY next_visible_at, id LIMIT 1%[6]s)
+		RETURNING %[7]s`,
+		TableName,
+		c.dialect.Placeholder(1),
+		c.dialect.Placeholder(2),
+		c.dialect.Placeholder(3),
+		c.dialect.Placeholder(4),
+		lock,
+		columns)
+
+	msg, err := scanMessage(c.db.QueryRowContext(ctx, statement, now.Add(c.opts.MessageLockDuration).UnixNano(), c.opts.Name, now.UnixNano(), now.UnixNano()))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, client.ErrMessageNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// FinishMessage deletes the message from the queue. ErrDequeuedMessage is returned if the message was leased by another
+// client, and ErrInvalidMessage is returned if the message was deleted.
+func (c *Client) FinishMessage(ctx context.Context, msg *client.Message) error {
+	if msg == nil {
+		return client.ErrEmptyMessage
+	}
+
+	statement := fmt.Sprintf(
+		"DELETE FROM %s WHERE id = %s AND dequeue_count = %s AND queue_name = %s AND dead_lettered_at = 0",
+		TableName,
+		c.dialect.Placeholder(1),
+		c.dialect.Placeholder(2),
+		c.dialect.Placeholder(3))
+	result, err := c.db.ExecContext(ctx, statement, msg.ID, msg.DequeueCount, c.opts.Name)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return c.leaseError(ctx, msg)
+	}
+
+	return nil
+}
+
+// ExtendMessage extends the lease of a message dequeued by this client. ErrDequeuedMessage is returned if the message
+// was leased by another client, and ErrInvalidMessage is returned if the lease has expired or the message was deleted.
+func (c *Client) ExtendMessage(ctx context.Context, msg *client.Message) error {
+	if msg == nil {
+		return client.ErrEmptyMessage
+	}
+
+	now := time.Now()
+	nextVisibleAt := now.Add(c.opts.MessageLockDuration)
+
+	statement := fmt.Sprintf(
+		"UPDATE %s SET next_visible_at = %s WHERE id = %s AND dequeue_count = %s AND queue_name = %s AND next_visible_at >= %s AND dead_lettered_at = 0",
+		TableName,
+		c.dialect.Placeholder(1),
+		c.dialect.Placeholder(2),
+		c.dialect.Placeholder(3),
+		c.dialect.Placeholder(4),
+		c.dialect.Placeholder(5))
+	result, err := c.db.ExecContext(ctx, statement, nextVisibleAt.UnixNano(), msg.ID, msg.DequeueCount, c.opts.Name, now.UnixNano())
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return c.leaseError(ctx, msg)
+	}
+
+	msg.NextVisibleAt = time.Unix(0, nextVisibleAt.UnixNano())
+	return nil
+}
+
+// leaseError finds out why the lease of the message could not be used, so that we return the same errors as the other
+// queues. ErrDequeuedMessage is returned if the message was leased by another client, ErrInvalidMessage otherwise.
+func (c *Client) leaseError(ctx context.Context, msg *client.Message) error {
+	var dequeueCount int
+	statement := fmt.Sprintf("SELECT dequeue_count FROM %s WHERE id = %s AND queue_name = %s", TableName, c.dialect.Placeholder(1), c.dialect.Placeholder(2))
+	err := c.db.QueryRowContext(ctx, statement, msg.ID, c.opts.Name).Scan(&dequeueCount)
+	if err == nil && dequeueCount != msg.DequeueCount {
+		return client.ErrDequeuedMessage
+	} else if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+
+	return client.ErrInvalidMessage
+}
+
+// DeadLetterMessage moves the message to the dead-letter queue.
+func (c *Client) DeadLetterMessage(ctx context.Context, msg *client.Message, reason string) error {
+	if msg == nil {
+		return client.ErrEmptyMessage
+	}
+
+	now := time.Now()
+	statement := fmt.Sprintf(
+		"UPDATE %s SET dead_letter_reason = %s, dead_lettered_at = %s WHERE id = %s AND queue_name = %s AND dead_lettered_at = 0",
+		TableName,
+		c.dialect.Placeholder(1),
+		c.dialect.Placeholder(2),
+		c.dialect.Placeholder(3),
+		c.dialect.Placeholder(4))
+	result, err := c.db.ExecContext(ctx, statement, reason, now.UnixNano(), msg.ID, c.opts.Name)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return client.ErrInvalidMessage
+	}
+
+	msg.DeadLetterReason = reason
+	msg.DeadLetteredAt = time.Unix(0, now.UnixNano()).UTC()
+	return nil
+}
+
+// ListDeadLetterMessages lists the messages in the dead-letter queue, ordered by the time they were dead-lettered.
+func (c *Client) ListDeadLetterMessages(ctx context.Context) ([]*client.Message, error) {
+	statement := fmt.Sprintf(
+		"SELECT %s FROM %s WHERE queue_name = %s AND dead_lettered_at > 0 ORDER BY dead_lettered_at, id",
+		columns,
+		TableName,
+		c.dialect.Placeholder(1))
+	rows, err := c.db.QueryContext(ctx, statement, c.opts.Name)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	messages := []*client.Message{}
+	for rows.Next() {
+		msg, err := scanMessage(rows)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, msg)
+	}
+
+	return messages, rows.Err()
+}
+
+// GetDeadLetterMessage gets the message with the given id from the dead-letter queue.
+func (c *Client) GetDeadLetterMessage(ctx context.Context, id string) (*client.Message, error) {
+	statement := fmt.Sprintf(
+		"SELECT %s FROM %s WHERE id = %s AND queue_name = %s AND dead_lettered_at > 0",
+		columns,
+		TableName,
+		c.dialect.Placeholder(1),
+		c.dialect.Placeholder(2))
+	msg, err := scanMessage(c.db.QueryRowContext(ctx, statement, id, c.opts.Name))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, client.ErrDeadLetterMessageNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// RequeueDeadLetterMessage moves the message from the dead-letter queue back to the queue and resets its dequeue count.
+func (c *Client) RequeueDeadLetterMessage(ctx context.Context, id string) error {
+	now := time.Now()
+	statement := fmt.Sprintf(
+		`UPDATE %s SET dequeue_count = 0, next_visible_at = %s, expire_at = %s, dead_letter_reason = '', dead_lettered_at = 0
+		WHERE id = %s AND queue_name = %s AND dead_lettered_at > 0`,
+		TableName,
+		c.dialect.Placeholder(1),
+		c.dialect.Placeholder(2),
+		c.dialect.Placeholder(3),
+		c.dialect.Placeholder(4))
+	result, err := c.db.ExecContext(ctx, statement, now.UnixNano(), now.Add(c.opts.ExpiryDuration).UnixNano(), id, c.opts.Name)
+	if err != nil {
+		return err
+	}
+
+	return checkDeadLetterAffected(result)
+}
+
+// PurgeDeadLetterMessage deletes the message from the dead-letter queue.
+func (c *Client) PurgeDeadLetterMessage(ctx context.Context, id string) error {
+	statement := fmt.Sprintf(
+		"DELETE FROM %s WHERE id = %s AND queue_name = %s AND dead_lettered_at > 0",
+		TableName,
+		c.dialect.Placeholder(1),
+		c.dialect.Placeholder(2))
+	result, err := c.db.ExecContext(ctx, statement, id, c.opts.Name)
+	if err != nil {
+		return err
+	}
+
+	return checkDeadLetterAffected(result)
+}
+
+func checkDeadLetterAffected(result sql.Result) error {
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return client.ErrDeadLetterMessageNotFound
+	}
+
+	return nil
+}
+
+// scanner is implemented by both *sql.Row and *sql.Rows.
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanMessage(row scanner) (*client.Message, error) {
+	var data string
+	var enqueueAt, expireAt, nextVisibleAt, deadLetteredAt int64
+	msg := &client.Message{}
+
+	err := row.Scan(&msg.ID, &msg.DequeueCount, &enqueueAt, &expireAt, &nextVisibleAt, &msg.ContentType, &data, &msg.DeadLetterReason, &deadLetteredAt)
+	if err != nil {
+		return nil, err
+	}
+
+	msg.EnqueueAt = time.Unix(0, enqueueAt).UTC()
+	msg.ExpireAt = time.Unix(0, expireAt).UTC()
+	msg.NextVisibleAt = time.Unix(0, nextVisibleAt)
+	msg.Data = []byte(data)
+	if deadLetteredAt > 0 {
+		msg.DeadLetteredAt = time.Unix(0, deadLetteredAt).UTC()
+	}
+
+	return msg, nil
+}
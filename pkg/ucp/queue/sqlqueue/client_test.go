@@ -0,0 +1,136 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlqueue
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/ucp/queue/client"
+	"github.com/radius-project/radius/pkg/ucp/store/sqlstore"
+	"github.com/radius-project/radius/test/testcontext"
+	sharedtest "github.com/radius-project/radius/test/ucp/queuetest"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestClient(t *testing.T, name string) *Client {
+	ctx := testcontext.New(t)
+
+	db, err := sqlstore.Open(sqlstore.DialectSQLite, ":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	cli, err := New(db, sqlstore.DialectSQLite, Options{Name: name, MessageLockDuration: sharedtest.TestMessageLockTime})
+	require.NoError(t, err)
+	require.NoError(t, cli.Init(ctx))
+
+	return cli
+}
+
+func TestNew_Invalid(t *testing.T) {
+	_, err := New(nil, sqlstore.DialectSQLite, Options{Name: "applications.core"})
+	require.Error(t, err)
+
+	db, err := sqlstore.Open(sqlstore.DialectSQLite, ":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	_, err = New(db, sqlstore.Dialect("mysql"), Options{Name: "applications.core"})
+	require.Error(t, err)
+
+	_, err = New(db, sqlstore.DialectSQLite, Options{})
+	require.Error(t, err)
+}
+
+func TestClient(t *testing.T) {
+	cli := newTestClient(t, "applications.core")
+
+	clear := func(t *testing.T) {
+		_, err := cli.DB().Exec("DELETE FROM " + TableName)
+		require.NoError(t, err)
+	}
+
+	sharedtest.RunTest(t, cli, clear)
+	sharedtest.RunDeadLetterTest(t, cli, clear)
+}
+
+func TestClient_NamedQueues(t *testing.T) {
+	ctx := testcontext.New(t)
+	cli1 := newTestClient(t, "queue1")
+
+	// Share the same table between two queues.
+	cli2, err := New(cli1.DB(), sqlstore.DialectSQLite, Options{Name: "queue2"})
+	require.NoError(t, err)
+
+	require.NoError(t, cli1.Enqueue(ctx, client.NewMessage("test1")))
+	require.NoError(t, cli2.Enqueue(ctx, client.NewMessage("test2")))
+
+	msg, err := cli1.Dequeue(ctx, client.QueueClientConfig{})
+	require.NoError(t, err)
+	require.Equal(t, "test1", string(msg.Data))
+
+	_, err = cli1.Dequeue(ctx, client.QueueClientConfig{})
+	require.ErrorIs(t, err, client.ErrMessageNotFound)
+
+	msg, err = cli2.Dequeue(ctx, client.QueueClientConfig{})
+	require.NoError(t, err)
+	require.Equal(t, "test2", string(msg.Data))
+}
+
+func TestClient_DeadLetterOtherQueue(t *testing.T) {
+	ctx := testcontext.New(t)
+	cli1 := newTestClient(t, "queue1")
+
+	// Share the same table between two queues.
+	cli2, err := New(cli1.DB(), sqlstore.DialectSQLite, Options{Name: "queue2"})
+	require.NoError(t, err)
+
+	require.NoError(t, cli1.Enqueue(ctx, client.NewMessage("test1")))
+	msg, err := cli1.Dequeue(ctx, client.QueueClientConfig{})
+	require.NoError(t, err)
+
+	err = cli2.DeadLetterMessage(ctx, msg, "wrong queue")
+	require.ErrorIs(t, err, client.ErrInvalidMessage)
+
+	messages, err := cli1.ListDeadLetterMessages(ctx)
+	require.NoError(t, err)
+	require.Empty(t, messages)
+
+	require.NoError(t, cli1.DeadLetterMessage(ctx, msg, "failed"))
+	messages, err = cli1.ListDeadLetterMessages(ctx)
+	require.NoError(t, err)
+	require.Len(t, messages, 1)
+}
+
+func TestClient_ExtendDequeuedByOtherClient(t *testing.T) {
+	ctx := testcontext.New(t)
+	cli := newTestClient(t, "applications.core")
+
+	require.NoError(t, cli.Enqueue(ctx, client.NewMessage("test")))
+
+	msg, err := cli.Dequeue(ctx, client.QueueClientConfig{})
+	require.NoError(t, err)
+
+	// Simulate another client leasing the message after the lock expired.
+	_, err = cli.DB().Exec("UPDATE "+TableName+" SET dequeue_count = dequeue_count + 1 WHERE id = ?", msg.ID)
+	require.NoError(t, err)
+
+	err = cli.ExtendMessage(ctx, msg)
+	require.ErrorIs(t, err, client.ErrDequeuedMessage)
+
+	err = cli.FinishMessage(ctx, msg)
+	require.ErrorIs(t, err, client.ErrDequeuedMessage)
+}
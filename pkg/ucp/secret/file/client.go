@@ -0,0 +1,192 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package file implements secret storage using encrypted files in a local directory.
+//
+// Each secret is stored in its own file named <name>.enc. The content is encrypted with AES-256-GCM and the secret name
+// is used as additional authenticated data, so a file that is renamed or copied over another secret fails to decrypt.
+package file
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/ucp/secret"
+)
+
+const (
+	// KeySize is the size in bytes of the encryption key.
+	KeySize = 32
+
+	fileExtension = ".enc"
+)
+
+var (
+	_ secret.Client = (*Client)(nil)
+
+	// validName restricts secret names so that they map to a single file in the directory.
+	validName = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9._-]*$`)
+)
+
+// Options represents the options to create an encrypted file secret client.
+type Options struct {
+	// Directory is the directory where the encrypted secrets are stored. It is created if it does not exist.
+	Directory string
+	// Key is the AES-256 key used to encrypt the secrets. It must be KeySize bytes long.
+	Key []byte
+}
+
+// Client implements secret storage using encrypted files.
+type Client struct {
+	directory string
+	aead      cipher.AEAD
+}
+
+// New creates an encrypted file secret client. It returns an error if the directory cannot be created or the key is invalid.
+func New(opts Options) (*Client, error) {
+	if opts.Directory == "" {
+		return nil, errors.New("the secret directory is required")
+	}
+	if len(opts.Key) != KeySize {
+		return nil, fmt.Errorf("the encryption key must be %d bytes long", KeySize)
+	}
+
+	block, err := aes.NewCipher(opts.Key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(opts.Directory, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create the secret directory: %w", err)
+	}
+
+	return &Client{directory: opts.Directory, aead: aead}, nil
+}
+
+// ReadKeyFile reads a base64 encoded encryption key from the given file.
+func ReadKeyFile(path string) ([]byte, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the encryption key file: %w", err)
+	}
+
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(b)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode the encryption key file: %w", err)
+	}
+
+	return key, nil
+}
+
+func validateName(name string) error {
+	if name == "" {
+		return &secret.ErrInvalid{Message: "invalid argument. 'name' is required"}
+	}
+	if !validName.MatchString(name) {
+		return &secret.ErrInvalid{Message: "invalid name: " + name}
+	}
+	return nil
+}
+
+func (c *Client) path(name string) string {
+	return filepath.Join(c.directory, name+fileExtension)
+}
+
+// Save encrypts the value and writes it to the secret file, replacing the previous value atomically.
+func (c *Client) Save(ctx context.Context, name string, value []byte) error {
+	if err := validateName(name); err != nil {
+		return err
+	}
+	if value == nil {
+		return &secret.ErrInvalid{Message: "invalid argument. 'value' is required"}
+	}
+
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return err
+	}
+	encrypted := c.aead.Seal(nonce, nonce, value, []byte(name))
+
+	// Write to a temporary file first so that readers never observe a partially written secret.
+	f, err := os.CreateTemp(c.directory, name+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(f.Name()) }()
+
+	if _, err := f.Write(encrypted); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(f.Name(), c.path(name))
+}
+
+// Delete deletes the secret file. It returns secret.ErrNotFound if the secret does not exist.
+func (c *Client) Delete(ctx context.Context, name string) error {
+	if err := validateName(name); err != nil {
+		return err
+	}
+
+	err := os.Remove(c.path(name))
+	if errors.Is(err, fs.ErrNotExist) {
+		return &secret.ErrNotFound{}
+	}
+	return err
+}
+
+// Get reads and decrypts the secret file. It returns secret.ErrNotFound if the secret does not exist.
+func (c *Client) Get(ctx context.Context, name string) ([]byte, error) {
+	if err := validateName(name); err != nil {
+		return nil, err
+	}
+
+	encrypted, err := os.ReadFile(c.path(name))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, &secret.ErrNotFound{}
+	} else if err != nil {
+		return nil, err
+	}
+
+	nonceSize := c.aead.NonceSize()
+	if len(encrypted) < nonceSize {
+		return nil, fmt.Errorf("the secret %q is corrupted", name)
+	}
+
+	value, err := c.aead.Open(nil, encrypted[:nonceSize], encrypted[nonceSize:], []byte(name))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt the secret %q: %w", name, err)
+	}
+
+	return value, nil
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package file
+
+import (
+	"bytes"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/ucp/secret"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testSecretName = "azure-azurecloud-default"
+)
+
+func newTestClient(t *testing.T) *Client {
+	client, err := New(Options{Directory: filepath.Join(t.TempDir(), "secrets"), Key: bytes.Repeat([]byte{1}, KeySize)})
+	require.NoError(t, err)
+	return client
+}
+
+func TestNew_Invalid(t *testing.T) {
+	_, err := New(Options{Key: bytes.Repeat([]byte{1}, KeySize)})
+	require.EqualError(t, err, "the secret directory is required")
+
+	_, err = New(Options{Directory: t.TempDir(), Key: []byte("short")})
+	require.EqualError(t, err, "the encryption key must be 32 bytes long")
+}
+
+func TestReadKeyFile(t *testing.T) {
+	key := bytes.Repeat([]byte{2}, KeySize)
+	keyFile := filepath.Join(t.TempDir(), "key")
+	require.NoError(t, os.WriteFile(keyFile, []byte(base64.StdEncoding.EncodeToString(key)+"\n"), 0600))
+
+	result, err := ReadKeyFile(keyFile)
+	require.NoError(t, err)
+	require.Equal(t, key, result)
+
+	require.NoError(t, os.WriteFile(keyFile, []byte("not base64!"), 0600))
+	_, err = ReadKeyFile(keyFile)
+	require.ErrorContains(t, err, "failed to decode the encryption key file")
+
+	_, err = ReadKeyFile(filepath.Join(t.TempDir(), "missing"))
+	require.ErrorContains(t, err, "failed to read the encryption key file")
+}
+
+func TestClient(t *testing.T) {
+	ctx := testcontext.New(t)
+	client := newTestClient(t)
+
+	tests := []struct {
+		testName   string
+		secretName string
+		secret     []byte
+		save       bool
+		get        bool
+		delete     bool
+		err        error
+	}{
+		{"save-get-delete-secret-success", testSecretName, []byte(`"test_secret"`), true, true, true, nil},
+		{"save-secret-empty-name", "", []byte("test"), true, false, false, &secret.ErrInvalid{Message: "invalid argument. 'name' is required"}},
+		{"save-secret-invalid-name", "../test", []byte("test"), true, false, false, &secret.ErrInvalid{Message: "invalid name: ../test"}},
+		{"save-secret-empty-secret", testSecretName, nil, true, false, false, &secret.ErrInvalid{Message: "invalid argument. 'value' is required"}},
+		{"delete-secret-without-save", testSecretName, nil, false, false, true, &secret.ErrNotFound{}},
+		{"get-secret-without-save", testSecretName, nil, false, true, false, &secret.ErrNotFound{}},
+		{"get-secret-with-empty-name", "", nil, false, true, false, &secret.ErrInvalid{Message: "invalid argument. 'name' is required"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			if tt.save {
+				err := client.Save(ctx, tt.secretName, tt.secret)
+				if tt.err == nil {
+					require.NoError(t, err)
+				} else {
+					require.Equal(t, tt.err, err)
+				}
+			}
+			if tt.get {
+				response, err := client.Get(ctx, tt.secretName)
+				if tt.err == nil {
+					require.NoError(t, err)
+					require.Equal(t, tt.secret, response)
+				} else {
+					require.Equal(t, tt.err, err)
+				}
+			}
+			if tt.delete {
+				err := client.Delete(ctx, tt.secretName)
+				if tt.err == nil {
+					require.NoError(t, err)
+				} else {
+					require.Equal(t, tt.err, err)
+				}
+			}
+		})
+	}
+}
+
+func TestClient_EncryptedAtRest(t *testing.T) {
+	ctx := testcontext.New(t)
+	client := newTestClient(t)
+
+	require.NoError(t, client.Save(ctx, "first", []byte("first-value")))
+	require.NoError(t, client.Save(ctx, "second", []byte("second-value")))
+
+	// Only the encrypted secrets are left in the directory.
+	entries, err := os.ReadDir(client.directory)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+
+	b, err := os.ReadFile(client.path("first"))
+	require.NoError(t, err)
+	require.NotContains(t, string(b), "first-value")
+
+	// Overwriting replaces the value.
+	require.NoError(t, client.Save(ctx, "first", []byte("updated-value")))
+	value, err := client.Get(ctx, "first")
+	require.NoError(t, err)
+	require.Equal(t, "updated-value", string(value))
+
+	// A secret copied over another one fails to decrypt because the name is authenticated.
+	b, err = os.ReadFile(client.path("second"))
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(client.path("first"), b, 0600))
+	_, err = client.Get(ctx, "first")
+	require.ErrorContains(t, err, "failed to decrypt the secret \"first\"")
+
+	// A different key can't decrypt the secrets.
+	other, err := New(Options{Directory: client.directory, Key: bytes.Repeat([]byte{2}, KeySize)})
+	require.NoError(t, err)
+	_, err = other.Get(ctx, "second")
+	require.ErrorContains(t, err, "failed to decrypt the secret \"second\"")
+}
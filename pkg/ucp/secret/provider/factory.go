@@ -24,7 +24,9 @@ import (
 	"github.com/radius-project/radius/pkg/ucp/dataprovider"
 	"github.com/radius-project/radius/pkg/ucp/secret"
 	"github.com/radius-project/radius/pkg/ucp/secret/etcd"
+	"github.com/radius-project/radius/pkg/ucp/secret/file"
 	kubernetes_client "github.com/radius-project/radius/pkg/ucp/secret/kubernetes"
+	"github.com/radius-project/radius/pkg/ucp/secret/vault"
 	"github.com/radius-project/radius/pkg/ucp/store/etcdstore"
 	"k8s.io/kubectl/pkg/scheme"
 	controller_runtime "sigs.k8s.io/controller-runtime/pkg/client"
@@ -35,6 +37,8 @@ type secretFactoryFunc func(context.Context, SecretProviderOptions) (secret.Clie
 var secretClientFactory = map[SecretProviderType]secretFactoryFunc{
 	TypeETCDSecret:       initETCDSecretClient,
 	TypeKubernetesSecret: initKubernetesSecretClient,
+	TypeVaultSecret:      initVaultSecretClient,
+	TypeFileSecret:       initFileSecretClient,
 }
 
 func initETCDSecretClient(ctx context.Context, opts SecretProviderOptions) (secret.Client, error) {
@@ -70,3 +74,29 @@ func initKubernetesSecretClient(ctx context.Context, opt SecretProviderOptions)
 	}
 	return &kubernetes_client.Client{K8sClient: client}, nil
 }
+
+func initVaultSecretClient(ctx context.Context, opt SecretProviderOptions) (secret.Client, error) {
+	return vault.New(vault.Options{
+		Address:    opt.Vault.Address,
+		TokenFile:  opt.Vault.TokenFile,
+		Namespace:  opt.Vault.Namespace,
+		MountPath:  opt.Vault.MountPath,
+		PathPrefix: opt.Vault.PathPrefix,
+	})
+}
+
+func initFileSecretClient(ctx context.Context, opt SecretProviderOptions) (secret.Client, error) {
+	if opt.File.KeyFile == "" {
+		return nil, errors.New("the encryption key file is required")
+	}
+
+	key, err := file.ReadKeyFile(opt.File.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return file.New(file.Options{
+		Directory: opt.File.Directory,
+		Key:       key,
+	})
+}
@@ -25,4 +25,37 @@ type SecretProviderOptions struct {
 
 	// ETCD configures options for the etcd secret store.
 	ETCD dataprovider.ETCDOptions `yaml:"etcd,omitempty"`
+
+	// Vault configures options for the HashiCorp Vault secret store.
+	Vault VaultOptions `yaml:"vault,omitempty"`
+
+	// File configures options for the encrypted file secret store.
+	File FileOptions `yaml:"file,omitempty"`
+}
+
+// VaultOptions represents the options of the HashiCorp Vault secret store. The store uses the KV version 2 secrets engine.
+type VaultOptions struct {
+	// Address is the address of the Vault server. Defaults to the VAULT_ADDR environment variable.
+	Address string `yaml:"address,omitempty"`
+
+	// TokenFile is the path of a file containing the Vault token. Defaults to the VAULT_TOKEN environment variable.
+	TokenFile string `yaml:"tokenFile,omitempty"`
+
+	// Namespace is the Vault Enterprise namespace.
+	Namespace string `yaml:"namespace,omitempty"`
+
+	// MountPath is the path where the KV version 2 secrets engine is mounted. Defaults to "secret".
+	MountPath string `yaml:"mountPath,omitempty"`
+
+	// PathPrefix is the path under the mount where secrets are stored. Defaults to "radius".
+	PathPrefix string `yaml:"pathPrefix,omitempty"`
+}
+
+// FileOptions represents the options of the encrypted file secret store.
+type FileOptions struct {
+	// Directory is the directory where the encrypted secrets are stored.
+	Directory string `yaml:"directory"`
+
+	// KeyFile is the path of a file containing the base64 encoded 32-byte AES-256 encryption key.
+	KeyFile string `yaml:"keyFile"`
 }
@@ -18,8 +18,14 @@ package provider
 
 import (
 	"context"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
+	"github.com/radius-project/radius/pkg/ucp/secret/file"
+	"github.com/radius-project/radius/pkg/ucp/secret/vault"
 	"github.com/stretchr/testify/require"
 )
 
@@ -31,3 +37,57 @@ func TestGetClient_InvalidType(t *testing.T) {
 	require.Equal(t, err, ErrUnsupportedSecretProvider)
 	require.Nil(t, client)
 }
+
+func TestGetClient_Vault(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("test-token"), 0600))
+
+	secretProvider := NewSecretProvider(SecretProviderOptions{
+		Provider: TypeVaultSecret,
+		Vault: VaultOptions{
+			Address:   "http://127.0.0.1:8200",
+			TokenFile: tokenFile,
+		},
+	})
+	client, err := secretProvider.GetClient(context.TODO())
+	require.NoError(t, err)
+	require.IsType(t, &vault.Client{}, client)
+}
+
+func TestGetClient_Vault_Invalid(t *testing.T) {
+	t.Setenv(vault.EnvAddress, "")
+
+	secretProvider := NewSecretProvider(SecretProviderOptions{
+		Provider: TypeVaultSecret,
+	})
+	_, err := secretProvider.GetClient(context.TODO())
+	require.EqualError(t, err, "the Vault address is required")
+}
+
+func TestGetClient_File(t *testing.T) {
+	keyFile := filepath.Join(t.TempDir(), "key")
+	key := base64.StdEncoding.EncodeToString([]byte(strings.Repeat("k", file.KeySize)))
+	require.NoError(t, os.WriteFile(keyFile, []byte(key), 0600))
+
+	secretProvider := NewSecretProvider(SecretProviderOptions{
+		Provider: TypeFileSecret,
+		File: FileOptions{
+			Directory: filepath.Join(t.TempDir(), "secrets"),
+			KeyFile:   keyFile,
+		},
+	})
+	client, err := secretProvider.GetClient(context.TODO())
+	require.NoError(t, err)
+	require.IsType(t, &file.Client{}, client)
+}
+
+func TestGetClient_File_Invalid(t *testing.T) {
+	secretProvider := NewSecretProvider(SecretProviderOptions{
+		Provider: TypeFileSecret,
+		File: FileOptions{
+			Directory: t.TempDir(),
+		},
+	})
+	_, err := secretProvider.GetClient(context.TODO())
+	require.EqualError(t, err, "the encryption key file is required")
+}
@@ -25,4 +25,10 @@ const (
 
 	// TypeKubernetesSecret represents the Kubernetes secret provider.
 	TypeKubernetesSecret SecretProviderType = "kubernetes"
+
+	// TypeVaultSecret represents the HashiCorp Vault secret provider.
+	TypeVaultSecret SecretProviderType = "vault"
+
+	// TypeFileSecret represents the encrypted file secret provider.
+	TypeFileSecret SecretProviderType = "file"
 )
@@ -0,0 +1,270 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package vault implements secret storage using the KV version 2 secrets engine of HashiCorp Vault.
+//
+// Each secret is stored as a separate Vault secret at <mountPath>/data/<pathPrefix>/<name>. The value is base64 encoded
+// and stored in the "value" field, because UCP secrets are arbitrary bytes. Deleting a secret deletes all of its versions
+// and metadata.
+package vault
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/radius-project/radius/pkg/ucp/secret"
+)
+
+const (
+	// EnvAddress is the environment variable used for the Vault address when Options.Address is not set.
+	EnvAddress = "VAULT_ADDR"
+	// EnvToken is the environment variable used for the Vault token when Options.Token and Options.TokenFile are not set.
+	EnvToken = "VAULT_TOKEN"
+
+	// ValueKey is the key of the field which holds the secret value.
+	ValueKey = "value"
+
+	defaultMountPath  = "secret"
+	defaultPathPrefix = "radius"
+	defaultTimeout    = 30 * time.Second
+)
+
+var (
+	_ secret.Client = (*Client)(nil)
+
+	// validName restricts secret names so that they map to a single Vault path segment.
+	validName = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9._-]*$`)
+)
+
+// Options represents the options to create a Vault secret client.
+type Options struct {
+	// Address is the address of the Vault server, for example http://127.0.0.1:8200. Defaults to VAULT_ADDR.
+	Address string
+	// Token is the token used to authenticate with Vault.
+	Token string
+	// TokenFile is the path of a file containing the token. It is used when Token is not set. Defaults to VAULT_TOKEN
+	// when neither is set.
+	TokenFile string
+	// Namespace is the Vault Enterprise namespace. Optional.
+	Namespace string
+	// MountPath is the path where the KV version 2 secrets engine is mounted. Defaults to "secret".
+	MountPath string
+	// PathPrefix is the path under the mount where secrets are stored. Defaults to "radius".
+	PathPrefix string
+	// HTTPClient is the HTTP client used to call Vault. Optional.
+	HTTPClient *http.Client
+}
+
+// Client implements secret storage for HashiCorp Vault.
+type Client struct {
+	address    string
+	token      string
+	namespace  string
+	mountPath  string
+	pathPrefix string
+	httpClient *http.Client
+}
+
+// New creates a Vault secret client. It returns an error if the address or token are missing.
+func New(opts Options) (*Client, error) {
+	address := opts.Address
+	if address == "" {
+		address = os.Getenv(EnvAddress)
+	}
+	if address == "" {
+		return nil, errors.New("the Vault address is required")
+	}
+	if _, err := url.ParseRequestURI(address); err != nil {
+		return nil, fmt.Errorf("invalid Vault address %q: %w", address, err)
+	}
+
+	token := opts.Token
+	if token == "" && opts.TokenFile != "" {
+		b, err := os.ReadFile(opts.TokenFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the Vault token file: %w", err)
+		}
+		token = strings.TrimSpace(string(b))
+	}
+	if token == "" {
+		token = os.Getenv(EnvToken)
+	}
+	if token == "" {
+		return nil, errors.New("the Vault token is required")
+	}
+
+	mountPath := strings.Trim(opts.MountPath, "/")
+	if mountPath == "" {
+		mountPath = defaultMountPath
+	}
+	pathPrefix := strings.Trim(opts.PathPrefix, "/")
+	if pathPrefix == "" {
+		pathPrefix = defaultPathPrefix
+	}
+
+	httpClient := opts.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: defaultTimeout}
+	}
+
+	return &Client{
+		address:    strings.TrimSuffix(address, "/"),
+		token:      token,
+		namespace:  opts.Namespace,
+		mountPath:  mountPath,
+		pathPrefix: pathPrefix,
+		httpClient: httpClient,
+	}, nil
+}
+
+type secretData struct {
+	Data map[string]any `json:"data"`
+}
+
+type readResponse struct {
+	Data secretData `json:"data"`
+}
+
+type errorResponse struct {
+	Errors []string `json:"errors"`
+}
+
+func validateName(name string) error {
+	if name == "" {
+		return &secret.ErrInvalid{Message: "invalid argument. 'name' is required"}
+	}
+	if !validName.MatchString(name) {
+		return &secret.ErrInvalid{Message: "invalid name: " + name}
+	}
+	return nil
+}
+
+// url returns the URL of the secret for the given KV version 2 API, either "data" or "metadata".
+func (c *Client) url(api string, name string) string {
+	return fmt.Sprintf("%s/v1/%s/%s/%s/%s", c.address, c.mountPath, api, c.pathPrefix, name)
+}
+
+// do sends the request to Vault and decodes the response into out if it is not nil. It returns secret.ErrNotFound if
+// Vault responds with 404.
+func (c *Client) do(ctx context.Context, method string, url string, body any, out any) error {
+	var reader io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, reader)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("X-Vault-Token", c.token)
+	if c.namespace != "" {
+		req.Header.Set("X-Vault-Namespace", c.namespace)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return &secret.ErrNotFound{}
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		errResp := errorResponse{}
+		_ = json.NewDecoder(resp.Body).Decode(&errResp)
+		if len(errResp.Errors) > 0 {
+			return fmt.Errorf("vault request %s %s failed with status %d: %s", method, req.URL.Path, resp.StatusCode, strings.Join(errResp.Errors, "; "))
+		}
+		return fmt.Errorf("vault request %s %s failed with status %d", method, req.URL.Path, resp.StatusCode)
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// Save creates or updates the secret by writing a new version of the Vault secret.
+func (c *Client) Save(ctx context.Context, name string, value []byte) error {
+	if err := validateName(name); err != nil {
+		return err
+	}
+	if value == nil {
+		return &secret.ErrInvalid{Message: "invalid argument. 'value' is required"}
+	}
+
+	body := secretData{Data: map[string]any{ValueKey: base64.StdEncoding.EncodeToString(value)}}
+	return c.do(ctx, http.MethodPost, c.url("data", name), body, nil)
+}
+
+// Delete deletes all versions and the metadata of the secret. It returns secret.ErrNotFound if the secret does not exist.
+func (c *Client) Delete(ctx context.Context, name string) error {
+	if err := validateName(name); err != nil {
+		return err
+	}
+
+	// Deleting the metadata succeeds even if the secret does not exist, so check that it exists first.
+	if err := c.do(ctx, http.MethodGet, c.url("metadata", name), nil, nil); err != nil {
+		return err
+	}
+
+	return c.do(ctx, http.MethodDelete, c.url("metadata", name), nil, nil)
+}
+
+// Get reads the latest version of the secret. It returns secret.ErrNotFound if the secret does not exist.
+func (c *Client) Get(ctx context.Context, name string) ([]byte, error) {
+	if err := validateName(name); err != nil {
+		return nil, err
+	}
+
+	resp := readResponse{}
+	if err := c.do(ctx, http.MethodGet, c.url("data", name), nil, &resp); err != nil {
+		return nil, err
+	}
+
+	encoded, ok := resp.Data.Data[ValueKey].(string)
+	if !ok {
+		return nil, fmt.Errorf("the Vault secret %q does not have a string %q field", name, ValueKey)
+	}
+
+	value, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode the Vault secret %q: %w", name, err)
+	}
+
+	return value, nil
+}
@@ -0,0 +1,212 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vault
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/ucp/secret"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testSecretName = "azure-azurecloud-default"
+	testToken      = "test-token"
+)
+
+// newFakeVault returns a server which implements the subset of the KV version 2 API used by the client.
+func newFakeVault(t *testing.T) *httptest.Server {
+	secrets := map[string]map[string]any{}
+	mu := sync.Mutex{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if r.Header.Get("X-Vault-Token") != testToken {
+			w.WriteHeader(http.StatusForbidden)
+			_ = json.NewEncoder(w).Encode(errorResponse{Errors: []string{"permission denied"}})
+			return
+		}
+
+		var key string
+		var isMetadata bool
+		if strings.HasPrefix(r.URL.Path, "/v1/secret/data/") {
+			key = strings.TrimPrefix(r.URL.Path, "/v1/secret/data/")
+		} else if strings.HasPrefix(r.URL.Path, "/v1/secret/metadata/") {
+			key = strings.TrimPrefix(r.URL.Path, "/v1/secret/metadata/")
+			isMetadata = true
+		} else {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		switch {
+		case r.Method == http.MethodPost && !isMetadata:
+			body := secretData{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			secrets[key] = body.Data
+			w.WriteHeader(http.StatusOK)
+		case r.Method == http.MethodGet:
+			data, ok := secrets[key]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			if isMetadata {
+				_ = json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"current_version": 1}})
+				return
+			}
+			_ = json.NewEncoder(w).Encode(readResponse{Data: secretData{Data: data}})
+		case r.Method == http.MethodDelete && isMetadata:
+			delete(secrets, key)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestNew(t *testing.T) {
+	t.Run("missing address", func(t *testing.T) {
+		t.Setenv(EnvAddress, "")
+		_, err := New(Options{Token: testToken})
+		require.EqualError(t, err, "the Vault address is required")
+	})
+
+	t.Run("missing token", func(t *testing.T) {
+		t.Setenv(EnvToken, "")
+		_, err := New(Options{Address: "http://127.0.0.1:8200"})
+		require.EqualError(t, err, "the Vault token is required")
+	})
+
+	t.Run("defaults from environment", func(t *testing.T) {
+		t.Setenv(EnvAddress, "http://127.0.0.1:8200/")
+		t.Setenv(EnvToken, testToken)
+		client, err := New(Options{})
+		require.NoError(t, err)
+		require.Equal(t, "http://127.0.0.1:8200", client.address)
+		require.Equal(t, testToken, client.token)
+		require.Equal(t, "http://127.0.0.1:8200/v1/secret/data/radius/test", client.url("data", "test"))
+	})
+
+	t.Run("token file", func(t *testing.T) {
+		tokenFile := filepath.Join(t.TempDir(), "token")
+		require.NoError(t, os.WriteFile(tokenFile, []byte(testToken+"\n"), 0600))
+
+		client, err := New(Options{Address: "http://127.0.0.1:8200", TokenFile: tokenFile, MountPath: "/kv/", PathPrefix: "ucp"})
+		require.NoError(t, err)
+		require.Equal(t, testToken, client.token)
+		require.Equal(t, "http://127.0.0.1:8200/v1/kv/metadata/ucp/test", client.url("metadata", "test"))
+	})
+}
+
+func TestClient(t *testing.T) {
+	server := newFakeVault(t)
+	client, err := New(Options{Address: server.URL, Token: testToken})
+	require.NoError(t, err)
+
+	runSaveTests(t, client)
+}
+
+func TestClient_Unauthorized(t *testing.T) {
+	server := newFakeVault(t)
+	client, err := New(Options{Address: server.URL, Token: "invalid"})
+	require.NoError(t, err)
+
+	_, err = client.Get(testcontext.New(t), testSecretName)
+	require.ErrorContains(t, err, "failed with status 403: permission denied")
+}
+
+// TestClient_DevServer runs the tests against a Vault server, for example one started with `vault server -dev`. It is
+// skipped unless VAULT_ADDR and VAULT_TOKEN are set.
+func TestClient_DevServer(t *testing.T) {
+	if os.Getenv(EnvAddress) == "" || os.Getenv(EnvToken) == "" {
+		t.Skipf("%s and %s must be set to run this test", EnvAddress, EnvToken)
+	}
+
+	client, err := New(Options{PathPrefix: "radius-test"})
+	require.NoError(t, err)
+
+	runSaveTests(t, client)
+}
+
+func runSaveTests(t *testing.T, client *Client) {
+	ctx := testcontext.New(t)
+
+	tests := []struct {
+		testName   string
+		secretName string
+		secret     []byte
+		save       bool
+		get        bool
+		delete     bool
+		err        error
+	}{
+		{"save-get-delete-secret-success", testSecretName, []byte(`"test_secret"`), true, true, true, nil},
+		{"save-get-delete-binary-secret-success", testSecretName, []byte{0, 1, 2, 255}, true, true, true, nil},
+		{"save-secret-empty-name", "", []byte("test"), true, false, false, &secret.ErrInvalid{Message: "invalid argument. 'name' is required"}},
+		{"save-secret-invalid-name", "../test", []byte("test"), true, false, false, &secret.ErrInvalid{Message: "invalid name: ../test"}},
+		{"save-secret-empty-secret", testSecretName, nil, true, false, false, &secret.ErrInvalid{Message: "invalid argument. 'value' is required"}},
+		{"delete-secret-without-save", testSecretName, nil, false, false, true, &secret.ErrNotFound{}},
+		{"get-secret-without-save", testSecretName, nil, false, true, false, &secret.ErrNotFound{}},
+		{"get-secret-with-empty-name", "", nil, false, true, false, &secret.ErrInvalid{Message: "invalid argument. 'name' is required"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			if tt.save {
+				err := client.Save(ctx, tt.secretName, tt.secret)
+				if tt.err == nil {
+					require.NoError(t, err)
+				} else {
+					require.Equal(t, tt.err, err)
+				}
+			}
+			if tt.get {
+				response, err := client.Get(ctx, tt.secretName)
+				if tt.err == nil {
+					require.NoError(t, err)
+					require.Equal(t, tt.secret, response)
+				} else {
+					require.Equal(t, tt.err, err)
+				}
+			}
+			if tt.delete {
+				err := client.Delete(ctx, tt.secretName)
+				if tt.err == nil {
+					require.NoError(t, err)
+
+					_, err = client.Get(ctx, tt.secretName)
+					require.Equal(t, &secret.ErrNotFound{}, err)
+				} else {
+					require.Equal(t, tt.err, err)
+				}
+			}
+		})
+	}
+}
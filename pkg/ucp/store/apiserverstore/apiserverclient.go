@@ -44,7 +44,9 @@ import (
 	"context"
 	"crypto/sha1"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"unicode"
 
@@ -59,6 +61,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/watch"
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -87,7 +90,7 @@ func NewAPIServerClient(client runtimeclient.Client, namespace string) *APIServe
 	return &APIServerClient{client: client, namespace: namespace}
 }
 
-var _ store.StorageClient = (*APIServerClient)(nil)
+var _ store.WatchableStorageClient = (*APIServerClient)(nil)
 
 type APIServerClient struct {
 	client    runtimeclient.Client
@@ -111,6 +114,16 @@ func (c *APIServerClient) Query(ctx context.Context, query store.Query, options
 	if query.IsScopeQuery && query.RoutingScopePrefix != "" {
 		return nil, &store.ErrInvalid{Message: "invalid argument. 'query.RoutingScopePrefix' is not supported for scope queries"}
 	}
+	if err := store.ValidateFilters(query.Filters); err != nil {
+		return nil, err
+	}
+
+	cfg := store.NewQueryConfig(options...)
+
+	cursor, err := storeutil.DecodePaginationToken(cfg.PaginationToken)
+	if err != nil {
+		return nil, err
+	}
 
 	selector, err := createLabelSelector(query)
 	if err != nil {
@@ -123,7 +136,10 @@ func (c *APIServerClient) Query(ctx context.Context, query store.Query, options
 		return nil, err
 	}
 
-	results := store.ObjectQueryResult{}
+	// Kubernetes objects can hold multiple entries, so we can't use the Kubernetes pagination support. Instead we
+	// order the entries by their (case-insensitive) ID and paginate client-side.
+	keys := []string{}
+	matches := map[string]*store.Object{}
 	for _, resource := range rs.Items {
 		for _, entry := range resource.Entries {
 			id, err := resources.Parse(entry.ID)
@@ -135,22 +151,38 @@ func (c *APIServerClient) Query(ctx context.Context, query store.Query, options
 				continue
 			}
 
-			if storeutil.IDMatchesQuery(id, query) {
-				converted, err := readEntry(&entry)
-				if err != nil {
-					return nil, err
-				}
+			key := strings.ToLower(id.String())
+			if key <= cursor || !storeutil.IDMatchesQuery(id, query) {
+				continue
+			}
 
-				match, err := converted.MatchesFilters(query.Filters)
-				if err != nil {
-					return nil, err
-				} else if !match {
-					continue
-				}
+			converted, err := readEntry(&entry)
+			if err != nil {
+				return nil, err
+			}
 
-				results.Items = append(results.Items, *converted)
+			match, err := converted.MatchesFilters(query.Filters)
+			if err != nil {
+				return nil, err
+			} else if !match {
+				continue
 			}
+
+			keys = append(keys, key)
+			matches[key] = converted
+		}
+	}
+	sort.Strings(keys)
+
+	results := store.ObjectQueryResult{}
+	for i, key := range keys {
+		if cfg.MaxQueryItemCount > 0 && i == cfg.MaxQueryItemCount {
+			// There's at least one more result, so return a token pointing at the last item we returned.
+			results.PaginationToken = storeutil.EncodePaginationToken(keys[i-1])
+			break
 		}
+
+		results.Items = append(results.Items, *matches[key])
 	}
 
 	return &results, nil
@@ -355,6 +387,162 @@ func (c *APIServerClient) Save(ctx context.Context, obj *store.Object, options .
 	return err
 }
 
+// Watch streams changes to the objects matching the query using a Kubernetes watch. The underlying Kubernetes client
+// must implement runtimeclient.WithWatch.
+//
+// Each Kubernetes object can hold multiple entries, so the client keeps a copy of the entries of each object it has seen
+// and compares them to report changes to individual entries.
+func (c *APIServerClient) Watch(ctx context.Context, query store.Query) (<-chan store.WatchEvent, error) {
+	if ctx == nil {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	if query.RootScope == "" {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'query.RootScope' is required"}
+	}
+	if query.IsScopeQuery && query.RoutingScopePrefix != "" {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'query.RoutingScopePrefix' is not supported for scope queries"}
+	}
+	if err := store.ValidateFilters(query.Filters); err != nil {
+		return nil, err
+	}
+
+	wc, ok := c.client.(runtimeclient.WithWatch)
+	if !ok {
+		return nil, errors.New("the Kubernetes client does not support watches")
+	}
+
+	selector, err := createLabelSelector(query)
+	if err != nil {
+		return nil, err
+	}
+
+	// List first so we know the current state of each object, then watch for changes from that point on.
+	rs := ucpv1alpha1.ResourceList{}
+	err = c.client.List(ctx, &rs, runtimeclient.InNamespace(c.namespace), runtimeclient.MatchingLabelsSelector{Selector: selector})
+	if err != nil {
+		return nil, err
+	}
+
+	known := map[string][]ucpv1alpha1.ResourceEntry{}
+	for _, resource := range rs.Items {
+		known[resource.Name] = resource.Entries
+	}
+
+	options := runtimeclient.ListOptions{
+		Namespace:     c.namespace,
+		LabelSelector: selector,
+		Raw:           &v1.ListOptions{ResourceVersion: rs.ResourceVersion},
+	}
+	watcher, err := wc.Watch(ctx, &ucpv1alpha1.ResourceList{}, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan store.WatchEvent)
+	go func() {
+		defer close(out)
+		defer watcher.Stop()
+
+		logger := ucplog.FromContextOrDiscard(ctx)
+		for {
+			var event watch.Event
+			select {
+			case <-ctx.Done():
+				return
+			case e, ok := <-watcher.ResultChan():
+				if !ok {
+					return
+				}
+				event = e
+			}
+
+			var events []store.WatchEvent
+			switch event.Type {
+			case watch.Added, watch.Modified:
+				resource, ok := event.Object.(*ucpv1alpha1.Resource)
+				if !ok {
+					continue
+				}
+				events = diffEntries(known[resource.Name], resource.Entries)
+				known[resource.Name] = resource.Entries
+			case watch.Deleted:
+				resource, ok := event.Object.(*ucpv1alpha1.Resource)
+				if !ok {
+					continue
+				}
+				// Prefer our own copy of the entries, the object in the event may be stale.
+				previous, ok := known[resource.Name]
+				if !ok {
+					previous = resource.Entries
+				}
+				events = diffEntries(previous, nil)
+				delete(known, resource.Name)
+			case watch.Error:
+				logger.Error(apierrors.FromObject(event.Object), "kubernetes watch failed", "rootScope", query.RootScope)
+				return
+			}
+
+			for _, e := range events {
+				id, err := resources.Parse(e.Object.ID)
+				if err != nil || !storeutil.IDMatchesQuery(id, query) {
+					continue
+				}
+
+				match, err := e.Object.MatchesFilters(query.Filters)
+				if err != nil || !match {
+					continue
+				}
+
+				select {
+				case out <- e:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// diffEntries compares the previous and current entries of a Kubernetes object and returns the changes as watch events.
+func diffEntries(previous []ucpv1alpha1.ResourceEntry, current []ucpv1alpha1.ResourceEntry) []store.WatchEvent {
+	existing := map[string]*ucpv1alpha1.ResourceEntry{}
+	for i := range previous {
+		existing[strings.ToLower(previous[i].ID)] = &previous[i]
+	}
+
+	events := []store.WatchEvent{}
+	for i := range current {
+		key := strings.ToLower(current[i].ID)
+		old, ok := existing[key]
+		delete(existing, key)
+
+		eventType := store.WatchEventCreated
+		if ok && old.ETag == current[i].ETag {
+			continue
+		} else if ok {
+			eventType = store.WatchEventUpdated
+		}
+
+		obj, err := readEntry(&current[i])
+		if err != nil {
+			continue
+		}
+		events = append(events, store.WatchEvent{Type: eventType, Object: *obj})
+	}
+
+	for _, old := range existing {
+		obj, err := readEntry(old)
+		if err != nil {
+			continue
+		}
+		events = append(events, store.WatchEvent{Type: store.WatchEventDeleted, Object: *obj})
+	}
+
+	return events
+}
+
 func (c *APIServerClient) doWithRetry(ctx context.Context, action func() (bool, error)) error {
 	for i := 0; i < RetryCount; i++ {
 		retryable, err := action()
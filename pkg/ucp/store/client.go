@@ -71,14 +71,49 @@ type Query struct {
 	// 	set RootScope to /planes/radius/local and ScopeRecursive = True and IsScopeQuery to False.
 	IsScopeQuery bool
 
-	// TODO: Revisit filter design
-
-	// Filters is an query filter to filter the specific property value.
+	// Filters is an query filter to filter the specific property value. All filters must match.
 	Filters []QueryFilter
 }
 
+// FilterOperator is the comparison used by a QueryFilter.
+type FilterOperator string
+
+const (
+	// FilterOperatorEqual matches objects where the field is equal to Value. This is the default operator.
+	FilterOperatorEqual FilterOperator = ""
+
+	// FilterOperatorEqualIgnoreCase matches objects where the field is equal to Value, ignoring case.
+	FilterOperatorEqualIgnoreCase FilterOperator = "ieq"
+
+	// FilterOperatorNotEqual matches objects where the field is not equal to Value, including objects where
+	// the field is not set.
+	FilterOperatorNotEqual FilterOperator = "ne"
+
+	// FilterOperatorIn matches objects where the field is equal to one of Values.
+	FilterOperatorIn FilterOperator = "in"
+
+	// FilterOperatorPrefix matches objects where the field starts with Value.
+	FilterOperatorPrefix FilterOperator = "prefix"
+
+	// FilterOperatorExists matches objects where the field is set.
+	FilterOperatorExists FilterOperator = "exists"
+)
+
 // QueryFilter is the filter which filters property in resource entity.
+//
+// Values are compared to string fields case-sensitively, except by FilterOperatorEqualIgnoreCase. Fields that are not
+// strings never match, except for the not-equal and exists operators.
 type QueryFilter struct {
+	// Field is the path of the property to compare, using '.' to separate nested properties.
+	// Example: properties.application
 	Field string
+
+	// Value is the value to compare with. Not used by the in and exists operators.
 	Value string
+
+	// Operator is the comparison to use. Defaults to FilterOperatorEqual.
+	Operator FilterOperator
+
+	// Values are the values to compare with for the in operator.
+	Values []string
 }
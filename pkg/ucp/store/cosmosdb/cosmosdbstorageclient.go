@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync"
 
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	resources_azure "github.com/radius-project/radius/pkg/ucp/resources/azure"
@@ -41,7 +42,7 @@ const (
 	errEtagPreconditionMsgPrefix = "The operation specified an eTag"
 )
 
-var _ store.StorageClient = (*CosmosDBStorageClient)(nil)
+var _ store.TransactionalStorageClient = (*CosmosDBStorageClient)(nil)
 
 // ResourceEntity represents the default envelope model to store resource metadata.
 type ResourceEntity struct {
@@ -68,9 +69,17 @@ type ResourceEntity struct {
 }
 
 // CosmosDBStorageClient implements CosmosDB stroage client.
+//
+// A client is created for the collection of each resource type. Transactions are scoped to the collection of the client,
+// so objects of different resource types, such as a resource and the status of its async operation, can't be written
+// in a single transaction.
 type CosmosDBStorageClient struct {
 	client  *cosmosapi.Client
 	options *ConnectionOptions
+
+	// procedureMu guards procedureReady, which is set once the stored procedure used for transactions is created.
+	procedureMu    sync.Mutex
+	procedureReady bool
 }
 
 // NewCosmosDBStorageClient creates a new CosmosDBStorageClient instance using the provided ConnectionOptions and returns
@@ -211,14 +220,46 @@ func constructCosmosDBQuery(query store.Query) (*cosmosapi.Query, error) {
 		})
 	}
 
+	// Filter fields are validated to only contain property names, so they are safe to use in the query.
+	if err := store.ValidateFilters(query.Filters); err != nil {
+		return nil, err
+	}
+
 	for i, filter := range query.Filters {
 		if whereParam != "" {
 			whereParam += " and "
 		}
-		filterParam := fmt.Sprintf("filter%d", i)
-		whereParam += fmt.Sprintf("STRINGEQUALS(c.entity.%s, @%s, true)", filter.Field, filterParam)
+		field := "c.entity." + filter.Field
+		filterParam := fmt.Sprintf("@filter%d", i)
+		switch filter.Operator {
+		case store.FilterOperatorEqualIgnoreCase:
+			whereParam += fmt.Sprintf("STRINGEQUALS(%s, %s, true)", field, filterParam)
+		case store.FilterOperatorNotEqual:
+			whereParam += fmt.Sprintf("NOT (IS_STRING(%s) and STRINGEQUALS(%s, %s))", field, field, filterParam)
+		case store.FilterOperatorPrefix:
+			whereParam += fmt.Sprintf("STARTSWITH(%s, %s)", field, filterParam)
+		case store.FilterOperatorExists:
+			whereParam += fmt.Sprintf("(IS_DEFINED(%s) and NOT IS_NULL(%s))", field, field)
+			continue
+		case store.FilterOperatorIn:
+			conditions := []string{}
+			for j, value := range filter.Values {
+				valueParam := fmt.Sprintf("%s_%d", filterParam, j)
+				conditions = append(conditions, fmt.Sprintf("STRINGEQUALS(%s, %s)", field, valueParam))
+				queryParams = append(queryParams, cosmosapi.QueryParam{
+					Name:  valueParam,
+					Value: value,
+				})
+			}
+			whereParam += "(" + strings.Join(conditions, " or ") + ")"
+			continue
+		default:
+			// CosmosDB has always compared equality filters case-insensitively, unlike the other stores. This is
+			// kept for compatibility with existing callers.
+			whereParam += fmt.Sprintf("STRINGEQUALS(%s, %s, true)", field, filterParam)
+		}
 		queryParams = append(queryParams, cosmosapi.QueryParam{
-			Name:  "@" + filterParam,
+			Name:  filterParam,
 			Value: filter.Value,
 		})
 	}
@@ -241,6 +282,9 @@ func (c *CosmosDBStorageClient) Query(ctx context.Context, query store.Query, op
 	if query.IsScopeQuery && query.RoutingScopePrefix != "" {
 		return nil, &store.ErrInvalid{Message: "invalid argument. 'query.RoutingScopePrefix' is not supported for scope queries"}
 	}
+	if err := store.ValidateFilters(query.Filters); err != nil {
+		return nil, err
+	}
 
 	cfg := store.NewQueryConfig(opts...)
 
@@ -392,23 +436,11 @@ func (c *CosmosDBStorageClient) Save(ctx context.Context, obj *store.Object, opt
 		return err
 	}
 
-	docID, err := GenerateCosmosDBKey(parsed)
+	entity, err := newResourceEntity(parsed, obj)
 	if err != nil {
 		return err
 	}
-
-	partitionKey, err := GetPartitionKey(parsed)
-	if err != nil {
-		return err
-	}
-
-	entity := &ResourceEntity{
-		ID:           docID,
-		ResourceID:   strings.ToLower(parsed.String()),
-		RootScope:    strings.ToLower(parsed.RootScope()),
-		PartitionKey: partitionKey,
-		Entity:       obj.Data,
-	}
+	partitionKey := entity.PartitionKey
 
 	ifMatch := cfg.ETag
 	if ifMatch == "" && obj.ETag != "" {
@@ -444,6 +476,27 @@ func (c *CosmosDBStorageClient) Save(ctx context.Context, obj *store.Object, opt
 	return nil
 }
 
+// newResourceEntity creates the ResourceEntity used to store the object.
+func newResourceEntity(parsed resources.ID, obj *store.Object) (*ResourceEntity, error) {
+	docID, err := GenerateCosmosDBKey(parsed)
+	if err != nil {
+		return nil, err
+	}
+
+	partitionKey, err := GetPartitionKey(parsed)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ResourceEntity{
+		ID:           docID,
+		ResourceID:   strings.ToLower(parsed.String()),
+		RootScope:    strings.ToLower(parsed.RootScope()),
+		PartitionKey: partitionKey,
+		Entity:       obj.Data,
+	}, nil
+}
+
 // GetPartitionKey returns a partition key based on the given ID, normalizing the subscription ID and normalizing the
 // plane namespace if the ID is UCP-qualified.
 // Examples:
@@ -227,6 +227,41 @@ func TestConstructCosmosDBQuery(t *testing.T) {
 			}},
 			err: nil,
 		},
+		{
+			desc: "filter-operators",
+			storeQuery: store.Query{
+				RootScope: "/planes/radius/local/resourcegroups/testgroup",
+				Filters: []store.QueryFilter{
+					{Field: "properties.application", Value: "/planes/radius/local/resourcegroups/testgroup/", Operator: store.FilterOperatorPrefix},
+					{Field: "properties.environment", Value: "env0", Operator: store.FilterOperatorNotEqual},
+					{Field: "properties.status", Operator: store.FilterOperatorExists},
+					{Field: "name", Values: []string{"a", "b"}, Operator: store.FilterOperatorIn},
+					{Field: "kind", Value: "Cool", Operator: store.FilterOperatorEqualIgnoreCase},
+				},
+			},
+			queryString: "SELECT * FROM c WHERE c.rootScope = @rootScope and STARTSWITH(c.entity.properties.application, @filter0)" +
+				" and NOT (IS_STRING(c.entity.properties.environment) and STRINGEQUALS(c.entity.properties.environment, @filter1))" +
+				" and (IS_DEFINED(c.entity.properties.status) and NOT IS_NULL(c.entity.properties.status))" +
+				" and (STRINGEQUALS(c.entity.name, @filter3_0) or STRINGEQUALS(c.entity.name, @filter3_1))" +
+				" and STRINGEQUALS(c.entity.kind, @filter4, true)",
+			params: []cosmosapi.QueryParam{
+				{Name: "@rootScope", Value: "/planes/radius/local/resourcegroups/testgroup"},
+				{Name: "@filter0", Value: "/planes/radius/local/resourcegroups/testgroup/"},
+				{Name: "@filter1", Value: "env0"},
+				{Name: "@filter3_0", Value: "a"},
+				{Name: "@filter3_1", Value: "b"},
+				{Name: "@filter4", Value: "Cool"},
+			},
+			err: nil,
+		},
+		{
+			desc: "invalid-filter-field",
+			storeQuery: store.Query{
+				RootScope: "/planes/radius/local/resourcegroups/testgroup",
+				Filters:   []store.QueryFilter{{Field: "name) or (1=1", Value: "a"}},
+			},
+			err: &store.ErrInvalid{Message: "invalid argument. 'filter.Field' must be a '.' separated list of property names"},
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -0,0 +1,264 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cosmosdb
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/vippsas/go-cosmosdb/cosmosapi"
+)
+
+const (
+	// TransactionProcedureName is the name of the stored procedure used to execute transactions.
+	TransactionProcedureName = "ucpExecuteTransaction"
+
+	// transactionProcedureBody is the body of the stored procedure used to execute transactions. go-cosmosdb does not
+	// support transactional batches, so we use a stored procedure instead. Stored procedures run as a transaction
+	// scoped to a single partition key, and any exception thrown by the procedure rolls back all of its writes.
+	//
+	// go-cosmosdb does not return the error response body, so the preconditions are checked before writing anything
+	// and precondition failures are reported in the response body rather than by throwing.
+	transactionProcedureBody = `function ucpExecuteTransaction(operations) {
+    var collection = getContext().getCollection();
+    var response = getContext().getResponse();
+    var collectionLink = collection.getAltLink();
+
+    function documentLink(id) {
+        return collectionLink + "/docs/" + id;
+    }
+
+    function check(i) {
+        if (i >= operations.length) {
+            write(0, []);
+            return;
+        }
+
+        var op = operations[i];
+        var accepted = collection.readDocument(documentLink(op.id), {}, function (err, doc) {
+            if (err && err.number !== 404) {
+                throw err;
+            }
+
+            var exists = !err;
+            if (op.etag && (!exists || doc._etag !== op.etag)) {
+                response.setBody({ error: "Concurrency", index: i });
+                return;
+            }
+            if (op.type === "Delete" && !exists) {
+                response.setBody({ error: "NotFound", index: i });
+                return;
+            }
+
+            check(i + 1);
+        });
+        if (!accepted) {
+            throw new Error("The transaction was not accepted.");
+        }
+    }
+
+    function write(i, etags) {
+        if (i >= operations.length) {
+            response.setBody({ etags: etags });
+            return;
+        }
+
+        var op = operations[i];
+        var callback = function (err, doc) {
+            if (err) {
+                throw err;
+            }
+
+            etags.push(doc && doc._etag ? doc._etag : "");
+            write(i + 1, etags);
+        };
+
+        var accepted;
+        if (op.type === "Save") {
+            accepted = collection.upsertDocument(collectionLink, op.document, {}, callback);
+        } else {
+            accepted = collection.deleteDocument(documentLink(op.id), {}, callback);
+        }
+        if (!accepted) {
+            throw new Error("The transaction was not accepted.");
+        }
+    }
+
+    check(0);
+}`
+)
+
+// transactionOperation is the representation of a store.TransactionOperation passed to the stored procedure.
+type transactionOperation struct {
+	Type     store.TransactionOperationType `json:"type"`
+	ID       string                         `json:"id"`
+	ETag     string                         `json:"etag,omitempty"`
+	Document *ResourceEntity                `json:"document,omitempty"`
+}
+
+// transactionResult is the response of the stored procedure.
+type transactionResult struct {
+	// Error is set to "Concurrency" or "NotFound" when a precondition failed.
+	Error string `json:"error,omitempty"`
+
+	// Index is the index of the operation that failed.
+	Index int `json:"index,omitempty"`
+
+	// ETags are the ETags of the documents written by each operation.
+	ETags []string `json:"etags,omitempty"`
+}
+
+// ensureTransactionProcedure creates the stored procedure used for transactions the first time a transaction is
+// executed, so clients that never use transactions don't pay for it.
+func (c *CosmosDBStorageClient) ensureTransactionProcedure(ctx context.Context) error {
+	c.procedureMu.Lock()
+	defer c.procedureMu.Unlock()
+
+	if c.procedureReady {
+		return nil
+	}
+	if err := c.createTransactionProcedureIfNotExists(ctx); err != nil {
+		return err
+	}
+
+	c.procedureReady = true
+	return nil
+}
+
+func (c *CosmosDBStorageClient) createTransactionProcedureIfNotExists(ctx context.Context) error {
+	sproc, err := c.client.GetStoredProcedure(ctx, c.options.DatabaseName, c.options.CollectionName, TransactionProcedureName)
+	if err == nil {
+		if sproc.Body == transactionProcedureBody {
+			return nil
+		}
+
+		_, err = c.client.ReplaceStoredProcedure(ctx, c.options.DatabaseName, c.options.CollectionName, TransactionProcedureName, transactionProcedureBody)
+		return err
+	}
+	if !strings.EqualFold(err.Error(), errResourceNotFoundMsg) {
+		return err
+	}
+
+	_, err = c.client.CreateStoredProcedure(ctx, c.options.DatabaseName, c.options.CollectionName, TransactionProcedureName, transactionProcedureBody)
+	if err != nil && strings.EqualFold(err.Error(), errIDConflictMsg) {
+		return nil
+	}
+	return err
+}
+
+// ExecuteTransaction commits all of the operations in the transaction atomically using a stored procedure. CosmosDB
+// transactions are scoped to a single collection and partition key, so all of the objects in the transaction must
+// share the same partition key. Resource types are stored in separate collections, so objects of different resource
+// types can't be written in a single transaction. In particular, the async operation worker can't save a resource in the
+// same transaction as its operation status, and writes them one after the other.
+func (c *CosmosDBStorageClient) ExecuteTransaction(ctx context.Context, tx *store.Transaction) error {
+	if ctx == nil {
+		return &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	if tx == nil {
+		return &store.ErrInvalid{Message: "invalid argument. 'tx' is required"}
+	}
+	if len(tx.Operations) == 0 {
+		return nil
+	}
+
+	partitionKey := ""
+	seen := map[string]struct{}{}
+	operations := []transactionOperation{}
+	for i, op := range tx.Operations {
+		operation := transactionOperation{Type: op.Type, ETag: op.ETag}
+
+		var id resources.ID
+		var err error
+		switch op.Type {
+		case store.TransactionOperationSave:
+			if op.Object == nil {
+				return &store.ErrInvalid{Message: "invalid argument. 'obj' is required"}
+			}
+			if id, err = resources.Parse(op.Object.ID); err != nil {
+				return err
+			}
+			if operation.Document, err = newResourceEntity(id, op.Object); err != nil {
+				return err
+			}
+
+		case store.TransactionOperationDelete:
+			if id, err = resources.Parse(op.ID); err != nil {
+				return err
+			}
+
+		default:
+			return &store.ErrInvalid{Message: "invalid argument. unsupported transaction operation type " + string(op.Type)}
+		}
+
+		if operation.ID, err = GenerateCosmosDBKey(id); err != nil {
+			return err
+		}
+		if _, ok := seen[operation.ID]; ok {
+			return &store.ErrInvalid{Message: "invalid argument. a transaction must not contain more than one operation for the same object"}
+		}
+		seen[operation.ID] = struct{}{}
+
+		pk, err := GetPartitionKey(id)
+		if err != nil {
+			return err
+		}
+		if i == 0 {
+			partitionKey = pk
+		} else if pk != partitionKey {
+			return &store.ErrInvalid{Message: "invalid argument. all objects in a transaction must have the same partition key"}
+		}
+
+		operations = append(operations, operation)
+	}
+
+	if err := c.ensureTransactionProcedure(ctx); err != nil {
+		return err
+	}
+
+	result := transactionResult{}
+	ops := cosmosapi.ExecuteStoredProcedureOptions{PartitionKeyValue: partitionKey}
+	err := c.client.ExecuteStoredProcedure(ctx, c.options.DatabaseName, c.options.CollectionName, TransactionProcedureName, ops, &result, operations)
+	if err != nil {
+		return err
+	}
+
+	switch result.Error {
+	case "":
+	case "Concurrency":
+		return &store.ErrConcurrency{}
+	case "NotFound":
+		return &store.ErrNotFound{ID: tx.Operations[result.Index].ID}
+	default:
+		return errors.New("failed to execute transaction: " + result.Error)
+	}
+
+	if len(result.ETags) != len(tx.Operations) {
+		return errors.New("failed to execute transaction: unexpected response from stored procedure")
+	}
+
+	for i, op := range tx.Operations {
+		if op.Type == store.TransactionOperationSave {
+			op.Object.ETag = result.ETags[i]
+		}
+	}
+
+	return nil
+}
@@ -74,3 +74,21 @@ func (e *ErrConcurrency) Is(target error) bool {
 	_, ok := target.(*ErrConcurrency)
 	return ok
 }
+
+var _ error = (*ErrTransactionNotSupported)(nil)
+
+// ErrTransactionNotSupported is returned when a set of objects can't be written in a single transaction, for example
+// because they are stored in different CosmosDB collections.
+type ErrTransactionNotSupported struct {
+}
+
+// Error returns the error message for ErrTransactionNotSupported error.
+func (e *ErrTransactionNotSupported) Error() string {
+	return "the objects can't be written in a single transaction because they are not stored together"
+}
+
+// Is checks if the target error is an instance of ErrTransactionNotSupported.
+func (e *ErrTransactionNotSupported) Is(target error) bool {
+	_, ok := target.(*ErrTransactionNotSupported)
+	return ok
+}
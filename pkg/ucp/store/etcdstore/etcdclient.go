@@ -54,6 +54,7 @@ import (
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/radius-project/radius/pkg/ucp/store"
 	"github.com/radius-project/radius/pkg/ucp/store/storeutil"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
 	"github.com/radius-project/radius/pkg/ucp/util/etag"
 	etcdclient "go.etcd.io/etcd/client/v3"
 )
@@ -67,7 +68,8 @@ func NewETCDClient(c *etcdclient.Client) *ETCDClient {
 	return &ETCDClient{client: c}
 }
 
-var _ store.StorageClient = (*ETCDClient)(nil)
+var _ store.WatchableStorageClient = (*ETCDClient)(nil)
+var _ store.TransactionalStorageClient = (*ETCDClient)(nil)
 
 type ETCDClient struct {
 	client *etcdclient.Client
@@ -84,21 +86,47 @@ func (c *ETCDClient) Query(ctx context.Context, query store.Query, options ...st
 	if query.IsScopeQuery && query.RoutingScopePrefix != "" {
 		return nil, &store.ErrInvalid{Message: "invalid argument. 'query.RoutingScopePrefix' is not supported for scope queries"}
 	}
+	if err := store.ValidateFilters(query.Filters); err != nil {
+		return nil, err
+	}
 
-	key := keyFromQuery(query)
+	cfg := store.NewQueryConfig(options...)
 
-	// TODO: We don't place a limit/top value on the query right now so we get all
-	// results as a single page. This would be a nice future improvement
-	//
-	// https://stackoverflow.com/questions/44873514/etcd3-go-client-how-to-paginate-large-sets-of-keys
-	response, err := c.client.Get(ctx, key, etcdclient.WithPrefix())
+	cursor, err := storeutil.DecodePaginationToken(cfg.PaginationToken)
 	if err != nil {
 		return nil, err
 	}
 
+	key := keyFromQuery(query)
+	rangeEnd := etcdclient.GetPrefixRangeEnd(key)
+
+	// Keys are returned in sorted order so the next page starts right after the last key we returned.
+	start := key
+	if cursor != "" {
+		start = cursor + "\x00"
+	}
+
+	// When paginating we read one more key than the page size so we know whether there is another page. Keys that don't
+	// match the query are filtered client-side, so we may need to read more than one batch to fill a page.
+	batchSize := int64(0)
+	if cfg.MaxQueryItemCount > 0 {
+		batchSize = int64(cfg.MaxQueryItemCount) + 1
+	}
+
 	results := store.ObjectQueryResult{}
-	for _, kv := range response.Kvs {
-		if keyMatchesQuery(kv.Key, query) {
+	lastKey := ""
+	for {
+		response, err := c.client.Get(ctx, start, etcdclient.WithRange(rangeEnd), etcdclient.WithLimit(batchSize))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, kv := range response.Kvs {
+			start = string(kv.Key) + "\x00"
+			if !keyMatchesQuery(kv.Key, query) {
+				continue
+			}
+
 			value := store.Object{}
 			err = json.Unmarshal(kv.Value, &value)
 			if err != nil {
@@ -112,12 +140,21 @@ func (c *ETCDClient) Query(ctx context.Context, query store.Query, options ...st
 				continue
 			}
 
+			if cfg.MaxQueryItemCount > 0 && len(results.Items) == cfg.MaxQueryItemCount {
+				// There's at least one more result, so return a token pointing at the last item we returned.
+				results.PaginationToken = storeutil.EncodePaginationToken(lastKey)
+				return &results, nil
+			}
+
 			value.ETag = etag.NewFromRevision(kv.ModRevision)
 			results.Items = append(results.Items, value)
+			lastKey = string(kv.Key)
 		}
-	}
 
-	return &results, nil
+		if !response.More {
+			return &results, nil
+		}
+	}
 }
 
 // Get checks if the provided context, id and options are valid, then retrieves the corresponding object from
@@ -279,6 +316,216 @@ func (c *ETCDClient) Save(ctx context.Context, obj *store.Object, options ...sto
 	return nil
 }
 
+// ExecuteTransaction commits all of the operations in the transaction atomically using a single etcd transaction.
+// The preconditions of all operations are checked as the guard of the transaction.
+func (c *ETCDClient) ExecuteTransaction(ctx context.Context, tx *store.Transaction) error {
+	if ctx == nil {
+		return &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	if tx == nil {
+		return &store.ErrInvalid{Message: "invalid argument. 'tx' is required"}
+	}
+	if len(tx.Operations) == 0 {
+		return nil
+	}
+
+	keys := []string{}
+	seen := map[string]struct{}{}
+	revisions := []int64{}
+	compares := []etcdclient.Cmp{}
+	operations := []etcdclient.Op{}
+	for _, op := range tx.Operations {
+		var key string
+		switch op.Type {
+		case store.TransactionOperationSave:
+			if op.Object == nil {
+				return &store.ErrInvalid{Message: "invalid argument. 'obj' is required"}
+			}
+
+			parsed, err := resources.Parse(op.Object.ID)
+			if err != nil {
+				return err
+			}
+
+			b, err := json.Marshal(op.Object)
+			if err != nil {
+				return err
+			}
+
+			key = keyFromID(parsed)
+			operations = append(operations, etcdclient.OpPut(key, string(b)))
+
+		case store.TransactionOperationDelete:
+			parsed, err := resources.Parse(op.ID)
+			if err != nil {
+				return &store.ErrInvalid{Message: "invalid argument. 'id' must be a valid resource id"}
+			}
+			if parsed.IsEmpty() {
+				return &store.ErrInvalid{Message: "invalid argument. 'id' must not be empty"}
+			}
+			if parsed.IsResourceCollection() || parsed.IsScopeCollection() {
+				return &store.ErrInvalid{Message: "invalid argument. 'id' must refer to a named resource, not a collection"}
+			}
+
+			key = keyFromID(parsed)
+			operations = append(operations, etcdclient.OpDelete(key))
+
+		default:
+			return &store.ErrInvalid{Message: "invalid argument. unsupported transaction operation type " + string(op.Type)}
+		}
+
+		// etcd rejects transactions that write the same key more than once.
+		if _, ok := seen[key]; ok {
+			return &store.ErrInvalid{Message: "invalid argument. a transaction must not contain more than one operation for the same object"}
+		}
+		seen[key] = struct{}{}
+		keys = append(keys, key)
+
+		revision := int64(0)
+		if op.ETag != "" {
+			var err error
+			revision, err = etag.ParseRevision(op.ETag)
+			if err != nil {
+				// Treat an invalid ETag as a concurrency failure, since it will never match.
+				return &store.ErrConcurrency{}
+			}
+			compares = append(compares, etcdclient.Compare(etcdclient.ModRevision(key), "=", revision))
+		} else if op.Type == store.TransactionOperationDelete {
+			// The object must exist to be deleted.
+			compares = append(compares, etcdclient.Compare(etcdclient.CreateRevision(key), ">", 0))
+		}
+		revisions = append(revisions, revision)
+	}
+
+	// When the preconditions fail we read the current state of each key to report which precondition failed.
+	reads := []etcdclient.Op{}
+	for _, key := range keys {
+		reads = append(reads, etcdclient.OpGet(key))
+	}
+
+	txn, err := c.client.Txn(ctx).If(compares...).Then(operations...).Else(reads...).Commit()
+	if err != nil {
+		return err
+	}
+
+	if !txn.Succeeded {
+		for i, op := range tx.Operations {
+			kvs := txn.Responses[i].GetResponseRange().Kvs
+			if op.ETag != "" && (len(kvs) == 0 || kvs[0].ModRevision != revisions[i]) {
+				return &store.ErrConcurrency{}
+			} else if op.ETag == "" && op.Type == store.TransactionOperationDelete && len(kvs) == 0 {
+				return &store.ErrNotFound{ID: op.ID}
+			}
+		}
+
+		// The reads are evaluated at the same revision as the preconditions, so we don't expect to get here.
+		return &store.ErrConcurrency{}
+	}
+
+	// All of the writes in the transaction share the revision of the transaction.
+	for _, op := range tx.Operations {
+		if op.Type == store.TransactionOperationSave {
+			op.Object.ETag = etag.NewFromRevision(txn.Header.Revision)
+		}
+	}
+
+	return nil
+}
+
+// Watch streams changes to the objects matching the query using an etcd watch on the key prefix of the query.
+func (c *ETCDClient) Watch(ctx context.Context, query store.Query) (<-chan store.WatchEvent, error) {
+	if ctx == nil {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	if query.RootScope == "" {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'query.RootScope' is required"}
+	}
+	if query.IsScopeQuery && query.RoutingScopePrefix != "" {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'query.RoutingScopePrefix' is not supported for scope queries"}
+	}
+	if err := store.ValidateFilters(query.Filters); err != nil {
+		return nil, err
+	}
+
+	// Use the current revision as the starting point so we don't miss changes that happen
+	// between this call and the watch being established.
+	response, err := c.client.Get(ctx, keyFromQuery(query), etcdclient.WithPrefix(), etcdclient.WithCountOnly())
+	if err != nil {
+		return nil, err
+	}
+
+	watch := c.client.Watch(ctx, keyFromQuery(query), etcdclient.WithPrefix(), etcdclient.WithPrevKV(), etcdclient.WithRev(response.Header.Revision+1))
+
+	out := make(chan store.WatchEvent)
+	go func() {
+		defer close(out)
+
+		logger := ucplog.FromContextOrDiscard(ctx)
+		for response := range watch {
+			if err := response.Err(); err != nil {
+				logger.Error(err, "etcd watch failed", "rootScope", query.RootScope)
+				return
+			}
+
+			for _, ev := range response.Events {
+				event, err := watchEventFromETCD(ev, query)
+				if err != nil {
+					logger.Error(err, "failed to read etcd watch event", "key", string(ev.Kv.Key))
+					continue
+				} else if event == nil {
+					continue
+				}
+
+				select {
+				case out <- *event:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// watchEventFromETCD converts an etcd event to a store.WatchEvent. Returns nil if the event does not match the query.
+func watchEventFromETCD(ev *etcdclient.Event, query store.Query) (*store.WatchEvent, error) {
+	if !keyMatchesQuery(ev.Kv.Key, query) {
+		return nil, nil
+	}
+
+	event := store.WatchEvent{}
+	value := ev.Kv.Value
+	switch {
+	case ev.Type == etcdclient.EventTypeDelete:
+		if ev.PrevKv == nil {
+			// The previous value is not available if it was compacted, so we can't report the deletion.
+			return nil, nil
+		}
+		event.Type = store.WatchEventDeleted
+		value = ev.PrevKv.Value
+	case ev.IsCreate():
+		event.Type = store.WatchEventCreated
+	default:
+		event.Type = store.WatchEventUpdated
+	}
+
+	err := json.Unmarshal(value, &event.Object)
+	if err != nil {
+		return nil, err
+	}
+
+	match, err := event.Object.MatchesFilters(query.Filters)
+	if err != nil {
+		return nil, err
+	} else if !match {
+		return nil, nil
+	}
+
+	event.Object.ETag = etag.NewFromRevision(ev.Kv.ModRevision)
+	return &event, nil
+}
+
 // Client returns the etcdclient.Client instance stored in the ETCDClient struct.
 func (c *ETCDClient) Client() *etcdclient.Client {
 	return c.client
@@ -327,9 +574,17 @@ func keyFromQuery(query store.Query) string {
 
 	if query.ScopeRecursive {
 		return prefix + SectionSeparator + storeutil.NormalizePart(query.RootScope)
-	} else {
-		return prefix + SectionSeparator + storeutil.NormalizePart(query.RootScope) + SectionSeparator + storeutil.NormalizePart(query.RoutingScopePrefix)
 	}
+
+	routingScopePrefix := query.RoutingScopePrefix
+	if routingScopePrefix == "" && !query.IsScopeQuery && strings.Count(query.ResourceType, resources.SegmentSeparator) == 1 {
+		// The routing scope of a top-level resource starts with its type, so we can push the type filter down
+		// into the key prefix rather than reading every resource in the scope. Nested resource types are still
+		// filtered client-side.
+		routingScopePrefix = query.ResourceType
+	}
+
+	return prefix + SectionSeparator + storeutil.NormalizePart(query.RootScope) + SectionSeparator + storeutil.NormalizePart(routingScopePrefix)
 }
 
 func keyMatchesQuery(key []byte, query store.Query) bool {
@@ -67,4 +67,6 @@ func Test_ETCDClient(t *testing.T) {
 
 	// The actual test logic lives in a shared package, we're just doing the setup here.
 	shared.RunTest(t, client, clear)
+	shared.RunWatchTest(t, client, clear)
+	shared.RunTransactionTest(t, client, clear)
 }
@@ -18,9 +18,41 @@ package store
 
 import (
 	"reflect"
+	"regexp"
 	"strings"
 )
 
+// fieldSegmentPattern is the pattern that each segment of QueryFilter.Field must match. Restricting the field names
+// allows backends to safely embed them in queries.
+var fieldSegmentPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// ValidateFilters checks that the filters are valid and returns ErrInvalid if they are not.
+func ValidateFilters(filters []QueryFilter) error {
+	for _, filter := range filters {
+		if filter.Field == "" {
+			return &ErrInvalid{Message: "invalid argument. 'filter.Field' is required"}
+		}
+
+		for _, segment := range strings.Split(filter.Field, ".") {
+			if !fieldSegmentPattern.MatchString(segment) {
+				return &ErrInvalid{Message: "invalid argument. 'filter.Field' must be a '.' separated list of property names"}
+			}
+		}
+
+		switch filter.Operator {
+		case FilterOperatorEqual, FilterOperatorEqualIgnoreCase, FilterOperatorNotEqual, FilterOperatorPrefix, FilterOperatorExists:
+		case FilterOperatorIn:
+			if len(filter.Values) == 0 {
+				return &ErrInvalid{Message: "invalid argument. 'filter.Values' is required for the 'in' operator"}
+			}
+		default:
+			return &ErrInvalid{Message: "invalid argument. 'filter.Operator' " + string(filter.Operator) + " is not supported"}
+		}
+	}
+
+	return nil
+}
+
 // MatchesFilters checks if the object's data matches the given filters and returns a boolean and an error.
 func (o Object) MatchesFilters(filters []QueryFilter) (bool, error) {
 	if len(filters) == 0 {
@@ -28,6 +60,10 @@ func (o Object) MatchesFilters(filters []QueryFilter) (bool, error) {
 		return true, nil
 	}
 
+	if err := ValidateFilters(filters); err != nil {
+		return false, err
+	}
+
 	data := o.Data
 	if data == nil {
 		// Treat nil as "empty" data
@@ -44,32 +80,71 @@ func (o Object) MatchesFilters(filters []QueryFilter) (bool, error) {
 	}
 
 	for _, filter := range filters {
-		value := reflect.ValueOf(data)
-		fields := strings.Split(filter.Field, ".")
-		for i, field := range fields {
-			value = value.MapIndex(reflect.ValueOf(field))
-			if i < len(fields)-1 {
-				// Need to go further into the nested fields
-				value = reflect.ValueOf(value.Interface())
-			}
+		value, found := lookupField(reflect.ValueOf(data), filter.Field)
+		if !matchesFilter(filter, value, found) {
+			return false, nil
 		}
-		comparator := reflect.ValueOf(filter.Value)
+	}
+
+	return true, nil
+}
 
-		if value.Type().Kind() == reflect.Interface {
+// lookupField finds the value of a '.' separated field path. Returns false if the field is not set.
+func lookupField(value reflect.Value, field string) (reflect.Value, bool) {
+	for _, segment := range strings.Split(field, ".") {
+		if value.Kind() == reflect.Interface {
 			// Unwrap interface{}
-			value = reflect.ValueOf(value.Interface())
+			value = value.Elem()
 		}
 
-		if value.Type().Kind() != reflect.String {
-			// not a string, can't compare!
-			return false, nil
+		if value.Kind() != reflect.Map || value.Type().Key().Kind() != reflect.String {
+			// Can't go further into the nested fields.
+			return reflect.Value{}, false
 		}
 
-		if value.String() != comparator.String() {
-			// not the same value!
-			return false, nil
+		value = value.MapIndex(reflect.ValueOf(segment))
+		if !value.IsValid() {
+			return reflect.Value{}, false
 		}
 	}
 
-	return true, nil
+	if value.Kind() == reflect.Interface {
+		// Unwrap interface{}
+		value = value.Elem()
+	}
+
+	// A null value is treated the same as a field that is not set.
+	return value, value.IsValid()
+}
+
+func matchesFilter(filter QueryFilter, value reflect.Value, found bool) bool {
+	if filter.Operator == FilterOperatorExists {
+		return found
+	}
+
+	isString := found && value.Kind() == reflect.String
+	switch filter.Operator {
+	case FilterOperatorEqualIgnoreCase:
+		return isString && strings.EqualFold(value.String(), filter.Value)
+
+	case FilterOperatorNotEqual:
+		return !isString || value.String() != filter.Value
+
+	case FilterOperatorPrefix:
+		return isString && strings.HasPrefix(value.String(), filter.Value)
+
+	case FilterOperatorIn:
+		if !isString {
+			return false
+		}
+		for _, v := range filter.Values {
+			if value.String() == v {
+				return true
+			}
+		}
+		return false
+
+	default:
+		return isString && value.String() == filter.Value
+	}
 }
@@ -113,6 +113,104 @@ func Test_MatchesFilters(t *testing.T) {
 			Filters:       []QueryFilter{{Field: "properties.value", Value: "warm"}},
 			ExpectedMatch: false,
 		},
+		{
+			Description:   "nested_missing",
+			Obj:           &Object{Data: map[string]any{"properties": map[string]any{}}},
+			Filters:       []QueryFilter{{Field: "properties.application.name", Value: "cool"}},
+			ExpectedMatch: false,
+		},
+		{
+			Description:   "nested_not_a_map",
+			Obj:           &Object{Data: map[string]any{"properties": "cool"}},
+			Filters:       []QueryFilter{{Field: "properties.value", Value: "cool"}},
+			ExpectedMatch: false,
+		},
+		{
+			Description:   "equal_is_case_sensitive",
+			Obj:           &Object{Data: map[string]any{"value": "/planes/radius/local/resourceGroups/rg"}},
+			Filters:       []QueryFilter{{Field: "value", Value: "/planes/radius/local/resourcegroups/RG"}},
+			ExpectedMatch: false,
+		},
+		{
+			Description:   "equal_ignore_case_match",
+			Obj:           &Object{Data: map[string]any{"value": "/planes/radius/local/resourceGroups/rg"}},
+			Filters:       []QueryFilter{{Field: "value", Value: "/planes/radius/local/resourcegroups/RG", Operator: FilterOperatorEqualIgnoreCase}},
+			ExpectedMatch: true,
+		},
+		{
+			Description:   "equal_ignore_case_not_match",
+			Obj:           &Object{Data: map[string]any{"value": "cool"}},
+			Filters:       []QueryFilter{{Field: "value", Value: "uncool", Operator: FilterOperatorEqualIgnoreCase}},
+			ExpectedMatch: false,
+		},
+
+		// Operators
+		{
+			Description:   "not_equal_match",
+			Obj:           &Object{Data: map[string]any{"value": "cool"}},
+			Filters:       []QueryFilter{{Field: "value", Value: "uncool", Operator: FilterOperatorNotEqual}},
+			ExpectedMatch: true,
+		},
+		{
+			Description:   "not_equal_not_match",
+			Obj:           &Object{Data: map[string]any{"value": "cool"}},
+			Filters:       []QueryFilter{{Field: "value", Value: "cool", Operator: FilterOperatorNotEqual}},
+			ExpectedMatch: false,
+		},
+		{
+			Description:   "not_equal_missing_match",
+			Obj:           &Object{Data: map[string]any{}},
+			Filters:       []QueryFilter{{Field: "value", Value: "cool", Operator: FilterOperatorNotEqual}},
+			ExpectedMatch: true,
+		},
+		{
+			Description:   "in_match",
+			Obj:           &Object{Data: map[string]any{"value": "cool"}},
+			Filters:       []QueryFilter{{Field: "value", Values: []string{"warm", "cool"}, Operator: FilterOperatorIn}},
+			ExpectedMatch: true,
+		},
+		{
+			Description:   "in_not_match",
+			Obj:           &Object{Data: map[string]any{"value": "cool"}},
+			Filters:       []QueryFilter{{Field: "value", Values: []string{"warm", "hot"}, Operator: FilterOperatorIn}},
+			ExpectedMatch: false,
+		},
+		{
+			Description:   "prefix_match",
+			Obj:           &Object{Data: map[string]any{"properties": map[string]any{"application": "/planes/radius/local/resourceGroups/rg/providers/Applications.Core/applications/app"}}},
+			Filters:       []QueryFilter{{Field: "properties.application", Value: "/planes/radius/local/resourceGroups/rg/", Operator: FilterOperatorPrefix}},
+			ExpectedMatch: true,
+		},
+		{
+			Description:   "prefix_is_case_sensitive",
+			Obj:           &Object{Data: map[string]any{"properties": map[string]any{"application": "/planes/radius/local/resourceGroups/rg/providers/Applications.Core/applications/app"}}},
+			Filters:       []QueryFilter{{Field: "properties.application", Value: "/planes/radius/local/resourcegroups/rg/", Operator: FilterOperatorPrefix}},
+			ExpectedMatch: false,
+		},
+		{
+			Description:   "prefix_not_match",
+			Obj:           &Object{Data: map[string]any{"value": "cool"}},
+			Filters:       []QueryFilter{{Field: "value", Value: "un", Operator: FilterOperatorPrefix}},
+			ExpectedMatch: false,
+		},
+		{
+			Description:   "exists_match",
+			Obj:           &Object{Data: map[string]any{"properties": map[string]any{"value": 3}}},
+			Filters:       []QueryFilter{{Field: "properties.value", Operator: FilterOperatorExists}},
+			ExpectedMatch: true,
+		},
+		{
+			Description:   "exists_null_not_match",
+			Obj:           &Object{Data: map[string]any{"properties": map[string]any{"value": nil}}},
+			Filters:       []QueryFilter{{Field: "properties.value", Operator: FilterOperatorExists}},
+			ExpectedMatch: false,
+		},
+		{
+			Description:   "exists_missing_not_match",
+			Obj:           &Object{Data: map[string]any{"properties": map[string]any{}}},
+			Filters:       []QueryFilter{{Field: "properties.value", Operator: FilterOperatorExists}},
+			ExpectedMatch: false,
+		},
 	}
 
 	for _, testcase := range cases {
@@ -123,3 +221,29 @@ func Test_MatchesFilters(t *testing.T) {
 		})
 	}
 }
+
+func Test_ValidateFilters(t *testing.T) {
+	valid := [][]QueryFilter{
+		nil,
+		{{Field: "properties.application", Value: "cool"}},
+		{{Field: "value", Values: []string{"cool"}, Operator: FilterOperatorIn}},
+		{{Field: "value", Operator: FilterOperatorExists}},
+	}
+	for _, filters := range valid {
+		require.NoError(t, ValidateFilters(filters))
+	}
+
+	invalid := [][]QueryFilter{
+		{{Field: "", Value: "cool"}},
+		{{Field: "properties..value", Value: "cool"}},
+		{{Field: "c.id) OR (1=1", Value: "cool"}},
+		{{Field: "value", Operator: FilterOperatorIn}},
+		{{Field: "value", Value: "cool", Operator: "gt"}},
+	}
+	for _, filters := range invalid {
+		require.ErrorIs(t, ValidateFilters(filters), &ErrInvalid{})
+
+		_, err := Object{}.MatchesFilters(filters)
+		require.ErrorIs(t, err, &ErrInvalid{})
+	}
+}
@@ -0,0 +1,473 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package inmemory provides an in-memory implementation of store.StorageClient for development and testing.
+// The data is lost when the process exits.
+//
+// Objects are kept JSON encoded in a map guarded by a single mutex, so every operation (including a
+// store.Transaction) is trivially atomic. Queries scan the whole map, which is fine for the small data sets this
+// store is intended for.
+package inmemory
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"sync"
+
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/store/storeutil"
+	"github.com/radius-project/radius/pkg/ucp/util/etag"
+)
+
+var defaultClient = NewClient()
+
+var _ store.WatchableStorageClient = (*Client)(nil)
+var _ store.TransactionalStorageClient = (*Client)(nil)
+
+// Client is an in-memory implementation of store.StorageClient.
+type Client struct {
+	// mutex is used to synchronize access to the resources map.
+	mutex sync.Mutex
+
+	// resources is a map of resources. The key is built from the resource id using the same scheme as the etcd store.
+	resources map[string]entry
+
+	// revision is incremented on every write and used to generate ETags.
+	revision int64
+
+	// watchers is the set of active watches.
+	watchers map[*watcher]struct{}
+}
+
+type entry struct {
+	// id is the parsed resource id of the object.
+	id resources.ID
+
+	// obj is the JSON encoded object. We store the encoded form so callers can't mutate stored data.
+	obj []byte
+
+	// etag is the ETag of the object.
+	etag string
+}
+
+// NewClient creates a new empty in-memory Client.
+func NewClient() *Client {
+	return &Client{
+		resources: map[string]entry{},
+		watchers:  map[*watcher]struct{}{},
+	}
+}
+
+// Default returns the process-wide in-memory Client. This allows multiple services in the same process
+// to share data.
+func Default() *Client {
+	return defaultClient
+}
+
+// Clear deletes all data from the client.
+func (c *Client) Clear() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.resources = map[string]entry{}
+}
+
+// Query retrieves objects from the store that match the given query and filters, and returns them in a store.ObjectQueryResult.
+// When a maximum item count is specified the results are paginated, and the returned pagination token can be used to
+// retrieve the next page.
+func (c *Client) Query(ctx context.Context, query store.Query, options ...store.QueryOptions) (*store.ObjectQueryResult, error) {
+	if ctx == nil {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	if query.RootScope == "" {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'query.RootScope' is required"}
+	}
+	if query.IsScopeQuery && query.RoutingScopePrefix != "" {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'query.RoutingScopePrefix' is not supported for scope queries"}
+	}
+	if err := store.ValidateFilters(query.Filters); err != nil {
+		return nil, err
+	}
+
+	cfg := store.NewQueryConfig(options...)
+
+	cursor, err := storeutil.DecodePaginationToken(cfg.PaginationToken)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	keys := []string{}
+	for key, entry := range c.resources {
+		if key > cursor && storeutil.IDMatchesQuery(entry.id, query) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	results := store.ObjectQueryResult{}
+	lastKey := ""
+	for _, key := range keys {
+		obj, err := c.resources[key].object()
+		if err != nil {
+			return nil, err
+		}
+
+		match, err := obj.MatchesFilters(query.Filters)
+		if err != nil {
+			return nil, err
+		} else if !match {
+			continue
+		}
+
+		if cfg.MaxQueryItemCount > 0 && len(results.Items) == cfg.MaxQueryItemCount {
+			// There's at least one more result, so return a token pointing at the last item we returned.
+			results.PaginationToken = storeutil.EncodePaginationToken(lastKey)
+			break
+		}
+
+		results.Items = append(results.Items, *obj)
+		lastKey = key
+	}
+
+	return &results, nil
+}
+
+// Get retrieves the object with the given id from the store, or returns store.ErrNotFound if it does not exist.
+func (c *Client) Get(ctx context.Context, id string, options ...store.GetOptions) (*store.Object, error) {
+	if ctx == nil {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	parsed, err := storeutil.ParseNamedID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	entry, ok := c.resources[storeutil.StorageKey(parsed)]
+	if !ok {
+		return nil, &store.ErrNotFound{ID: id}
+	}
+
+	return entry.object()
+}
+
+// Delete deletes the object with the given id from the store. If an ETag is provided it must match the ETag of the
+// stored object, otherwise store.ErrConcurrency is returned.
+func (c *Client) Delete(ctx context.Context, id string, options ...store.DeleteOptions) error {
+	if ctx == nil {
+		return &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	parsed, err := storeutil.ParseNamedID(id)
+	if err != nil {
+		return err
+	}
+
+	config := store.NewDeleteConfig(options...)
+	key := storeutil.StorageKey(parsed)
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	entry, ok := c.resources[key]
+	if !ok && config.ETag != "" {
+		// The ETag is treated as a match failure when the resource does not exist.
+		return &store.ErrConcurrency{}
+	} else if !ok {
+		return &store.ErrNotFound{ID: id}
+	} else if config.ETag != "" && config.ETag != entry.etag {
+		return &store.ErrConcurrency{}
+	}
+
+	delete(c.resources, key)
+	c.notify(store.WatchEventDeleted, entry)
+	return nil
+}
+
+// Save creates or updates an object in the store and sets the object's ETag. If an ETag is provided it must match the
+// ETag of the stored object, otherwise store.ErrConcurrency is returned.
+func (c *Client) Save(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+	if ctx == nil {
+		return &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	if obj == nil {
+		return &store.ErrInvalid{Message: "invalid argument. 'obj' is required"}
+	}
+
+	parsed, err := resources.Parse(obj.ID)
+	if err != nil {
+		return err
+	}
+
+	// The ETag is stored separately, so don't duplicate it in the data.
+	stored := *obj
+	stored.ETag = ""
+	b, err := json.Marshal(&stored)
+	if err != nil {
+		return err
+	}
+
+	config := store.NewSaveConfig(options...)
+	key := storeutil.StorageKey(parsed)
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	existing, ok := c.resources[key]
+	if config.ETag != "" && (!ok || config.ETag != existing.etag) {
+		// The ETag is treated as a match failure when the resource does not exist.
+		return &store.ErrConcurrency{}
+	}
+
+	c.revision++
+	updated := entry{id: parsed, obj: b, etag: etag.NewFromRevision(c.revision)}
+	c.resources[key] = updated
+
+	if ok {
+		c.notify(store.WatchEventUpdated, updated)
+	} else {
+		c.notify(store.WatchEventCreated, updated)
+	}
+
+	obj.ETag = updated.etag
+	return nil
+}
+
+// ExecuteTransaction commits all of the operations in the transaction atomically.
+func (c *Client) ExecuteTransaction(ctx context.Context, tx *store.Transaction) error {
+	if ctx == nil {
+		return &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	if tx == nil {
+		return &store.ErrInvalid{Message: "invalid argument. 'tx' is required"}
+	}
+
+	// Validate and encode everything before taking the lock.
+	type pending struct {
+		op      store.TransactionOperation
+		key     string
+		id      resources.ID
+		encoded []byte
+	}
+
+	operations := []pending{}
+	keys := map[string]struct{}{}
+	for _, op := range tx.Operations {
+		p := pending{op: op}
+		switch op.Type {
+		case store.TransactionOperationSave:
+			if op.Object == nil {
+				return &store.ErrInvalid{Message: "invalid argument. 'obj' is required"}
+			}
+
+			parsed, err := resources.Parse(op.Object.ID)
+			if err != nil {
+				return err
+			}
+
+			stored := *op.Object
+			stored.ETag = ""
+			b, err := json.Marshal(&stored)
+			if err != nil {
+				return err
+			}
+			p.id = parsed
+			p.encoded = b
+
+		case store.TransactionOperationDelete:
+			parsed, err := storeutil.ParseNamedID(op.ID)
+			if err != nil {
+				return err
+			}
+			p.id = parsed
+
+		default:
+			return &store.ErrInvalid{Message: "invalid argument. unsupported transaction operation type " + string(op.Type)}
+		}
+
+		p.key = storeutil.StorageKey(p.id)
+		if _, ok := keys[p.key]; ok {
+			return &store.ErrInvalid{Message: "invalid argument. a transaction must not contain more than one operation for the same object"}
+		}
+		keys[p.key] = struct{}{}
+		operations = append(operations, p)
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	// Check all of the preconditions before applying any changes.
+	for _, p := range operations {
+		existing, ok := c.resources[p.key]
+		if p.op.ETag != "" && (!ok || p.op.ETag != existing.etag) {
+			// The ETag is treated as a match failure when the resource does not exist.
+			return &store.ErrConcurrency{}
+		} else if p.op.Type == store.TransactionOperationDelete && !ok {
+			return &store.ErrNotFound{ID: p.op.ID}
+		}
+	}
+
+	// All writes in a transaction share the same revision, like they do in etcd.
+	c.revision++
+	for _, p := range operations {
+		existing, ok := c.resources[p.key]
+		switch p.op.Type {
+		case store.TransactionOperationSave:
+			updated := entry{id: p.id, obj: p.encoded, etag: etag.NewFromRevision(c.revision)}
+			c.resources[p.key] = updated
+			if ok {
+				c.notify(store.WatchEventUpdated, updated)
+			} else {
+				c.notify(store.WatchEventCreated, updated)
+			}
+			p.op.Object.ETag = updated.etag
+
+		case store.TransactionOperationDelete:
+			delete(c.resources, p.key)
+			c.notify(store.WatchEventDeleted, existing)
+		}
+	}
+
+	return nil
+}
+
+// Watch streams changes to the objects matching the query.
+func (c *Client) Watch(ctx context.Context, query store.Query) (<-chan store.WatchEvent, error) {
+	if ctx == nil {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	if query.RootScope == "" {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'query.RootScope' is required"}
+	}
+	if query.IsScopeQuery && query.RoutingScopePrefix != "" {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'query.RoutingScopePrefix' is not supported for scope queries"}
+	}
+	if err := store.ValidateFilters(query.Filters); err != nil {
+		return nil, err
+	}
+
+	w := &watcher{
+		query:  query,
+		signal: make(chan struct{}, 1),
+	}
+
+	c.mutex.Lock()
+	c.watchers[w] = struct{}{}
+	c.mutex.Unlock()
+
+	out := make(chan store.WatchEvent)
+	go func() {
+		defer close(out)
+		defer func() {
+			c.mutex.Lock()
+			delete(c.watchers, w)
+			c.mutex.Unlock()
+		}()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-w.signal:
+			}
+
+			for _, event := range w.drain() {
+				select {
+				case out <- event:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// notify queues an event for each watcher matching the entry. The caller must hold the mutex.
+func (c *Client) notify(eventType store.WatchEventType, e entry) {
+	if len(c.watchers) == 0 {
+		return
+	}
+
+	obj, err := e.object()
+	if err != nil {
+		// Can't happen, we just encoded this data.
+		return
+	}
+
+	for w := range c.watchers {
+		if !storeutil.IDMatchesQuery(e.id, w.query) {
+			continue
+		}
+
+		match, err := obj.MatchesFilters(w.query.Filters)
+		if err != nil || !match {
+			continue
+		}
+
+		w.push(store.WatchEvent{Type: eventType, Object: *obj})
+	}
+}
+
+// watcher buffers the events of a single watch. Events are buffered without limit so that writes to the
+// store never block on slow consumers.
+type watcher struct {
+	query store.Query
+
+	mutex  sync.Mutex
+	events []store.WatchEvent
+
+	// signal is notified when events are added.
+	signal chan struct{}
+}
+
+func (w *watcher) push(event store.WatchEvent) {
+	w.mutex.Lock()
+	w.events = append(w.events, event)
+	w.mutex.Unlock()
+
+	select {
+	case w.signal <- struct{}{}:
+	default:
+		// Already signaled.
+	}
+}
+
+func (w *watcher) drain() []store.WatchEvent {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	events := w.events
+	w.events = nil
+	return events
+}
+
+func (e entry) object() (*store.Object, error) {
+	obj := store.Object{}
+	if err := json.Unmarshal(e.obj, &obj); err != nil {
+		return nil, err
+	}
+
+	obj.ETag = e.etag
+	return &obj, nil
+}
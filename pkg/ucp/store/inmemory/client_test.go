@@ -0,0 +1,54 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inmemory
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/test/testcontext"
+	shared "github.com/radius-project/radius/test/ucp/storetest"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_InMemoryClient(t *testing.T) {
+	client := NewClient()
+
+	clear := func(t *testing.T) {
+		client.Clear()
+	}
+
+	// The actual test logic lives in a shared package, we're just doing the setup here.
+	shared.RunTest(t, client, clear)
+	shared.RunWatchTest(t, client, clear)
+	shared.RunTransactionTest(t, client, clear)
+}
+
+func Test_InMemoryClient_ETagChangesAfterRecreate(t *testing.T) {
+	ctx := testcontext.New(t)
+	client := NewClient()
+
+	obj := store.Object{Metadata: store.Metadata{ID: "/planes/radius/local/resourceGroups/rg"}, Data: map[string]any{}}
+	require.NoError(t, client.Save(ctx, &obj))
+	original := obj.ETag
+
+	require.NoError(t, client.Delete(ctx, obj.ID))
+	require.NoError(t, client.Save(ctx, &obj))
+
+	err := client.Save(ctx, &obj, store.WithETag(original))
+	require.ErrorIs(t, err, &store.ErrConcurrency{})
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlstore
+
+import (
+	"fmt"
+
+	// Register the database/sql drivers for the supported dialects.
+	_ "github.com/lib/pq"
+	_ "modernc.org/sqlite"
+)
+
+// Dialect describes the differences between the SQL databases supported by the store.
+type Dialect string
+
+const (
+	// DialectPostgres is the dialect for PostgreSQL. The database/sql driver name is "postgres".
+	DialectPostgres Dialect = "postgres"
+
+	// DialectSQLite is the dialect for SQLite. The database/sql driver name is "sqlite".
+	DialectSQLite Dialect = "sqlite"
+)
+
+// DriverName returns the name of the database/sql driver used for the dialect.
+func (d Dialect) DriverName() string {
+	return string(d)
+}
+
+// Validate returns an error if the dialect is not supported.
+func (d Dialect) Validate() error {
+	switch d {
+	case DialectPostgres, DialectSQLite:
+		return nil
+	default:
+		return fmt.Errorf("unsupported sql dialect %q", d)
+	}
+}
+
+// Placeholder returns the bind-parameter syntax for the n-th (1-based) parameter of a statement.
+func (d Dialect) Placeholder(n int) string {
+	if d == DialectPostgres {
+		return fmt.Sprintf("$%d", n)
+	}
+
+	return "?"
+}
@@ -0,0 +1,487 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package sqlstore stores resources in a single table of a SQL database. PostgreSQL and SQLite are supported.
+//
+// Each row stores one UCP resource or scope. We use the same key scheme as the etcd store, using '|' as a
+// separator between the storage prefix (scope or resource), the root scope and the routing scope:
+//
+//	scope|/planes/radius/local/|/resourcegroups/cool-group/
+//	resource|/planes/radius/local/resourcegroups/cool-group/|/applications.core/applications/cool-app/
+//
+// The parts of the key are also stored in their own columns so that queries on the root scope, routing scope and
+// resource type can be evaluated by the database. Filters on the resource body are evaluated client-side.
+//
+// Rows are ordered by key, which allows us to use the key of the last item in a page as the pagination token.
+//
+// The ETag of each row is regenerated on every write and optimistic concurrency is implemented by making the
+// ETag part of the WHERE clause of the UPDATE and DELETE statements. store.Transaction is committed using a database
+// transaction.
+package sqlstore
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/store/storeutil"
+)
+
+const (
+	// TableName is the name of the table used to store resources.
+	TableName = "ucp_resources"
+)
+
+var _ store.TransactionalStorageClient = (*SQLClient)(nil)
+
+// execer is implemented by both *sql.DB and *sql.Tx.
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+// SQLClient implements store.StorageClient using a SQL database.
+type SQLClient struct {
+	db      *sql.DB
+	dialect Dialect
+}
+
+// NewSQLClient creates a new SQLClient instance with the given database handle and dialect. Init must be called
+// before the client is used.
+func NewSQLClient(db *sql.DB, dialect Dialect) *SQLClient {
+	return &SQLClient{db: db, dialect: dialect}
+}
+
+// Open opens a database handle for the given dialect and connection string.
+func Open(dialect Dialect, connectionString string) (*sql.DB, error) {
+	if err := dialect.Validate(); err != nil {
+		return nil, err
+	}
+	if connectionString == "" {
+		return nil, errors.New("connection string is required")
+	}
+
+	db, err := sql.Open(dialect.DriverName(), connectionString)
+	if err != nil {
+		return nil, err
+	}
+
+	if dialect == DialectSQLite {
+		// SQLite only supports a single writer at a time, and an in-memory database is private to the
+		// connection that created it. Using a single connection avoids both problems.
+		db.SetMaxOpenConns(1)
+	}
+
+	return db, nil
+}
+
+// Init creates the table and indexes used by the store if they do not already exist.
+func (c *SQLClient) Init(ctx context.Context) error {
+	statements := []string{
+		"CREATE TABLE IF NOT EXISTS " + TableName + ` (
+			storage_key TEXT NOT NULL PRIMARY KEY,
+			kind TEXT NOT NULL,
+			root_scope TEXT NOT NULL,
+			routing_scope TEXT NOT NULL,
+			resource_type TEXT NOT NULL,
+			etag TEXT NOT NULL,
+			data TEXT NOT NULL
+		)`,
+		"CREATE INDEX IF NOT EXISTS " + TableName + "_scope ON " + TableName + " (kind, root_scope, resource_type)",
+	}
+
+	if c.dialect == DialectSQLite {
+		// Multiple processes (eg: UCP and applications-rp) may share the same database file. Wait for locks held
+		// by other processes instead of failing immediately.
+		statements = append([]string{"PRAGMA busy_timeout = 5000"}, statements...)
+	}
+
+	for _, statement := range statements {
+		if _, err := c.db.ExecContext(ctx, statement); err != nil {
+			return fmt.Errorf("failed to initialize sql store: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// DB returns the database handle used by the client.
+func (c *SQLClient) DB() *sql.DB {
+	return c.db
+}
+
+// Query retrieves objects from the store that match the given query and filters, and returns them in a store.ObjectQueryResult.
+// When a maximum item count is specified the results are paginated, and the returned pagination token can be used to
+// retrieve the next page.
+func (c *SQLClient) Query(ctx context.Context, query store.Query, options ...store.QueryOptions) (*store.ObjectQueryResult, error) {
+	if ctx == nil {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	if query.RootScope == "" {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'query.RootScope' is required"}
+	}
+	if query.IsScopeQuery && query.RoutingScopePrefix != "" {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'query.RoutingScopePrefix' is not supported for scope queries"}
+	}
+	if err := store.ValidateFilters(query.Filters); err != nil {
+		return nil, err
+	}
+
+	cfg := store.NewQueryConfig(options...)
+
+	cursor, err := storeutil.DecodePaginationToken(cfg.PaginationToken)
+	if err != nil {
+		return nil, err
+	}
+
+	// When paginating we read one more row than the page size so we know whether there is another page.
+	batchSize := 0
+	if cfg.MaxQueryItemCount > 0 {
+		batchSize = cfg.MaxQueryItemCount + 1
+	}
+
+	results := store.ObjectQueryResult{}
+	lastKey := ""
+	for {
+		rows, err := c.queryRows(ctx, query, cursor, batchSize)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, row := range rows {
+			cursor = row.key
+
+			obj, err := row.object()
+			if err != nil {
+				return nil, err
+			}
+
+			match, err := obj.MatchesFilters(query.Filters)
+			if err != nil {
+				return nil, err
+			} else if !match {
+				continue
+			}
+
+			if cfg.MaxQueryItemCount > 0 && len(results.Items) == cfg.MaxQueryItemCount {
+				// There's at least one more result, so return a token pointing at the last item we returned.
+				results.PaginationToken = storeutil.EncodePaginationToken(lastKey)
+				return &results, nil
+			}
+
+			results.Items = append(results.Items, *obj)
+			lastKey = row.key
+		}
+
+		if batchSize == 0 || len(rows) < batchSize {
+			return &results, nil
+		}
+	}
+}
+
+// Get retrieves the object with the given id from the store, or returns store.ErrNotFound if it does not exist.
+func (c *SQLClient) Get(ctx context.Context, id string, options ...store.GetOptions) (*store.Object, error) {
+	if ctx == nil {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	parsed, err := storeutil.ParseNamedID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	statement := fmt.Sprintf("SELECT storage_key, etag, data FROM %s WHERE storage_key = %s", TableName, c.dialect.Placeholder(1))
+
+	r := row{}
+	err = c.db.QueryRowContext(ctx, statement, storeutil.StorageKey(parsed)).Scan(&r.key, &r.etag, &r.data)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, &store.ErrNotFound{ID: id}
+	} else if err != nil {
+		return nil, err
+	}
+
+	return r.object()
+}
+
+// Delete deletes the object with the given id from the store. If an ETag is provided it must match the ETag of the
+// stored object, otherwise store.ErrConcurrency is returned.
+func (c *SQLClient) Delete(ctx context.Context, id string, options ...store.DeleteOptions) error {
+	if ctx == nil {
+		return &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	parsed, err := storeutil.ParseNamedID(id)
+	if err != nil {
+		return err
+	}
+
+	config := store.NewDeleteConfig(options...)
+	return c.delete(ctx, c.db, id, parsed, config.ETag)
+}
+
+func (c *SQLClient) delete(ctx context.Context, ex execer, id string, parsed resources.ID, etag store.ETag) error {
+	statement := fmt.Sprintf("DELETE FROM %s WHERE storage_key = %s", TableName, c.dialect.Placeholder(1))
+	args := []any{storeutil.StorageKey(parsed)}
+	if etag != "" {
+		statement += fmt.Sprintf(" AND etag = %s", c.dialect.Placeholder(2))
+		args = append(args, etag)
+	}
+
+	result, err := ex.ExecContext(ctx, statement, args...)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 && etag != "" {
+		// The ETag is treated as a match failure when the resource does not exist.
+		return &store.ErrConcurrency{}
+	} else if affected == 0 {
+		return &store.ErrNotFound{ID: id}
+	}
+
+	return nil
+}
+
+// Save creates or updates an object in the store and sets the object's ETag. If an ETag is provided it must match the
+// ETag of the stored object, otherwise store.ErrConcurrency is returned.
+func (c *SQLClient) Save(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+	if ctx == nil {
+		return &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	if obj == nil {
+		return &store.ErrInvalid{Message: "invalid argument. 'obj' is required"}
+	}
+
+	config := store.NewSaveConfig(options...)
+
+	newETag, err := c.save(ctx, c.db, obj, config.ETag)
+	if err != nil {
+		return err
+	}
+
+	obj.ETag = newETag
+	return nil
+}
+
+// save writes the object and returns the new ETag. The ETag of obj is not modified.
+func (c *SQLClient) save(ctx context.Context, ex execer, obj *store.Object, etag store.ETag) (store.ETag, error) {
+	parsed, err := resources.Parse(obj.ID)
+	if err != nil {
+		return "", err
+	}
+
+	prefix, rootScope, routingScope, resourceType := storeutil.ExtractStorageParts(parsed)
+	key := storeutil.StorageKey(parsed)
+	newETag := uuid.New().String()
+
+	// The ETag is stored in its own column, so don't duplicate it in the data.
+	entry := *obj
+	entry.ETag = ""
+	b, err := json.Marshal(&entry)
+	if err != nil {
+		return "", err
+	}
+
+	var result sql.Result
+	if etag != "" {
+		statement := fmt.Sprintf(
+			"UPDATE %s SET etag = %s, data = %s WHERE storage_key = %s AND etag = %s",
+			TableName,
+			c.dialect.Placeholder(1),
+			c.dialect.Placeholder(2),
+			c.dialect.Placeholder(3),
+			c.dialect.Placeholder(4))
+		result, err = ex.ExecContext(ctx, statement, newETag, string(b), key, etag)
+	} else {
+		statement := fmt.Sprintf(
+			"INSERT INTO %s (storage_key, kind, root_scope, routing_scope, resource_type, etag, data) VALUES (%s, %s, %s, %s, %s, %s, %s) "+
+				"ON CONFLICT (storage_key) DO UPDATE SET etag = excluded.etag, data = excluded.data",
+			TableName,
+			c.dialect.Placeholder(1),
+			c.dialect.Placeholder(2),
+			c.dialect.Placeholder(3),
+			c.dialect.Placeholder(4),
+			c.dialect.Placeholder(5),
+			c.dialect.Placeholder(6),
+			c.dialect.Placeholder(7))
+		result, err = ex.ExecContext(ctx, statement, key, prefix, rootScope, routingScope, resourceType, newETag, string(b))
+	}
+	if err != nil {
+		return "", err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return "", err
+	}
+
+	if affected == 0 {
+		// Only possible when an ETag was provided. The ETag is treated as a match failure when the resource does not exist.
+		return "", &store.ErrConcurrency{}
+	}
+
+	return newETag, nil
+}
+
+// ExecuteTransaction commits all of the operations in the transaction atomically using a database transaction.
+func (c *SQLClient) ExecuteTransaction(ctx context.Context, tx *store.Transaction) error {
+	if ctx == nil {
+		return &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	if tx == nil {
+		return &store.ErrInvalid{Message: "invalid argument. 'tx' is required"}
+	}
+
+	// Validate everything before starting the database transaction.
+	parsedIDs := make([]resources.ID, len(tx.Operations))
+	seen := map[string]struct{}{}
+	for i, op := range tx.Operations {
+		var err error
+		switch op.Type {
+		case store.TransactionOperationSave:
+			if op.Object == nil {
+				return &store.ErrInvalid{Message: "invalid argument. 'obj' is required"}
+			}
+			parsedIDs[i], err = resources.Parse(op.Object.ID)
+		case store.TransactionOperationDelete:
+			parsedIDs[i], err = storeutil.ParseNamedID(op.ID)
+		default:
+			err = &store.ErrInvalid{Message: "invalid argument. unsupported transaction operation type " + string(op.Type)}
+		}
+		if err != nil {
+			return err
+		}
+
+		key := storeutil.StorageKey(parsedIDs[i])
+		if _, ok := seen[key]; ok {
+			return &store.ErrInvalid{Message: "invalid argument. a transaction must not contain more than one operation for the same object"}
+		}
+		seen[key] = struct{}{}
+	}
+
+	dbtx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	// Rollback is a no-op after a successful commit.
+	defer func() { _ = dbtx.Rollback() }()
+
+	etags := make([]store.ETag, len(tx.Operations))
+	for i, op := range tx.Operations {
+		switch op.Type {
+		case store.TransactionOperationSave:
+			etags[i], err = c.save(ctx, dbtx, op.Object, op.ETag)
+		case store.TransactionOperationDelete:
+			err = c.delete(ctx, dbtx, op.ID, parsedIDs[i], op.ETag)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := dbtx.Commit(); err != nil {
+		return err
+	}
+
+	for i, op := range tx.Operations {
+		if op.Type == store.TransactionOperationSave {
+			op.Object.ETag = etags[i]
+		}
+	}
+
+	return nil
+}
+
+func (c *SQLClient) queryRows(ctx context.Context, query store.Query, cursor string, limit int) ([]row, error) {
+	prefix := storeutil.ResourcePrefix
+	if query.IsScopeQuery {
+		prefix = storeutil.ScopePrefix
+	}
+
+	args := []any{}
+	where := []string{}
+	add := func(condition string, arg any) {
+		args = append(args, arg)
+		where = append(where, fmt.Sprintf(condition, c.dialect.Placeholder(len(args))))
+	}
+
+	add("kind = %s", prefix)
+	if query.ScopeRecursive {
+		add(`root_scope LIKE %s ESCAPE '\'`, escapeLike(storeutil.NormalizePart(query.RootScope))+"%")
+	} else {
+		add("root_scope = %s", storeutil.NormalizePart(query.RootScope))
+	}
+	if query.RoutingScopePrefix != "" {
+		add(`routing_scope LIKE %s ESCAPE '\'`, escapeLike(storeutil.NormalizePart(query.RoutingScopePrefix))+"%")
+	}
+	if query.ResourceType != "" {
+		add("resource_type = %s", strings.ToLower(query.ResourceType))
+	}
+	if cursor != "" {
+		add("storage_key > %s", cursor)
+	}
+
+	statement := fmt.Sprintf("SELECT storage_key, etag, data FROM %s WHERE %s ORDER BY storage_key", TableName, strings.Join(where, " AND "))
+	if limit > 0 {
+		statement += fmt.Sprintf(" LIMIT %d", limit)
+	}
+
+	rows, err := c.db.QueryContext(ctx, statement, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	results := []row{}
+	for rows.Next() {
+		r := row{}
+		if err := rows.Scan(&r.key, &r.etag, &r.data); err != nil {
+			return nil, err
+		}
+		results = append(results, r)
+	}
+
+	return results, rows.Err()
+}
+
+type row struct {
+	key  string
+	etag string
+	data string
+}
+
+func (r *row) object() (*store.Object, error) {
+	obj := store.Object{}
+	if err := json.Unmarshal([]byte(r.data), &obj); err != nil {
+		return nil, err
+	}
+
+	obj.ETag = r.etag
+	return &obj, nil
+}
+
+// escapeLike escapes the wildcard characters of a LIKE pattern.
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(value)
+}
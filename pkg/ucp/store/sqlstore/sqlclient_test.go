@@ -0,0 +1,55 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlstore
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/test/testcontext"
+	shared "github.com/radius-project/radius/test/ucp/storetest"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestClient(t *testing.T) *SQLClient {
+	ctx := testcontext.New(t)
+
+	db, err := Open(DialectSQLite, ":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	client := NewSQLClient(db, DialectSQLite)
+	require.NoError(t, client.Init(ctx))
+
+	return client
+}
+
+func Test_SQLClient(t *testing.T) {
+	client := newTestClient(t)
+
+	clear := func(t *testing.T) {
+		_, err := client.DB().Exec("DELETE FROM " + TableName)
+		require.NoError(t, err)
+	}
+
+	// The actual test logic lives in a shared package, we're just doing the setup here.
+	shared.RunTest(t, client, clear)
+	shared.RunTransactionTest(t, client, clear)
+}
+
+func Test_EscapeLike(t *testing.T) {
+	require.Equal(t, `/planes/radius/my\_group\%/`, escapeLike("/planes/radius/my_group%/"))
+}
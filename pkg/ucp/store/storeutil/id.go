@@ -26,6 +26,9 @@ import (
 const (
 	ScopePrefix    = "scope"
 	ResourcePrefix = "resource"
+
+	// KeySeparator is the separator used between the sections of a storage key.
+	KeySeparator = "|"
 )
 
 // ExtractStorageParts extracts the main components of the resource id in a way that easily
@@ -57,6 +60,34 @@ func ExtractStorageParts(id resources.ID) (string, string, string, string) {
 	}
 }
 
+// ParseNamedID parses the given resource id and validates that it refers to a named resource or scope rather than
+// a collection. Returns a *store.ErrInvalid if the id is not valid.
+func ParseNamedID(id string) (resources.ID, error) {
+	parsed, err := resources.Parse(id)
+	if err != nil {
+		return resources.ID{}, &store.ErrInvalid{Message: "invalid argument. 'id' must be a valid resource id"}
+	}
+	if parsed.IsEmpty() {
+		return resources.ID{}, &store.ErrInvalid{Message: "invalid argument. 'id' must not be empty"}
+	}
+	if parsed.IsResourceCollection() || parsed.IsScopeCollection() {
+		return resources.ID{}, &store.ErrInvalid{Message: "invalid argument. 'id' must refer to a named resource, not a collection"}
+	}
+
+	return parsed, nil
+}
+
+// StorageKey returns the storage key for the given resource id. The key joins the storage prefix, root scope and
+// routing scope using KeySeparator, for example:
+//
+//	resource|/planes/radius/local/resourcegroups/cool-group/|/applications.core/applications/cool-app/
+//
+// Sorting by this key groups resources by scope, which stores use for ordering and pagination.
+func StorageKey(id resources.ID) string {
+	prefix, rootScope, routingScope, _ := ExtractStorageParts(id)
+	return strings.Join([]string{prefix, rootScope, routingScope}, KeySeparator)
+}
+
 // IDMatchesQuery checks if the given ID matches the given query.
 func IDMatchesQuery(id resources.ID, query store.Query) bool {
 	prefix, rootScope, routingScope, resourceType := ExtractStorageParts(id)
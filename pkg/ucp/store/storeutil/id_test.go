@@ -106,6 +106,34 @@ func Test_ExtractStorageParts(t *testing.T) {
 	}
 }
 
+func Test_ParseNamedID(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		id, err := ParseNamedID("/planes/radius/local/resourceGroups/cool-group/providers/Applications.Core/applications/cool-app")
+		require.NoError(t, err)
+		require.Equal(t, "cool-app", id.Name())
+	})
+
+	invalid := []string{
+		"not a resource id",
+		"",
+		"/planes/radius/local/resourceGroups/cool-group/providers/Applications.Core/applications",
+	}
+	for _, id := range invalid {
+		t.Run(id, func(t *testing.T) {
+			_, err := ParseNamedID(id)
+			require.ErrorAs(t, err, new(*store.ErrInvalid))
+		})
+	}
+}
+
+func Test_StorageKey(t *testing.T) {
+	id := resources.MustParse("/planes/radius/local/resourceGroups/cool-group/providers/Applications.Core/applications/cool-app")
+	require.Equal(t, "resource|/planes/radius/local/resourcegroups/cool-group/|/applications.core/applications/cool-app/", StorageKey(id))
+
+	id = resources.MustParse("/planes/radius/local/resourceGroups/cool-group")
+	require.Equal(t, "scope|/planes/radius/local/|/resourcegroups/cool-group/", StorageKey(id))
+}
+
 func Test_IDMatchesQuery(t *testing.T) {
 	type testcase struct {
 		ID      string
@@ -0,0 +1,44 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storeutil
+
+import (
+	"encoding/base64"
+
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+// EncodePaginationToken encodes the key of the last item in a page as an opaque pagination token. This is used by stores
+// that return query results ordered by key, where the next page starts after the given key.
+func EncodePaginationToken(key string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(key))
+}
+
+// DecodePaginationToken decodes a pagination token created by EncodePaginationToken. An empty token decodes to an empty key.
+// Returns store.ErrInvalid if the token is not valid.
+func DecodePaginationToken(token string) (string, error) {
+	if token == "" {
+		return "", nil
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || len(b) == 0 {
+		return "", &store.ErrInvalid{Message: "invalid argument. 'PaginationToken' is invalid"}
+	}
+
+	return string(b), nil
+}
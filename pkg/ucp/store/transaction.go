@@ -0,0 +1,188 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"context"
+	"errors"
+	"strings"
+)
+
+// TransactionalStorageClient is implemented by StorageClient implementations that can commit writes to multiple
+// objects atomically. Not every storage backend supports transactions, so callers should use ExecuteTransaction
+// which falls back to applying the operations one at a time when the client does not implement this interface.
+type TransactionalStorageClient interface {
+	StorageClient
+
+	// ExecuteTransaction commits all of the operations in the transaction atomically. Either every operation is
+	// applied or none of them are.
+	//
+	// The operations follow the same rules as Save and Delete. If the ETag of any operation does not match then
+	// ErrConcurrency is returned, and if an object to delete without an ETag does not exist then ErrNotFound is
+	// returned. A transaction must not contain more than one operation for the same object. On success the ETag
+	// of each saved object is updated.
+	ExecuteTransaction(ctx context.Context, tx *Transaction) error
+}
+
+// TransactionOperationType is the type of an operation in a Transaction.
+type TransactionOperationType string
+
+const (
+	// TransactionOperationSave saves an object.
+	TransactionOperationSave TransactionOperationType = "Save"
+
+	// TransactionOperationDelete deletes an object.
+	TransactionOperationDelete TransactionOperationType = "Delete"
+)
+
+// TransactionOperation is a single write in a Transaction.
+type TransactionOperation struct {
+	// Type is the type of the operation.
+	Type TransactionOperationType
+
+	// Object is the object to save. Only set for save operations.
+	Object *Object
+
+	// ID is the id of the object to delete. Only set for delete operations.
+	ID string
+
+	// ETag is the ETag that the stored object must match. Optional.
+	ETag ETag
+}
+
+// Transaction is a set of writes that are committed together. Use ExecuteTransaction to commit the transaction.
+type Transaction struct {
+	// Operations is the ordered list of operations in the transaction.
+	Operations []TransactionOperation
+}
+
+// Save adds an operation to save the object to the transaction. The ETag of the object is updated when the
+// transaction is committed.
+func (tx *Transaction) Save(obj *Object, options ...SaveOptions) *Transaction {
+	config := NewSaveConfig(options...)
+	tx.Operations = append(tx.Operations, TransactionOperation{Type: TransactionOperationSave, Object: obj, ETag: config.ETag})
+	return tx
+}
+
+// Delete adds an operation to delete the object with the given id to the transaction.
+func (tx *Transaction) Delete(id string, options ...DeleteOptions) *Transaction {
+	config := NewDeleteConfig(options...)
+	tx.Operations = append(tx.Operations, TransactionOperation{Type: TransactionOperationDelete, ID: id, ETag: config.ETag})
+	return tx
+}
+
+// ExecuteTransaction commits the transaction using the given client. If the client implements TransactionalStorageClient
+// the transaction is committed atomically, otherwise it is committed using ExecuteTransactionBestEffort.
+func ExecuteTransaction(ctx context.Context, client StorageClient, tx *Transaction) error {
+	if tx == nil {
+		return &ErrInvalid{Message: "invalid argument. 'tx' is required"}
+	}
+
+	if transactional, ok := client.(TransactionalStorageClient); ok {
+		return transactional.ExecuteTransaction(ctx, tx)
+	}
+
+	return ExecuteTransactionBestEffort(ctx, client, tx)
+}
+
+// ExecuteTransactionBestEffort commits the transaction for storage clients that do not support transactions. The
+// operations are applied in order, and if one of them fails the operations that were already applied are undone
+// in reverse order. Undoing the operations is best-effort: it can fail, for example if the objects are modified
+// concurrently or the process crashes, and restored objects are assigned a new ETag.
+func ExecuteTransactionBestEffort(ctx context.Context, client StorageClient, tx *Transaction) error {
+	if tx == nil {
+		return &ErrInvalid{Message: "invalid argument. 'tx' is required"}
+	}
+
+	seen := map[string]struct{}{}
+	for _, op := range tx.Operations {
+		id := op.ID
+		if op.Type == TransactionOperationSave && op.Object != nil {
+			id = op.Object.ID
+		}
+
+		key := strings.ToLower(id)
+		if _, ok := seen[key]; ok {
+			return &ErrInvalid{Message: "invalid argument. a transaction must not contain more than one operation for the same object"}
+		}
+		seen[key] = struct{}{}
+	}
+
+	// undo holds the functions that revert each applied operation.
+	undo := []func() error{}
+	rollback := func(err error) error {
+		for i := len(undo) - 1; i >= 0; i-- {
+			if undoErr := undo[i](); undoErr != nil {
+				return errors.Join(err, undoErr)
+			}
+		}
+		return err
+	}
+
+	for _, op := range tx.Operations {
+		id := op.ID
+		if op.Type == TransactionOperationSave {
+			if op.Object == nil {
+				return rollback(&ErrInvalid{Message: "invalid argument. 'obj' is required"})
+			}
+			id = op.Object.ID
+		}
+
+		// Capture the previous state of the object so the operation can be undone.
+		previous, err := client.Get(ctx, id)
+		if errors.Is(err, &ErrNotFound{}) {
+			previous = nil
+		} else if err != nil {
+			return rollback(err)
+		}
+
+		switch op.Type {
+		case TransactionOperationSave:
+			err = client.Save(ctx, op.Object, WithETag(op.ETag))
+			if err != nil {
+				return rollback(err)
+			}
+
+			obj := op.Object
+			undo = append(undo, func() error {
+				if previous == nil {
+					return client.Delete(ctx, obj.ID, WithETag(obj.ETag))
+				}
+				return client.Save(ctx, previous, WithETag(obj.ETag))
+			})
+
+		case TransactionOperationDelete:
+			err = client.Delete(ctx, id, WithETag(op.ETag))
+			if err != nil {
+				return rollback(err)
+			}
+
+			undo = append(undo, func() error {
+				if previous == nil {
+					return nil
+				}
+				previous.ETag = ""
+				return client.Save(ctx, previous)
+			})
+
+		default:
+			return rollback(&ErrInvalid{Message: "invalid argument. unsupported transaction operation type " + string(op.Type)})
+		}
+	}
+
+	return nil
+}
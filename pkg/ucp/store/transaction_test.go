@@ -0,0 +1,41 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store_test
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/store/inmemory"
+	shared "github.com/radius-project/radius/test/ucp/storetest"
+)
+
+// nonTransactionalClient hides the ExecuteTransaction method of the wrapped client so that the best-effort
+// fallback is used.
+type nonTransactionalClient struct {
+	store.StorageClient
+}
+
+func Test_ExecuteTransactionBestEffort(t *testing.T) {
+	client := inmemory.NewClient()
+
+	clear := func(t *testing.T) {
+		client.Clear()
+	}
+
+	shared.RunTransactionTest(t, nonTransactionalClient{StorageClient: client}, clear)
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"context"
+)
+
+// WatchableStorageClient is implemented by StorageClient implementations that can stream changes to objects.
+// Not every storage backend supports watching, so callers should use a type assertion and fall back to polling
+// with Query or Get when the client does not implement this interface.
+type WatchableStorageClient interface {
+	StorageClient
+
+	// Watch streams changes to the objects matching the query, starting from the time Watch is called. The same
+	// rules that apply to Query are used to validate the query and to match objects. Filters are evaluated against
+	// the new state of the object, or the last known state of the object for deletions.
+	//
+	// The returned channel is closed when the context is cancelled or when the watch can no longer be continued,
+	// for example because the connection to the backend was lost. Callers that need to observe every change should
+	// Query again after the channel is closed and then start a new watch.
+	Watch(ctx context.Context, query Query) (<-chan WatchEvent, error)
+}
+
+// WatchEventType is the type of change reported by a WatchEvent.
+type WatchEventType string
+
+const (
+	// WatchEventCreated is used when an object is created.
+	WatchEventCreated WatchEventType = "Created"
+
+	// WatchEventUpdated is used when an existing object is updated.
+	WatchEventUpdated WatchEventType = "Updated"
+
+	// WatchEventDeleted is used when an object is deleted.
+	WatchEventDeleted WatchEventType = "Deleted"
+)
+
+// WatchEvent describes a change to an object in the store.
+type WatchEvent struct {
+	// Type is the type of change.
+	Type WatchEventType
+
+	// Object is the state of the object after the change. For deletions this is the last known state of the object.
+	// The ETag of the object identifies the version of the object produced by the change.
+	Object Object
+}
@@ -57,7 +57,8 @@ func StartEnvironment(crdPaths []string) (runtimeclient.Client, *envtest.Environ
 		return nil, nil, fmt.Errorf("failed to initialize environment: %w", err)
 	}
 
-	client, err := runtimeclient.New(cfg, runtimeclient.Options{
+	// Use a client that supports watches so that watch-based features can be tested.
+	client, err := runtimeclient.NewWithWatch(cfg, runtimeclient.Options{
 		Scheme: scheme,
 	})
 	if err != nil {
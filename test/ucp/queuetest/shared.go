@@ -204,6 +204,35 @@ func RunTest(t *testing.T, cli client.Client, clear func(t *testing.T)) {
 		require.ErrorIs(t, err, client.ErrInvalidMessage)
 	})
 
+	t.Run("finish message leased by another client", func(t *testing.T) {
+		clear(t)
+
+		err := queueTestMessage(cli, 1)
+		require.NoError(t, err)
+
+		msg1, err := cli.Dequeue(ctx, client.QueueClientConfig{})
+		require.NoError(t, err)
+
+		// Dequeue until the lock of msg1 expires and the message is leased again.
+		var msg2 *client.Message
+		for {
+			msg2, err = cli.Dequeue(ctx, client.QueueClientConfig{})
+			if err == nil {
+				break
+			}
+			time.Sleep(pollingInterval)
+		}
+		require.Equal(t, msg1.ID, msg2.ID)
+		require.Greater(t, msg2.DequeueCount, msg1.DequeueCount)
+
+		// The lease of msg1 was taken over, so it must not delete the message.
+		err = cli.FinishMessage(ctx, msg1)
+		require.ErrorIs(t, err, client.ErrDequeuedMessage)
+
+		err = cli.FinishMessage(ctx, msg2)
+		require.NoError(t, err)
+	})
+
 	t.Run("StartDequeuer dequeues message via channel", func(t *testing.T) {
 		clear(t)
 		msgCh, err := client.StartDequeuer(ctx, cli, client.WithDequeueInterval(defaultTestDequeueInterval))
@@ -240,3 +269,124 @@ func RunTest(t *testing.T, cli client.Client, clear func(t *testing.T)) {
 		require.Equal(t, msgCount, recvCnt)
 	})
 }
+
+// RunDeadLetterTest tests the client's DeadLetterMessage, ListDeadLetterMessages, GetDeadLetterMessage,
+// RequeueDeadLetterMessage and PurgeDeadLetterMessage methods.
+func RunDeadLetterTest(t *testing.T, cli client.DeadLetterClient, clear func(t *testing.T)) {
+	ctx, cancel := testcontext.NewWithCancel(t)
+	t.Cleanup(cancel)
+
+	t.Run("dead letter nil message", func(t *testing.T) {
+		err := cli.DeadLetterMessage(ctx, nil, "reason")
+		require.ErrorIs(t, err, client.ErrEmptyMessage)
+	})
+
+	t.Run("dead letter message not found", func(t *testing.T) {
+		clear(t)
+
+		_, err := cli.GetDeadLetterMessage(ctx, "not-found")
+		require.ErrorIs(t, err, client.ErrDeadLetterMessageNotFound)
+		err = cli.RequeueDeadLetterMessage(ctx, "not-found")
+		require.ErrorIs(t, err, client.ErrDeadLetterMessageNotFound)
+		err = cli.PurgeDeadLetterMessage(ctx, "not-found")
+		require.ErrorIs(t, err, client.ErrDeadLetterMessageNotFound)
+
+		messages, err := cli.ListDeadLetterMessages(ctx)
+		require.NoError(t, err)
+		require.Empty(t, messages)
+	})
+
+	t.Run("dead letter, requeue and purge message", func(t *testing.T) {
+		clear(t)
+
+		err := queueTestMessage(cli, 1)
+		require.NoError(t, err)
+
+		msg, err := cli.Dequeue(ctx, client.QueueClientConfig{})
+		require.NoError(t, err)
+
+		err = cli.DeadLetterMessage(ctx, msg, "poison message")
+		require.NoError(t, err)
+
+		// Dead-lettered messages can't be dequeued, extended or dead-lettered again.
+		_, err = cli.Dequeue(ctx, client.QueueClientConfig{})
+		require.ErrorIs(t, err, client.ErrMessageNotFound)
+		err = cli.ExtendMessage(ctx, msg)
+		require.ErrorIs(t, err, client.ErrInvalidMessage)
+		err = cli.DeadLetterMessage(ctx, msg, "poison message")
+		require.ErrorIs(t, err, client.ErrInvalidMessage)
+
+		messages, err := cli.ListDeadLetterMessages(ctx)
+		require.NoError(t, err)
+		require.Len(t, messages, 1)
+		require.Equal(t, msg.ID, messages[0].ID)
+		require.Equal(t, "poison message", messages[0].DeadLetterReason)
+		require.False(t, messages[0].DeadLetteredAt.IsZero())
+		require.Equal(t, msg.Data, messages[0].Data)
+
+		deadLettered, err := cli.GetDeadLetterMessage(ctx, msg.ID)
+		require.NoError(t, err)
+		require.Equal(t, "poison message", deadLettered.DeadLetterReason)
+		require.Equal(t, 1, deadLettered.DequeueCount)
+
+		err = cli.RequeueDeadLetterMessage(ctx, msg.ID)
+		require.NoError(t, err)
+
+		_, err = cli.GetDeadLetterMessage(ctx, msg.ID)
+		require.ErrorIs(t, err, client.ErrDeadLetterMessageNotFound)
+
+		// Requeued messages are delivered again with a reset dequeue count.
+		var requeued *client.Message
+		require.Eventually(t, func() bool {
+			requeued, err = cli.Dequeue(ctx, client.QueueClientConfig{})
+			return err == nil
+		}, TestMessageLockTime*5, pollingInterval)
+		require.Equal(t, msg.ID, requeued.ID)
+		require.Equal(t, 1, requeued.DequeueCount)
+		require.Empty(t, requeued.DeadLetterReason)
+
+		err = cli.DeadLetterMessage(ctx, requeued, "poison message")
+		require.NoError(t, err)
+
+		err = cli.PurgeDeadLetterMessage(ctx, msg.ID)
+		require.NoError(t, err)
+
+		messages, err = cli.ListDeadLetterMessages(ctx)
+		require.NoError(t, err)
+		require.Empty(t, messages)
+	})
+}
+
+// RunNotifyTest tests that StartDequeuer is woken up by the client's notifications instead of waiting for the next
+// polling interval.
+func RunNotifyTest(t *testing.T, cli client.Client, clear func(t *testing.T)) {
+	require.Implements(t, (*client.Notifier)(nil), cli)
+
+	t.Run("StartDequeuer is notified of new messages", func(t *testing.T) {
+		clear(t)
+		ctx, cancel := testcontext.NewWithCancel(t)
+		defer cancel()
+
+		// Use a polling interval longer than the test timeout so that only notifications can deliver the messages.
+		msgCh, err := client.StartDequeuer(ctx, cli, client.WithDequeueInterval(time.Hour))
+		require.NoError(t, err)
+
+		// Wait until the dequeuer is waiting for the next notification.
+		time.Sleep(pollingInterval)
+
+		msgCount := 3
+		err = queueTestMessage(cli, msgCount)
+		require.NoError(t, err)
+
+		for i := 0; i < msgCount; i++ {
+			select {
+			case msg := <-msgCh:
+				require.Equal(t, 1, msg.DequeueCount)
+				err = cli.FinishMessage(ctx, msg)
+				require.NoError(t, err)
+			case <-time.After(10 * time.Second):
+				require.Fail(t, "timed out waiting for the notified message")
+			}
+		}
+	})
+}
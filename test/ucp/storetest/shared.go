@@ -15,11 +15,18 @@ limitations under the License.
 */
 
 // package storetest contains SHARED testing logic that is common to our data-store implementations.
+//
+// RunTest is a conformance suite for store.StorageClient. Every implementation of store.StorageClient, including
+// implementations that live outside this repository, should pass it so that the resource providers can rely on
+// the same semantics for Query, Save, Delete, ETags and pagination regardless of the configured backend.
 package storetest
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/radius-project/radius/pkg/ucp/store"
@@ -44,6 +51,8 @@ const (
 	ResourceGroup2Scope = "/planes/radius/local/resourceGroups/group2"
 	ARMResourceScope    = "/subscriptions/abc/resourceGroups/group3"
 	APIVersion          = "test-api-version"
+
+	watchTimeout = 10 * time.Second
 )
 
 var ResourceGroup1ID = parseOrPanic(ResourceGroup1Scope)
@@ -359,6 +368,69 @@ func RunTest(t *testing.T, client store.StorageClient, clear func(t *testing.T))
 		require.Empty(t, objs)
 	})
 
+	t.Run("get_invalid_id", func(t *testing.T) {
+		clear(t)
+
+		_, err := client.Get(ctx, "")
+		require.ErrorIs(t, err, &store.ErrInvalid{})
+
+		_, err = client.Get(ctx, ResourceGroup1Scope+"/providers/"+ResourceType1)
+		require.ErrorIs(t, err, &store.ErrInvalid{})
+	})
+
+	t.Run("delete_invalid_id", func(t *testing.T) {
+		clear(t)
+
+		err := client.Delete(ctx, "")
+		require.ErrorIs(t, err, &store.ErrInvalid{})
+
+		err = client.Delete(ctx, ResourceGroup1Scope+"/providers/"+ResourceType1)
+		require.ErrorIs(t, err, &store.ErrInvalid{})
+	})
+
+	t.Run("save_updates_etag", func(t *testing.T) {
+		clear(t)
+
+		obj1 := createObject(Resource1ID, Data1)
+		err := client.Save(ctx, &obj1)
+		require.NoError(t, err)
+		original := obj1.ETag
+
+		obj1.Data = Data2
+		err = client.Save(ctx, &obj1, store.WithETag(original))
+		require.NoError(t, err)
+		require.NotEmpty(t, obj1.ETag)
+		require.NotEqual(t, original, obj1.ETag)
+
+		obj1Get, err := client.Get(ctx, Resource1ID.String())
+		require.NoError(t, err)
+		require.Equal(t, obj1.ETag, obj1Get.ETag)
+
+		// The original ETag is now stale.
+		obj1.Data = Data1
+		err = client.Save(ctx, &obj1, store.WithETag(original))
+		require.ErrorIs(t, err, &store.ErrConcurrency{})
+
+		err = client.Delete(ctx, Resource1ID.String(), store.WithETag(original))
+		require.ErrorIs(t, err, &store.ErrConcurrency{})
+	})
+
+	t.Run("query_invalid", func(t *testing.T) {
+		clear(t)
+
+		_, err := client.Query(ctx, store.Query{})
+		require.ErrorIs(t, err, &store.ErrInvalid{})
+
+		_, err = client.Query(ctx, store.Query{RootScope: RadiusScope, IsScopeQuery: true, RoutingScopePrefix: ResourcePath1})
+		require.ErrorIs(t, err, &store.ErrInvalid{})
+
+		_, err = client.Query(ctx, store.Query{RootScope: RadiusScope, Filters: []store.QueryFilter{{Field: "value'", Value: "1"}}})
+		require.ErrorIs(t, err, &store.ErrInvalid{})
+
+		_, err = client.Query(ctx, store.Query{RootScope: RadiusScope, Filters: []store.QueryFilter{{Field: "value", Operator: store.FilterOperatorIn}}})
+		require.ErrorIs(t, err, &store.ErrInvalid{})
+	})
+
 	t.Run("query_planes", func(t *testing.T) {
 		clear(t)
 
@@ -416,6 +488,16 @@ func RunTest(t *testing.T, client store.StorageClient, clear func(t *testing.T))
 			CompareObjectLists(t, expected, objs.Items)
 		})
 
+		t.Run("query_resources_at_resource_group_scope_with_nested_field_filter", func(t *testing.T) {
+			filters := []store.QueryFilter{{Field: "properties.resource", Value: "1"}}
+			objs, err := client.Query(ctx, store.Query{RootScope: ResourceGroup1Scope, Filters: filters})
+			require.NoError(t, err)
+			expected := []store.Object{
+				obj1,
+			}
+			CompareObjectLists(t, expected, objs.Items)
+		})
+
 		t.Run("query_resources_at_resource_group_scope_with_prefix", func(t *testing.T) {
 			objs, err := client.Query(ctx, store.Query{RootScope: ResourceGroup1Scope, RoutingScopePrefix: ResourcePath1})
 			require.NoError(t, err)
@@ -435,6 +517,15 @@ func RunTest(t *testing.T, client store.StorageClient, clear func(t *testing.T))
 			CompareObjectLists(t, expected, objs.Items)
 		})
 
+		t.Run("query_resources_at_resource_group_scope_with_top_level_type_filter", func(t *testing.T) {
+			objs, err := client.Query(ctx, store.Query{RootScope: ResourceGroup1Scope, ResourceType: ResourceType1})
+			require.NoError(t, err)
+			expected := []store.Object{
+				obj1,
+			}
+			CompareObjectLists(t, expected, objs.Items)
+		})
+
 		t.Run("query_resources_at_resource_group_scope_with_prefix_and_type_filter", func(t *testing.T) {
 			objs, err := client.Query(ctx, store.Query{RootScope: ResourceGroup1Scope, RoutingScopePrefix: ResourcePath1, ResourceType: NestedResourceType1})
 			require.NoError(t, err)
@@ -485,6 +576,62 @@ func RunTest(t *testing.T, client store.StorageClient, clear func(t *testing.T))
 			CompareObjectLists(t, expected, objs.Items)
 		})
 
+		t.Run("query_resources_at_plane_scope_recursive_with_filter_operators", func(t *testing.T) {
+			testCases := []struct {
+				name     string
+				filters  []store.QueryFilter
+				expected []store.Object
+			}{
+				{
+					name:     "not_equal",
+					filters:  []store.QueryFilter{{Field: "value", Operator: store.FilterOperatorNotEqual, Value: "1"}},
+					expected: []store.Object{obj2, nested1},
+				},
+				{
+					name:     "not_equal_missing_field",
+					filters:  []store.QueryFilter{{Field: "properties.group", Operator: store.FilterOperatorNotEqual, Value: "1"}},
+					expected: []store.Object{obj1, obj2, nested1},
+				},
+				{
+					name:     "in",
+					filters:  []store.QueryFilter{{Field: "value", Operator: store.FilterOperatorIn, Values: []string{"1", "3"}}},
+					expected: []store.Object{obj1, nested1},
+				},
+				{
+					name:     "prefix",
+					filters:  []store.QueryFilter{{Field: "properties.resource", Operator: store.FilterOperatorPrefix, Value: "2"}},
+					expected: []store.Object{obj2},
+				},
+				{
+					name:     "exists",
+					filters:  []store.QueryFilter{{Field: "properties.resource", Operator: store.FilterOperatorExists}},
+					expected: []store.Object{obj1, obj2, nested1},
+				},
+				{
+					name:     "exists_missing_field",
+					filters:  []store.QueryFilter{{Field: "properties.group", Operator: store.FilterOperatorExists}},
+					expected: []store.Object{},
+				},
+				{
+					name: "combined",
+					filters: []store.QueryFilter{
+						{Field: "properties.resource", Operator: store.FilterOperatorExists},
+						{Field: "value", Operator: store.FilterOperatorNotEqual, Value: "2"},
+						{Field: "value", Operator: store.FilterOperatorIn, Values: []string{"2", "3"}},
+					},
+					expected: []store.Object{nested1},
+				},
+			}
+
+			for _, tc := range testCases {
+				t.Run(tc.name, func(t *testing.T) {
+					objs, err := client.Query(ctx, store.Query{RootScope: RadiusScope, ScopeRecursive: true, Filters: tc.filters})
+					require.NoError(t, err)
+					CompareObjectLists(t, tc.expected, objs.Items)
+				})
+			}
+		})
+
 		t.Run("query_resources_at_plane_scope_recursive_with_prefix", func(t *testing.T) {
 			objs, err := client.Query(ctx, store.Query{RootScope: RadiusScope, ScopeRecursive: true, RoutingScopePrefix: ResourcePath1})
 			require.NoError(t, err)
@@ -588,4 +735,291 @@ func RunTest(t *testing.T, client store.StorageClient, clear func(t *testing.T))
 			CompareObjectLists(t, expected, objs.Items)
 		})
 	})
+
+	t.Run("query_paginated", func(t *testing.T) {
+		clear(t)
+
+		expected := []store.Object{}
+		for i := 0; i < 7; i++ {
+			obj := createObject(parseOrPanic(fmt.Sprintf("%s/providers/%s/paged%d", ResourceGroup1Scope, ResourceType1, i)), map[string]any{
+				"value": fmt.Sprintf("%d", i%2),
+			})
+			err := client.Save(ctx, &obj)
+			require.NoError(t, err)
+			expected = append(expected, obj)
+		}
+
+		// Resources outside of the query must not be returned or counted towards a page.
+		other := createObject(Resource2ID, Data2)
+		err := client.Save(ctx, &other)
+		require.NoError(t, err)
+
+		query := store.Query{RootScope: ResourceGroup1Scope, ResourceType: ResourceType1}
+
+		t.Run("all_pages", func(t *testing.T) {
+			actual := queryAllPages(t, client, query, 3)
+			CompareObjectLists(t, expected, actual)
+		})
+
+		t.Run("page_larger_than_results", func(t *testing.T) {
+			objs, err := client.Query(ctx, query, store.WithMaxQueryItemCount(100))
+			require.NoError(t, err)
+			require.Empty(t, objs.PaginationToken)
+			CompareObjectLists(t, expected, objs.Items)
+		})
+
+		t.Run("page_matching_results", func(t *testing.T) {
+			objs, err := client.Query(ctx, query, store.WithMaxQueryItemCount(len(expected)))
+			require.NoError(t, err)
+			require.Empty(t, objs.PaginationToken)
+			CompareObjectLists(t, expected, objs.Items)
+		})
+
+		t.Run("with_field_filter", func(t *testing.T) {
+			filtered := store.Query{RootScope: ResourceGroup1Scope, ResourceType: ResourceType1, Filters: []store.QueryFilter{{Field: "value", Value: "0"}}}
+			actual := queryAllPages(t, client, filtered, 2)
+			CompareObjectLists(t, []store.Object{expected[0], expected[2], expected[4], expected[6]}, actual)
+		})
+
+		t.Run("invalid_token", func(t *testing.T) {
+			_, err := client.Query(ctx, query, store.WithPaginationToken("!!!"))
+			require.ErrorIs(t, err, &store.ErrInvalid{})
+		})
+	})
+}
+
+// queryAllPages executes the query with the given page size and returns the combined results of all pages. It verifies
+// that each page respects the page size and that no item is returned twice.
+func queryAllPages(t *testing.T, client store.StorageClient, query store.Query, pageSize int) []store.Object {
+	t.Helper()
+	ctx := testcontext.New(t)
+
+	results := []store.Object{}
+	seen := map[string]bool{}
+	token := ""
+	for {
+		objs, err := client.Query(ctx, query, store.WithMaxQueryItemCount(pageSize), store.WithPaginationToken(token))
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(objs.Items), pageSize)
+
+		for _, obj := range objs.Items {
+			require.Falsef(t, seen[obj.ID], "item %s was returned more than once", obj.ID)
+			seen[obj.ID] = true
+		}
+		results = append(results, objs.Items...)
+
+		if objs.PaginationToken == "" {
+			return results
+		}
+
+		// Every page except the last one must be full.
+		require.Len(t, objs.Items, pageSize)
+		token = objs.PaginationToken
+	}
+}
+
+// RunWatchTest tests the Watch method of a store.WatchableStorageClient by making changes to objects and verifying
+// the events that are reported.
+func RunWatchTest(t *testing.T, client store.WatchableStorageClient, clear func(t *testing.T)) {
+	ctx, cancel := testcontext.NewWithCancel(t)
+	t.Cleanup(cancel)
+
+	t.Run("watch_invalid", func(t *testing.T) {
+		_, err := client.Watch(ctx, store.Query{})
+		require.ErrorIs(t, err, &store.ErrInvalid{})
+	})
+
+	t.Run("watch_create_update_delete", func(t *testing.T) {
+		clear(t)
+
+		watchCtx, watchCancel := context.WithCancel(ctx)
+		defer watchCancel()
+
+		events, err := client.Watch(watchCtx, store.Query{RootScope: ResourceGroup1Scope})
+		require.NoError(t, err)
+
+		obj1 := createObject(Resource1ID, Data1)
+		err = client.Save(ctx, &obj1)
+		require.NoError(t, err)
+
+		event := receiveEvent(t, events)
+		require.Equal(t, store.WatchEventCreated, event.Type)
+		require.Equal(t, obj1.ETag, event.Object.ETag)
+		compareObjects(t, &obj1, &event.Object)
+
+		// Changes outside of the query must not be reported.
+		obj2 := createObject(Resource2ID, Data2)
+		err = client.Save(ctx, &obj2)
+		require.NoError(t, err)
+
+		obj1.Data = Data2
+		err = client.Save(ctx, &obj1)
+		require.NoError(t, err)
+
+		event = receiveEvent(t, events)
+		require.Equal(t, store.WatchEventUpdated, event.Type)
+		require.Equal(t, obj1.ETag, event.Object.ETag)
+		compareObjects(t, &obj1, &event.Object)
+
+		err = client.Delete(ctx, Resource1ID.String())
+		require.NoError(t, err)
+
+		event = receiveEvent(t, events)
+		require.Equal(t, store.WatchEventDeleted, event.Type)
+		require.Equal(t, obj1.ID, event.Object.ID)
+
+		// Cancelling the context closes the channel.
+		watchCancel()
+		requireClosed(t, events)
+	})
+
+	t.Run("watch_with_field_filter", func(t *testing.T) {
+		clear(t)
+
+		watchCtx, watchCancel := context.WithCancel(ctx)
+		defer watchCancel()
+
+		filters := []store.QueryFilter{{Field: "value", Value: "1"}}
+		events, err := client.Watch(watchCtx, store.Query{RootScope: RadiusScope, ScopeRecursive: true, Filters: filters})
+		require.NoError(t, err)
+
+		obj2 := createObject(Resource2ID, Data2)
+		err = client.Save(ctx, &obj2)
+		require.NoError(t, err)
+
+		obj1 := createObject(Resource1ID, Data1)
+		err = client.Save(ctx, &obj1)
+		require.NoError(t, err)
+
+		event := receiveEvent(t, events)
+		require.Equal(t, store.WatchEventCreated, event.Type)
+		compareObjects(t, &obj1, &event.Object)
+	})
+}
+
+// RunTransactionTest tests committing a store.Transaction with the client using store.ExecuteTransaction. Clients that
+// implement store.TransactionalStorageClient commit the transaction atomically, other clients use the best-effort
+// fallback. Both must leave the store unchanged when the transaction fails.
+func RunTransactionTest(t *testing.T, client store.StorageClient, clear func(t *testing.T)) {
+	ctx, cancel := testcontext.NewWithCancel(t)
+	t.Cleanup(cancel)
+
+	t.Run("transaction_empty", func(t *testing.T) {
+		err := store.ExecuteTransaction(ctx, client, &store.Transaction{})
+		require.NoError(t, err)
+	})
+
+	t.Run("transaction_save_multiple", func(t *testing.T) {
+		clear(t)
+
+		obj1 := createObject(Resource1ID, Data1)
+		obj2 := createObject(Resource2ID, Data2)
+		tx := (&store.Transaction{}).Save(&obj1).Save(&obj2)
+		err := store.ExecuteTransaction(ctx, client, tx)
+		require.NoError(t, err)
+
+		for _, expected := range []*store.Object{&obj1, &obj2} {
+			require.NotEmpty(t, expected.ETag)
+
+			actual, err := client.Get(ctx, expected.ID)
+			require.NoError(t, err)
+			require.Equal(t, expected.ETag, actual.ETag)
+			compareObjects(t, expected, actual)
+		}
+	})
+
+	t.Run("transaction_save_and_delete_with_matching_etag", func(t *testing.T) {
+		clear(t)
+
+		obj1 := createObject(Resource1ID, Data1)
+		err := client.Save(ctx, &obj1)
+		require.NoError(t, err)
+
+		obj2 := createObject(Resource2ID, Data2)
+		tx := (&store.Transaction{}).Save(&obj2).Delete(obj1.ID, store.WithETag(obj1.ETag))
+		err = store.ExecuteTransaction(ctx, client, tx)
+		require.NoError(t, err)
+
+		_, err = client.Get(ctx, obj1.ID)
+		require.ErrorIs(t, err, &store.ErrNotFound{})
+
+		actual, err := client.Get(ctx, obj2.ID)
+		require.NoError(t, err)
+		compareObjects(t, &obj2, actual)
+	})
+
+	t.Run("transaction_not_matching_etag_writes_nothing", func(t *testing.T) {
+		clear(t)
+
+		obj1 := createObject(Resource1ID, Data1)
+		err := client.Save(ctx, &obj1)
+		require.NoError(t, err)
+
+		obj2 := createObject(Resource2ID, Data2)
+		updated := createObject(Resource1ID, Data2)
+		tx := (&store.Transaction{}).Save(&obj2).Save(&updated, store.WithETag("not-matching"))
+		err = store.ExecuteTransaction(ctx, client, tx)
+		require.ErrorIs(t, err, &store.ErrConcurrency{})
+
+		_, err = client.Get(ctx, obj2.ID)
+		require.ErrorIs(t, err, &store.ErrNotFound{})
+
+		actual, err := client.Get(ctx, obj1.ID)
+		require.NoError(t, err)
+		compareObjects(t, &obj1, actual)
+	})
+
+	t.Run("transaction_delete_not_found_writes_nothing", func(t *testing.T) {
+		clear(t)
+
+		obj1 := createObject(Resource1ID, Data1)
+		tx := (&store.Transaction{}).Save(&obj1).Delete(Resource2ID.String())
+		err := store.ExecuteTransaction(ctx, client, tx)
+		require.ErrorIs(t, err, &store.ErrNotFound{})
+
+		_, err = client.Get(ctx, obj1.ID)
+		require.ErrorIs(t, err, &store.ErrNotFound{})
+	})
+
+	t.Run("transaction_duplicate_object_invalid", func(t *testing.T) {
+		clear(t)
+
+		obj1 := createObject(Resource1ID, Data1)
+		tx := (&store.Transaction{}).Save(&obj1).Delete(Resource1ID.String())
+		err := store.ExecuteTransaction(ctx, client, tx)
+		require.ErrorIs(t, err, &store.ErrInvalid{})
+
+		_, err = client.Get(ctx, obj1.ID)
+		require.ErrorIs(t, err, &store.ErrNotFound{})
+	})
+}
+
+func receiveEvent(t *testing.T, events <-chan store.WatchEvent) store.WatchEvent {
+	t.Helper()
+
+	select {
+	case event, ok := <-events:
+		require.True(t, ok, "watch channel was closed unexpectedly")
+		return event
+	case <-time.After(watchTimeout):
+		require.Fail(t, "timed out waiting for watch event")
+		return store.WatchEvent{}
+	}
+}
+
+func requireClosed(t *testing.T, events <-chan store.WatchEvent) {
+	t.Helper()
+
+	timeout := time.After(watchTimeout)
+	for {
+		select {
+		case _, ok := <-events:
+			if !ok {
+				return
+			}
+		case <-timeout:
+			require.Fail(t, "timed out waiting for watch channel to close")
+			return
+		}
+	}
 }